@@ -0,0 +1,29 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/argoproj/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// printRerunWorkflow prints a workflow that was created from an archived workflow
+func printRerunWorkflow(wf *wfv1.Workflow, output string) {
+	switch output {
+	case "name":
+		fmt.Println(wf.Name)
+	case "json":
+		data, err := json.Marshal(wf)
+		errors.CheckError(err)
+		fmt.Println(string(data))
+	case "yaml":
+		data, err := yaml.Marshal(wf)
+		errors.CheckError(err)
+		fmt.Println(string(data))
+	default:
+		fmt.Printf("Workflow '%s' created in namespace '%s'\n", wf.Name, wf.Namespace)
+	}
+}
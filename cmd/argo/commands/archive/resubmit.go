@@ -0,0 +1,51 @@
+package archive
+
+import (
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+func NewResubmitCommand() *cobra.Command {
+	var (
+		output     string
+		memoized   bool
+		parameters []string
+	)
+	command := &cobra.Command{
+		Use:   "resubmit UID",
+		Short: "resubmit a workflow in the archive",
+		Example: `# Resubmit an archived workflow:
+
+  argo archive resubmit 7a9b9f2c-3c2e-4c5d-a1b2-0b2f8c5e6d7f
+
+# Resubmit an archived workflow with a different parameter, re-using the steps that do not use it:
+
+  argo archive resubmit 7a9b9f2c-3c2e-4c5d-a1b2-0b2f8c5e6d7f --memoized -p message=hello
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			wf, err := serviceClient.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{
+				Uid:        args[0],
+				Memoized:   memoized,
+				Parameters: parameters,
+			})
+			errors.CheckError(err)
+			printRerunWorkflow(wf, output)
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml")
+	command.Flags().BoolVar(&memoized, "memoized", false, "re-use successful steps & outputs from the previous run")
+	command.Flags().StringArrayVarP(&parameters, "parameter", "p", []string{}, "override a parameter of the workflow; in memoized mode, the nodes whose inputs it changes are not re-used")
+	return command
+}
@@ -0,0 +1,54 @@
+package archive
+
+import (
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+)
+
+func NewRetryCommand() *cobra.Command {
+	var (
+		output            string
+		restartSuccessful bool
+		nodeFieldSelector string
+		parameters        []string
+	)
+	command := &cobra.Command{
+		Use:   "retry UID",
+		Short: "retry a failed workflow in the archive, restoring it to the cluster",
+		Example: `# Retry an archived workflow:
+
+  argo archive retry 7a9b9f2c-3c2e-4c5d-a1b2-0b2f8c5e6d7f
+
+# Retry an archived workflow with a different parameter:
+
+  argo archive retry 7a9b9f2c-3c2e-4c5d-a1b2-0b2f8c5e6d7f -p message=hello
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
+			errors.CheckError(err)
+			wf, err := serviceClient.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{
+				Uid:               args[0],
+				RestartSuccessful: restartSuccessful,
+				NodeFieldSelector: nodeFieldSelector,
+				Parameters:        parameters,
+			})
+			errors.CheckError(err)
+			printRerunWorkflow(wf, output)
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: name|json|yaml")
+	command.Flags().BoolVar(&restartSuccessful, "restart-successful", false, "indicates to restart successful nodes matching the --node-field-selector")
+	command.Flags().StringVar(&nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	command.Flags().StringArrayVarP(&parameters, "parameter", "p", []string{}, "override a parameter of the workflow; the nodes whose inputs it changes are run again")
+	return command
+}
@@ -17,5 +17,7 @@ func NewArchiveCommand() *cobra.Command {
 	command.AddCommand(NewDeleteCommand())
 	command.AddCommand(NewListLabelKeyCommand())
 	command.AddCommand(NewListLabelValueCommand())
+	command.AddCommand(NewRetryCommand())
+	command.AddCommand(NewResubmitCommand())
 	return command
 }
@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+func NewDeleteCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "delete TYPE CACHE KEY...",
+		Short: "delete entries of a memoization cache",
+		Example: `# Delete two entries of an artifact cache:
+  argo cache delete Artifact my-cache my-key my-other-key
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 3 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewCacheServiceClient()
+			errors.CheckError(err)
+			cacheType, cacheName := args[0], args[1]
+			for _, key := range args[2:] {
+				_, err := serviceClient.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{
+					Namespace: client.Namespace(),
+					CacheType: cacheType,
+					CacheName: cacheName,
+					Key:       key,
+				})
+				errors.CheckError(err)
+				fmt.Printf("Cache entry '%s' deleted\n", key)
+			}
+		},
+	}
+	return command
+}
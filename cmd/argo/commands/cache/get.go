@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+func NewGetCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "get TYPE CACHE KEY",
+		Short: "get an entry of a memoization cache",
+		Example: `# Get an entry of a ConfigMap cache:
+  argo cache get ConfigMap my-cache my-key
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 3 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewCacheServiceClient()
+			errors.CheckError(err)
+			entry, err := serviceClient.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{
+				Namespace: client.Namespace(),
+				CacheType: args[0],
+				CacheName: args[1],
+				Key:       args[2],
+			})
+			errors.CheckError(err)
+			errors.CheckError(printEntry(entry, output, os.Stdout))
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
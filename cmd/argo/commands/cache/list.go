@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		cacheType string
+		cacheName string
+		prefix    string
+		output    string
+	)
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "list the entries of the memoization caches created by the workflows of the namespace",
+		Example: `# List all the entries:
+  argo cache list
+
+# List the entries of one cache whose keys have a prefix:
+  argo cache list --type SQL --cache my-cache --prefix my-key
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewCacheServiceClient()
+			errors.CheckError(err)
+			list, err := serviceClient.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{
+				Namespace: client.Namespace(),
+				CacheType: cacheType,
+				CacheName: cacheName,
+				KeyPrefix: prefix,
+			})
+			errors.CheckError(err)
+			errors.CheckError(printEntries(list.Items, output, os.Stdout))
+		},
+	}
+	command.Flags().StringVar(&cacheType, "type", "", "Only list the entries of caches of this type. One of: ConfigMap|SQL|Artifact")
+	command.Flags().StringVar(&cacheName, "cache", "", "Only list the entries of the cache with this name")
+	command.Flags().StringVar(&prefix, "prefix", "", "Only list the entries whose keys have this prefix")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: name|json|yaml|wide")
+	return command
+}
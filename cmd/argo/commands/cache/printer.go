@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+	"sigs.k8s.io/yaml"
+
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+func printEntries(entries []*cachepkg.CacheEntry, output string, out io.Writer) error {
+	switch output {
+	case "name":
+		for _, e := range entries {
+			_, _ = fmt.Fprintf(out, "%s/%s/%s\n", e.CacheType, e.CacheName, e.Key)
+		}
+	case "json":
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out, string(data))
+	case "yaml":
+		data, err := yaml.Marshal(entries)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprint(out, string(data))
+	case "", "wide":
+		w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprint(w, "TYPE\tCACHE\tKEY\tWORKFLOW\tNODE\tHITS\tAGE\tLAST HIT\n")
+		for _, e := range entries {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", e.CacheType, e.CacheName, e.Key, orNone(e.WorkflowName), e.NodeID, e.HitCount, relativeTime(e.CreationTimestamp.Time), relativeTime(e.LastHitTimestamp.Time))
+		}
+		_ = w.Flush()
+	default:
+		return fmt.Errorf("unknown output mode: %s", output)
+	}
+	return nil
+}
+
+func printEntry(e *cachepkg.CacheEntry, output string, out io.Writer) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(e, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out, string(data))
+	case "yaml":
+		data, err := yaml.Marshal(e)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprint(out, string(data))
+	case "", "wide":
+		const fmtStr = "%-20s %v\n"
+		_, _ = fmt.Fprintf(out, fmtStr, "Type:", e.CacheType)
+		_, _ = fmt.Fprintf(out, fmtStr, "Cache:", e.CacheName)
+		_, _ = fmt.Fprintf(out, fmtStr, "Key:", e.Key)
+		_, _ = fmt.Fprintf(out, fmtStr, "Workflow:", orNone(e.WorkflowName))
+		_, _ = fmt.Fprintf(out, fmtStr, "Node:", e.NodeID)
+		_, _ = fmt.Fprintf(out, fmtStr, "Hits:", e.HitCount)
+		_, _ = fmt.Fprintf(out, fmtStr, "Created:", humanize.Timestamp(e.CreationTimestamp.Time))
+		_, _ = fmt.Fprintf(out, fmtStr, "Last Hit:", humanize.Timestamp(e.LastHitTimestamp.Time))
+		if e.Outputs != nil {
+			for _, p := range e.Outputs.Parameters {
+				_, _ = fmt.Fprintf(out, fmtStr, "Parameter:", p.Name+": "+p.Value.String())
+			}
+			for _, a := range e.Outputs.Artifacts {
+				_, _ = fmt.Fprintf(out, fmtStr, "Artifact:", a.Name)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown output mode: %s", output)
+	}
+	return nil
+}
+
+// orNone returns "-" for entries that were created before their workflow was recorded
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func relativeTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return humanize.RelativeDurationShort(t, time.Now())
+}
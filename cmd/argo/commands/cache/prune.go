@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+func NewPruneCommand() *cobra.Command {
+	var (
+		cacheType string
+		cacheName string
+		prefix    string
+		older     string
+		dryRun    bool
+		output    string
+	)
+	command := &cobra.Command{
+		Use:   "prune [--prefix PREFIX] [--older DURATION]",
+		Short: "delete the entries of the memoization caches that have a key prefix or are older than a duration",
+		Example: `# Delete the entries created more than a week ago:
+  argo cache prune --older 7d
+
+# Print the entries of one cache whose keys have a prefix, without deleting them:
+  argo cache prune --cache my-cache --prefix my-key --dry-run
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if prefix == "" && older == "" {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewCacheServiceClient()
+			errors.CheckError(err)
+			list, err := serviceClient.PruneCacheEntries(ctx, &cachepkg.PruneCacheEntriesRequest{
+				Namespace: client.Namespace(),
+				CacheType: cacheType,
+				CacheName: cacheName,
+				KeyPrefix: prefix,
+				OlderThan: older,
+				DryRun:    dryRun,
+			})
+			errors.CheckError(err)
+			if output != "" {
+				errors.CheckError(printEntries(list.Items, output, os.Stdout))
+				return
+			}
+			for _, e := range list.Items {
+				if dryRun {
+					fmt.Printf("Cache entry '%s/%s/%s' would be deleted\n", e.CacheType, e.CacheName, e.Key)
+				} else {
+					fmt.Printf("Cache entry '%s/%s/%s' deleted\n", e.CacheType, e.CacheName, e.Key)
+				}
+			}
+		},
+	}
+	command.Flags().StringVar(&cacheType, "type", "", "Only delete the entries of caches of this type. One of: ConfigMap|SQL|Artifact")
+	command.Flags().StringVar(&cacheName, "cache", "", "Only delete the entries of the cache with this name")
+	command.Flags().StringVar(&prefix, "prefix", "", "Delete the entries whose keys have this prefix")
+	command.Flags().StringVar(&older, "older", "", "Delete the entries created before the specified duration (e.g. 10m, 3h, 1d)")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Do not delete the entries, only print what would happen")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format of the deleted entries. One of: name|json|yaml|wide")
+	return command
+}
@@ -52,6 +52,12 @@ func AddAPIClientFlagsToCmd(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolVarP(&argoServerOpts.InsecureSkipVerify, "insecure-skip-verify", "k", os.Getenv("ARGO_INSECURE_SKIP_VERIFY") == "true", "If true, the Argo Server's certificate will not be checked for validity. This will make your HTTPS connections insecure. Defaults to the ARGO_INSECURE_SKIP_VERIFY environment variable.")
 }
 
+// GetArgoServerOpts returns the options of the Argo Server, for the commands that use its HTTP endpoints that are not
+// part of the API, such as the artifact server
+func GetArgoServerOpts() apiclient.ArgoServerOpts {
+	return argoServerOpts
+}
+
 func NewAPIClient(ctx context.Context) (context.Context, apiclient.Client) {
 	ctx, client, err := apiclient.NewClientFromOpts(
 		apiclient.Opts{
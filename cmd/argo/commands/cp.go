@@ -0,0 +1,211 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/artifacts"
+)
+
+type cpOpts struct {
+	nodeID       string // --node-id
+	templateName string // --template-name
+	artifactName string // --artifact-name
+	path         string // --path
+	input        bool   // --input
+}
+
+// artifactCopier copies the files of artifacts from the artifact server of the Argo Server
+type artifactCopier struct {
+	client        *http.Client
+	baseURL       string
+	authorization string
+}
+
+func NewCpCommand() *cobra.Command {
+	var opts cpOpts
+	command := &cobra.Command{
+		Use:   "cp WORKFLOW DIRECTORY",
+		Short: "copy the files of the artifacts of a workflow to a local directory",
+		Long: `Copy the files of the artifacts of a workflow to a local directory, as DIRECTORY/NODE_ID/ARTIFACT_NAME/PATH.
+
+The files of a directory artifact are its files, and the files of a tar or zip archive, such as an output artifact
+archived with the default strategy, are its entries, which are extracted by the Argo Server. Any other artifact is a
+single file, named after its key.
+
+This command needs the Argo Server.`,
+		Example: `# Copy all the output artifacts of a workflow:
+
+  argo cp my-wf ./artifacts
+
+# Copy the output artifacts named "report" of the nodes of a template:
+
+  argo cp my-wf ./artifacts --template-name main --artifact-name report
+
+# Copy the HTML files of the output artifacts of a node:
+
+  argo cp my-wf ./artifacts --node-id my-wf-1234567890 --path 'reports/*.html'
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			serverOpts := client.GetArgoServerOpts()
+			if serverOpts.URL == "" {
+				log.Fatal("argo cp downloads artifacts from the Argo Server, set it with --argo-server or ARGO_SERVER")
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			namespace := client.Namespace()
+			wf, err := apiClient.NewWorkflowServiceClient().GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{Name: args[0], Namespace: namespace})
+			errors.CheckError(err)
+			c := &artifactCopier{
+				client:        &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: serverOpts.InsecureSkipVerify}}},
+				baseURL:       serverOpts.GetURL(),
+				authorization: client.GetAuthString(),
+			}
+			errors.CheckError(c.copyArtifacts(ctx, wf, opts, args[1]))
+		},
+	}
+	command.Flags().StringVar(&opts.nodeID, "node-id", "", "copy the artifacts of the node of this ID")
+	command.Flags().StringVar(&opts.templateName, "template-name", "", "copy the artifacts of the nodes of this template")
+	command.Flags().StringVar(&opts.artifactName, "artifact-name", "", "copy the artifacts of this name")
+	command.Flags().StringVar(&opts.path, "path", "", "copy the files whose paths match this pattern, or are in the directory of this path, e.g. 'reports/*.html'")
+	command.Flags().BoolVar(&opts.input, "input", false, "copy the input artifacts rather than the output artifacts")
+	return command
+}
+
+// matchPath returns true if the path of a file matches the pattern, or is in the directory of the pattern
+func matchPath(pattern, filePath string) bool {
+	if pattern == "" {
+		return true
+	}
+	pattern = strings.TrimSuffix(pattern, "/")
+	if ok, _ := path.Match(pattern, filePath); ok {
+		return true
+	}
+	return strings.HasPrefix(filePath, pattern+"/")
+}
+
+func (c *artifactCopier) copyArtifacts(ctx context.Context, wf *wfv1.Workflow, opts cpOpts, dir string) error {
+	var nodeIDs []string
+	for id, node := range wf.Status.Nodes {
+		if (opts.nodeID == "" || id == opts.nodeID) && (opts.templateName == "" || node.TemplateName == opts.templateName) {
+			nodeIDs = append(nodeIDs, id)
+		}
+	}
+	sort.Strings(nodeIDs)
+	copied := 0
+	for _, id := range nodeIDs {
+		node := wf.Status.Nodes[id]
+		var arts wfv1.Artifacts
+		if opts.input && node.Inputs != nil {
+			arts = node.Inputs.Artifacts
+		} else if !opts.input && node.Outputs != nil {
+			arts = node.Outputs.Artifacts
+		}
+		for _, art := range arts {
+			if opts.artifactName != "" && art.Name != opts.artifactName {
+				continue
+			}
+			files, err := c.listFiles(ctx, wf, id, art.Name, opts.input)
+			if err != nil {
+				return fmt.Errorf("failed to list the files of artifact %s of node %s: %w", art.Name, id, err)
+			}
+			for _, f := range files {
+				if !matchPath(opts.path, f) {
+					continue
+				}
+				localPath := filepath.Join(dir, id, art.Name, filepath.FromSlash(path.Clean("/"+f)))
+				if err := c.copyFile(ctx, wf, id, art.Name, opts.input, f, localPath); err != nil {
+					return fmt.Errorf("failed to copy %s of artifact %s of node %s: %w", f, art.Name, id, err)
+				}
+				fmt.Println(localPath)
+				copied++
+			}
+		}
+	}
+	if copied == 0 {
+		return fmt.Errorf("no files of artifacts matched")
+	}
+	return nil
+}
+
+// get gets a path of the artifact files endpoint of an artifact
+func (c *artifactCopier) get(ctx context.Context, wf *wfv1.Workflow, nodeID, artifactName string, input bool, filePath string) (*http.Response, error) {
+	endpoint := "artifact-files"
+	if input {
+		endpoint = "input-artifact-files"
+	}
+	u := &url.URL{Path: path.Join("/", endpoint, wf.Namespace, wf.Name, nodeID, artifactName) + "/" + filePath}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.baseURL, "/")+u.EscapedPath(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", c.authorization)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer func() { _ = resp.Body.Close() }()
+		message, _ := ioutil.ReadAll(resp.Body)
+		if len(bytes.TrimSpace(message)) == 0 {
+			return nil, fmt.Errorf("%s", resp.Status)
+		}
+		return nil, fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(message))
+	}
+	return resp, nil
+}
+
+func (c *artifactCopier) listFiles(ctx context.Context, wf *wfv1.Workflow, nodeID, artifactName string, input bool) ([]string, error) {
+	resp, err := c.get(ctx, wf, nodeID, artifactName, input, "")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	listing := artifacts.ArtifactFiles{}
+	if err := json.NewDecoder(resp.Body).Decode(&listing); err != nil {
+		return nil, err
+	}
+	return listing.Files, nil
+}
+
+func (c *artifactCopier) copyFile(ctx context.Context, wf *wfv1.Workflow, nodeID, artifactName string, input bool, filePath, localPath string) error {
+	resp, err := c.get(ctx, wf, nodeID, artifactName, input, filePath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Clean(localPath))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
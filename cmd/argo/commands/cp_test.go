@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/artifacts"
+)
+
+func Test_matchPath(t *testing.T) {
+	assert.True(t, matchPath("", "a/b.txt"))
+	assert.True(t, matchPath("a/*.txt", "a/b.txt"))
+	assert.True(t, matchPath("a", "a/b.txt"))
+	assert.True(t, matchPath("a/", "a/b.txt"))
+	assert.False(t, matchPath("*.txt", "a/b.txt"))
+	assert.False(t, matchPath("ab", "a/b.txt"))
+}
+
+func Test_copyArtifacts(t *testing.T) {
+	files := map[string]string{"report.html": "<html/>", "data/a.csv": "1,2"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		prefix := "/artifact-files/my-ns/my-wf/my-wf-1/my-art/"
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		filePath := strings.TrimPrefix(r.URL.Path, prefix)
+		if filePath == "" {
+			_ = json.NewEncoder(w).Encode(artifacts.ArtifactFiles{Files: []string{"data/a.csv", "report.html"}})
+			return
+		}
+		_, _ = w.Write([]byte(files[filePath]))
+	}))
+	defer server.Close()
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf"},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf":   {ID: "my-wf", TemplateName: "dag"},
+			"my-wf-1": {ID: "my-wf-1", TemplateName: "main", Outputs: &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: "my-art"}, {Name: "main-logs"}}}},
+		}},
+	}
+	c := &artifactCopier{client: http.DefaultClient, baseURL: server.URL, authorization: "Bearer my-token"}
+
+	t.Run("Path", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, c.copyArtifacts(context.Background(), wf, cpOpts{artifactName: "my-art", path: "data"}, dir))
+		data, err := ioutil.ReadFile(filepath.Join(dir, "my-wf-1", "my-art", "data", "a.csv"))
+		require.NoError(t, err)
+		assert.Equal(t, "1,2", string(data))
+		assert.NoFileExists(t, filepath.Join(dir, "my-wf-1", "my-art", "report.html"))
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		assert.EqualError(t, c.copyArtifacts(context.Background(), wf, cpOpts{artifactName: "my-art", path: "*.pdf"}, t.TempDir()), "no files of artifacts matched")
+	})
+	t.Run("Error", func(t *testing.T) {
+		err := c.copyArtifacts(context.Background(), wf, cpOpts{templateName: "main"}, t.TempDir())
+		assert.EqualError(t, err, "failed to list the files of artifact main-logs of node my-wf-1: 404 Not Found")
+	})
+}
@@ -0,0 +1,247 @@
+package executorplugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	executorplugins "github.com/argoproj/argo-workflows/v3/pkg/plugins/executor"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
+	"github.com/argoproj/argo-workflows/v3/util/template"
+)
+
+// startPlugin starts the plugin's sidecar locally, either as a process or as a Docker container, and returns its
+// address and a func to stop it.
+func startPlugin(ctx context.Context, plug *spec.Plugin, docker bool) (string, func(), error) {
+	c := plug.Spec.Sidecar.Container
+	port := c.Ports[0].ContainerPort
+	var cmd *exec.Cmd
+	var stop func()
+	if docker {
+		name := fmt.Sprintf("argo-executor-plugin-test-%s", plug.Name)
+		args := []string{"run", "--rm", "--name", name, "-p", fmt.Sprintf("%d:%d", port, port)}
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				args = append(args, "-e", fmt.Sprintf("%s=%s", e.Name, e.Value))
+			}
+		}
+		command := c.Command
+		if len(command) > 0 {
+			args = append(args, "--entrypoint", command[0])
+			command = command[1:]
+		}
+		args = append(args, c.Image)
+		args = append(args, command...)
+		args = append(args, c.Args...)
+		cmd = exec.CommandContext(ctx, "docker", args...)
+		stop = func() { _ = exec.Command("docker", "rm", "-f", name).Run() }
+	} else {
+		command := append(append([]string{}, c.Command...), c.Args...)
+		if len(command) == 0 {
+			return "", nil, fmt.Errorf("sidecar has no command to run, use --docker to run the image")
+		}
+		cmd = exec.CommandContext(ctx, command[0], command[1:]...)
+		cmd.Env = os.Environ()
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", e.Name, e.Value))
+			}
+		}
+		stop = func() {
+			if cmd.Process != nil {
+				_ = cmd.Process.Kill()
+			}
+		}
+	}
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	log.WithField("args", cmd.Args[:1]).Info("Starting plugin")
+	if err := cmd.Start(); err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("http://localhost:%d", port), stop, nil
+}
+
+// waitForPlugin waits until the plugin's port accepts connections
+func waitForPlugin(ctx context.Context, address string, timeout time.Duration) error {
+	host := strings.TrimPrefix(strings.TrimPrefix(address, "http://"), "https://")
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := net.DialTimeout("tcp", host, time.Second)
+		if err == nil {
+			return conn.Close()
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("plugin did not start listening on %s within %v: %w", host, timeout, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+// pluginTemplates returns the plugin templates of the workflow, with their input parameters substituted
+func pluginTemplates(wf *wfv1.Workflow, templateName string, parameters map[string]string) ([]wfv1.Template, error) {
+	var tmpls []wfv1.Template
+	for _, tmpl := range wf.Spec.Templates {
+		if tmpl.Plugin == nil || (templateName != "" && tmpl.Name != templateName) {
+			continue
+		}
+		replaceMap := map[string]string{"workflow.name": wf.Name}
+		for _, p := range wf.Spec.Arguments.Parameters {
+			replaceMap["workflow.parameters."+p.Name] = p.GetValue()
+		}
+		for _, p := range tmpl.Inputs.Parameters {
+			value := p.GetValue()
+			if v, ok := parameters[p.Name]; ok {
+				value = v
+			}
+			replaceMap["inputs.parameters."+p.Name] = value
+		}
+		data, err := json.Marshal(tmpl)
+		if err != nil {
+			return nil, err
+		}
+		s, err := template.Replace(string(data), replaceMap, true)
+		if err != nil {
+			return nil, err
+		}
+		var resolved wfv1.Template
+		if err := json.Unmarshal([]byte(s), &resolved); err != nil {
+			return nil, err
+		}
+		tmpls = append(tmpls, resolved)
+	}
+	if len(tmpls) == 0 {
+		return nil, fmt.Errorf("workflow %q has no plugin templates to test", wf.Name)
+	}
+	return tmpls, nil
+}
+
+// executeTemplate calls the plugin once, returning the reply, which must strictly match the API contract
+func executeTemplate(ctx context.Context, address string, args executorplugins.ExecuteTemplateArgs) (*executorplugins.ExecuteTemplateReply, error) {
+	body, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", address+"/api/v1/template.execute", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case 200:
+	case 404:
+		return nil, fmt.Errorf("plugin does not implement template.execute")
+	default:
+		return nil, fmt.Errorf("%s: %s", resp.Status, string(data))
+	}
+	reply := &executorplugins.ExecuteTemplateReply{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(reply); err != nil {
+		return nil, fmt.Errorf("reply does not match the API contract: %w", err)
+	}
+	return reply, checkReply(reply)
+}
+
+// checkReply checks the reply is one the agent can act on
+func checkReply(reply *executorplugins.ExecuteTemplateReply) error {
+	node := reply.Node
+	if node == nil {
+		return nil
+	}
+	switch node.Phase {
+	case wfv1.NodePending, wfv1.NodeRunning:
+		if reply.GetRequeue() <= 0 {
+			return fmt.Errorf("phase %q is not terminal, but no requeue was requested", node.Phase)
+		}
+	case wfv1.NodeSucceeded, wfv1.NodeFailed, wfv1.NodeError:
+	default:
+		return fmt.Errorf("invalid node phase %q", node.Phase)
+	}
+	if node.Outputs != nil {
+		for _, p := range node.Outputs.Parameters {
+			if p.Name == "" {
+				return fmt.Errorf("output parameter name is mandatory")
+			}
+		}
+	}
+	return nil
+}
+
+type testResult struct {
+	Template string           `json:"template"`
+	Calls    int              `json:"calls"`
+	Node     *wfv1.NodeResult `json:"node,omitempty"`
+	Error    string           `json:"error,omitempty"`
+}
+
+func (r testResult) passed() bool {
+	return r.Error == "" && r.Node != nil && r.Node.Phase.Fulfilled()
+}
+
+// testTemplate calls the plugin, simulating requeues, until the node reaches a terminal phase
+func testTemplate(ctx context.Context, address string, wf *wfv1.Workflow, tmpl wfv1.Template, maxRequeues int, maxRequeueDelay time.Duration) testResult {
+	result := testResult{Template: tmpl.Name}
+	args := executorplugins.ExecuteTemplateArgs{
+		Workflow: &executorplugins.Workflow{ObjectMeta: executorplugins.ObjectMeta{Name: wf.Name}},
+		Template: &tmpl,
+	}
+	for {
+		result.Calls++
+		reply, err := executeTemplate(ctx, address, args)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		if reply.Node == nil {
+			result.Error = "plugin replied {}, it does not support this template"
+			return result
+		}
+		result.Node = reply.Node
+		log.WithField("template", tmpl.Name).
+			WithField("phase", reply.Node.Phase).
+			WithField("message", reply.Node.Message).
+			WithField("requeue", reply.GetRequeue()).
+			Info("Plugin replied")
+		if reply.Node.Phase.Fulfilled() {
+			return result
+		}
+		if result.Calls > maxRequeues {
+			result.Error = fmt.Sprintf("node did not reach a terminal phase after %d requeues", maxRequeues)
+			return result
+		}
+		delay := reply.GetRequeue()
+		if delay > maxRequeueDelay {
+			delay = maxRequeueDelay
+		}
+		select {
+		case <-ctx.Done():
+			result.Error = ctx.Err().Error()
+			return result
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package executorplugin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	executorplugins "github.com/argoproj/argo-workflows/v3/pkg/plugins/executor"
+)
+
+func TestCheckReply(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		assert.NoError(t, checkReply(&executorplugins.ExecuteTemplateReply{}))
+	})
+	t.Run("InvalidPhase", func(t *testing.T) {
+		assert.EqualError(t, checkReply(&executorplugins.ExecuteTemplateReply{Node: &wfv1.NodeResult{Phase: "Done"}}), `invalid node phase "Done"`)
+	})
+	t.Run("RunningWithoutRequeue", func(t *testing.T) {
+		assert.EqualError(t, checkReply(&executorplugins.ExecuteTemplateReply{Node: &wfv1.NodeResult{Phase: wfv1.NodeRunning}}), `phase "Running" is not terminal, but no requeue was requested`)
+	})
+	t.Run("RunningWithRequeue", func(t *testing.T) {
+		assert.NoError(t, checkReply(&executorplugins.ExecuteTemplateReply{Node: &wfv1.NodeResult{Phase: wfv1.NodeRunning}, Requeue: &metav1.Duration{Duration: time.Second}}))
+	})
+}
+
+func TestPluginTemplates(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
+		Spec: wfv1.WorkflowSpec{Templates: []wfv1.Template{
+			{Name: "main", Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "x", Default: wfv1.AnyStringPtr("1")}}}, Plugin: &wfv1.Plugin{Object: wfv1.Object{Value: json.RawMessage(`{"hello":"{{inputs.parameters.x}} {{workflow.name}}"}`)}}},
+			{Name: "other", Container: &apiv1.Container{}},
+		}},
+	}
+	tmpls, err := pluginTemplates(wf, "", map[string]string{"x": "2"})
+	if assert.NoError(t, err) && assert.Len(t, tmpls, 1) {
+		assert.JSONEq(t, `{"hello":"2 my-wf"}`, string(tmpls[0].Plugin.Value))
+	}
+	_, err = pluginTemplates(wf, "other", nil)
+	assert.EqualError(t, err, `workflow "my-wf" has no plugin templates to test`)
+}
+
+func TestTestTemplate(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reply := executorplugins.ExecuteTemplateReply{Node: &wfv1.NodeResult{Phase: wfv1.NodeRunning}, Requeue: &metav1.Duration{Duration: time.Minute}}
+		if atomic.AddInt32(&calls, 1) == 3 {
+			reply = executorplugins.ExecuteTemplateReply{Node: &wfv1.NodeResult{Phase: wfv1.NodeSucceeded, Message: "done"}}
+		}
+		_ = json.NewEncoder(w).Encode(reply)
+	}))
+	defer server.Close()
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}}
+	t.Run("Terminal", func(t *testing.T) {
+		r := testTemplate(context.Background(), server.URL, wf, wfv1.Template{Name: "main"}, 10, time.Millisecond)
+		assert.True(t, r.passed())
+		assert.Equal(t, 3, r.Calls)
+		assert.Equal(t, "done", r.Node.Message)
+	})
+	t.Run("TooManyRequeues", func(t *testing.T) {
+		atomic.StoreInt32(&calls, 0)
+		r := testTemplate(context.Background(), server.URL, wf, wfv1.Template{Name: "main"}, 1, time.Millisecond)
+		assert.False(t, r.passed())
+		assert.Equal(t, "node did not reach a terminal phase after 1 requeues", r.Error)
+	})
+}
@@ -30,7 +30,7 @@ func loadPluginManifest(pluginDir string) (*spec.Plugin, error) {
 	if len(files) > 1 {
 		return nil, fmt.Errorf("plugin %s has more than one server.* file", p.Name)
 	}
-	if len(files) == 1 {
+	if len(files) == 1 && p.Spec.Service == nil {
 		code, err := os.ReadFile(files[0])
 		if err != nil {
 			return nil, err
@@ -0,0 +1,151 @@
+package executorplugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func NewTestCommand() *cobra.Command {
+	var (
+		address         string
+		templateName    string
+		parameters      []string
+		docker          bool
+		startupTimeout  time.Duration
+		maxRequeues     int
+		maxRequeueDelay time.Duration
+		output          string
+	)
+	command := &cobra.Command{
+		Use:   "test DIR WORKFLOW_FILE",
+		Short: "test an executor plugin locally against the plugin templates of a workflow",
+		Example: `# Start the plugin in ./hello as a local process, and execute each plugin template in my-wf.yaml:
+
+  argo executor-plugin test ./hello my-wf.yaml
+
+# Run the plugin's image using Docker, and only test one template with an input parameter:
+
+  argo executor-plugin test ./hello my-wf.yaml --docker --template main -p message=hi
+
+# Test a plugin that is already running:
+
+  argo executor-plugin test ./hello my-wf.yaml --address http://localhost:4355
+`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx := cmd.Context()
+			plug, err := loadPluginManifest(args[0])
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			wfs, err := common.SplitWorkflowYAMLFile(data, true)
+			if err != nil {
+				return err
+			}
+			if len(wfs) != 1 {
+				return fmt.Errorf("%s must contain exactly one workflow, found %d", args[1], len(wfs))
+			}
+			wf := &wfs[0]
+			if wf.Name == "" {
+				wf.Name = wf.GenerateName + "test"
+			}
+			params := map[string]string{}
+			for _, p := range parameters {
+				parts := strings.SplitN(p, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("expected parameter of the form: NAME=VALUE, received: %s", p)
+				}
+				params[parts[0]] = parts[1]
+			}
+			tmpls, err := pluginTemplates(wf, templateName, params)
+			if err != nil {
+				return err
+			}
+			if address == "" {
+				if plug.Spec.Service != nil {
+					address = plug.Spec.Service.Address
+				} else {
+					var stop func()
+					address, stop, err = startPlugin(ctx, plug, docker)
+					if err != nil {
+						return err
+					}
+					defer stop()
+				}
+			}
+			if err := waitForPlugin(ctx, address, startupTimeout); err != nil {
+				return err
+			}
+			var results []testResult
+			for _, tmpl := range tmpls {
+				results = append(results, testTemplate(ctx, address, wf, tmpl, maxRequeues, maxRequeueDelay))
+			}
+			if err := printResults(results, output); err != nil {
+				return err
+			}
+			for _, r := range results {
+				if !r.passed() {
+					return fmt.Errorf("plugin %s failed testing", plug.Name)
+				}
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&address, "address", "", "address of an already running plugin, rather than starting it")
+	command.Flags().StringVar(&templateName, "template", "", "only test the plugin template with this name")
+	command.Flags().StringArrayVarP(&parameters, "parameter", "p", []string{}, "input parameter of the template")
+	command.Flags().BoolVar(&docker, "docker", false, "run the sidecar's image using Docker, rather than running its command as a local process")
+	command.Flags().DurationVar(&startupTimeout, "startup-timeout", 30*time.Second, "how long to wait for the plugin to start listening")
+	command.Flags().IntVar(&maxRequeues, "max-requeues", 10, "maximum number of requeues before the test fails")
+	command.Flags().DurationVar(&maxRequeueDelay, "max-requeue-delay", 5*time.Second, "maximum time to wait between requeued calls, rather than the requeue time the plugin requested")
+	command.Flags().StringVarP(&output, "output", "o", "", "output format, one of: json")
+	return command
+}
+
+func printResults(results []testResult, output string) error {
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	case "":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprintln(w, "TEMPLATE\tRESULT\tCALLS\tPHASE\tMESSAGE")
+		for _, r := range results {
+			status := "PASS"
+			if !r.passed() {
+				status = "FAIL"
+			}
+			var phase, message string
+			if r.Node != nil {
+				phase, message = string(r.Node.Phase), r.Node.Message
+			}
+			if r.Error != "" {
+				message = r.Error
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", r.Template, status, r.Calls, phase, message)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+	return nil
+}
@@ -150,6 +150,9 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) string {
 		}
 	}
 	out += fmt.Sprintf(fmtStr, "Progress:", wf.Status.Progress)
+	if n := len(wf.Status.AutoRetries); n > 0 {
+		out += fmt.Sprintf(fmtStr, "AutoRetries:", n)
+	}
 	if !wf.Status.ResourcesDuration.IsZero() {
 		out += fmt.Sprintf(fmtStr, "ResourcesDuration:", wf.Status.ResourcesDuration)
 	}
@@ -182,6 +185,19 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) string {
 			}
 		}
 	}
+	if len(wf.Status.CreatedResources) > 0 {
+		out += fmt.Sprintf(fmtStr, "Created Resources:", "")
+		for _, r := range wf.Status.CreatedResources {
+			phase := string(r.CleanupPhase)
+			if phase == "" {
+				phase = "-"
+			}
+			if r.Message != "" {
+				phase += " (" + r.Message + ")"
+			}
+			out += fmt.Sprintf(fmtStr, "  "+r.DisplayName()+":", phase)
+		}
+	}
 	printTree := true
 	if wf.Status.Nodes == nil {
 		printTree = false
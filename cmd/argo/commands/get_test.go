@@ -132,6 +132,27 @@ status:
 		output := printWorkflowHelper(&wf, getFlags{})
 		assert.Regexp(t, `EstimatedDuration: *1 second`, output)
 	})
+	t.Run("CreatedResources", func(t *testing.T) {
+		var wf wfv1.Workflow
+		wfv1.MustUnmarshal(`
+status:
+  phase: Failed
+  createdResources:
+    - apiVersion: v1
+      kind: ConfigMap
+      namespace: my-ns
+      name: my-cm
+      cleanupPhase: Retained
+      message: workflow did not succeed
+    - apiVersion: v1
+      kind: Namespace
+      name: my-ns
+`, &wf)
+		output := printWorkflowHelper(&wf, getFlags{})
+		assert.Contains(t, output, "Created Resources:")
+		assert.Regexp(t, `v1/ConfigMap my-ns/my-cm: *Retained \(workflow did not succeed\)`, output)
+		assert.Regexp(t, `v1/Namespace my-ns: *-`, output)
+	})
 	t.Run("IndexOrdering", func(t *testing.T) {
 		var wf wfv1.Workflow
 		wfv1.MustUnmarshal(`apiVersion: argoproj.io/v1alpha1
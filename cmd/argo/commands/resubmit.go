@@ -13,11 +13,12 @@ import (
 )
 
 type resubmitOps struct {
-	priority      int32  // --priority
-	memoized      bool   // --memoized
-	namespace     string // --namespace
-	labelSelector string // --selector
-	fieldSelector string // --field-selector
+	priority      int32    // --priority
+	memoized      bool     // --memoized
+	namespace     string   // --namespace
+	labelSelector string   // --selector
+	fieldSelector string   // --field-selector
+	parameters    []string // --parameter
 }
 
 // hasSelector returns true if the CLI arguments selects multiple workflows
@@ -67,6 +68,10 @@ func NewResubmitCommand() *cobra.Command {
 # Resubmit the latest workflow:
 
   argo resubmit @latest
+
+# Resubmit a workflow with a different parameter, re-using the steps that do not use it:
+
+  argo resubmit --memoized my-wf -p message=hello
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			if cmd.Flag("priority").Changed {
@@ -87,6 +92,7 @@ func NewResubmitCommand() *cobra.Command {
 	command.Flags().BoolVar(&cliSubmitOpts.watch, "watch", false, "watch the workflow until it completes, only works when a single workflow is resubmitted")
 	command.Flags().BoolVar(&cliSubmitOpts.log, "log", false, "log the workflow until it completes")
 	command.Flags().BoolVar(&resubmitOpts.memoized, "memoized", false, "re-use successful steps & outputs from the previous run")
+	command.Flags().StringArrayVarP(&resubmitOpts.parameters, "parameter", "p", []string{}, "override a parameter of the workflow; in memoized mode, the nodes whose inputs it changes are not re-used")
 	command.Flags().StringVarP(&resubmitOpts.labelSelector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&resubmitOpts.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
 	return command
@@ -129,9 +135,10 @@ func resubmitWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowSe
 		resubmittedNames[wf.Name] = true
 
 		lastResubmitted, err = serviceClient.ResubmitWorkflow(ctx, &workflowpkg.WorkflowResubmitRequest{
-			Namespace: wf.Namespace,
-			Name:      wf.Name,
-			Memoized:  resubmitOpts.memoized,
+			Namespace:  wf.Namespace,
+			Name:       wf.Name,
+			Memoized:   resubmitOpts.memoized,
+			Parameters: resubmitOpts.parameters,
 		})
 		if err != nil {
 			return err
@@ -16,11 +16,12 @@ import (
 )
 
 type retryOps struct {
-	nodeFieldSelector string // --node-field-selector
-	restartSuccessful bool   // --restart-successful
-	namespace         string // --namespace
-	labelSelector     string // --selector
-	fieldSelector     string // --field-selector
+	nodeFieldSelector string   // --node-field-selector
+	restartSuccessful bool     // --restart-successful
+	namespace         string   // --namespace
+	labelSelector     string   // --selector
+	fieldSelector     string   // --field-selector
+	parameters        []string // --parameter
 }
 
 // hasSelector returns true if the CLI arguments selects multiple workflows
@@ -70,6 +71,10 @@ func NewRetryCommand() *cobra.Command {
 # Retry the latest workflow:
 
   argo retry @latest
+
+# Retry a workflow with a different parameter, restarting the steps that use it:
+
+  argo retry my-wf -p message=hello
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 && !retryOpts.hasSelector() {
@@ -90,6 +95,7 @@ func NewRetryCommand() *cobra.Command {
 	command.Flags().BoolVar(&cliSubmitOpts.log, "log", false, "log the workflow until it completes")
 	command.Flags().BoolVar(&retryOpts.restartSuccessful, "restart-successful", false, "indicates to restart successful nodes matching the --node-field-selector")
 	command.Flags().StringVar(&retryOpts.nodeFieldSelector, "node-field-selector", "", "selector of nodes to reset, eg: --node-field-selector inputs.paramaters.myparam.value=abc")
+	command.Flags().StringArrayVarP(&retryOpts.parameters, "parameter", "p", []string{}, "override a parameter of the workflow, restarting the nodes whose inputs it changes")
 	command.Flags().StringVarP(&retryOpts.labelSelector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
 	command.Flags().StringVar(&retryOpts.fieldSelector, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type.")
 	return command
@@ -136,6 +142,7 @@ func retryWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServi
 			Namespace:         wf.Namespace,
 			RestartSuccessful: retryOpts.restartSuccessful,
 			NodeFieldSelector: selector.String(),
+			Parameters:        retryOpts.parameters,
 		})
 		if err != nil {
 			return err
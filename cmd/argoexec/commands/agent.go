@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -13,6 +15,8 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3"
 	executorplugins "github.com/argoproj/argo-workflows/v3/pkg/plugins/executor"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
+	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor"
@@ -61,5 +65,20 @@ func initAgentExecutor() *executor.AgentExecutor {
 		plugins = append(plugins, rpc.New(address))
 	}
 
+	var services []spec.Service
+	if err := json.Unmarshal([]byte(env.LookupEnvStringOr(common.EnvVarPluginServices, "null")), &services); err != nil {
+		log.Fatal(err)
+	}
+	for _, s := range services {
+		var token string
+		if s.Token != nil {
+			// the controller mounts the secret of the token
+			data, err := ioutil.ReadFile(filepath.Clean(filepath.Join(common.SecretVolMountPath, s.Token.Name, s.Token.Key)))
+			checkErr(err)
+			token = string(data)
+		}
+		plugins = append(plugins, rpc.NewService(s, token))
+	}
+
 	return executor.NewAgentExecutor(clientSet, restClient, config, namespace, workflowName, plugins)
 }
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor"
+)
+
+func NewArtifactCommand() *cobra.Command {
+	command := cobra.Command{
+		Use:   "artifact",
+		Short: "Manage artifacts",
+	}
+	command.AddCommand(NewArtifactDeleteCommand())
+	return &command
+}
+
+func NewArtifactDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete",
+		Short: "Delete the output artifacts of a workflow for artifact GC",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := deleteArtifacts(context.Background())
+			if err != nil {
+				util.WriteTerminateMessage(err.Error())
+			}
+			return err
+		},
+	}
+}
+
+func deleteArtifacts(ctx context.Context) error {
+	data, err := os.ReadFile(common.ArtifactGCArtifactsPath)
+	if err != nil {
+		return err
+	}
+	artifacts := make(map[string][]wfv1.Artifact)
+	if err := json.Unmarshal(data, &artifacts); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", common.ArtifactGCArtifactsPath, err)
+	}
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return err
+	}
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	wfExecutor := executor.NewExecutor(clientset, nil, nil, "", namespace, nil, wfv1.Template{}, false, time.Time{}, 0, 0)
+	return wfExecutor.DeleteArtifacts(ctx, artifacts)
+}
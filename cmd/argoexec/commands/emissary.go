@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"compress/gzip"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -20,6 +20,7 @@ import (
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/archive"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	osspecific "github.com/argoproj/argo-workflows/v3/workflow/executor/os-specific"
 )
@@ -79,27 +80,26 @@ func NewEmissaryCommand() *cobra.Command {
 				return fmt.Errorf("failed to unmarshal template: %w", err)
 			}
 
-			for _, x := range template.ContainerSet.GetGraph() {
-				if x.Name == containerName {
-					for _, y := range x.Dependencies {
-						logger.Infof("waiting for dependency %q", y)
-						for {
-							data, err := ioutil.ReadFile(filepath.Clean(varRunArgo + "/ctr/" + y + "/exitcode"))
-							if os.IsNotExist(err) {
-								time.Sleep(time.Second)
-								continue
-							}
-							exitCode, err := strconv.Atoi(string(data))
-							if err != nil {
-								return fmt.Errorf("failed to read exit-code of dependency %q: %w", y, err)
-							}
-							if exitCode != 0 {
-								return fmt.Errorf("dependency %q exited with non-zero code: %d", y, exitCode)
-							}
-							break
-						}
-					}
+			node := template.ContainerSet.GetNode(containerName)
+
+			execute, err := waitForDependencies()
+			if err != nil {
+				return err
+			}
+			if !execute {
+				logger.Infof("not running container: %s", common.ContainerOmittedMessage)
+				if err := ioutil.WriteFile(varRunArgo+"/ctr/"+containerName+"/skipped", nil, 0o644); err != nil {
+					return fmt.Errorf("failed to write skipped marker: %w", err)
+				}
+				terminationMessagePath := "/dev/termination-log"
+				if node != nil && node.TerminationMessagePath != "" {
+					terminationMessagePath = node.TerminationMessagePath
+				}
+				if err := ioutil.WriteFile(terminationMessagePath, []byte(common.ContainerOmittedMessage), 0o644); err != nil {
+					logger.WithError(err).Warn("failed to write termination message")
 				}
+				exitCode = 0
+				return nil
 			}
 
 			name, err = exec.LookPath(name)
@@ -107,29 +107,24 @@ func NewEmissaryCommand() *cobra.Command {
 				return fmt.Errorf("failed to find name in PATH: %w", err)
 			}
 
-			command := exec.Command(name, args...)
-			command.Env = os.Environ()
-			command.SysProcAttr = &syscall.SysProcAttr{}
-			osspecific.Setpgid(command.SysProcAttr)
-			command.Stdout = os.Stdout
-			command.Stderr = os.Stderr
+			stdout, stderr := io.Writer(os.Stdout), io.Writer(os.Stderr)
 
 			// this may not be that important an optimisation, except for very long logs we don't want to capture
 			if includeScriptOutput || template.SaveLogsAsArtifact() {
 				logger.Info("capturing logs")
-				stdout, err := os.Create(varRunArgo + "/ctr/" + containerName + "/stdout")
+				stdoutFile, err := os.Create(varRunArgo + "/ctr/" + containerName + "/stdout")
 				if err != nil {
 					return fmt.Errorf("failed to open stdout: %w", err)
 				}
-				defer func() { _ = stdout.Close() }()
-				command.Stdout = io.MultiWriter(os.Stdout, stdout)
+				defer func() { _ = stdoutFile.Close() }()
+				stdout = io.MultiWriter(os.Stdout, stdoutFile)
 
-				stderr, err := os.Create(varRunArgo + "/ctr/" + containerName + "/stderr")
+				stderrFile, err := os.Create(varRunArgo + "/ctr/" + containerName + "/stderr")
 				if err != nil {
 					return fmt.Errorf("failed to open stderr: %w", err)
 				}
-				defer func() { _ = stderr.Close() }()
-				command.Stderr = io.MultiWriter(os.Stderr, stderr)
+				defer func() { _ = stderrFile.Close() }()
+				stderr = io.MultiWriter(os.Stderr, stderrFile)
 			}
 
 			if _, ok := os.LookupEnv("ARGO_DEBUG_PAUSE_BEFORE"); ok {
@@ -144,23 +139,52 @@ func NewEmissaryCommand() *cobra.Command {
 					break
 				}
 			}
-			if err := command.Start(); err != nil {
-				return err
-			}
 
+			var process atomic.Value // *os.Process
+			var signalled int32
 			go func() {
 				for {
-					data, _ := ioutil.ReadFile(filepath.Clean(varRunArgo + "/ctr/" + containerName + "/signal"))
-					_ = os.Remove(varRunArgo + "/ctr/" + containerName + "/signal")
-					s, _ := strconv.Atoi(string(data))
-					if s > 0 {
-						_ = osspecific.Kill(command.Process.Pid, syscall.Signal(s))
+					// the signal is only read once the sub-process has been started
+					if p, ok := process.Load().(*os.Process); ok {
+						data, _ := ioutil.ReadFile(filepath.Clean(varRunArgo + "/ctr/" + containerName + "/signal"))
+						_ = os.Remove(varRunArgo + "/ctr/" + containerName + "/signal")
+						s, _ := strconv.Atoi(string(data))
+						if s > 0 {
+							atomic.StoreInt32(&signalled, 1)
+							_ = osspecific.Kill(p.Pid, syscall.Signal(s))
+						}
 					}
 					time.Sleep(2 * time.Second)
 				}
 			}()
 
-			cmdErr := command.Wait()
+			retries, err := node.GetRetryStrategy().GetRetries()
+			if err != nil {
+				return err
+			}
+			var cmdErr error
+			for attempt := 0; ; attempt++ {
+				command := exec.Command(name, args...)
+				command.Env = os.Environ()
+				command.SysProcAttr = &syscall.SysProcAttr{}
+				osspecific.Setpgid(command.SysProcAttr)
+				command.Stdout = stdout
+				command.Stderr = stderr
+
+				if err := command.Start(); err != nil {
+					return err
+				}
+				process.Store(command.Process)
+
+				cmdErr = command.Wait()
+
+				// we do not retry containers that were asked to stop, e.g. because the workflow was terminated
+				if cmdErr == nil || attempt >= retries || atomic.LoadInt32(&signalled) == 1 {
+					break
+				}
+				logger.WithError(cmdErr).Infof("retrying container, attempt %d of %d", attempt+1, retries)
+				time.Sleep(node.GetRetryStrategy().GetDuration())
+			}
 
 			if _, ok := os.LookupEnv("ARGO_DEBUG_PAUSE_AFTER"); ok {
 				for {
@@ -188,18 +212,30 @@ func NewEmissaryCommand() *cobra.Command {
 			if containerName == common.MainContainerName {
 				for _, x := range template.Outputs.Parameters {
 					if x.ValueFrom != nil && x.ValueFrom.Path != "" {
-						if err := saveParameter(x.ValueFrom.Path); err != nil {
+						if err := saveParameter(outputsDir(containerName), x.ValueFrom.Path); err != nil {
 							return err
 						}
 					}
 				}
 				for _, x := range template.Outputs.Artifacts {
 					if x.Path != "" {
-						if err := saveArtifact(x.Path); err != nil {
+						if err := saveArtifact(outputsDir(containerName), x); err != nil {
 							return err
 						}
 					}
 				}
+			} else if node != nil && node.Outputs != nil {
+				dir := outputsDir(containerName)
+				for _, x := range node.Outputs.Parameters {
+					if err := saveParameter(dir, x.ValueFrom.Path); err != nil {
+						return err
+					}
+				}
+				for _, x := range node.Outputs.Artifacts {
+					if err := saveArtifact(dir, x); err != nil {
+						return err
+					}
+				}
 			} else {
 				logger.Info("not saving outputs - not main container")
 			}
@@ -209,7 +245,73 @@ func NewEmissaryCommand() *cobra.Command {
 	}
 }
 
-func saveArtifact(srcPath string) error {
+// outputsDir returns the directory the outputs of a container are saved to. The main container's outputs are the
+// template's outputs, other containers in a container set save their outputs within their own directory.
+func outputsDir(containerName string) string {
+	if containerName == common.MainContainerName {
+		return varRunArgo + "/outputs"
+	}
+	return varRunArgo + "/ctr/" + containerName + "/outputs"
+}
+
+// waitForDependencies waits for the containers this container depends on to complete, and then returns whether this
+// container should be executed. Plain dependencies must all succeed, otherwise this container fails, whereas depends
+// logic may skip this container.
+func waitForDependencies() (bool, error) {
+	if node := template.ContainerSet.GetNode(containerName); node != nil && node.Depends == "" {
+		for _, y := range node.Dependencies {
+			exitCode, err := waitForExitCode(y)
+			if err != nil {
+				return false, err
+			}
+			if exitCode != 0 {
+				return false, fmt.Errorf("dependency %q exited with non-zero code: %d", y, exitCode)
+			}
+		}
+		return true, nil
+	}
+	dependencies, logic := common.GetContainerDependencies(template.ContainerSet, containerName)
+	if logic == "" {
+		return true, nil
+	}
+	results := make(map[string]common.ContainerResults)
+	for _, y := range dependencies {
+		exitCode, err := waitForExitCode(y)
+		if err != nil {
+			return false, err
+		}
+		_, err = os.Stat(varRunArgo + "/ctr/" + y + "/skipped")
+		skipped := err == nil
+		results[y] = common.ContainerResults{
+			Succeeded: exitCode == 0 && !skipped,
+			Skipped:   skipped,
+			// special emissary exit code indicating the emissary errors, rather than the sub-process failure
+			Errored: exitCode == 64,
+			Failed:  exitCode != 0 && exitCode != 64,
+		}
+	}
+	return argoexpr.EvalBool(strings.ReplaceAll(logic, "-", "_"), common.ContainerDependsEnv(results))
+}
+
+// waitForExitCode waits for a container to complete, and returns its exit code
+func waitForExitCode(name string) (int, error) {
+	logger.Infof("waiting for dependency %q", name)
+	for {
+		data, err := ioutil.ReadFile(filepath.Clean(varRunArgo + "/ctr/" + name + "/exitcode"))
+		if os.IsNotExist(err) {
+			time.Sleep(time.Second)
+			continue
+		}
+		exitCode, err := strconv.Atoi(string(data))
+		if err != nil {
+			return 0, fmt.Errorf("failed to read exit-code of dependency %q: %w", name, err)
+		}
+		return exitCode, nil
+	}
+}
+
+func saveArtifact(dir string, art wfv1.Artifact) error {
+	srcPath := art.Path
 	if common.FindOverlappingVolume(template, srcPath) != nil {
 		logger.Infof("no need to save artifact - on overlapping volume: %s", srcPath)
 		return nil
@@ -218,7 +320,7 @@ func saveArtifact(srcPath string) error {
 		logger.WithError(err).Errorf("cannot save artifact %s", srcPath)
 		return nil
 	}
-	dstPath := filepath.Join(varRunArgo, "/outputs/artifacts/", strings.TrimSuffix(srcPath, "/")+".tgz")
+	dstPath := filepath.Join(dir, "artifacts", strings.TrimSuffix(srcPath, "/")+".tgz")
 	logger.Infof("%s -> %s", srcPath, dstPath)
 	z := filepath.Dir(dstPath)
 	if err := os.MkdirAll(z, 0o755); err != nil { // chmod rwxr-xr-x
@@ -229,7 +331,8 @@ func saveArtifact(srcPath string) error {
 		return fmt.Errorf("failed to create destination %s: %w", dstPath, err)
 	}
 	defer func() { _ = dst.Close() }()
-	if err = archive.TarGzToWriter(srcPath, gzip.DefaultCompression, dst); err != nil {
+	// the wait container uploads the tarball as it is, or recompresses or unarchives it first
+	if err = archive.TarGzToWriter(srcPath, archive.GzipLevel(art.Archive), dst); err != nil {
 		return fmt.Errorf("failed to tarball the output %s to %s: %w", srcPath, dstPath, err)
 	}
 	if err = dst.Close(); err != nil {
@@ -238,7 +341,7 @@ func saveArtifact(srcPath string) error {
 	return nil
 }
 
-func saveParameter(srcPath string) error {
+func saveParameter(dir, srcPath string) error {
 	if common.FindOverlappingVolume(template, srcPath) != nil {
 		logger.Infof("no need to save parameter - on overlapping volume: %s", srcPath)
 		return nil
@@ -252,7 +355,7 @@ func saveParameter(srcPath string) error {
 		return fmt.Errorf("failed to open %s: %w", srcPath, err)
 	}
 	defer func() { _ = src.Close() }()
-	dstPath := varRunArgo + "/outputs/parameters/" + srcPath
+	dstPath := filepath.Join(dir, "parameters", srcPath)
 	logger.Infof("%s -> %s", srcPath, dstPath)
 	z := filepath.Dir(dstPath)
 	if err := os.MkdirAll(z, 0o755); err != nil { // chmod rwxr-xr-x
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func TestEmissary(t *testing.T) {
@@ -127,10 +129,82 @@ func TestEmissary(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Contains(t, string(data), "hello")
 	})
+	t.Run("Retries", func(t *testing.T) {
+		err = ioutil.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"containerSet": {
+		"containers": [
+			{"name": "main", "retryStrategy": {"retries": 1}}
+		]
+	}
+}
+`), 0o600)
+		assert.NoError(t, err)
+		marker := filepath.Join(tmp, "attempted")
+		err := run("/bin/sh", []string{"-c", "test -f " + marker + " || { touch " + marker + "; exit 1; }"})
+		assert.NoError(t, err)
+		data, err := ioutil.ReadFile(varRunArgo + "/ctr/main/exitcode")
+		assert.NoError(t, err)
+		assert.Equal(t, "0", string(data))
+	})
+	t.Run("Depends", func(t *testing.T) {
+		terminationLog := filepath.Join(tmp, "termination-log")
+		err = ioutil.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"containerSet": {
+		"containers": [
+			{"name": "build"},
+			{"name": "cleanup", "depends": "build.Failed", "terminationMessagePath": "`+terminationLog+`"}
+		]
+	}
+}
+`), 0o600)
+		assert.NoError(t, err)
+		assert.NoError(t, os.MkdirAll(varRunArgo+"/ctr/build", 0o755))
+
+		assert.NoError(t, ioutil.WriteFile(varRunArgo+"/ctr/build/exitcode", []byte("1"), 0o600))
+		err := runContainer("cleanup", x, []string{"echo", "hello", "/dev/stdout"})
+		assert.NoError(t, err)
+		_, err = os.Stat(varRunArgo + "/ctr/cleanup/skipped")
+		assert.True(t, os.IsNotExist(err))
+
+		assert.NoError(t, ioutil.WriteFile(varRunArgo+"/ctr/build/exitcode", []byte("0"), 0o600))
+		err = runContainer("cleanup", x, []string{"exit", "1"})
+		assert.NoError(t, err)
+		_, err = os.Stat(varRunArgo + "/ctr/cleanup/skipped")
+		assert.NoError(t, err)
+		data, err := ioutil.ReadFile(terminationLog)
+		assert.NoError(t, err)
+		assert.Equal(t, common.ContainerOmittedMessage, string(data))
+		data, err = ioutil.ReadFile(varRunArgo + "/ctr/cleanup/exitcode")
+		assert.NoError(t, err)
+		assert.Equal(t, "0", string(data))
+	})
+	t.Run("ContainerParameter", func(t *testing.T) {
+		err = ioutil.WriteFile(varRunArgo+"/template", []byte(`
+{
+	"containerSet": {
+		"containers": [
+			{"name": "report", "outputs": {"parameters": [{"name": "message", "valueFrom": {"path": "/tmp/report"}}]}}
+		]
+	}
+}
+`), 0o600)
+		assert.NoError(t, err)
+		err := runContainer("report", x, []string{"echo", "hello", "/tmp/report"})
+		assert.NoError(t, err)
+		data, err := ioutil.ReadFile(varRunArgo + "/ctr/report/outputs/parameters/tmp/report")
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "hello")
+	})
 }
 
 func run(name string, args []string) error {
+	return runContainer("main", name, args)
+}
+
+func runContainer(ctrName, name string, args []string) error {
 	cmd := NewEmissaryCommand()
-	containerName = "main"
+	containerName = ctrName
 	return cmd.RunE(cmd, append([]string{name}, args...))
 }
@@ -13,7 +13,7 @@ import (
 
 func NewResourceCommand() *cobra.Command {
 	command := cobra.Command{
-		Use:   "resource (get|create|apply|delete) MANIFEST",
+		Use:   "resource (get|create|apply|delete|replace|patch)",
 		Short: "update a resource and wait for resource conditions",
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) != 1 {
@@ -40,25 +40,29 @@ func execResource(ctx context.Context, action string) error {
 		return err
 	}
 	isDelete := action == "delete"
-	if isDelete && (wfExecutor.Template.Resource.SuccessCondition != "" || wfExecutor.Template.Resource.FailureCondition != "" || len(wfExecutor.Template.Outputs.Parameters) > 0) {
-		err = fmt.Errorf("successCondition, failureCondition and outputs are not supported for delete action")
+	if isDelete && (wfExecutor.Template.Resource.SuccessCondition != "" || wfExecutor.Template.Resource.FailureCondition != "" || (wfExecutor.Template.Resource.WaitForReady != nil && *wfExecutor.Template.Resource.WaitForReady) || len(wfExecutor.Template.Outputs.Parameters) > 0) {
+		err = fmt.Errorf("successCondition, failureCondition, waitForReady and outputs are not supported for delete action")
 		wfExecutor.AddError(err)
 		return err
 	}
-	resourceNamespace, resourceName, selfLink, err := wfExecutor.ExecResource(
-		action, common.ExecutorResourceManifestPath, wfExecutor.Template.Resource.Flags,
+	objs, err := wfExecutor.ExecResource(
+		ctx, action, common.ExecutorResourceManifestPath, wfExecutor.Template.Resource.Flags,
 	)
+	// resources created before an error must still be cleaned up
+	if annotateErr := wfExecutor.AnnotateCreatedResources(ctx); annotateErr != nil && err == nil {
+		err = annotateErr
+	}
 	if err != nil {
 		wfExecutor.AddError(err)
 		return err
 	}
 	if !isDelete {
-		err = wfExecutor.WaitResource(ctx, resourceNamespace, resourceName, selfLink)
+		err = wfExecutor.WaitResource(ctx, objs)
 		if err != nil {
 			wfExecutor.AddError(err)
 			return err
 		}
-		err = wfExecutor.SaveResourceParameters(ctx, resourceNamespace, resourceName)
+		err = wfExecutor.SaveResourceParameters(ctx, objs)
 		if err != nil {
 			wfExecutor.AddError(err)
 			return err
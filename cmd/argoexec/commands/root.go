@@ -10,8 +10,12 @@ import (
 	kubecli "github.com/argoproj/pkg/kube/cli"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/argoproj/argo-workflows/v3"
@@ -60,6 +64,7 @@ func NewRootCommand() *cobra.Command {
 	}
 
 	command.AddCommand(NewAgentCommand())
+	command.AddCommand(NewArtifactCommand())
 	command.AddCommand(NewEmissaryCommand())
 	command.AddCommand(NewInitCommand())
 	command.AddCommand(NewResourceCommand())
@@ -91,7 +96,13 @@ func initExecutor() *executor.WorkflowExecutor {
 	clientset, err := kubernetes.NewForConfig(config)
 	checkErr(err)
 
-	restClient := clientset.RESTClient()
+	dynamicClient, err := dynamic.NewForConfig(config)
+	checkErr(err)
+
+	// discovery is deferred, so that only resource templates need to discover resources
+	discoveryClient := memory.NewMemCacheClient(clientset.Discovery())
+	deferredMapper := restmapper.NewDeferredDiscoveryRESTMapper(discoveryClient)
+	restMapper := resettableRESTMapper{RESTMapper: restmapper.NewShortcutExpander(deferredMapper, discoveryClient), deferred: deferredMapper}
 
 	podName, ok := os.LookupEnv(common.EnvVarPodName)
 	if !ok {
@@ -125,7 +136,7 @@ func initExecutor() *executor.WorkflowExecutor {
 	}
 	checkErr(err)
 
-	wfExecutor := executor.NewExecutor(clientset, restClient, podName, namespace, cre, *tmpl, includeScriptOutput, deadline, annotationPatchTickDuration, progressFileTickDuration)
+	wfExecutor := executor.NewExecutor(clientset, dynamicClient, restMapper, podName, namespace, cre, *tmpl, includeScriptOutput, deadline, annotationPatchTickDuration, progressFileTickDuration)
 
 	log.
 		WithField("version", version.String()).
@@ -145,3 +156,14 @@ func checkErr(err error) {
 		panic(err.Error())
 	}
 }
+
+// resettableRESTMapper is a REST mapper that expands shortcuts, whose discovered resources can be reset, so that the
+// executor discovers the kinds of custom resource definitions created since
+type resettableRESTMapper struct {
+	meta.RESTMapper
+	deferred *restmapper.DeferredDiscoveryRESTMapper
+}
+
+func (m resettableRESTMapper) Reset() {
+	m.deferred.Reset()
+}
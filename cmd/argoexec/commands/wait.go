@@ -61,6 +61,11 @@ func waitContainer(ctx context.Context) error {
 	if err != nil {
 		wfExecutor.AddError(err)
 	}
+	// Saving outputs of other containers in a container set
+	err = wfExecutor.SaveContainerOutputs(ctx)
+	if err != nil {
+		wfExecutor.AddError(err)
+	}
 	// Annotating pod with output
 	err = wfExecutor.AnnotateOutputs(ctx, logArt)
 	if err != nil {
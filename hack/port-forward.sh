@@ -21,6 +21,11 @@ wait-for() {
 wait-for minio
 pf minio 9000
 
+wait-for azurite
+pf azurite 10000
+wait-for registry
+pf registry 5000
+
 dex=$(kubectl -n argo get pod -l app=dex -o name)
 if [[ "$dex" != "" ]]; then
   wait-for dex
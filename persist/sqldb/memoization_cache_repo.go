@@ -0,0 +1,201 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+)
+
+const memoizationCacheTableName = "argo_memoization_cache"
+
+// MemoizationCacheRecord is an entry of a memoization cache, Outputs is the JSON of the outputs of the memoized node
+type MemoizationCacheRecord struct {
+	ClusterName string `db:"clustername"`
+	Namespace   string `db:"namespace"`
+	Name        string `db:"name"`
+	// "key" is a reserved word
+	Key               string    `db:"cachekey"`
+	NodeID            string    `db:"nodeid"`
+	WorkflowName      string    `db:"workflowname"`
+	WorkflowNamespace string    `db:"workflownamespace"`
+	Outputs           string    `db:"outputs"`
+	CreatedAt         time.Time `db:"createdat"`
+	LastHitAt         time.Time `db:"lasthitat"`
+	HitCount          int64     `db:"hitcount"`
+}
+
+//go:generate mockery --name=MemoizationCacheRepo
+
+type MemoizationCacheRepo interface {
+	IsEnabled() bool
+	// Load returns the entry and records that it has been hit, or nil if there is no such entry.
+	Load(namespace, name, key string) (*MemoizationCacheRecord, error)
+	// Save creates or replaces the entry, then evicts the least recently hit entries of its cache if it has more than maxEntries.
+	// A maxEntries of zero means the cache is not limited.
+	Save(record *MemoizationCacheRecord, maxEntries int) error
+	// List returns the entries of the cache whose keys have the prefix, without recording that they have been hit.
+	List(namespace, name, keyPrefix string) ([]MemoizationCacheRecord, error)
+	// ListCaches returns the names of the caches that have entries.
+	ListCaches(namespace string) ([]string, error)
+	// Delete deletes the entries of the cache with the keys.
+	Delete(namespace, name string, keys []string) error
+	// DeleteNotHitSince deletes the entries, in any cache, that have not been hit since the time.
+	DeleteNotHitSince(t time.Time) (int64, error)
+}
+
+type memoizationCacheRepo struct {
+	session     sqlbuilder.Database
+	clusterName string
+}
+
+// NewMemoizationCacheRepo returns a new memoizationCacheRepo
+func NewMemoizationCacheRepo(session sqlbuilder.Database, clusterName string) MemoizationCacheRepo {
+	return &memoizationCacheRepo{session: session, clusterName: clusterName}
+}
+
+func (r *memoizationCacheRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *memoizationCacheRepo) entry(namespace, name, key string) db.Cond {
+	return db.Cond{"clustername": r.clusterName, "namespace": namespace, "name": name, "cachekey": key}
+}
+
+func (r *memoizationCacheRepo) Load(namespace, name, key string) (*MemoizationCacheRecord, error) {
+	record := &MemoizationCacheRecord{}
+	err := r.session.
+		SelectFrom(memoizationCacheTableName).
+		Where(r.entry(namespace, name, key)).
+		One(record)
+	if err == db.ErrNoMoreRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	record.LastHitAt = time.Now().UTC()
+	record.HitCount++
+	_, err = r.session.
+		Update(memoizationCacheTableName).
+		Set("lasthitat", record.LastHitAt).
+		Set("hitcount = hitcount + 1").
+		Where(r.entry(namespace, name, key)).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to record the hit of the cache entry: %w", err)
+	}
+	return record, nil
+}
+
+func (r *memoizationCacheRepo) Save(record *MemoizationCacheRecord, maxEntries int) error {
+	record.ClusterName = r.clusterName
+	logCtx := log.WithFields(log.Fields{"namespace": record.Namespace, "name": record.Name, "key": record.Key})
+	logCtx.Debug("Saving memoization cache entry")
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.
+			DeleteFrom(memoizationCacheTableName).
+			Where(r.entry(record.Namespace, record.Name, record.Key)).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = sess.Collection(memoizationCacheTableName).Insert(record)
+		if err != nil {
+			return err
+		}
+		if maxEntries <= 0 {
+			return nil
+		}
+		var keys []struct {
+			Key string `db:"cachekey"`
+		}
+		err = sess.
+			Select("cachekey").
+			From(memoizationCacheTableName).
+			Where(db.Cond{"clustername": r.clusterName, "namespace": record.Namespace, "name": record.Name}).
+			// the entry that has just been saved is never evicted
+			And(db.Cond{"cachekey <>": record.Key}).
+			OrderBy("-lasthitat", "cachekey").
+			All(&keys)
+		if err != nil {
+			return err
+		}
+		if len(keys) < maxEntries {
+			return nil
+		}
+		var evicted []string
+		for _, k := range keys[maxEntries-1:] {
+			evicted = append(evicted, k.Key)
+		}
+		_, err = sess.
+			DeleteFrom(memoizationCacheTableName).
+			Where(db.Cond{"clustername": r.clusterName, "namespace": record.Namespace, "name": record.Name}).
+			And(db.Cond{"cachekey IN": evicted}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		logCtx.WithField("evicted", len(evicted)).Info("Evicted memoization cache entries")
+		return nil
+	})
+}
+
+func (r *memoizationCacheRepo) List(namespace, name, keyPrefix string) ([]MemoizationCacheRecord, error) {
+	var records []MemoizationCacheRecord
+	err := r.session.
+		SelectFrom(memoizationCacheTableName).
+		Where(db.Cond{"clustername": r.clusterName, "namespace": namespace, "name": name}).
+		And(db.Cond{"cachekey LIKE": keyPrefix + "%"}).
+		OrderBy("cachekey").
+		All(&records)
+	return records, err
+}
+
+func (r *memoizationCacheRepo) ListCaches(namespace string) ([]string, error) {
+	var caches []struct {
+		Name string `db:"name"`
+	}
+	err := r.session.
+		Select().
+		Distinct("name").
+		From(memoizationCacheTableName).
+		Where(db.Cond{"clustername": r.clusterName, "namespace": namespace}).
+		OrderBy("name").
+		All(&caches)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, c := range caches {
+		names = append(names, c.Name)
+	}
+	return names, nil
+}
+
+func (r *memoizationCacheRepo) Delete(namespace, name string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := r.session.
+		DeleteFrom(memoizationCacheTableName).
+		Where(db.Cond{"clustername": r.clusterName, "namespace": namespace, "name": name}).
+		And(db.Cond{"cachekey IN": keys}).
+		Exec()
+	return err
+}
+
+func (r *memoizationCacheRepo) DeleteNotHitSince(t time.Time) (int64, error) {
+	rs, err := r.session.
+		DeleteFrom(memoizationCacheTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"lasthitat <": t.UTC()}).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+	return rs.RowsAffected()
+}
@@ -253,6 +253,24 @@ func (m migrate) Exec(ctx context.Context) (err error) {
 		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
 		// add argo_archived_workflows name index for prefix searching performance
 		ansiSQLChange(`create index argo_archived_workflows_i3 on argo_archived_workflows (clustername,instanceid,name)`),
+		// entries of the memoization caches of the SQL type, the outputs are the JSON of the memoized node's outputs, with how
+		// often the entries are hit and the workflows that created them
+		ansiSQLChange(`create table if not exists argo_memoization_cache (
+    clustername varchar(64) not null,
+    namespace varchar(63) not null,
+    name varchar(253) not null,
+    cachekey varchar(253) not null,
+    nodeid varchar(256) not null,
+    outputs json not null,
+    createdat timestamp not null default current_timestamp,
+    lasthitat timestamp not null default current_timestamp,
+    hitcount bigint not null default 0,
+    workflowname varchar(256) not null default '',
+    workflownamespace varchar(63) not null default '',
+    primary key (clustername, namespace, name, cachekey)
+)`),
+		// index to find the entries to evict or to garbage-collect
+		ansiSQLChange(`create index argo_memoization_cache_i1 on argo_memoization_cache (clustername,lasthitat)`),
 	} {
 		err := m.applyChange(ctx, changeSchemaVersion, change)
 		if err != nil {
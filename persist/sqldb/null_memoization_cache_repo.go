@@ -0,0 +1,41 @@
+package sqldb
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	NullMemoizationCacheRepo          MemoizationCacheRepo = &nullMemoizationCacheRepo{}
+	MemoizationCacheNotSupportedError                      = fmt.Errorf("SQL memoization caches require persistence to be configured")
+)
+
+type nullMemoizationCacheRepo struct{}
+
+func (r *nullMemoizationCacheRepo) IsEnabled() bool {
+	return false
+}
+
+func (r *nullMemoizationCacheRepo) Load(string, string, string) (*MemoizationCacheRecord, error) {
+	return nil, MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) Save(*MemoizationCacheRecord, int) error {
+	return MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) List(string, string, string) ([]MemoizationCacheRecord, error) {
+	return nil, MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) ListCaches(string) ([]string, error) {
+	return nil, nil
+}
+
+func (r *nullMemoizationCacheRepo) Delete(string, string, []string) error {
+	return MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) DeleteNotHitSince(time.Time) (int64, error) {
+	return 0, nil
+}
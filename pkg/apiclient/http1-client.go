@@ -3,6 +3,7 @@ package apiclient
 import (
 	"context"
 
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/http1"
@@ -40,6 +41,10 @@ func (h httpClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error) {
 	return http1.InfoServiceClient(h), nil
 }
 
+func (h httpClient) NewCacheServiceClient() (cachepkg.CacheServiceClient, error) {
+	return http1.CacheServiceClient(h), nil
+}
+
 func newHTTP1Client(baseUrl string, auth string, insecureSkipVerify bool) (context.Context, Client, error) {
 	return context.Background(), httpClient(http1.NewFacade(baseUrl, auth, insecureSkipVerify)), nil
 }
@@ -0,0 +1,31 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+)
+
+type CacheServiceClient = Facade
+
+func (h CacheServiceClient) ListCacheEntries(_ context.Context, in *cachepkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntryList, error) {
+	out := &cachepkg.CacheEntryList{}
+	return out, h.Get(in, out, "/api/v1/cache-entries/{namespace}")
+}
+
+func (h CacheServiceClient) GetCacheEntry(_ context.Context, in *cachepkg.GetCacheEntryRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntry, error) {
+	out := &cachepkg.CacheEntry{}
+	return out, h.Get(in, out, "/api/v1/cache-entries/{namespace}/{cacheType}/{cacheName}/{key}")
+}
+
+func (h CacheServiceClient) DeleteCacheEntry(_ context.Context, in *cachepkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntryDeletedResponse, error) {
+	out := &cachepkg.CacheEntryDeletedResponse{}
+	return out, h.Delete(in, out, "/api/v1/cache-entries/{namespace}/{cacheType}/{cacheName}/{key}")
+}
+
+func (h CacheServiceClient) PruneCacheEntries(_ context.Context, in *cachepkg.PruneCacheEntriesRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntryList, error) {
+	out := &cachepkg.CacheEntryList{}
+	return out, h.Post(in, out, "/api/v1/cache-entries/{namespace}/prune")
+}
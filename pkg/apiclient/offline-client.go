@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	infopkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/info"
@@ -42,6 +43,10 @@ func (a *offlineClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error
 	return nil, NotImplError
 }
 
+func (a *offlineClient) NewCacheServiceClient() (cachepkg.CacheServiceClient, error) {
+	return nil, NotImplError
+}
+
 func (a *offlineClient) NewClusterWorkflowTemplateServiceClient() (clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient, error) {
 	return nil, NotImplError
 }
@@ -255,6 +255,7 @@ type WorkflowResubmitRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Memoized             bool     `protobuf:"varint,3,opt,name=memoized,proto3" json:"memoized,omitempty"`
+	Parameters           []string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -314,11 +315,19 @@ func (m *WorkflowResubmitRequest) GetMemoized() bool {
 	return false
 }
 
+func (m *WorkflowResubmitRequest) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
 type WorkflowRetryRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	RestartSuccessful    bool     `protobuf:"varint,3,opt,name=restartSuccessful,proto3" json:"restartSuccessful,omitempty"`
 	NodeFieldSelector    string   `protobuf:"bytes,4,opt,name=nodeFieldSelector,proto3" json:"nodeFieldSelector,omitempty"`
+	Parameters           []string `protobuf:"bytes,5,rep,name=parameters,proto3" json:"parameters,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -385,6 +394,13 @@ func (m *WorkflowRetryRequest) GetNodeFieldSelector() string {
 	return ""
 }
 
+func (m *WorkflowRetryRequest) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
 type WorkflowResumeRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -1288,96 +1304,97 @@ func init() {
 }
 
 var fileDescriptor_1f6bb75f9e833cb6 = []byte{
-	// 1416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x4f, 0x6f, 0xdc, 0xc4,
-	0x1b, 0xc7, 0x35, 0x9b, 0x36, 0x4d, 0x26, 0x7f, 0xda, 0xce, 0xaf, 0xed, 0x6f, 0xb1, 0xda, 0x34,
-	0x9d, 0x52, 0x48, 0xd3, 0xc6, 0xce, 0x26, 0x05, 0x5a, 0x24, 0x90, 0x68, 0x53, 0x22, 0xca, 0x52,
-	0x2a, 0x2f, 0x12, 0x82, 0x0b, 0x72, 0xbc, 0x13, 0xc7, 0x8d, 0xed, 0x31, 0x33, 0xb3, 0x5b, 0x85,
-	0x52, 0x24, 0xb8, 0xc0, 0x01, 0x89, 0x03, 0x47, 0x2e, 0x08, 0x81, 0xe0, 0x80, 0x00, 0x21, 0x21,
-	0x21, 0x21, 0x21, 0x8e, 0x1c, 0x2b, 0xf5, 0xca, 0x01, 0x55, 0xbc, 0x01, 0xde, 0x01, 0x9a, 0xb1,
-	0x3d, 0xb6, 0xb3, 0xdb, 0xc5, 0x24, 0x1b, 0xe8, 0x6d, 0xc6, 0xf6, 0xcc, 0xf3, 0x99, 0xef, 0x33,
-	0xf3, 0x3c, 0xcf, 0x18, 0x9e, 0x89, 0x37, 0x3d, 0xcb, 0x89, 0x7d, 0x37, 0xf0, 0x49, 0x24, 0xac,
-	0x5b, 0x94, 0x6d, 0xae, 0x07, 0xf4, 0x96, 0x6e, 0x98, 0x31, 0xa3, 0x82, 0xa2, 0xb1, 0xac, 0x6f,
-	0x1c, 0xf7, 0x28, 0xf5, 0x02, 0x22, 0xc7, 0x58, 0x4e, 0x14, 0x51, 0xe1, 0x08, 0x9f, 0x46, 0x3c,
-	0xf9, 0xce, 0xb8, 0xb0, 0x79, 0x91, 0x9b, 0x3e, 0x95, 0x6f, 0x43, 0xc7, 0xdd, 0xf0, 0x23, 0xc2,
-	0xb6, 0xac, 0xd4, 0x04, 0xb7, 0x42, 0x22, 0x1c, 0xab, 0xdb, 0xb0, 0x3c, 0x12, 0x11, 0xe6, 0x08,
-	0xd2, 0x4e, 0x47, 0xbd, 0xe4, 0xf9, 0x62, 0xa3, 0xb3, 0x66, 0xba, 0x34, 0xb4, 0x1c, 0xe6, 0xd1,
-	0x98, 0xd1, 0x9b, 0xaa, 0xb1, 0x90, 0x99, 0xe5, 0xf9, 0x24, 0x1a, 0xb1, 0xdb, 0x70, 0x82, 0x78,
-	0xc3, 0xe9, 0x9d, 0x0e, 0xe7, 0x10, 0x96, 0x4b, 0x19, 0xe9, 0x63, 0x12, 0xff, 0x52, 0x83, 0x47,
-	0x5f, 0x4d, 0x67, 0xba, 0xc2, 0x88, 0x23, 0x88, 0x4d, 0xde, 0xec, 0x10, 0x2e, 0xd0, 0x71, 0x38,
-	0x1e, 0x39, 0x21, 0xe1, 0xb1, 0xe3, 0x92, 0x3a, 0x98, 0x05, 0x73, 0xe3, 0x76, 0xfe, 0x00, 0xad,
-	0x43, 0x2d, 0x45, 0xbd, 0x36, 0x0b, 0xe6, 0x26, 0x96, 0xae, 0x99, 0x39, 0xbd, 0x99, 0xd1, 0xab,
-	0xc6, 0x1b, 0x9a, 0xde, 0xec, 0x2e, 0x9b, 0xf1, 0xa6, 0x67, 0xca, 0x05, 0x98, 0x5a, 0xda, 0x6c,
-	0x01, 0x66, 0x06, 0x62, 0xeb, 0xb9, 0x11, 0x86, 0xd0, 0x8f, 0xb8, 0x70, 0x22, 0x97, 0xbc, 0xb0,
-	0x52, 0x1f, 0x91, 0x18, 0x97, 0x6b, 0x75, 0x60, 0x17, 0x9e, 0x22, 0x0c, 0x27, 0x39, 0x61, 0x5d,
-	0xc2, 0x56, 0xd8, 0x96, 0xdd, 0x89, 0xea, 0xfb, 0x66, 0xc1, 0xdc, 0x98, 0x5d, 0x7a, 0x86, 0x5e,
-	0x83, 0x53, 0xae, 0x5a, 0xde, 0xcb, 0xb1, 0xf2, 0x53, 0x7d, 0xbf, 0x82, 0x5e, 0x36, 0x13, 0x8d,
-	0xcc, 0xa2, 0xa3, 0x72, 0x44, 0xe9, 0x28, 0xb3, 0xdb, 0x30, 0xaf, 0x14, 0x87, 0xda, 0xe5, 0x99,
-	0xf0, 0x77, 0x00, 0xa2, 0x8c, 0x7c, 0x95, 0x88, 0x4c, 0x3f, 0x04, 0xf7, 0x49, 0xb9, 0x52, 0xe9,
-	0x54, 0xbb, 0xac, 0x69, 0x6d, 0xbb, 0xa6, 0x37, 0x20, 0xf4, 0x88, 0xc8, 0x00, 0x47, 0x14, 0xe0,
-	0x62, 0x35, 0xc0, 0x55, 0x3d, 0xce, 0x2e, 0xcc, 0x81, 0x8e, 0xc1, 0xd1, 0x75, 0x9f, 0x04, 0x6d,
-	0xae, 0x34, 0x19, 0xb7, 0xd3, 0x1e, 0xfe, 0x14, 0xc0, 0xff, 0x65, 0xc8, 0x4d, 0x9f, 0x8b, 0x6a,
-	0x3e, 0x6f, 0xc1, 0x89, 0xc0, 0xe7, 0x1a, 0x30, 0x71, 0x7b, 0xa3, 0x1a, 0x60, 0x33, 0x1f, 0x68,
-	0x17, 0x67, 0x29, 0x20, 0x8e, 0x94, 0x10, 0x3d, 0xf8, 0x7f, 0xbd, 0x1d, 0x08, 0xef, 0xac, 0x85,
-	0xfe, 0x2e, 0x94, 0x35, 0xe0, 0x58, 0x48, 0x42, 0xea, 0xbf, 0x45, 0xda, 0xca, 0xcc, 0x98, 0xad,
-	0xfb, 0xf8, 0x73, 0x00, 0x8f, 0xe4, 0x96, 0x04, 0xdb, 0xda, 0xb9, 0x99, 0xf3, 0xf0, 0x30, 0x23,
-	0x5c, 0x38, 0x4c, 0xb4, 0x3a, 0xae, 0x4b, 0x38, 0x5f, 0xef, 0x04, 0xa9, 0xbd, 0xde, 0x17, 0xf2,
-	0xeb, 0x88, 0xb6, 0xc9, 0xf3, 0x72, 0xbd, 0x2d, 0x12, 0x10, 0x57, 0x50, 0x96, 0xfa, 0xa9, 0xf7,
-	0x05, 0xbe, 0x95, 0x9f, 0x53, 0xa9, 0x47, 0x48, 0x76, 0x85, 0xd9, 0x6b, 0x78, 0xe4, 0x41, 0x86,
-	0x9b, 0xb0, 0x9e, 0x19, 0x7e, 0x85, 0xb0, 0xd0, 0x8f, 0x0a, 0x31, 0xe2, 0x1f, 0xdb, 0xc6, 0x1f,
-	0x15, 0x76, 0x5e, 0x4b, 0xd0, 0xf8, 0x5f, 0x5a, 0x05, 0xaa, 0xc3, 0x03, 0x21, 0xe1, 0xdc, 0xf1,
-	0x48, 0x2a, 0x71, 0xd6, 0xc5, 0x77, 0x0b, 0xc7, 0xb7, 0xb5, 0x9b, 0xe3, 0x3b, 0x24, 0x20, 0x74,
-	0x04, 0xee, 0x8f, 0x37, 0x1c, 0x4e, 0x54, 0x88, 0x1a, 0xb7, 0x93, 0x0e, 0x9a, 0x87, 0x87, 0x68,
-	0x47, 0xc4, 0x1d, 0x71, 0xc3, 0x61, 0x4e, 0x48, 0x04, 0x61, 0xbc, 0x3e, 0xaa, 0x3e, 0xe8, 0x79,
-	0x8e, 0xaf, 0xc1, 0x63, 0x7a, 0x45, 0x1d, 0x1e, 0x93, 0xa8, 0xbd, 0x73, 0x87, 0xdd, 0x2b, 0xc8,
-	0xd3, 0xa4, 0xde, 0xce, 0xe5, 0xa9, 0xc3, 0x03, 0x31, 0x6d, 0x5f, 0x97, 0x83, 0x12, 0x51, 0xb2,
-	0x2e, 0x7a, 0x0e, 0xc2, 0x80, 0x7a, 0x59, 0x58, 0xd9, 0xa7, 0xc2, 0xca, 0xa9, 0x42, 0x58, 0x31,
-	0x65, 0xf2, 0x92, 0x41, 0xe4, 0x06, 0x6d, 0x37, 0xf5, 0x87, 0x76, 0x61, 0x90, 0xc4, 0xf1, 0x18,
-	0x89, 0x53, 0xc9, 0x54, 0x5b, 0x1e, 0x7a, 0x9e, 0xb9, 0x21, 0x51, 0x4a, 0xf7, 0xe5, 0xa1, 0xd7,
-	0xc7, 0x69, 0x85, 0x04, 0x64, 0x17, 0x5b, 0x5a, 0xa6, 0x96, 0xb6, 0x9a, 0xa2, 0x1c, 0xb9, 0x2b,
-	0xa6, 0x96, 0x95, 0xe2, 0x50, 0xbb, 0x3c, 0x13, 0xae, 0xe7, 0x8e, 0xcc, 0x28, 0x79, 0x4c, 0x23,
-	0x4e, 0xf0, 0x67, 0x72, 0x01, 0x8e, 0x70, 0x37, 0xb2, 0xf7, 0xfc, 0x21, 0x8c, 0xe1, 0x1f, 0x16,
-	0xf6, 0x8e, 0x82, 0xbd, 0xda, 0x25, 0x91, 0x92, 0x58, 0x6c, 0xc5, 0x5a, 0x62, 0xd9, 0x46, 0x6b,
-	0x70, 0x94, 0xae, 0xdd, 0x24, 0xae, 0xd8, 0x83, 0x6a, 0x22, 0x9d, 0x19, 0xbf, 0x2f, 0x71, 0x34,
-	0xc6, 0x7f, 0x28, 0x18, 0x7e, 0x16, 0x8e, 0x35, 0xa9, 0x77, 0x35, 0x12, 0x6c, 0x4b, 0x9e, 0x0b,
-	0x97, 0x46, 0x82, 0x44, 0x22, 0x35, 0x9e, 0x75, 0x8b, 0x27, 0xa6, 0x56, 0x3a, 0x31, 0xf8, 0x93,
-	0x52, 0xfe, 0x8e, 0xc4, 0x43, 0x55, 0xb3, 0xe1, 0x3f, 0x0b, 0x87, 0xab, 0x55, 0xca, 0xdc, 0x83,
-	0xf9, 0x30, 0x9c, 0x64, 0x84, 0xd3, 0x0e, 0x73, 0xc9, 0x8b, 0x7e, 0xd4, 0x4e, 0x17, 0x5d, 0x7a,
-	0x56, 0xfc, 0xa6, 0x10, 0x4a, 0x4a, 0xcf, 0x10, 0x83, 0x53, 0x49, 0xc1, 0x50, 0x0e, 0x29, 0xcd,
-	0xdd, 0x2f, 0xb6, 0x95, 0x4d, 0xcb, 0xed, 0xb2, 0x89, 0xa5, 0xdf, 0x8e, 0xc2, 0x83, 0x79, 0x16,
-	0x61, 0x5d, 0xdf, 0x25, 0xe8, 0x4b, 0x00, 0xa7, 0x93, 0xca, 0x31, 0x7b, 0x83, 0x4e, 0xe6, 0x93,
-	0xf6, 0xad, 0xba, 0x8d, 0x21, 0x7a, 0x04, 0xcf, 0xbd, 0x77, 0xef, 0x8f, 0x8f, 0x6b, 0x18, 0x9f,
-	0x50, 0x37, 0x80, 0x6e, 0xc3, 0xca, 0x6f, 0x11, 0xb7, 0xb5, 0xea, 0x77, 0x9e, 0x06, 0xf3, 0xe8,
-	0x0b, 0x00, 0x27, 0x56, 0x89, 0xd0, 0x98, 0xc7, 0x7b, 0x31, 0xf3, 0xca, 0x76, 0xa8, 0x8c, 0xe7,
-	0x15, 0xe3, 0x63, 0xe8, 0xd1, 0x81, 0x8c, 0x49, 0xfb, 0x8e, 0xe4, 0x9c, 0x92, 0x87, 0x4a, 0x07,
-	0x3d, 0x74, 0xa2, 0x97, 0xb4, 0x50, 0xd0, 0x1a, 0xd7, 0x87, 0x87, 0x2a, 0xa7, 0xc5, 0x67, 0x14,
-	0xee, 0x49, 0x34, 0x58, 0x52, 0xf4, 0x0e, 0x9c, 0x2e, 0x07, 0xe7, 0x92, 0xe3, 0xfb, 0x85, 0x6d,
-	0xa3, 0x8f, 0xe4, 0x79, 0xac, 0xc2, 0xe7, 0x94, 0xdd, 0x33, 0xe8, 0xf4, 0x76, 0xbb, 0x0b, 0x44,
-	0xc5, 0xb2, 0xa2, 0xf5, 0x45, 0x80, 0x38, 0x9c, 0x28, 0x04, 0xba, 0x92, 0x3b, 0x7b, 0xe2, 0x9f,
-	0xf1, 0x48, 0xbf, 0x54, 0x9b, 0x98, 0x3d, 0xab, 0xcc, 0x9e, 0x46, 0xa7, 0x32, 0xb3, 0x5c, 0x30,
-	0xe2, 0x84, 0x56, 0x5f, 0xa3, 0xef, 0x02, 0x38, 0x9d, 0x64, 0xa9, 0x41, 0xdb, 0xbd, 0x94, 0x6d,
-	0x8d, 0xd9, 0x07, 0x7f, 0x90, 0x26, 0xba, 0x74, 0x83, 0xcc, 0x57, 0xdb, 0x20, 0xdf, 0x03, 0x38,
-	0xa5, 0x8a, 0x78, 0x8d, 0x30, 0xd3, 0x6b, 0xa1, 0x58, 0xe5, 0x0f, 0x75, 0x33, 0x3f, 0xa1, 0x58,
-	0x2d, 0x63, 0xbe, 0x0a, 0xab, 0xc5, 0x24, 0x86, 0x3c, 0x7d, 0x3f, 0x01, 0x78, 0x28, 0xbb, 0xe3,
-	0x68, 0xee, 0x53, 0xfd, 0xb8, 0x4b, 0xf7, 0xa0, 0xa1, 0xa2, 0x5f, 0x54, 0xe8, 0x4b, 0xc6, 0x42,
-	0x45, 0xf4, 0x84, 0x44, 0xd2, 0xff, 0x00, 0xe0, 0x74, 0x72, 0x23, 0x19, 0xe4, 0xf6, 0xd2, 0x9d,
-	0x65, 0xa8, 0xe4, 0x4f, 0x2a, 0xf2, 0x45, 0xe3, 0x5c, 0x65, 0xf2, 0x90, 0x48, 0xee, 0x1f, 0x01,
-	0x3c, 0x98, 0x56, 0xc7, 0x1a, 0xbc, 0xcf, 0x76, 0x2c, 0x17, 0xd0, 0x43, 0x25, 0x7f, 0x4a, 0x91,
-	0x37, 0x8c, 0xf3, 0x95, 0xc8, 0x79, 0x02, 0x22, 0xd1, 0x7f, 0x06, 0xf0, 0xb0, 0xbe, 0x8b, 0x69,
-	0x78, 0xdc, 0x0b, 0xbf, 0xfd, 0xc2, 0x36, 0x54, 0xfc, 0x4b, 0x0a, 0x7f, 0xd9, 0x30, 0x2b, 0xe1,
-	0x8b, 0x0c, 0x45, 0x2e, 0xe0, 0x5b, 0x00, 0x27, 0xe5, 0xed, 0x4f, 0xb3, 0xf7, 0x09, 0xe3, 0x85,
-	0xdb, 0xe1, 0x50, 0xb1, 0x2f, 0x28, 0x6c, 0xd3, 0x38, 0x5b, 0x4d, 0x75, 0x41, 0x63, 0x49, 0xfc,
-	0x35, 0x80, 0x13, 0xad, 0xc1, 0x19, 0xb2, 0xb5, 0x37, 0x19, 0x72, 0x59, 0xf1, 0x2e, 0x18, 0x73,
-	0xd5, 0x78, 0x89, 0x3a, 0x94, 0x5f, 0x01, 0x38, 0x29, 0x0b, 0xc3, 0x41, 0x02, 0x17, 0x0a, 0xc7,
-	0xa1, 0x02, 0x2f, 0x28, 0xe0, 0xc7, 0x31, 0x1e, 0x0c, 0x1c, 0xf8, 0x91, 0x42, 0x7d, 0x1b, 0x1e,
-	0x48, 0xee, 0x75, 0xbc, 0x9f, 0xa8, 0xf9, 0x95, 0xd3, 0x40, 0xf9, 0xdb, 0xac, 0x78, 0xc6, 0xcf,
-	0x28, 0x5b, 0x17, 0xd0, 0x52, 0x25, 0x71, 0x6e, 0xa7, 0xf5, 0xf3, 0x1d, 0x2b, 0xa0, 0xde, 0x07,
-	0x35, 0xb0, 0x08, 0x90, 0x80, 0x93, 0x05, 0x53, 0x3b, 0x41, 0x58, 0x54, 0x08, 0xf3, 0xa8, 0x9a,
-	0x7f, 0x02, 0xea, 0x2d, 0x02, 0xf4, 0x0d, 0x80, 0xd3, 0xad, 0x72, 0xbc, 0x3f, 0xd9, 0x2f, 0xf4,
-	0xec, 0x55, 0xb4, 0xb7, 0x14, 0xf3, 0x59, 0xfc, 0x37, 0x49, 0x55, 0x07, 0xf9, 0xcb, 0xab, 0xbf,
-	0xde, 0x9f, 0x01, 0x77, 0xef, 0xcf, 0x80, 0xdf, 0xef, 0xcf, 0x80, 0xd7, 0x2f, 0x55, 0xff, 0x4f,
-	0xbd, 0xed, 0x7f, 0xfa, 0xda, 0xa8, 0xfa, 0xed, 0xbc, 0xfc, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd5, 0x18, 0x9f, 0xd1, 0x70, 0x17, 0x00, 0x00,
+	// 1427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1c, 0x35,
+	0x14, 0xc7, 0xe5, 0xa4, 0x4d, 0x13, 0xe7, 0x47, 0x5b, 0xd3, 0x96, 0x65, 0xd4, 0xa6, 0xa9, 0x4b,
+	0x21, 0x4d, 0x9b, 0x99, 0xfc, 0x28, 0xd0, 0x22, 0x81, 0x44, 0x9b, 0x12, 0x51, 0x96, 0x52, 0xcd,
+	0x22, 0x21, 0xb8, 0xa0, 0xc9, 0xec, 0xcb, 0x64, 0x9a, 0x9d, 0xf1, 0x60, 0x7b, 0xb7, 0x0a, 0xa5,
+	0x48, 0x70, 0x81, 0x03, 0x12, 0x07, 0x8e, 0x5c, 0x10, 0x02, 0xc1, 0x01, 0x01, 0x42, 0x42, 0x42,
+	0x42, 0x42, 0x1c, 0x38, 0x70, 0xac, 0xd4, 0x2b, 0x07, 0x54, 0xf1, 0x0f, 0xf0, 0x1f, 0x20, 0x7b,
+	0x7e, 0x67, 0xb7, 0xcb, 0x28, 0xd9, 0xd2, 0xde, 0xc6, 0x9e, 0xb1, 0xdf, 0xc7, 0xdf, 0x67, 0xbf,
+	0xf7, 0x3c, 0xf8, 0x54, 0xb4, 0xe9, 0x59, 0x4e, 0xe4, 0xbb, 0x2d, 0x1f, 0x42, 0x69, 0xdd, 0x60,
+	0x7c, 0x73, 0xbd, 0xc5, 0x6e, 0x64, 0x0f, 0x66, 0xc4, 0x99, 0x64, 0x64, 0x34, 0x6d, 0x1b, 0x47,
+	0x3d, 0xc6, 0xbc, 0x16, 0xa8, 0x31, 0x96, 0x13, 0x86, 0x4c, 0x3a, 0xd2, 0x67, 0xa1, 0x88, 0xbf,
+	0x33, 0xce, 0x6d, 0x9e, 0x17, 0xa6, 0xcf, 0xd4, 0xdb, 0xc0, 0x71, 0x37, 0xfc, 0x10, 0xf8, 0x96,
+	0x95, 0x98, 0x10, 0x56, 0x00, 0xd2, 0xb1, 0x3a, 0x8b, 0x96, 0x07, 0x21, 0x70, 0x47, 0x42, 0x33,
+	0x19, 0xf5, 0x8a, 0xe7, 0xcb, 0x8d, 0xf6, 0x9a, 0xe9, 0xb2, 0xc0, 0x72, 0xb8, 0xc7, 0x22, 0xce,
+	0xae, 0xeb, 0x87, 0xf9, 0xd4, 0xac, 0xc8, 0x27, 0xc9, 0x10, 0x3b, 0x8b, 0x4e, 0x2b, 0xda, 0x70,
+	0xba, 0xa7, 0xa3, 0x39, 0x84, 0xe5, 0x32, 0x0e, 0x3d, 0x4c, 0xd2, 0xdf, 0x86, 0xf0, 0xe1, 0xd7,
+	0x93, 0x99, 0x2e, 0x71, 0x70, 0x24, 0xd8, 0xf0, 0x76, 0x1b, 0x84, 0x24, 0x47, 0xf1, 0x58, 0xe8,
+	0x04, 0x20, 0x22, 0xc7, 0x85, 0x1a, 0x9a, 0x41, 0xb3, 0x63, 0x76, 0xde, 0x41, 0xd6, 0x71, 0x26,
+	0x45, 0x6d, 0x68, 0x06, 0xcd, 0x8e, 0x2f, 0x5d, 0x31, 0x73, 0x7a, 0x33, 0xa5, 0xd7, 0x0f, 0x6f,
+	0x65, 0xf4, 0x66, 0x67, 0xd9, 0x8c, 0x36, 0x3d, 0x53, 0x2d, 0xc0, 0x4c, 0x7b, 0xcd, 0x74, 0x01,
+	0x66, 0x0a, 0x62, 0x67, 0x73, 0x13, 0x8a, 0xb1, 0x1f, 0x0a, 0xe9, 0x84, 0x2e, 0xbc, 0xb4, 0x52,
+	0x1b, 0x56, 0x18, 0x17, 0x87, 0x6a, 0xc8, 0x2e, 0xf4, 0x12, 0x8a, 0x27, 0x04, 0xf0, 0x0e, 0xf0,
+	0x15, 0xbe, 0x65, 0xb7, 0xc3, 0xda, 0x9e, 0x19, 0x34, 0x3b, 0x6a, 0x97, 0xfa, 0xc8, 0x1b, 0x78,
+	0xd2, 0xd5, 0xcb, 0x7b, 0x35, 0xd2, 0x7e, 0xaa, 0xed, 0xd5, 0xd0, 0xcb, 0x66, 0xac, 0x91, 0x59,
+	0x74, 0x54, 0x8e, 0xa8, 0x1c, 0x65, 0x76, 0x16, 0xcd, 0x4b, 0xc5, 0xa1, 0x76, 0x79, 0x26, 0xfa,
+	0x03, 0xc2, 0x24, 0x25, 0x5f, 0x05, 0x99, 0xea, 0x47, 0xf0, 0x1e, 0x25, 0x57, 0x22, 0x9d, 0x7e,
+	0x2e, 0x6b, 0x3a, 0xb4, 0x5d, 0xd3, 0x6b, 0x18, 0x7b, 0x20, 0x53, 0xc0, 0x61, 0x0d, 0xb8, 0x50,
+	0x0d, 0x70, 0x35, 0x1b, 0x67, 0x17, 0xe6, 0x20, 0x47, 0xf0, 0xc8, 0xba, 0x0f, 0xad, 0xa6, 0xd0,
+	0x9a, 0x8c, 0xd9, 0x49, 0x8b, 0x7e, 0x8e, 0xf0, 0x23, 0x29, 0x72, 0xdd, 0x17, 0xb2, 0x9a, 0xcf,
+	0x1b, 0x78, 0xbc, 0xe5, 0x8b, 0x0c, 0x30, 0x76, 0xfb, 0x62, 0x35, 0xc0, 0x7a, 0x3e, 0xd0, 0x2e,
+	0xce, 0x52, 0x40, 0x1c, 0x2e, 0x21, 0x7e, 0x88, 0xf0, 0xa3, 0xd9, 0x7e, 0x00, 0xd1, 0x5e, 0x0b,
+	0xfc, 0x5d, 0x48, 0x6b, 0xe0, 0xd1, 0x00, 0x02, 0xe6, 0xbf, 0x03, 0x4d, 0x6d, 0x67, 0xd4, 0xce,
+	0xda, 0x64, 0x1a, 0xe3, 0xc8, 0xe1, 0x4e, 0x00, 0x12, 0xb8, 0x12, 0x6a, 0x78, 0x76, 0xcc, 0x2e,
+	0xf4, 0xd0, 0xdf, 0x11, 0x3e, 0x94, 0x93, 0x48, 0xbe, 0xb5, 0x73, 0x8c, 0xb3, 0xf8, 0x20, 0x07,
+	0x21, 0x1d, 0x2e, 0x1b, 0x6d, 0xd7, 0x05, 0x21, 0xd6, 0xdb, 0xad, 0x84, 0xa7, 0xfb, 0x85, 0xfa,
+	0x3a, 0x64, 0x4d, 0x78, 0x51, 0x09, 0xd2, 0x80, 0x16, 0xb8, 0x92, 0xf1, 0xc4, 0x91, 0xdd, 0x2f,
+	0xb6, 0x2d, 0x63, 0x6f, 0xd7, 0x32, 0x6e, 0xe0, 0xc3, 0x45, 0x3d, 0x03, 0xd8, 0xd5, 0x32, 0xba,
+	0xc1, 0x86, 0xef, 0x01, 0x46, 0xeb, 0xb8, 0x96, 0x1a, 0x7e, 0x0d, 0x78, 0xe0, 0x87, 0x8e, 0xdc,
+	0xb9, 0x6d, 0xfa, 0x49, 0x61, 0xeb, 0x36, 0x24, 0x8b, 0xfe, 0xa7, 0x55, 0x90, 0x1a, 0xde, 0x17,
+	0x80, 0x10, 0x8e, 0x07, 0x89, 0x0b, 0xd2, 0x26, 0xbd, 0x5d, 0x38, 0xff, 0x0d, 0x90, 0x0f, 0x1c,
+	0x88, 0x1c, 0xc2, 0x7b, 0xa3, 0x0d, 0x47, 0x80, 0x8e, 0x71, 0x63, 0x76, 0xdc, 0x20, 0x73, 0xf8,
+	0x00, 0x6b, 0xcb, 0xa8, 0x2d, 0xaf, 0xe5, 0xbb, 0x64, 0x44, 0x7f, 0xd0, 0xd5, 0x4f, 0xaf, 0xe0,
+	0x23, 0xd9, 0x8a, 0xda, 0x22, 0x82, 0xb0, 0xb9, 0x73, 0x87, 0xdd, 0x29, 0xc8, 0x53, 0x67, 0xde,
+	0xce, 0xe5, 0xa9, 0xe1, 0x7d, 0x11, 0x6b, 0x5e, 0x55, 0x83, 0x62, 0x51, 0xd2, 0x26, 0x79, 0x01,
+	0xe3, 0x16, 0xf3, 0xd2, 0xb8, 0xb4, 0x47, 0xc7, 0xa5, 0x13, 0x85, 0xb8, 0x64, 0xaa, 0xec, 0xa7,
+	0xa2, 0xd0, 0x35, 0xd6, 0xac, 0x67, 0x1f, 0xda, 0x85, 0x41, 0x0a, 0xc7, 0xe3, 0x10, 0x25, 0x92,
+	0xe9, 0x67, 0x15, 0x34, 0x44, 0xea, 0x86, 0x58, 0xa9, 0xac, 0x4d, 0xbf, 0x44, 0xf9, 0x71, 0x5a,
+	0x81, 0x16, 0xec, 0x62, 0x4b, 0xab, 0xdc, 0xd4, 0xd4, 0x53, 0x94, 0x43, 0x7f, 0xc5, 0xdc, 0xb4,
+	0x52, 0x1c, 0x6a, 0x97, 0x67, 0xa2, 0xb5, 0xdc, 0x91, 0x29, 0xa5, 0x88, 0x58, 0x28, 0x80, 0x7e,
+	0xa1, 0x16, 0xe0, 0x48, 0x77, 0x23, 0x7d, 0x2f, 0x1e, 0xc2, 0x24, 0xf0, 0x71, 0x61, 0xef, 0x68,
+	0xd8, 0xcb, 0x1d, 0x08, 0xb5, 0xc4, 0x72, 0x2b, 0xca, 0x24, 0x56, 0xcf, 0x64, 0x0d, 0x8f, 0xb0,
+	0xb5, 0xeb, 0xe0, 0xca, 0xfb, 0x50, 0x8e, 0x24, 0x33, 0xab, 0x9c, 0x44, 0x72, 0x8c, 0x07, 0x28,
+	0x18, 0x7d, 0x1e, 0x8f, 0xd6, 0x99, 0x77, 0x39, 0x94, 0x7c, 0x4b, 0x9d, 0x0b, 0x97, 0x85, 0x12,
+	0x42, 0x99, 0x18, 0x4f, 0x9b, 0xc5, 0x13, 0x33, 0x54, 0x3a, 0x31, 0xf4, 0xb3, 0x52, 0x01, 0x10,
+	0xca, 0x87, 0xaa, 0xe8, 0xa3, 0xff, 0x14, 0x0e, 0x57, 0xa3, 0x94, 0xf9, 0xfb, 0xf3, 0x51, 0x3c,
+	0xc1, 0x41, 0xb0, 0x36, 0x77, 0xe1, 0x65, 0x3f, 0x6c, 0x26, 0x8b, 0x2e, 0xf5, 0x15, 0xbf, 0x29,
+	0x84, 0x92, 0x52, 0x1f, 0xe1, 0x78, 0x32, 0x2e, 0x38, 0xca, 0x21, 0xa5, 0xbe, 0xfb, 0xc5, 0x36,
+	0xd2, 0x69, 0x85, 0x5d, 0x36, 0xb1, 0xf4, 0xe7, 0x61, 0xbc, 0x3f, 0xcf, 0x22, 0xbc, 0xe3, 0xbb,
+	0x40, 0xbe, 0x46, 0x78, 0x2a, 0x2e, 0x3d, 0xd3, 0x37, 0xe4, 0x78, 0x3e, 0x69, 0xcf, 0xb2, 0xdd,
+	0x18, 0xa0, 0x47, 0xe8, 0xec, 0x07, 0x77, 0xfe, 0xfe, 0x74, 0x88, 0xd2, 0x63, 0xfa, 0x0a, 0xd1,
+	0x59, 0xb4, 0xf2, 0x6b, 0xc8, 0xcd, 0x4c, 0xf5, 0x5b, 0xcf, 0xa2, 0x39, 0xf2, 0x15, 0xc2, 0xe3,
+	0xab, 0x20, 0x33, 0xcc, 0xa3, 0xdd, 0x98, 0x79, 0x69, 0x3c, 0x50, 0xc6, 0xb3, 0x9a, 0xf1, 0x09,
+	0xf2, 0x78, 0x5f, 0xc6, 0xf8, 0xf9, 0x96, 0xe2, 0x9c, 0x54, 0x87, 0x2a, 0x1d, 0x2e, 0xc8, 0xb1,
+	0x6e, 0xd2, 0x42, 0x45, 0x6c, 0x5c, 0x1d, 0x1c, 0xaa, 0x9a, 0x96, 0x9e, 0xd2, 0xb8, 0xc7, 0x49,
+	0x7f, 0x49, 0xc9, 0x7b, 0x78, 0xaa, 0x1c, 0x9c, 0x4b, 0x8e, 0xef, 0x15, 0xb6, 0x8d, 0x1e, 0x92,
+	0xe7, 0xb1, 0x8a, 0x9e, 0xd1, 0x76, 0x4f, 0x91, 0x93, 0xdb, 0xed, 0xce, 0x83, 0x7a, 0x5f, 0xb2,
+	0xbe, 0x80, 0x88, 0xc0, 0xe3, 0xf9, 0x60, 0x51, 0x72, 0x67, 0x57, 0xfc, 0x33, 0x1e, 0xeb, 0x95,
+	0x6a, 0x63, 0xb3, 0xa7, 0xb5, 0xd9, 0x93, 0xe4, 0x44, 0x6a, 0x56, 0x48, 0x0e, 0x4e, 0x60, 0xf5,
+	0x34, 0xfa, 0x3e, 0xc2, 0x53, 0x71, 0x96, 0xea, 0xb7, 0xdd, 0x4b, 0xd9, 0xd6, 0x98, 0xb9, 0xf7,
+	0x07, 0x49, 0xa2, 0x4b, 0x36, 0xc8, 0x5c, 0xb5, 0x0d, 0xf2, 0x23, 0xc2, 0x93, 0xba, 0xc8, 0xcf,
+	0x10, 0xa6, 0xbb, 0x2d, 0x14, 0x6f, 0x01, 0x03, 0xdd, 0xcc, 0x4f, 0x69, 0x56, 0xcb, 0x98, 0xab,
+	0xc2, 0x6a, 0x71, 0x85, 0xa1, 0x4e, 0xdf, 0x2f, 0x08, 0x1f, 0x48, 0xef, 0x48, 0x19, 0xf7, 0x89,
+	0x5e, 0xdc, 0xa5, 0x7b, 0xd4, 0x40, 0xd1, 0xcf, 0x6b, 0xf4, 0x25, 0x63, 0xbe, 0x22, 0x7a, 0x4c,
+	0xa2, 0xe8, 0x7f, 0x42, 0x78, 0x2a, 0xbe, 0x91, 0xf4, 0x73, 0x7b, 0xe9, 0xce, 0x32, 0x50, 0xf2,
+	0xa7, 0x35, 0xf9, 0x82, 0x71, 0xa6, 0x32, 0x79, 0x00, 0x8a, 0xfb, 0x67, 0x84, 0xf7, 0x27, 0xd5,
+	0x71, 0x06, 0xde, 0x63, 0x3b, 0x96, 0x0b, 0xe8, 0x81, 0x92, 0x3f, 0xa3, 0xc9, 0x17, 0x8d, 0xb3,
+	0x95, 0xc8, 0x45, 0x0c, 0xa2, 0xd0, 0x7f, 0x45, 0xf8, 0x60, 0x76, 0x17, 0xcb, 0xe0, 0x69, 0x37,
+	0xfc, 0xf6, 0x0b, 0xdb, 0x40, 0xf1, 0x2f, 0x68, 0xfc, 0x65, 0xc3, 0xac, 0x84, 0x2f, 0x53, 0x14,
+	0xb5, 0x80, 0xef, 0x11, 0x9e, 0x50, 0xb7, 0xbf, 0x8c, 0xbd, 0x47, 0x18, 0x2f, 0xdc, 0x0e, 0x07,
+	0x8a, 0x7d, 0x4e, 0x63, 0x9b, 0xc6, 0xe9, 0x6a, 0xaa, 0x4b, 0x16, 0x29, 0xe2, 0x6f, 0x11, 0x1e,
+	0x6f, 0xf4, 0xcf, 0x90, 0x8d, 0xfb, 0x93, 0x21, 0x97, 0x35, 0xef, 0xbc, 0x31, 0x5b, 0x8d, 0x17,
+	0xf4, 0xa1, 0xfc, 0x06, 0xe1, 0x09, 0x55, 0x18, 0xf6, 0x13, 0xb8, 0x50, 0x38, 0x0e, 0x14, 0x78,
+	0x5e, 0x03, 0x3f, 0x49, 0x69, 0x7f, 0xe0, 0x96, 0x1f, 0x6a, 0xd4, 0x77, 0xf1, 0xbe, 0xf8, 0x5e,
+	0x27, 0x7a, 0x89, 0x9a, 0x5f, 0x39, 0x0d, 0x92, 0xbf, 0x4d, 0x8b, 0x67, 0xfa, 0x9c, 0xb6, 0x75,
+	0x8e, 0x2c, 0x55, 0x12, 0xe7, 0x66, 0x52, 0x3f, 0xdf, 0xb2, 0x5a, 0xcc, 0xfb, 0x68, 0x08, 0x2d,
+	0x20, 0x22, 0xf1, 0x44, 0xc1, 0xd4, 0x4e, 0x10, 0x16, 0x34, 0xc2, 0x1c, 0xa9, 0xe6, 0x9f, 0x16,
+	0xf3, 0x16, 0x10, 0xf9, 0x0e, 0xe1, 0xa9, 0x46, 0x39, 0xde, 0x1f, 0xef, 0x15, 0x7a, 0xee, 0x57,
+	0xb4, 0xb7, 0x34, 0xf3, 0x69, 0xfa, 0x1f, 0x49, 0x35, 0x0b, 0xf2, 0x17, 0x57, 0xff, 0xb8, 0x3b,
+	0x8d, 0x6e, 0xdf, 0x9d, 0x46, 0x7f, 0xdd, 0x9d, 0x46, 0x6f, 0x5e, 0xa8, 0xfe, 0xa3, 0x7b, 0xdb,
+	0x0f, 0xf9, 0xb5, 0x11, 0xfd, 0xdf, 0x7a, 0xf9, 0xdf, 0x01, 0x00, 0xb5, 0x1a, 0x39, 0xac, 0xb1,
+	0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2360,6 +2377,15 @@ func (m *WorkflowResubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Memoized {
 		i--
 		if m.Memoized {
@@ -2411,6 +2437,15 @@ func (m *WorkflowRetryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintWorkflow(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.NodeFieldSelector) > 0 {
 		i -= len(m.NodeFieldSelector)
 		copy(dAtA[i:], m.NodeFieldSelector)
@@ -3256,6 +3291,12 @@ func (m *WorkflowResubmitRequest) Size() (n int) {
 	if m.Memoized {
 		n += 2
 	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3283,6 +3324,12 @@ func (m *WorkflowRetryRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflow(uint64(l))
 	}
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovWorkflow(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4281,6 +4328,38 @@ func (m *WorkflowResubmitRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Memoized = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
@@ -4448,6 +4527,38 @@ func (m *WorkflowRetryRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflow(dAtA[iNdEx:])
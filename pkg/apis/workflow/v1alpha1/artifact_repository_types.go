@@ -24,6 +24,12 @@ type ArtifactRepository struct {
 	OSS *OSSArtifactRepository `json:"oss,omitempty" protobuf:"bytes,5,opt,name=oss"`
 	// GCS stores artifact in a GCS object store
 	GCS *GCSArtifactRepository `json:"gcs,omitempty" protobuf:"bytes,6,opt,name=gcs"`
+	// Azure stores artifacts in an Azure Blob Storage container
+	Azure *AzureArtifactRepository `json:"azure,omitempty" protobuf:"bytes,7,opt,name=azure"`
+	// Encryption encrypts the artifacts in the executor before they are saved to the repository
+	Encryption *ArtifactEncryption `json:"encryption,omitempty" protobuf:"bytes,8,opt,name=encryption"`
+	// Plugin stores artifacts with an artifact plugin
+	Plugin *PluginArtifactRepository `json:"plugin,omitempty" protobuf:"bytes,9,opt,name=plugin"`
 }
 
 func (a *ArtifactRepository) IsArchiveLogs() bool {
@@ -39,12 +45,16 @@ func (a *ArtifactRepository) Get() ArtifactRepositoryType {
 		return nil
 	} else if a.Artifactory != nil {
 		return a.Artifactory
+	} else if a.Azure != nil {
+		return a.Azure
 	} else if a.GCS != nil {
 		return a.GCS
 	} else if a.HDFS != nil {
 		return a.HDFS
 	} else if a.OSS != nil {
 		return a.OSS
+	} else if a.Plugin != nil {
+		return a.Plugin
 	} else if a.S3 != nil {
 		return a.S3
 	}
@@ -57,7 +67,7 @@ func (a *ArtifactRepository) ToArtifactLocation() *ArtifactLocation {
 	if a == nil {
 		return nil
 	}
-	l := &ArtifactLocation{ArchiveLogs: a.ArchiveLogs}
+	l := &ArtifactLocation{ArchiveLogs: a.ArchiveLogs, Encryption: a.Encryption}
 	v := a.Get()
 	if v != nil {
 		v.IntoArtifactLocation(l)
@@ -117,6 +127,22 @@ func (r *GCSArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
 	l.GCS = &GCSArtifact{GCSBucket: r.GCSBucket, Key: k}
 }
 
+// AzureArtifactRepository defines the controller configuration for an Azure Blob Storage artifact repository
+type AzureArtifactRepository struct {
+	AzureBlobContainer `json:",inline" protobuf:"bytes,1,opt,name=azureBlobContainer"`
+
+	// BlobNameFormat is defines the format of how to store blob names. Can reference workflow variables
+	BlobNameFormat string `json:"blobNameFormat,omitempty" protobuf:"bytes,2,opt,name=blobNameFormat"`
+}
+
+func (r *AzureArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
+	k := r.BlobNameFormat
+	if k == "" {
+		k = DefaultArchivePattern
+	}
+	l.Azure = &AzureArtifact{AzureBlobContainer: r.AzureBlobContainer, Blob: k}
+}
+
 // ArtifactoryArtifactRepository defines the controller configuration for an artifactory artifact repository
 type ArtifactoryArtifactRepository struct {
 	ArtifactoryAuth `json:",inline" protobuf:"bytes,1,opt,name=artifactoryAuth"`
@@ -152,4 +178,24 @@ func (r *HDFSArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
 	l.HDFS = &HDFSArtifact{HDFSConfig: r.HDFSConfig, Path: p, Force: r.Force}
 }
 
+// PluginArtifactRepository defines the controller configuration for an artifact repository of an artifact plugin
+type PluginArtifactRepository struct {
+	// Name is the name of the artifact plugin
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Configuration is passed to the plugin as is
+	Configuration string `json:"configuration,omitempty" protobuf:"bytes,2,opt,name=configuration"`
+
+	// KeyFormat is defines the format of how to store keys. Can reference workflow variables
+	KeyFormat string `json:"keyFormat,omitempty" protobuf:"bytes,3,opt,name=keyFormat"`
+}
+
+func (r *PluginArtifactRepository) IntoArtifactLocation(l *ArtifactLocation) {
+	k := r.KeyFormat
+	if k == "" {
+		k = DefaultArchivePattern
+	}
+	l.Plugin = &PluginArtifact{Name: r.Name, Configuration: r.Configuration, Key: k}
+}
+
 // MetricsConfig defines a config for a metrics server
@@ -51,6 +51,14 @@ func TestArtifactRepository(t *testing.T) {
 			assert.Equal(t, "{{workflow.name}}/{{pod.name}}", l.OSS.Key)
 		}
 	})
+	t.Run("Plugin", func(t *testing.T) {
+		r := &ArtifactRepository{Plugin: &PluginArtifactRepository{Name: "my-plugin", Configuration: "bucket: my-bucket"}}
+		assert.IsType(t, &PluginArtifactRepository{}, r.Get())
+		l := r.ToArtifactLocation()
+		if assert.NotNil(t, l.Plugin) {
+			assert.Equal(t, &PluginArtifact{Name: "my-plugin", Configuration: "bucket: my-bucket", Key: "{{workflow.name}}/{{pod.name}}"}, l.Plugin)
+		}
+	})
 	t.Run("S3", func(t *testing.T) {
 		r := &ArtifactRepository{S3: &S3ArtifactRepository{KeyPrefix: "my-key-prefix"}}
 		assert.IsType(t, &S3ArtifactRepository{}, r.Get())
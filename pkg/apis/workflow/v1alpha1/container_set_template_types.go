@@ -2,8 +2,12 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 type ContainerSetTemplate struct {
@@ -37,9 +41,30 @@ func (in *ContainerSetTemplate) GetGraph() []ContainerNode {
 	return in.Containers
 }
 
+// GetNode returns the named container node, or nil if there is no such container
+func (in *ContainerSetTemplate) GetNode(name string) *ContainerNode {
+	for _, n := range in.GetGraph() {
+		if n.Name == name {
+			return &n
+		}
+	}
+	return nil
+}
+
 func (in *ContainerSetTemplate) HasSequencedContainers() bool {
 	for _, n := range in.GetGraph() {
-		if len(n.Dependencies) > 0 {
+		if len(n.Dependencies) > 0 || n.Depends != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresEmissary returns true if the containers are sequenced, retried, or have their own outputs,
+// which are only supported by the emissary executor
+func (in *ContainerSetTemplate) RequiresEmissary() bool {
+	for _, n := range in.GetGraph() {
+		if len(n.Dependencies) > 0 || n.Depends != "" || n.RetryStrategy != nil || n.Outputs != nil {
 			return true
 		}
 	}
@@ -76,6 +101,29 @@ func (in *ContainerSetTemplate) Validate() error {
 		nameToContainer[ctr.Name] = ctr
 	}
 	for _, ctr := range in.Containers {
+		if len(ctr.Dependencies) > 0 && ctr.Depends != "" {
+			return fmt.Errorf("containers.%s cannot use both 'depends' and 'dependencies'", ctr.Name)
+		}
+		if ctr.RetryStrategy != nil {
+			if err := ctr.RetryStrategy.Validate(); err != nil {
+				return fmt.Errorf("containers.%s.retryStrategy %w", ctr.Name, err)
+			}
+		}
+		if ctr.Outputs != nil {
+			if ctr.Name == "main" {
+				return fmt.Errorf("containers.main.outputs must be specified as the template's outputs")
+			}
+			for _, p := range ctr.Outputs.Parameters {
+				if p.ValueFrom == nil || p.ValueFrom.Path == "" {
+					return fmt.Errorf("containers.%s.outputs.parameters.%s must have valueFrom.path", ctr.Name, p.Name)
+				}
+			}
+			for _, a := range ctr.Outputs.Artifacts {
+				if a.Path == "" {
+					return fmt.Errorf("containers.%s.outputs.artifacts.%s must have a path", ctr.Name, a.Name)
+				}
+			}
+		}
 		for _, depName := range ctr.Dependencies {
 			_, ok := nameToContainer[depName]
 			if !ok {
@@ -99,4 +147,78 @@ func (in *ContainerSetTemplate) Validate() error {
 type ContainerNode struct {
 	corev1.Container `json:",inline" protobuf:"bytes,1,opt,name=container"`
 	Dependencies     []string `json:"dependencies,omitempty" protobuf:"bytes,2,rep,name=dependencies"`
+	// Depends is a boolean expression over the results of other containers, e.g. "build.Failed".
+	// It uses the same syntax as DAG task depends, and may not be used with Dependencies.
+	Depends string `json:"depends,omitempty" protobuf:"bytes,3,opt,name=depends"`
+	// RetryStrategy describes how to retry this container when it fails
+	RetryStrategy *ContainerRetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,4,opt,name=retryStrategy"`
+	// Outputs are the outputs of this container, they are reported on the container's node.
+	// Parameters and artifacts must be sourced from paths.
+	Outputs *Outputs `json:"outputs,omitempty" protobuf:"bytes,5,opt,name=outputs"`
+}
+
+// GetRetryStrategy returns the retry strategy of the container, it is safe to call on a nil node
+func (n *ContainerNode) GetRetryStrategy() *ContainerRetryStrategy {
+	if n == nil {
+		return nil
+	}
+	return n.RetryStrategy
+}
+
+// ContainerRetryStrategy is the retry strategy of a single container in a container set.
+// Retries are performed within the pod by the emissary.
+type ContainerRetryStrategy struct {
+	// Retries is the maximum number of times to retry the container, it may be a parameter
+	Retries *intstr.IntOrString `json:"retries" protobuf:"bytes,1,opt,name=retries"`
+	// Duration is the time to wait between retries, e.g. "10s". Defaults to no delay.
+	Duration string `json:"duration,omitempty" protobuf:"bytes,2,opt,name=duration"`
+}
+
+// GetRetries returns the maximum number of retries, or an error if the value is not a non-negative integer
+func (s *ContainerRetryStrategy) GetRetries() (int, error) {
+	if s == nil || s.Retries == nil {
+		return 0, nil
+	}
+	retries := s.Retries.IntValue()
+	if s.Retries.Type == intstr.String {
+		var err error
+		retries, err = strconv.Atoi(s.Retries.StrVal)
+		if err != nil {
+			return 0, fmt.Errorf("retries %q is not an integer", s.Retries.StrVal)
+		}
+	}
+	if retries < 0 {
+		return 0, fmt.Errorf("retries must not be negative")
+	}
+	return retries, nil
+}
+
+// GetDuration returns the time to wait between retries
+func (s *ContainerRetryStrategy) GetDuration() time.Duration {
+	if s == nil || s.Duration == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(s.Duration)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// Validate checks if the ContainerRetryStrategy is valid
+func (s *ContainerRetryStrategy) Validate() error {
+	if s.Retries == nil {
+		return fmt.Errorf("retries is required")
+	}
+	if s.Retries.Type == intstr.Int || !strings.Contains(s.Retries.StrVal, "{{") {
+		if _, err := s.GetRetries(); err != nil {
+			return err
+		}
+	}
+	if s.Duration != "" && !strings.Contains(s.Duration, "{{") {
+		if _, err := time.ParseDuration(s.Duration); err != nil {
+			return fmt.Errorf("duration is invalid: %w", err)
+		}
+	}
+	return nil
 }
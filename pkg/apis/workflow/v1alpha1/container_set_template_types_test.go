@@ -2,9 +2,11 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/yaml"
 )
 
@@ -41,7 +43,19 @@ func TestContainerSetTemplate(t *testing.T) {
 		assert.Len(t, x.GetGraph(), 2)
 		assert.Len(t, x.GetContainers(), 2)
 		assert.True(t, x.HasSequencedContainers())
+		assert.True(t, x.RequiresEmissary())
 		assert.True(t, x.HasContainerNamed("a"))
+		assert.NotNil(t, x.GetNode("a"))
+		assert.Nil(t, x.GetNode("b"))
+	})
+	t.Run("Depends", func(t *testing.T) {
+		x := &ContainerSetTemplate{Containers: []ContainerNode{{Container: corev1.Container{Name: "a"}}, {Depends: "a.Failed"}}}
+		assert.True(t, x.HasSequencedContainers())
+	})
+	t.Run("Retries", func(t *testing.T) {
+		x := &ContainerSetTemplate{Containers: []ContainerNode{{RetryStrategy: &ContainerRetryStrategy{}}}}
+		assert.False(t, x.HasSequencedContainers())
+		assert.True(t, x.RequiresEmissary())
 	})
 }
 
@@ -156,3 +170,100 @@ containers:
 	err := validateContainerSetTemplate(validContainerSet)
 	assert.NoError(t, err)
 }
+
+func TestInvalidContainerSetDependsAndDependencies(t *testing.T) {
+	err := validateContainerSetTemplate(`
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+  - name: b
+    image: argoproj/argosay:v2
+    depends: a.Failed
+    dependencies:
+      - a
+`)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "containers.b cannot use both 'depends' and 'dependencies'")
+	}
+}
+
+func TestInvalidContainerSetRetryStrategy(t *testing.T) {
+	err := validateContainerSetTemplate(`
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+    retryStrategy:
+      retries: -1
+`)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "containers.a.retryStrategy retries must not be negative")
+	}
+	err = validateContainerSetTemplate(`
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+    retryStrategy:
+      retries: 1
+      duration: soon
+`)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "containers.a.retryStrategy duration is invalid")
+	}
+}
+
+func TestInvalidContainerSetOutputs(t *testing.T) {
+	err := validateContainerSetTemplate(`
+containers:
+  - name: main
+    image: argoproj/argosay:v2
+    outputs:
+      parameters:
+        - name: p
+          valueFrom:
+            path: /tmp/p
+`)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "containers.main.outputs must be specified as the template's outputs")
+	}
+	err = validateContainerSetTemplate(`
+containers:
+  - name: a
+    image: argoproj/argosay:v2
+    outputs:
+      parameters:
+        - name: p
+`)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "containers.a.outputs.parameters.p must have valueFrom.path")
+	}
+}
+
+func TestContainerRetryStrategy(t *testing.T) {
+	var nilStrategy *ContainerRetryStrategy
+	retries, err := nilStrategy.GetRetries()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, retries)
+	assert.Equal(t, time.Duration(0), nilStrategy.GetDuration())
+	value := intstr.FromString("3")
+	s := &ContainerRetryStrategy{Retries: &value, Duration: "2s"}
+	retries, err = s.GetRetries()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, retries)
+	assert.Equal(t, 2*time.Second, s.GetDuration())
+	assert.NoError(t, s.Validate())
+	t.Run("Parameter", func(t *testing.T) {
+		value := intstr.FromString("{{inputs.parameters.retries}}")
+		s := &ContainerRetryStrategy{Retries: &value}
+		assert.NoError(t, s.Validate())
+		_, err := s.GetRetries()
+		assert.Error(t, err)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		for _, value := range []intstr.IntOrString{intstr.FromString("three"), intstr.FromString("-1"), intstr.FromInt(-1)} {
+			s := &ContainerRetryStrategy{Retries: &value}
+			assert.Error(t, s.Validate())
+			_, err := s.GetRetries()
+			assert.Error(t, err)
+		}
+	})
+}
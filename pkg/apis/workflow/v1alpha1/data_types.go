@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
 // Data is a data template
 type Data struct {
 	// Source sources external data into a data template
@@ -10,31 +14,112 @@ type Data struct {
 }
 
 func (ds *DataSource) GetArtifactIfNeeded() (*Artifact, bool) {
-	if ds.ArtifactPaths != nil {
+	switch {
+	case ds.ArtifactPaths != nil:
 		return &ds.ArtifactPaths.Artifact, true
+	case ds.Artifact != nil:
+		return &ds.Artifact.Artifact, true
 	}
 	return nil, false
 }
 
 type Transformation []TransformationStep
 
+// TransformationStep is a single step of a transformation. Exactly one of its fields should be set.
+// With the exception of Expression, the expressions are evaluated once per element of the data, which is
+// available as "item".
 type TransformationStep struct {
 	// Expression defines an expr expression to apply
-	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+	Expression string `json:"expression,omitempty" protobuf:"bytes,1,opt,name=expression"`
+	// Filter keeps only the elements for which the expression evaluates to true
+	Filter string `json:"filter,omitempty" protobuf:"bytes,2,opt,name=filter"`
+	// Map replaces each element with the result of the expression
+	Map string `json:"map,omitempty" protobuf:"bytes,3,opt,name=map"`
+	// GroupBy groups the elements into a map keyed by the result of the expression
+	GroupBy string `json:"groupBy,omitempty" protobuf:"bytes,4,opt,name=groupBy"`
+	// SortBy sorts the elements in ascending order of the result of the expression
+	SortBy string `json:"sortBy,omitempty" protobuf:"bytes,5,opt,name=sortBy"`
+	// Chunk splits the elements into batches of the given size
+	Chunk int32 `json:"chunk,omitempty" protobuf:"varint,6,opt,name=chunk"`
+	// Dedupe removes elements for which the expression evaluates to a value already seen
+	Dedupe string `json:"dedupe,omitempty" protobuf:"bytes,7,opt,name=dedupe"`
 }
 
 // DataSource sources external data into a data template
 type DataSource struct {
 	// ArtifactPaths is a data transformation that collects a list of artifact paths
 	ArtifactPaths *ArtifactPaths `json:"artifactPaths,omitempty" protobuf:"bytes,1,opt,name=artifactPaths"`
+	// Value is a literal value, typically JSON or a parameter reference
+	Value *DataValue `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// HTTP sources the data from the body of an HTTP GET request
+	HTTP *HTTPDataSource `json:"http,omitempty" protobuf:"bytes,3,opt,name=http"`
+	// ConfigMap sources the data from a key of a ConfigMap in the workflow's namespace
+	ConfigMap *ConfigMapDataSource `json:"configMap,omitempty" protobuf:"bytes,4,opt,name=configMap"`
+	// Artifact sources the data from the contents of an artifact file
+	Artifact *ArtifactDataSource `json:"artifact,omitempty" protobuf:"bytes,5,opt,name=artifact"`
 }
 
+// DataFormat is the format used to parse sourced data
+type DataFormat string
+
+const (
+	// DataFormatJSON parses the data as a single JSON document. This is the default.
+	DataFormatJSON DataFormat = "json"
+	// DataFormatNDJSON parses the data as newline delimited JSON, one document per line, into a list
+	DataFormatNDJSON DataFormat = "ndjson"
+	// DataFormatCSV parses the data as CSV with a header row, into a list of maps keyed by the header
+	DataFormatCSV DataFormat = "csv"
+	// DataFormatText does not parse the data, it is returned as a string
+	DataFormatText DataFormat = "text"
+)
+
 // ArtifactPaths expands a step from a collection of artifacts
 type ArtifactPaths struct {
 	// Artifact is the artifact location from which to source the artifacts, it can be a directory
 	Artifact `json:",inline" protobuf:"bytes,1,opt,name=artifact"`
 }
 
+// DataValue is a literal value
+type DataValue struct {
+	// Value is the literal value
+	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
+	// Format is the format of the value, one of json, ndjson, csv or text. Defaults to json.
+	Format DataFormat `json:"format,omitempty" protobuf:"bytes,2,opt,name=format,casttype=DataFormat"`
+}
+
+// HTTPDataSource sources data from an HTTP GET request
+type HTTPDataSource struct {
+	// URL of the HTTP request
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Headers are an optional list of headers to send with the HTTP request
+	Headers HTTPHeaders `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers"`
+	// TimeoutSeconds is the request timeout. Default is 30 seconds
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"bytes,3,opt,name=timeoutSeconds"`
+	// Format is the format of the response body, one of json, ndjson, csv or text. Defaults to json.
+	Format DataFormat `json:"format,omitempty" protobuf:"bytes,4,opt,name=format,casttype=DataFormat"`
+}
+
+// ConfigMapDataSource sources data from a key of a ConfigMap
+type ConfigMapDataSource struct {
+	apiv1.ConfigMapKeySelector `json:",inline" protobuf:"bytes,1,opt,name=configMapKeySelector"`
+	// Format is the format of the value, one of json, ndjson, csv or text. Defaults to json.
+	Format DataFormat `json:"format,omitempty" protobuf:"bytes,2,opt,name=format,casttype=DataFormat"`
+}
+
+// ArtifactDataSource sources data from the contents of an artifact file
+type ArtifactDataSource struct {
+	// Artifact is the location of the artifact, it must be a file
+	Artifact `json:",inline" protobuf:"bytes,1,opt,name=artifact"`
+	// Format is the format of the file, one of json, ndjson, csv or text. Defaults to json.
+	Format DataFormat `json:"format,omitempty" protobuf:"bytes,2,opt,name=format,casttype=DataFormat"`
+}
+
 type DataSourceProcessor interface {
 	ProcessArtifactPaths(*ArtifactPaths) (interface{}, error)
+	// ProcessHTTP returns the raw body of the HTTP response
+	ProcessHTTP(*HTTPDataSource) ([]byte, error)
+	// ProcessConfigMap returns the raw value of the ConfigMap key
+	ProcessConfigMap(*ConfigMapDataSource) ([]byte, error)
+	// ProcessArtifact returns the raw contents of the artifact
+	ProcessArtifact(*ArtifactDataSource) ([]byte, error)
 }
@@ -14,6 +14,7 @@ import (
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	v1beta1 "k8s.io/api/policy/v1beta1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -120,10 +121,38 @@ func (m *Arguments) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Arguments proto.InternalMessageInfo
 
+func (m *ArtGCStatus) Reset()      { *m = ArtGCStatus{} }
+func (*ArtGCStatus) ProtoMessage() {}
+func (*ArtGCStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{3}
+}
+func (m *ArtGCStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtGCStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtGCStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtGCStatus.Merge(m, src)
+}
+func (m *ArtGCStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtGCStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtGCStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtGCStatus proto.InternalMessageInfo
+
 func (m *Artifact) Reset()      { *m = Artifact{} }
 func (*Artifact) ProtoMessage() {}
 func (*Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{3}
+	return fileDescriptor_724696e352c3df5f, []int{4}
 }
 func (m *Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -148,10 +177,122 @@ func (m *Artifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
+func (m *ArtifactCache) Reset()      { *m = ArtifactCache{} }
+func (*ArtifactCache) ProtoMessage() {}
+func (*ArtifactCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{5}
+}
+func (m *ArtifactCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactCache.Merge(m, src)
+}
+func (m *ArtifactCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactCache proto.InternalMessageInfo
+
+func (m *ArtifactDataSource) Reset()      { *m = ArtifactDataSource{} }
+func (*ArtifactDataSource) ProtoMessage() {}
+func (*ArtifactDataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{6}
+}
+func (m *ArtifactDataSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactDataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactDataSource.Merge(m, src)
+}
+func (m *ArtifactDataSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactDataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactDataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactDataSource proto.InternalMessageInfo
+
+func (m *ArtifactEncryption) Reset()      { *m = ArtifactEncryption{} }
+func (*ArtifactEncryption) ProtoMessage() {}
+func (*ArtifactEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{7}
+}
+func (m *ArtifactEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactEncryption.Merge(m, src)
+}
+func (m *ArtifactEncryption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactEncryption proto.InternalMessageInfo
+
+func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
+func (*ArtifactGC) ProtoMessage() {}
+func (*ArtifactGC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{8}
+}
+func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactGC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactGC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactGC.Merge(m, src)
+}
+func (m *ArtifactGC) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactGC) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactGC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
+
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{4}
+	return fileDescriptor_724696e352c3df5f, []int{9}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +320,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactPaths) Reset()      { *m = ArtifactPaths{} }
 func (*ArtifactPaths) ProtoMessage() {}
 func (*ArtifactPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{5}
+	return fileDescriptor_724696e352c3df5f, []int{10}
 }
 func (m *ArtifactPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +348,7 @@ var xxx_messageInfo_ArtifactPaths proto.InternalMessageInfo
 func (m *ArtifactRepository) Reset()      { *m = ArtifactRepository{} }
 func (*ArtifactRepository) ProtoMessage() {}
 func (*ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{6}
+	return fileDescriptor_724696e352c3df5f, []int{11}
 }
 func (m *ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +376,7 @@ var xxx_messageInfo_ArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{7}
+	return fileDescriptor_724696e352c3df5f, []int{12}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +404,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{8}
+	return fileDescriptor_724696e352c3df5f, []int{13}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +432,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{9}
+	return fileDescriptor_724696e352c3df5f, []int{14}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +460,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryArtifactRepository) Reset()      { *m = ArtifactoryArtifactRepository{} }
 func (*ArtifactoryArtifactRepository) ProtoMessage() {}
 func (*ArtifactoryArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{10}
+	return fileDescriptor_724696e352c3df5f, []int{15}
 }
 func (m *ArtifactoryArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +488,7 @@ var xxx_messageInfo_ArtifactoryArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{11}
+	return fileDescriptor_724696e352c3df5f, []int{16}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,10 +513,150 @@ func (m *ArtifactoryAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 
+func (m *AutoRetryAttempt) Reset()      { *m = AutoRetryAttempt{} }
+func (*AutoRetryAttempt) ProtoMessage() {}
+func (*AutoRetryAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{17}
+}
+func (m *AutoRetryAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRetryAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutoRetryAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRetryAttempt.Merge(m, src)
+}
+func (m *AutoRetryAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRetryAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRetryAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRetryAttempt proto.InternalMessageInfo
+
+func (m *AutoRetryStrategy) Reset()      { *m = AutoRetryStrategy{} }
+func (*AutoRetryStrategy) ProtoMessage() {}
+func (*AutoRetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{18}
+}
+func (m *AutoRetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutoRetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRetryStrategy.Merge(m, src)
+}
+func (m *AutoRetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRetryStrategy proto.InternalMessageInfo
+
+func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
+func (*AzureArtifact) ProtoMessage() {}
+func (*AzureArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{19}
+}
+func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifact.Merge(m, src)
+}
+func (m *AzureArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
+
+func (m *AzureArtifactRepository) Reset()      { *m = AzureArtifactRepository{} }
+func (*AzureArtifactRepository) ProtoMessage() {}
+func (*AzureArtifactRepository) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{20}
+}
+func (m *AzureArtifactRepository) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifactRepository) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifactRepository) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifactRepository.Merge(m, src)
+}
+func (m *AzureArtifactRepository) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifactRepository) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifactRepository.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifactRepository proto.InternalMessageInfo
+
+func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
+func (*AzureBlobContainer) ProtoMessage() {}
+func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{21}
+}
+func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureBlobContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureBlobContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureBlobContainer.Merge(m, src)
+}
+func (m *AzureBlobContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureBlobContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureBlobContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{22}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,10 +681,38 @@ func (m *Backoff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Backoff proto.InternalMessageInfo
 
+func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
+func (*BasicAuth) ProtoMessage() {}
+func (*BasicAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{23}
+}
+func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasicAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BasicAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasicAuth.Merge(m, src)
+}
+func (m *BasicAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasicAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasicAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
+
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{24}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +740,7 @@ var xxx_messageInfo_Cache proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +768,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{26}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +796,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{27}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,10 +821,38 @@ func (m *Condition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Condition proto.InternalMessageInfo
 
+func (m *ConfigMapDataSource) Reset()      { *m = ConfigMapDataSource{} }
+func (*ConfigMapDataSource) ProtoMessage() {}
+func (*ConfigMapDataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{28}
+}
+func (m *ConfigMapDataSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigMapDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConfigMapDataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigMapDataSource.Merge(m, src)
+}
+func (m *ConfigMapDataSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigMapDataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigMapDataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigMapDataSource proto.InternalMessageInfo
+
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{29}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,10 +877,38 @@ func (m *ContainerNode) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 
+func (m *ContainerRetryStrategy) Reset()      { *m = ContainerRetryStrategy{} }
+func (*ContainerRetryStrategy) ProtoMessage() {}
+func (*ContainerRetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{30}
+}
+func (m *ContainerRetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerRetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerRetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerRetryStrategy.Merge(m, src)
+}
+func (m *ContainerRetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerRetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerRetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerRetryStrategy proto.InternalMessageInfo
+
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +936,7 @@ var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +964,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +992,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{34}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,10 +1017,38 @@ func (m *CreateS3BucketOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 
+func (m *CreatedResource) Reset()      { *m = CreatedResource{} }
+func (*CreatedResource) ProtoMessage() {}
+func (*CreatedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{35}
+}
+func (m *CreatedResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CreatedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatedResource.Merge(m, src)
+}
+func (m *CreatedResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatedResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatedResource proto.InternalMessageInfo
+
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{36}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +1076,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +1104,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{38}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +1132,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +1160,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +1188,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{41}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +1216,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{42}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1244,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,10 +1269,38 @@ func (m *DataSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DataSource proto.InternalMessageInfo
 
+func (m *DataValue) Reset()      { *m = DataValue{} }
+func (*DataValue) ProtoMessage() {}
+func (*DataValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{44}
+}
+func (m *DataValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DataValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataValue.Merge(m, src)
+}
+func (m *DataValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataValue proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{45}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1328,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1356,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1384,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{48}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1412,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{49}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1440,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1468,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,10 +1493,38 @@ func (m *GitArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 
+func (m *GitLFS) Reset()      { *m = GitLFS{} }
+func (*GitLFS) ProtoMessage() {}
+func (*GitLFS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{52}
+}
+func (m *GitLFS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GitLFS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GitLFS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GitLFS.Merge(m, src)
+}
+func (m *GitLFS) XXX_Size() int {
+	return m.Size()
+}
+func (m *GitLFS) XXX_DiscardUnknown() {
+	xxx_messageInfo_GitLFS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GitLFS proto.InternalMessageInfo
+
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{53}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1552,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1580,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1608,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1636,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1664,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{58}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1240,10 +1689,66 @@ func (m *HTTPArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 
+func (m *HTTPAuth) Reset()      { *m = HTTPAuth{} }
+func (*HTTPAuth) ProtoMessage() {}
+func (*HTTPAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{59}
+}
+func (m *HTTPAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPAuth.Merge(m, src)
+}
+func (m *HTTPAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPAuth proto.InternalMessageInfo
+
+func (m *HTTPDataSource) Reset()      { *m = HTTPDataSource{} }
+func (*HTTPDataSource) ProtoMessage() {}
+func (*HTTPDataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{60}
+}
+func (m *HTTPDataSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPDataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPDataSource.Merge(m, src)
+}
+func (m *HTTPDataSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPDataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPDataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPDataSource proto.InternalMessageInfo
+
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1776,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1804,7 @@ var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1832,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1860,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1888,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1916,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *LabelKeys) Reset()      { *m = LabelKeys{} }
 func (*LabelKeys) ProtoMessage() {}
 func (*LabelKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *LabelKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1944,7 @@ var xxx_messageInfo_LabelKeys proto.InternalMessageInfo
 func (m *LabelValues) Reset()      { *m = LabelValues{} }
 func (*LabelValues) ProtoMessage() {}
 func (*LabelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *LabelValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1972,7 @@ var xxx_messageInfo_LabelValues proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +2000,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +2028,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{71}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +2056,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +2084,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +2112,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2140,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +2168,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{76}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +2196,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2224,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2252,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2280,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2308,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2336,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{82}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1856,10 +2361,38 @@ func (m *NoneStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 
+func (m *OCIArtifact) Reset()      { *m = OCIArtifact{} }
+func (*OCIArtifact) ProtoMessage() {}
+func (*OCIArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{83}
+}
+func (m *OCIArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifact.Merge(m, src)
+}
+func (m *OCIArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIArtifact proto.InternalMessageInfo
+
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2420,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2448,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2476,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2504,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Object) Reset()      { *m = Object{} }
 func (*Object) ProtoMessage() {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2532,7 @@ var xxx_messageInfo_Object proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2560,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2588,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2616,7 @@ var xxx_messageInfo_Parameter proto.InternalMessageInfo
 func (m *Plugin) Reset()      { *m = Plugin{} }
 func (*Plugin) ProtoMessage() {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{92}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2108,10 +2641,66 @@ func (m *Plugin) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Plugin proto.InternalMessageInfo
 
+func (m *PluginArtifact) Reset()      { *m = PluginArtifact{} }
+func (*PluginArtifact) ProtoMessage() {}
+func (*PluginArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{93}
+}
+func (m *PluginArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PluginArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PluginArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginArtifact.Merge(m, src)
+}
+func (m *PluginArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *PluginArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginArtifact proto.InternalMessageInfo
+
+func (m *PluginArtifactRepository) Reset()      { *m = PluginArtifactRepository{} }
+func (*PluginArtifactRepository) ProtoMessage() {}
+func (*PluginArtifactRepository) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{94}
+}
+func (m *PluginArtifactRepository) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PluginArtifactRepository) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PluginArtifactRepository) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginArtifactRepository.Merge(m, src)
+}
+func (m *PluginArtifactRepository) XXX_Size() int {
+	return m.Size()
+}
+func (m *PluginArtifactRepository) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginArtifactRepository.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PluginArtifactRepository proto.InternalMessageInfo
+
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2728,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2756,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2192,10 +2781,66 @@ func (m *RawArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 
+func (m *ResourceCleanup) Reset()      { *m = ResourceCleanup{} }
+func (*ResourceCleanup) ProtoMessage() {}
+func (*ResourceCleanup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{98}
+}
+func (m *ResourceCleanup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceCleanup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceCleanup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceCleanup.Merge(m, src)
+}
+func (m *ResourceCleanup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceCleanup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceCleanup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceCleanup proto.InternalMessageInfo
+
+func (m *ResourceEscalation) Reset()      { *m = ResourceEscalation{} }
+func (*ResourceEscalation) ProtoMessage() {}
+func (*ResourceEscalation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{99}
+}
+func (m *ResourceEscalation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceEscalation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceEscalation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceEscalation.Merge(m, src)
+}
+func (m *ResourceEscalation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceEscalation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceEscalation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceEscalation proto.InternalMessageInfo
+
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{100}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2868,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{101}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2248,10 +2893,38 @@ func (m *RetryAffinity) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 
+func (m *RetryDecision) Reset()      { *m = RetryDecision{} }
+func (*RetryDecision) ProtoMessage() {}
+func (*RetryDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{102}
+}
+func (m *RetryDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryDecision.Merge(m, src)
+}
+func (m *RetryDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryDecision proto.InternalMessageInfo
+
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{103}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2276,10 +2949,38 @@ func (m *RetryNodeAntiAffinity) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 
+func (m *RetryRule) Reset()      { *m = RetryRule{} }
+func (*RetryRule) ProtoMessage() {}
+func (*RetryRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{104}
+}
+func (m *RetryRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryRule.Merge(m, src)
+}
+func (m *RetryRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryRule proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +3008,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{106}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +3036,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{107}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +3064,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +3092,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3EncryptionOptions) Reset()      { *m = S3EncryptionOptions{} }
 func (*S3EncryptionOptions) ProtoMessage() {}
 func (*S3EncryptionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{109}
 }
 func (m *S3EncryptionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2416,10 +3117,38 @@ func (m *S3EncryptionOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 
+func (m *SQLCache) Reset()      { *m = SQLCache{} }
+func (*SQLCache) ProtoMessage() {}
+func (*SQLCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{110}
+}
+func (m *SQLCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLCache.Merge(m, src)
+}
+func (m *SQLCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLCache proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +3176,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +3204,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +3232,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{114}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +3260,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{115}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +3288,7 @@ var xxx_messageInfo_Sequence proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{116}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +3316,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{117}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +3344,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +3372,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +3400,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +3428,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{121}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +3456,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{122}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +3484,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{123}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +3512,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +3540,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2839,7 +3568,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{126}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3596,7 @@ var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{127}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3624,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3652,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2951,7 +3680,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{130}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2979,7 +3708,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{131}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3736,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3764,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3792,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3820,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3848,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{136}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3876,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3904,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3932,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{139}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3960,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{140}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3988,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +4016,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +4044,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{143}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +4072,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{144}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3371,7 +4100,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{145}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +4128,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *WorkflowTemplateSpec) Reset()      { *m = WorkflowTemplateSpec{} }
 func (*WorkflowTemplateSpec) ProtoMessage() {}
 func (*WorkflowTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{146}
 }
 func (m *WorkflowTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +4156,7 @@ var xxx_messageInfo_WorkflowTemplateSpec proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{147}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3456,7 +4185,15 @@ func init() {
 	proto.RegisterType((*Amount)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Amount")
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Arguments")
+	proto.RegisterType((*ArtGCStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtGCStatus")
+	proto.RegisterMapType((map[ArtifactGCStrategy]int32)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtGCStatus.PodsFailedEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtGCStatus.PodsRecoupedEntry")
+	proto.RegisterMapType((map[ArtifactGCStrategy]bool)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtGCStatus.StrategiesProcessedEntry")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Artifact")
+	proto.RegisterType((*ArtifactCache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactCache")
+	proto.RegisterType((*ArtifactDataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactDataSource")
+	proto.RegisterType((*ArtifactEncryption)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactEncryption")
+	proto.RegisterType((*ArtifactGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactGC")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*ArtifactPaths)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactPaths")
 	proto.RegisterType((*ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepository")
@@ -3465,16 +4202,25 @@ func init() {
 	proto.RegisterType((*ArtifactoryArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryArtifact")
 	proto.RegisterType((*ArtifactoryArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryArtifactRepository")
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
+	proto.RegisterType((*AutoRetryAttempt)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AutoRetryAttempt")
+	proto.RegisterType((*AutoRetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AutoRetryStrategy")
+	proto.RegisterType((*AzureArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureArtifact")
+	proto.RegisterType((*AzureArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureArtifactRepository")
+	proto.RegisterType((*AzureBlobContainer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureBlobContainer")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
+	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Cache")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
 	proto.RegisterType((*Condition)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Condition")
+	proto.RegisterType((*ConfigMapDataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ConfigMapDataSource")
 	proto.RegisterType((*ContainerNode)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerNode")
+	proto.RegisterType((*ContainerRetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerRetryStrategy")
 	proto.RegisterType((*ContainerSetTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerSetTemplate")
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContinueOn")
 	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CreateS3BucketOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CreateS3BucketOptions")
+	proto.RegisterType((*CreatedResource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CreatedResource")
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflow")
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowList")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
@@ -3484,6 +4230,7 @@ func init() {
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
 	proto.RegisterType((*Data)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Data")
 	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataSource")
+	proto.RegisterType((*DataValue)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataValue")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Event")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExecutorConfig")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifact")
@@ -3491,12 +4238,15 @@ func init() {
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSBucket")
 	proto.RegisterType((*Gauge)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Gauge")
 	proto.RegisterType((*GitArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GitArtifact")
+	proto.RegisterType((*GitLFS)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GitLFS")
 	proto.RegisterType((*HDFSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSArtifact")
 	proto.RegisterType((*HDFSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSArtifactRepository")
 	proto.RegisterType((*HDFSConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSConfig")
 	proto.RegisterType((*HDFSKrbConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSKrbConfig")
 	proto.RegisterType((*HTTP)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTP")
 	proto.RegisterType((*HTTPArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPArtifact")
+	proto.RegisterType((*HTTPAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPAuth")
+	proto.RegisterType((*HTTPDataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPDataSource")
 	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeader")
 	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Header")
@@ -3522,6 +4272,9 @@ func init() {
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.ResourcesDurationEntry")
 	proto.RegisterType((*NodeSynchronizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeSynchronizationStatus")
 	proto.RegisterType((*NoneStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NoneStrategy")
+	proto.RegisterType((*OCIArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OCIArtifact")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OCIArtifact.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OCIArtifact.MediaTypesEntry")
 	proto.RegisterType((*OSSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSArtifact")
 	proto.RegisterType((*OSSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSArtifactRepository")
 	proto.RegisterType((*OSSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSBucket")
@@ -3531,17 +4284,24 @@ func init() {
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Parameter")
 	proto.RegisterType((*Plugin)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Plugin")
+	proto.RegisterType((*PluginArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PluginArtifact")
+	proto.RegisterType((*PluginArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PluginArtifactRepository")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
+	proto.RegisterType((*ResourceCleanup)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceCleanup")
+	proto.RegisterType((*ResourceEscalation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceEscalation")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryAffinity")
+	proto.RegisterType((*RetryDecision)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryDecision")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
+	proto.RegisterType((*RetryRule)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryRule")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Artifact")
 	proto.RegisterType((*S3ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3ArtifactRepository")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*S3EncryptionOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3EncryptionOptions")
+	proto.RegisterType((*SQLCache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SQLCache")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
@@ -3595,572 +4355,728 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 9035 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xc9,
-	0x75, 0xd8, 0xf5, 0x90, 0x43, 0xce, 0x3c, 0x92, 0x4b, 0x6e, 0xed, 0xd7, 0x1c, 0x6f, 0x6f, 0xb9,
-	0xee, 0xf3, 0x5d, 0x6e, 0xed, 0x13, 0xe9, 0xdb, 0x95, 0x92, 0x8b, 0x84, 0xc8, 0xe2, 0x90, 0xcb,
-	0x25, 0x8f, 0x9f, 0x57, 0xc3, 0xdd, 0x8d, 0xee, 0x2e, 0xb2, 0x9a, 0x33, 0xc5, 0x99, 0x3e, 0xce,
-	0x74, 0xcf, 0x75, 0xf7, 0xf0, 0xe3, 0x3e, 0x24, 0x45, 0xfe, 0xd0, 0x5d, 0x2c, 0xc7, 0xf9, 0x70,
-	0x6c, 0x59, 0x49, 0x00, 0xc3, 0xb1, 0x62, 0xc3, 0x31, 0x12, 0x08, 0xc8, 0x2f, 0xe7, 0x6f, 0x10,
-	0x28, 0x48, 0x90, 0x38, 0xb0, 0x12, 0x0b, 0x48, 0x42, 0x45, 0x4c, 0xe2, 0x1f, 0x09, 0x1c, 0x04,
-	0x46, 0x24, 0x0b, 0x9b, 0xfc, 0x08, 0xea, 0xb3, 0xab, 0x7a, 0x7a, 0xb8, 0xc3, 0xdd, 0x26, 0xf7,
-	0x10, 0xe7, 0xdf, 0xcc, 0xab, 0x57, 0xef, 0x55, 0x55, 0x57, 0xbd, 0x7a, 0xf5, 0xde, 0xab, 0x57,
-	0xb0, 0x51, 0x77, 0xa3, 0x46, 0x67, 0x6b, 0xba, 0xea, 0xb7, 0x66, 0x9c, 0xa0, 0xee, 0xb7, 0x03,
-	0xff, 0x2d, 0xf6, 0xe3, 0x63, 0x7b, 0x7e, 0xb0, 0xb3, 0xdd, 0xf4, 0xf7, 0xc2, 0x99, 0xdd, 0x5b,
-	0x33, 0xed, 0x9d, 0xfa, 0x8c, 0xd3, 0x76, 0xc3, 0x19, 0x09, 0x9d, 0xd9, 0x7d, 0xd9, 0x69, 0xb6,
-	0x1b, 0xce, 0xcb, 0x33, 0x75, 0xe2, 0x91, 0xc0, 0x89, 0x48, 0x6d, 0xba, 0x1d, 0xf8, 0x91, 0x8f,
-	0x3e, 0x13, 0x53, 0x9c, 0x96, 0x14, 0xd9, 0x8f, 0x9f, 0x52, 0x14, 0xa7, 0x77, 0x6f, 0x4d, 0xb7,
-	0x77, 0xea, 0xd3, 0x94, 0xe2, 0xb4, 0x84, 0x4e, 0x4b, 0x8a, 0x93, 0x1f, 0xd3, 0xda, 0x54, 0xf7,
-	0xeb, 0xfe, 0x0c, 0x23, 0xbc, 0xd5, 0xd9, 0x66, 0xff, 0xd8, 0x1f, 0xf6, 0x8b, 0x33, 0x9c, 0xb4,
-	0x77, 0x5e, 0x09, 0xa7, 0x5d, 0x9f, 0xb6, 0x6f, 0xa6, 0xea, 0x07, 0x64, 0x66, 0xb7, 0xab, 0x51,
-	0x93, 0x37, 0x34, 0x9c, 0xb6, 0xdf, 0x74, 0xab, 0x07, 0x33, 0xbb, 0x2f, 0x6f, 0x91, 0xa8, 0xbb,
-	0xfd, 0x93, 0x1f, 0x8f, 0x51, 0x5b, 0x4e, 0xb5, 0xe1, 0x7a, 0x24, 0x38, 0x88, 0xfb, 0xdf, 0x22,
-	0x91, 0x93, 0xc6, 0x60, 0xa6, 0x57, 0xad, 0xa0, 0xe3, 0x45, 0x6e, 0x8b, 0x74, 0x55, 0xf8, 0xb3,
-	0x0f, 0xab, 0x10, 0x56, 0x1b, 0xa4, 0xe5, 0x74, 0xd5, 0xbb, 0xd5, 0xab, 0x5e, 0x27, 0x72, 0x9b,
-	0x33, 0xae, 0x17, 0x85, 0x51, 0x90, 0xac, 0x64, 0xdf, 0x86, 0xa1, 0xd9, 0x96, 0xdf, 0xf1, 0x22,
-	0xf4, 0x29, 0xc8, 0xef, 0x3a, 0xcd, 0x0e, 0x29, 0x59, 0xd7, 0xad, 0x17, 0x8b, 0xe5, 0xe7, 0xbf,
-	0x75, 0x38, 0xf5, 0xd4, 0xd1, 0xe1, 0x54, 0xfe, 0x1e, 0x05, 0x3e, 0x38, 0x9c, 0xba, 0x48, 0xbc,
-	0xaa, 0x5f, 0x73, 0xbd, 0xfa, 0xcc, 0x5b, 0xa1, 0xef, 0x4d, 0xaf, 0x75, 0x5a, 0x5b, 0x24, 0xc0,
-	0xbc, 0x8e, 0xfd, 0xfb, 0x39, 0x18, 0x9f, 0x0d, 0xaa, 0x0d, 0x77, 0x97, 0x54, 0x22, 0x4a, 0xbf,
-	0x7e, 0x80, 0x1a, 0x30, 0x10, 0x39, 0x01, 0x23, 0x37, 0x72, 0x73, 0x75, 0xfa, 0x71, 0x3f, 0xfe,
-	0xf4, 0xa6, 0x13, 0x48, 0xda, 0xe5, 0xe1, 0xa3, 0xc3, 0xa9, 0x81, 0x4d, 0x27, 0xc0, 0x94, 0x05,
-	0x6a, 0xc2, 0xa0, 0xe7, 0x7b, 0xa4, 0x94, 0x63, 0xac, 0xd6, 0x1e, 0x9f, 0xd5, 0x9a, 0xef, 0xa9,
-	0x7e, 0x94, 0x0b, 0x47, 0x87, 0x53, 0x83, 0x14, 0x82, 0x19, 0x17, 0xda, 0xaf, 0x77, 0xdc, 0x76,
-	0x69, 0x20, 0xab, 0x7e, 0xbd, 0xee, 0xb6, 0xcd, 0x7e, 0xbd, 0xee, 0xb6, 0x31, 0x65, 0x61, 0x7f,
-	0x98, 0x83, 0xe2, 0x6c, 0x50, 0xef, 0xb4, 0x88, 0x17, 0x85, 0xe8, 0x8b, 0x00, 0x6d, 0x27, 0x70,
-	0x5a, 0x24, 0x22, 0x41, 0x58, 0xb2, 0xae, 0x0f, 0xbc, 0x38, 0x72, 0x73, 0xf9, 0xf1, 0xd9, 0x6f,
-	0x48, 0x9a, 0x65, 0x24, 0x3e, 0x39, 0x28, 0x50, 0x88, 0x35, 0x96, 0xe8, 0x5d, 0x28, 0x3a, 0x41,
-	0xe4, 0x6e, 0x3b, 0xd5, 0x28, 0x2c, 0xe5, 0x18, 0xff, 0x57, 0x1f, 0x9f, 0xff, 0xac, 0x20, 0x59,
-	0x3e, 0x2f, 0xd8, 0x17, 0x25, 0x24, 0xc4, 0x31, 0x3f, 0xfb, 0x37, 0xf3, 0x50, 0x90, 0x05, 0xe8,
-	0x3a, 0x0c, 0x7a, 0x4e, 0x4b, 0x4e, 0xd5, 0x51, 0x51, 0x71, 0x70, 0xcd, 0x69, 0xd1, 0x8f, 0xe4,
-	0xb4, 0x08, 0xc5, 0x68, 0x3b, 0x51, 0x83, 0x4d, 0x09, 0x0d, 0x63, 0xc3, 0x89, 0x1a, 0x98, 0x95,
-	0xa0, 0xab, 0x30, 0xd8, 0xf2, 0x6b, 0x84, 0x7d, 0xc7, 0x3c, 0xff, 0xc8, 0xab, 0x7e, 0x8d, 0x60,
-	0x06, 0xa5, 0xf5, 0xb7, 0x03, 0xbf, 0x55, 0x1a, 0x34, 0xeb, 0x2f, 0x04, 0x7e, 0x0b, 0xb3, 0x12,
-	0xf4, 0x35, 0x0b, 0x26, 0x64, 0xf3, 0x56, 0xfc, 0xaa, 0x13, 0xb9, 0xbe, 0x57, 0xca, 0xb3, 0x49,
-	0x81, 0xb3, 0x1b, 0x15, 0x49, 0xb9, 0x5c, 0x12, 0x4d, 0x98, 0x48, 0x96, 0xe0, 0xae, 0x56, 0xa0,
-	0x9b, 0x00, 0xf5, 0xa6, 0xbf, 0xe5, 0x34, 0xe9, 0x80, 0x94, 0x86, 0x58, 0x17, 0xd4, 0xc7, 0xbd,
-	0xa3, 0x4a, 0xb0, 0x86, 0x85, 0xf6, 0x61, 0xd8, 0xe1, 0x0b, 0xb8, 0x34, 0xcc, 0x3a, 0xf1, 0x5a,
-	0x16, 0x9d, 0x30, 0x24, 0x42, 0x79, 0xe4, 0xe8, 0x70, 0x6a, 0x58, 0x00, 0xb1, 0x64, 0x87, 0x5e,
-	0x82, 0x82, 0xdf, 0xa6, 0xed, 0x76, 0x9a, 0xa5, 0xc2, 0x75, 0xeb, 0xc5, 0x42, 0x79, 0x42, 0xb4,
-	0xb5, 0xb0, 0x2e, 0xe0, 0x58, 0x61, 0xa0, 0x1b, 0x30, 0x1c, 0x76, 0xb6, 0xe8, 0x77, 0x2c, 0x15,
-	0x59, 0xc7, 0xc6, 0x05, 0xf2, 0x70, 0x85, 0x83, 0xb1, 0x2c, 0x47, 0x9f, 0x80, 0x91, 0x80, 0x54,
-	0x3b, 0x41, 0x48, 0xe8, 0x87, 0x2d, 0x01, 0xa3, 0x7d, 0x41, 0xa0, 0x8f, 0xe0, 0xb8, 0x08, 0xeb,
-	0x78, 0xe8, 0xd3, 0x70, 0x8e, 0x7e, 0xe0, 0xdb, 0xfb, 0xed, 0x80, 0x84, 0x21, 0xfd, 0xaa, 0x23,
-	0x8c, 0xd1, 0x65, 0x51, 0xf3, 0xdc, 0x82, 0x51, 0x8a, 0x13, 0xd8, 0xf6, 0xef, 0x0e, 0x43, 0xd7,
-	0x47, 0x42, 0x2f, 0xc3, 0x88, 0xe8, 0xef, 0x8a, 0x5f, 0x0f, 0xd9, 0xc4, 0x2d, 0x94, 0xc7, 0x69,
-	0x3b, 0x66, 0x63, 0x30, 0xd6, 0x71, 0x50, 0x0d, 0x72, 0xe1, 0x2d, 0x21, 0xd3, 0x56, 0x1e, 0xff,
-	0x63, 0x54, 0x6e, 0xa9, 0x95, 0x36, 0x74, 0x74, 0x38, 0x95, 0xab, 0xdc, 0xc2, 0xb9, 0xf0, 0x16,
-	0x95, 0x66, 0x75, 0x37, 0xca, 0x4e, 0x9a, 0xdd, 0x71, 0x23, 0xc5, 0x87, 0x49, 0xb3, 0x3b, 0x6e,
-	0x84, 0x29, 0x0b, 0x2a, 0xa5, 0x1b, 0x51, 0xd4, 0x66, 0x4b, 0x2a, 0x13, 0x29, 0xbd, 0xb8, 0xb9,
-	0xb9, 0xa1, 0x78, 0xb1, 0x05, 0x4c, 0x21, 0x98, 0x71, 0x41, 0x1f, 0x58, 0x74, 0xc4, 0x79, 0xa1,
-	0x1f, 0x1c, 0x88, 0x95, 0x79, 0x37, 0xbb, 0x95, 0xe9, 0x07, 0x07, 0x8a, 0xb9, 0xf8, 0x90, 0xaa,
-	0x00, 0xeb, 0xac, 0x59, 0xc7, 0x6b, 0xdb, 0x21, 0x5b, 0x88, 0xd9, 0x74, 0x7c, 0x7e, 0xa1, 0x92,
-	0xe8, 0xf8, 0xfc, 0x42, 0x05, 0x33, 0x2e, 0xf4, 0x83, 0x06, 0xce, 0x9e, 0x58, 0xc4, 0x19, 0x7c,
-	0x50, 0xec, 0xec, 0x99, 0x1f, 0x14, 0x3b, 0x7b, 0x98, 0xb2, 0xa0, 0x9c, 0xfc, 0x30, 0x64, 0x6b,
-	0x36, 0x13, 0x4e, 0xeb, 0x95, 0x8a, 0xc9, 0x69, 0xbd, 0x52, 0xc1, 0x94, 0x05, 0x9b, 0xa4, 0xd5,
-	0x90, 0x2d, 0xf8, 0x6c, 0x26, 0xe9, 0x5c, 0x82, 0xd3, 0x9d, 0xb9, 0x0a, 0xa6, 0x2c, 0xec, 0x0f,
-	0x2d, 0x18, 0x93, 0x45, 0x54, 0x88, 0x84, 0x68, 0x1f, 0x0a, 0xf2, 0x63, 0x0a, 0x5d, 0x26, 0xcb,
-	0x4d, 0x4f, 0x89, 0x3a, 0x09, 0xc1, 0x8a, 0x9b, 0xfd, 0x3b, 0x79, 0x40, 0x0a, 0x4c, 0xda, 0x7e,
-	0xe8, 0xb2, 0xe9, 0xf4, 0x08, 0xa2, 0xc4, 0xd3, 0x44, 0xc9, 0xbd, 0x2c, 0x45, 0x49, 0xdc, 0x2c,
-	0x43, 0xa8, 0xfc, 0x8d, 0xc4, 0xe2, 0xe3, 0xd2, 0xe5, 0xa7, 0x4e, 0x65, 0xf1, 0x69, 0x4d, 0x38,
-	0x7e, 0x19, 0xee, 0x8a, 0x65, 0xc8, 0xe5, 0xcf, 0x5f, 0xcc, 0x76, 0x19, 0x6a, 0xad, 0x48, 0x2e,
-	0xc8, 0x80, 0x2f, 0x13, 0x2e, 0x80, 0xee, 0x67, 0xba, 0x4c, 0x34, 0xae, 0xe6, 0x82, 0x09, 0xf8,
-	0x82, 0x19, 0xca, 0x8a, 0xa7, 0xb6, 0x60, 0x92, 0x3c, 0xd5, 0xd2, 0x79, 0x1b, 0x2e, 0x75, 0xe3,
-	0x60, 0xb2, 0x8d, 0x66, 0xa0, 0x58, 0xf5, 0xbd, 0x6d, 0xb7, 0xbe, 0xea, 0xb4, 0x85, 0xca, 0xa6,
-	0x74, 0xbd, 0x39, 0x59, 0x80, 0x63, 0x1c, 0xf4, 0x2c, 0x0c, 0xec, 0x90, 0x03, 0xa1, 0xbb, 0x8d,
-	0x08, 0xd4, 0x81, 0x65, 0x72, 0x80, 0x29, 0xfc, 0x93, 0x85, 0xaf, 0xfd, 0xda, 0xd4, 0x53, 0x5f,
-	0xfa, 0x0f, 0xd7, 0x9f, 0xb2, 0xff, 0xcd, 0x00, 0x3c, 0x93, 0xca, 0xb3, 0x12, 0x39, 0x51, 0x27,
-	0x44, 0xbf, 0x63, 0xc1, 0x25, 0x27, 0xad, 0x5c, 0xac, 0xe4, 0xfb, 0xd9, 0xcd, 0x48, 0x83, 0x7c,
-	0xf9, 0x59, 0xd1, 0xe8, 0xf4, 0x11, 0xc1, 0xe9, 0x8d, 0xa2, 0x03, 0x45, 0x95, 0xd7, 0xb0, 0xed,
-	0x54, 0x89, 0xe8, 0xbd, 0x1a, 0xa8, 0x35, 0x59, 0x80, 0x63, 0x1c, 0xaa, 0x0c, 0xd5, 0xc8, 0xb6,
-	0xd3, 0x69, 0xf2, 0x0d, 0xbc, 0x10, 0x2b, 0x43, 0xf3, 0x1c, 0x8c, 0x65, 0x39, 0xfa, 0x3b, 0x16,
-	0xa0, 0x6e, 0xae, 0x62, 0x31, 0x6c, 0x9e, 0xc6, 0x38, 0x94, 0x2f, 0x1f, 0x1d, 0x4e, 0xa5, 0x08,
-	0x30, 0x9c, 0xd2, 0x0e, 0xed, 0x9b, 0xfe, 0x0b, 0x0b, 0x2e, 0xa4, 0x2c, 0x73, 0x3a, 0x29, 0x3a,
-	0x41, 0x53, 0xcc, 0x1f, 0x35, 0x29, 0xee, 0xe2, 0x15, 0x4c, 0xe1, 0xe8, 0x97, 0x2c, 0x18, 0xd7,
-	0x56, 0xfb, 0x6c, 0x47, 0x28, 0xff, 0x19, 0x29, 0xb2, 0x06, 0xe1, 0xf2, 0x15, 0xc1, 0x7e, 0x3c,
-	0x51, 0x80, 0x93, 0x4d, 0xb0, 0xbf, 0x67, 0xc1, 0xb3, 0xc7, 0x0a, 0xad, 0xd4, 0x86, 0x5b, 0x4f,
-	0xbc, 0xe1, 0x74, 0x6a, 0x05, 0xa4, 0xed, 0xdf, 0xc5, 0x2b, 0x62, 0x26, 0xaa, 0xa9, 0x85, 0x39,
-	0x18, 0xcb, 0x72, 0xfb, 0x0f, 0x2c, 0x48, 0xd2, 0x43, 0x0e, 0x9c, 0xeb, 0x84, 0x24, 0xa0, 0x53,
-	0xb5, 0x42, 0xaa, 0x01, 0x91, 0x7b, 0xe7, 0xf3, 0xd3, 0xdc, 0x4a, 0x41, 0x1b, 0x3c, 0x5d, 0xf5,
-	0x03, 0x32, 0xbd, 0xfb, 0xf2, 0x34, 0xc7, 0x58, 0x26, 0x07, 0x15, 0xd2, 0x24, 0x94, 0x46, 0x19,
-	0x51, 0x3d, 0xfb, 0xae, 0x41, 0x00, 0x27, 0x08, 0x52, 0x16, 0x6d, 0x27, 0x0c, 0xf7, 0xfc, 0xa0,
-	0x26, 0x58, 0xe4, 0x4e, 0xcc, 0x62, 0xc3, 0x20, 0x80, 0x13, 0x04, 0xed, 0x7f, 0x6a, 0xc1, 0x70,
-	0xd9, 0xa9, 0xee, 0xf8, 0xdb, 0xdb, 0xf4, 0x98, 0x52, 0xeb, 0x04, 0xfc, 0x98, 0xc7, 0x27, 0xa1,
-	0xda, 0xbb, 0xe7, 0x05, 0x1c, 0x2b, 0x0c, 0xb4, 0x09, 0x43, 0x7c, 0x38, 0x44, 0xa3, 0x7e, 0x42,
-	0x6b, 0x94, 0xb2, 0xce, 0xb0, 0x2f, 0xd7, 0x89, 0xdc, 0xe6, 0x34, 0xb7, 0xce, 0x4c, 0x2f, 0x79,
-	0xd1, 0x7a, 0x50, 0x89, 0x02, 0xd7, 0xab, 0x97, 0xe1, 0xe8, 0x70, 0x6a, 0x68, 0x81, 0xd1, 0xc0,
-	0x82, 0x16, 0x3d, 0xd1, 0xb4, 0x9c, 0x7d, 0xc9, 0x8e, 0xad, 0xf9, 0x62, 0x7c, 0xa2, 0x59, 0x8d,
-	0x8b, 0xb0, 0x8e, 0x67, 0x7f, 0x0e, 0xf2, 0x73, 0x4e, 0xb5, 0x41, 0xd0, 0xdd, 0xa4, 0x24, 0x1e,
-	0xb9, 0xf9, 0x62, 0xda, 0x68, 0x29, 0xa9, 0xac, 0x0f, 0xd8, 0x58, 0x2f, 0x79, 0x6d, 0x7f, 0xdf,
-	0x82, 0x2b, 0x73, 0xcd, 0x4e, 0x18, 0x91, 0xe0, 0xbe, 0x98, 0x82, 0x9b, 0xa4, 0xd5, 0x6e, 0x3a,
-	0x11, 0x41, 0x9f, 0x87, 0x42, 0x8b, 0x44, 0x4e, 0xcd, 0x89, 0x1c, 0xc1, 0xb1, 0xf7, 0x50, 0xb0,
-	0x49, 0x4c, 0xb1, 0x69, 0x1b, 0xd6, 0xb7, 0xde, 0x22, 0xd5, 0x68, 0x95, 0x44, 0x4e, 0x7c, 0x76,
-	0x8d, 0x61, 0x58, 0x51, 0x45, 0xfb, 0x30, 0x18, 0xb6, 0x49, 0x35, 0x3b, 0xf5, 0x26, 0xd9, 0x87,
-	0x4a, 0x9b, 0x54, 0x63, 0x13, 0x00, 0xfd, 0x87, 0x19, 0x47, 0xfb, 0x7f, 0x5b, 0xf0, 0x4c, 0x8f,
-	0x7e, 0xaf, 0xb8, 0x61, 0x84, 0xde, 0xec, 0xea, 0xfb, 0x74, 0x7f, 0x7d, 0xa7, 0xb5, 0x59, 0xcf,
-	0xd5, 0x14, 0x93, 0x10, 0xad, 0xdf, 0x5f, 0x80, 0xbc, 0x1b, 0x91, 0x96, 0x34, 0xc5, 0x7c, 0xf6,
-	0xf1, 0x3b, 0xde, 0xa3, 0x2f, 0xe5, 0x31, 0x69, 0x0b, 0x5c, 0xa2, 0xfc, 0x30, 0x67, 0x6b, 0xff,
-	0x73, 0x0b, 0xe8, 0x74, 0xa8, 0xb9, 0xe2, 0x80, 0x3b, 0x18, 0x1d, 0xb4, 0xa5, 0x49, 0x46, 0xee,
-	0x7f, 0x83, 0x9b, 0x07, 0x6d, 0xf2, 0xe0, 0x70, 0x6a, 0x4c, 0x21, 0x52, 0x00, 0x66, 0xa8, 0xe8,
-	0x73, 0x30, 0x14, 0xb2, 0x7d, 0x5a, 0x48, 0x98, 0x05, 0x51, 0x69, 0x88, 0xef, 0xde, 0x0f, 0x0e,
-	0xa7, 0xfa, 0xb2, 0xb8, 0x4e, 0x2b, 0xda, 0xbc, 0x1e, 0x16, 0x54, 0xa9, 0x08, 0x6b, 0x91, 0x30,
-	0x74, 0xea, 0x44, 0xac, 0x14, 0x25, 0xc2, 0x56, 0x39, 0x18, 0xcb, 0x72, 0xfb, 0x6f, 0x59, 0x40,
-	0x9b, 0x18, 0x39, 0x94, 0xc5, 0x9a, 0x5f, 0x23, 0x68, 0x8d, 0x2d, 0x15, 0x0e, 0x10, 0x1f, 0xef,
-	0xd9, 0x1e, 0x4b, 0x85, 0x23, 0x19, 0x3a, 0x0d, 0x07, 0xe1, 0x98, 0x04, 0xfa, 0x38, 0x8c, 0xd6,
-	0x48, 0x9b, 0x78, 0x35, 0xe2, 0x55, 0x5d, 0xc2, 0x3f, 0x5a, 0xb1, 0x3c, 0x71, 0x74, 0x38, 0x35,
-	0x3a, 0xaf, 0xc1, 0xb1, 0x81, 0x65, 0xff, 0x89, 0x05, 0x17, 0x15, 0xb9, 0x0a, 0x89, 0xd4, 0xb2,
-	0xfa, 0x69, 0x0b, 0x40, 0x11, 0xa7, 0x3a, 0x2d, 0x9d, 0x02, 0xeb, 0x19, 0x4c, 0x01, 0x7d, 0x10,
-	0xe2, 0x85, 0xa7, 0xc0, 0x21, 0xd6, 0xd8, 0xa2, 0xcf, 0xc2, 0xe8, 0xae, 0xdf, 0xec, 0xb4, 0xc8,
-	0xaa, 0xdf, 0xf1, 0xa2, 0xb0, 0x34, 0xc0, 0x9a, 0x31, 0x95, 0x36, 0x4e, 0xf7, 0x62, 0xbc, 0xf2,
-	0x45, 0x41, 0x76, 0x54, 0x03, 0x86, 0xd8, 0x20, 0x65, 0x7f, 0x16, 0x18, 0x53, 0xd7, 0xeb, 0x90,
-	0x75, 0x0f, 0x3d, 0x07, 0x79, 0x12, 0x04, 0x7e, 0x20, 0x4e, 0x3b, 0x6a, 0x42, 0xde, 0xa6, 0x40,
-	0xcc, 0xcb, 0xd0, 0x0b, 0x54, 0xe6, 0xba, 0x4d, 0x52, 0x63, 0xf3, 0xa9, 0x50, 0x3e, 0x27, 0xe7,
-	0xd3, 0x02, 0x83, 0x62, 0x51, 0x6a, 0x4f, 0xc3, 0xf0, 0x1c, 0x65, 0x42, 0x02, 0x4a, 0x57, 0x37,
-	0x7a, 0x8f, 0x19, 0x46, 0x6f, 0x69, 0xdc, 0xde, 0x84, 0x4b, 0x73, 0x01, 0xa1, 0x82, 0xe0, 0x56,
-	0xb9, 0x53, 0xdd, 0x21, 0x11, 0x37, 0x4b, 0x85, 0xe8, 0x53, 0x30, 0xe6, 0x33, 0x89, 0xb4, 0xe2,
-	0x57, 0x77, 0x5c, 0xaf, 0x2e, 0x94, 0xb0, 0x4b, 0x82, 0xca, 0xd8, 0xba, 0x5e, 0x88, 0x4d, 0x5c,
-	0xfb, 0xbf, 0xe4, 0x60, 0x74, 0x2e, 0xf0, 0x3d, 0xb9, 0xda, 0xce, 0x40, 0x52, 0x46, 0x86, 0xa4,
-	0xcc, 0xc0, 0x4a, 0xa9, 0xb7, 0xbf, 0x97, 0x94, 0x44, 0xef, 0xa9, 0x65, 0x3e, 0x90, 0x95, 0xb2,
-	0x69, 0xf0, 0x65, 0xb4, 0xe3, 0x8f, 0x6d, 0x0a, 0x01, 0xfb, 0xbf, 0x5a, 0x30, 0xa1, 0xa3, 0x9f,
-	0x81, 0x60, 0x0e, 0x4d, 0xc1, 0xbc, 0x96, 0x6d, 0x7f, 0x7b, 0x48, 0xe3, 0x0f, 0x87, 0xcc, 0x7e,
-	0xd2, 0x0f, 0x80, 0xbe, 0x66, 0xc1, 0xe8, 0x9e, 0x06, 0x10, 0x9d, 0x5d, 0xcb, 0x6e, 0x8f, 0x64,
-	0x5f, 0xfd, 0x47, 0xe5, 0x7a, 0xd6, 0xa1, 0x0f, 0x12, 0xff, 0xb1, 0xd1, 0x12, 0xaa, 0x4e, 0x85,
-	0xd5, 0x06, 0xa9, 0x75, 0x9a, 0xf2, 0xa8, 0xa3, 0x86, 0xb4, 0x22, 0xe0, 0x58, 0x61, 0xa0, 0x37,
-	0xe1, 0x7c, 0xd5, 0xf7, 0xaa, 0x9d, 0x20, 0x20, 0x5e, 0xf5, 0x60, 0x83, 0xf9, 0xe9, 0x84, 0x50,
-	0x9f, 0x16, 0xd5, 0xce, 0xcf, 0x25, 0x11, 0x1e, 0xa4, 0x01, 0x71, 0x37, 0x21, 0x6e, 0x53, 0x0e,
-	0xa9, 0xd8, 0x65, 0xe7, 0xa1, 0x82, 0x6e, 0x53, 0x66, 0x60, 0x2c, 0xcb, 0xd1, 0x5d, 0xb8, 0x12,
-	0x46, 0x54, 0x57, 0xf6, 0xea, 0xf3, 0xc4, 0xa9, 0x35, 0x5d, 0x8f, 0xaa, 0xa3, 0xbe, 0x57, 0xe3,
-	0x07, 0xfc, 0x81, 0xf2, 0x33, 0x47, 0x87, 0x53, 0x57, 0x2a, 0xe9, 0x28, 0xb8, 0x57, 0x5d, 0xf4,
-	0x39, 0x98, 0x0c, 0x3b, 0xd5, 0x2a, 0x09, 0xc3, 0xed, 0x4e, 0xf3, 0x55, 0x7f, 0x2b, 0x5c, 0x74,
-	0x43, 0xaa, 0x4b, 0xaf, 0xb8, 0x2d, 0x37, 0x62, 0xc7, 0xf8, 0x7c, 0xf9, 0xda, 0xd1, 0xe1, 0xd4,
-	0x64, 0xa5, 0x27, 0x16, 0x3e, 0x86, 0x02, 0xc2, 0x70, 0x99, 0x0b, 0xbf, 0x2e, 0xda, 0xc3, 0x8c,
-	0xf6, 0xe4, 0xd1, 0xe1, 0xd4, 0xe5, 0x85, 0x54, 0x0c, 0xdc, 0xa3, 0x26, 0xfd, 0x82, 0x91, 0xdb,
-	0x22, 0xef, 0xf8, 0x1e, 0x61, 0x36, 0x40, 0xed, 0x0b, 0x6e, 0x0a, 0x38, 0x56, 0x18, 0xe8, 0xad,
-	0x78, 0x26, 0xd2, 0xe5, 0x22, 0x6c, 0x79, 0x27, 0x97, 0x70, 0x17, 0x8f, 0x0e, 0xa7, 0x26, 0xee,
-	0x6b, 0x94, 0xe8, 0x92, 0xc3, 0x06, 0x6d, 0xfb, 0xf7, 0x73, 0x80, 0xba, 0x45, 0x04, 0x5a, 0x86,
-	0x21, 0xa7, 0x1a, 0xb9, 0xbb, 0x44, 0x38, 0xcf, 0x9e, 0x4b, 0xdb, 0xa7, 0x38, 0x2b, 0x4c, 0xb6,
-	0x09, 0x9d, 0x21, 0x24, 0x96, 0x2b, 0xb3, 0xac, 0x2a, 0x16, 0x24, 0x90, 0x0f, 0xe7, 0x9b, 0x4e,
-	0x18, 0xc9, 0xb9, 0x5a, 0xa3, 0x5d, 0x16, 0x82, 0xf5, 0xc7, 0xfa, 0xeb, 0x14, 0xad, 0x51, 0xbe,
-	0x44, 0x67, 0xee, 0x4a, 0x92, 0x10, 0xee, 0xa6, 0x8d, 0xbe, 0xc8, 0x36, 0x7c, 0xae, 0xe8, 0xc8,
-	0x9d, 0x76, 0x39, 0x93, 0x0d, 0x9f, 0xd3, 0x34, 0x36, 0x7b, 0xc1, 0x06, 0x6b, 0x2c, 0xed, 0x7f,
-	0x09, 0x30, 0x3c, 0x3f, 0x7b, 0x67, 0xd3, 0x09, 0x77, 0xfa, 0x70, 0xc0, 0xd1, 0xd9, 0x21, 0x94,
-	0x95, 0xe4, 0xfa, 0x96, 0x4a, 0x0c, 0x56, 0x18, 0xc8, 0x83, 0x21, 0xd7, 0xa3, 0x0b, 0xa2, 0x74,
-	0x2e, 0x2b, 0x13, 0xab, 0xd2, 0x5e, 0xd9, 0x41, 0x6a, 0x89, 0x51, 0xc7, 0x82, 0x0b, 0x7a, 0x0f,
-	0x8a, 0x8e, 0x74, 0xac, 0x8a, 0x6d, 0x69, 0x39, 0x8b, 0xd3, 0xb6, 0x20, 0xa9, 0xfb, 0x32, 0x05,
-	0x08, 0xc7, 0x0c, 0xd1, 0x97, 0x2c, 0x18, 0x91, 0x5d, 0xc7, 0x64, 0x5b, 0x18, 0x61, 0x56, 0xb3,
-	0xeb, 0x33, 0x26, 0xdb, 0xdc, 0x18, 0xaa, 0x01, 0xb0, 0xce, 0xb2, 0x4b, 0x1d, 0xcd, 0xf7, 0xa3,
-	0x8e, 0xa2, 0x3d, 0x28, 0xee, 0xb9, 0x51, 0x83, 0x6d, 0x3c, 0xa5, 0x21, 0x36, 0x05, 0x17, 0x1e,
-	0xbf, 0xd5, 0x94, 0x5c, 0x3c, 0x62, 0xf7, 0x25, 0x03, 0x1c, 0xf3, 0x42, 0x33, 0x9c, 0x31, 0x73,
-	0x4c, 0x33, 0x91, 0x55, 0x34, 0x2b, 0xb0, 0x02, 0x1c, 0xe3, 0xd0, 0x21, 0x1e, 0xa5, 0xff, 0x2a,
-	0xe4, 0xed, 0x0e, 0x5d, 0xc7, 0xc2, 0x4b, 0x91, 0xc1, 0xbc, 0x92, 0x14, 0xf9, 0x60, 0xdd, 0xd7,
-	0x78, 0x60, 0x83, 0x23, 0x5d, 0x23, 0x7b, 0x0d, 0xe2, 0x09, 0x37, 0xa5, 0x5a, 0x23, 0xf7, 0x1b,
-	0xc4, 0xc3, 0xac, 0x04, 0xbd, 0xc7, 0x75, 0x78, 0xae, 0xe3, 0x32, 0xff, 0x64, 0x26, 0x9e, 0xbe,
-	0x58, 0x6f, 0x2e, 0x9f, 0x93, 0xca, 0x3b, 0xff, 0x8f, 0x35, 0x7e, 0x54, 0x5d, 0xf6, 0xbd, 0xdb,
-	0xfb, 0x6e, 0x24, 0xfc, 0x9b, 0x4a, 0xd2, 0xad, 0x33, 0x28, 0x16, 0xa5, 0xdc, 0xc8, 0x48, 0x27,
-	0x41, 0x58, 0x1a, 0x35, 0x8f, 0x51, 0x7c, 0xa6, 0x84, 0x58, 0x96, 0xa3, 0xbf, 0x6b, 0x41, 0xbe,
-	0xe1, 0xfb, 0x3b, 0x61, 0x69, 0x8c, 0x4d, 0x8e, 0x0c, 0x54, 0x3d, 0x21, 0x71, 0xa6, 0x17, 0x29,
-	0xd9, 0xdb, 0x5e, 0x14, 0x1c, 0x94, 0x5f, 0x96, 0x0a, 0x10, 0x83, 0x3d, 0x38, 0x9c, 0x3a, 0xb7,
-	0xe2, 0x6e, 0x93, 0xea, 0x41, 0xb5, 0x49, 0x18, 0xe4, 0xcb, 0xdf, 0xd5, 0x20, 0xb7, 0x77, 0x89,
-	0x17, 0x61, 0xde, 0xaa, 0xc9, 0x0f, 0x2d, 0x80, 0x98, 0x10, 0x9a, 0xe0, 0x76, 0x66, 0x26, 0xc4,
-	0x98, 0x69, 0x19, 0x11, 0x79, 0x1e, 0xe0, 0x92, 0x3c, 0x83, 0x03, 0x95, 0xd1, 0x34, 0x71, 0xa2,
-	0xf8, 0x64, 0xee, 0x15, 0xcb, 0xfe, 0xd7, 0x16, 0x8c, 0xd0, 0xce, 0x49, 0x11, 0xf8, 0x02, 0x0c,
-	0x45, 0x4e, 0x50, 0x17, 0x96, 0x32, 0xed, 0x73, 0x6c, 0x32, 0x28, 0x16, 0xa5, 0xc8, 0x83, 0x7c,
-	0xe4, 0x84, 0x3b, 0x52, 0xbb, 0x5c, 0xca, 0x6c, 0x88, 0x63, 0xc5, 0x92, 0xfe, 0x0b, 0x31, 0x67,
-	0x83, 0x5e, 0x84, 0x02, 0x55, 0x00, 0x16, 0x9c, 0x50, 0x1a, 0x99, 0x47, 0xa9, 0x10, 0x5f, 0x10,
-	0x30, 0xac, 0x4a, 0xed, 0xbf, 0x99, 0x83, 0xc1, 0x79, 0x7e, 0xce, 0x18, 0x0a, 0xfd, 0x4e, 0x50,
-	0x25, 0x42, 0xdf, 0xcc, 0x60, 0x4e, 0x53, 0xba, 0x15, 0x46, 0x53, 0xd3, 0xf4, 0xd9, 0x7f, 0x2c,
-	0x78, 0xa1, 0x5f, 0xb2, 0xe0, 0x5c, 0x14, 0x38, 0x5e, 0xb8, 0xed, 0x07, 0x2d, 0x6e, 0x20, 0xcb,
-	0x65, 0x35, 0x0b, 0x37, 0x0d, 0xba, 0x95, 0x88, 0xb4, 0xe3, 0x70, 0x00, 0xb3, 0x0c, 0x27, 0xda,
-	0x60, 0xff, 0x8a, 0x05, 0x10, 0xb7, 0x1e, 0x7d, 0x60, 0xc1, 0x98, 0xa3, 0x3b, 0x18, 0xc5, 0x18,
-	0xad, 0x67, 0x67, 0xec, 0x65, 0x64, 0xcb, 0xe7, 0xe9, 0x09, 0xd4, 0x00, 0x61, 0x93, 0xb1, 0xfd,
-	0x09, 0xc8, 0xb3, 0xd5, 0xc1, 0x74, 0x71, 0x61, 0xe5, 0x4b, 0x9a, 0x36, 0xa5, 0xf5, 0x0f, 0x2b,
-	0x0c, 0xfb, 0x4d, 0x38, 0x77, 0x7b, 0x9f, 0x54, 0x3b, 0x91, 0x1f, 0x70, 0x6b, 0x20, 0x7a, 0x15,
-	0x50, 0x48, 0x82, 0x5d, 0xb7, 0x4a, 0x66, 0xab, 0x55, 0x7a, 0xb2, 0x5e, 0x8b, 0x75, 0x83, 0x49,
-	0x41, 0x09, 0x55, 0xba, 0x30, 0x70, 0x4a, 0x2d, 0xfb, 0xb7, 0x2d, 0x18, 0xd1, 0xbc, 0x4d, 0x74,
-	0xa7, 0xae, 0xcf, 0x55, 0xf8, 0xb9, 0x5b, 0x0c, 0xd5, 0x72, 0x26, 0xfe, 0x2c, 0x4e, 0x32, 0xde,
-	0x46, 0x14, 0x08, 0xc7, 0x0c, 0x1f, 0xe2, 0x89, 0xb2, 0xff, 0x99, 0x05, 0x97, 0x52, 0x5d, 0x63,
-	0x4f, 0xb8, 0xd9, 0x33, 0x50, 0xdc, 0x21, 0x07, 0x0b, 0x6c, 0x0e, 0x26, 0x1d, 0x49, 0xcb, 0xb2,
-	0x00, 0xc7, 0x38, 0xf6, 0x37, 0x2d, 0x88, 0x29, 0x51, 0x51, 0xb4, 0x15, 0xb7, 0x5c, 0x13, 0x45,
-	0x82, 0x93, 0x28, 0x45, 0xef, 0xc1, 0x15, 0xf3, 0x0b, 0x32, 0x73, 0xf1, 0xc9, 0x4d, 0xf1, 0xfc,
-	0xcc, 0x94, 0x4e, 0x09, 0xf7, 0x62, 0x61, 0xdf, 0x83, 0xfc, 0x1d, 0xa7, 0x53, 0x27, 0x7d, 0x19,
-	0x71, 0xa8, 0x18, 0x0b, 0x88, 0xd3, 0x8c, 0xa4, 0x9a, 0x2e, 0xc4, 0x18, 0x16, 0x30, 0xac, 0x4a,
-	0xed, 0xef, 0x0f, 0xc2, 0x88, 0x16, 0xc5, 0x42, 0xf7, 0xf1, 0x80, 0xb4, 0xfd, 0xa4, 0xae, 0x4b,
-	0x3f, 0x36, 0x66, 0x25, 0x74, 0xfd, 0x04, 0x64, 0xd7, 0x0d, 0xb9, 0xc8, 0x31, 0xd6, 0x0f, 0x16,
-	0x70, 0xac, 0x30, 0xd0, 0x14, 0xe4, 0x6b, 0xa4, 0x1d, 0x35, 0x98, 0x34, 0x1d, 0x2c, 0x17, 0x69,
-	0x53, 0xe7, 0x29, 0x00, 0x73, 0x38, 0x45, 0xd8, 0x26, 0x51, 0xb5, 0xc1, 0xac, 0x7a, 0x45, 0x8e,
-	0xb0, 0x40, 0x01, 0x98, 0xc3, 0x53, 0x9c, 0x2b, 0xf9, 0xd3, 0x77, 0xae, 0x0c, 0x65, 0xec, 0x5c,
-	0x41, 0x6d, 0xb8, 0x10, 0x86, 0x8d, 0x8d, 0xc0, 0xdd, 0x75, 0x22, 0x12, 0xcf, 0x9c, 0xe1, 0x93,
-	0xf0, 0xb9, 0x72, 0x74, 0x38, 0x75, 0xa1, 0x52, 0x59, 0x4c, 0x52, 0xc1, 0x69, 0xa4, 0x51, 0x05,
-	0x2e, 0xb9, 0x5e, 0x48, 0xaa, 0x9d, 0x80, 0x2c, 0xd5, 0x3d, 0x3f, 0x20, 0x8b, 0x7e, 0x48, 0xc9,
-	0x89, 0xb0, 0x33, 0xe5, 0xb4, 0x5d, 0x4a, 0x43, 0xc2, 0xe9, 0x75, 0xd1, 0x1d, 0x38, 0x5f, 0x73,
-	0x43, 0x67, 0xab, 0x49, 0x2a, 0x9d, 0xad, 0x96, 0x4f, 0x0f, 0x6c, 0x3c, 0x52, 0xa5, 0x50, 0x7e,
-	0x5a, 0x9a, 0x26, 0xe6, 0x93, 0x08, 0xb8, 0xbb, 0x8e, 0xfd, 0x1d, 0x0b, 0x46, 0xf5, 0x90, 0x02,
-	0xaa, 0xc3, 0x42, 0x63, 0x7e, 0xa1, 0xc2, 0xa5, 0x6c, 0x76, 0x7b, 0xe9, 0xa2, 0xa2, 0x19, 0x9f,
-	0xf9, 0x62, 0x18, 0xd6, 0x78, 0xf6, 0x11, 0x46, 0xf9, 0x1c, 0xe4, 0xb7, 0x7d, 0xba, 0xd5, 0x0f,
-	0x98, 0x96, 0xd9, 0x05, 0x0a, 0xc4, 0xbc, 0xcc, 0xfe, 0x5f, 0x16, 0x5c, 0x4e, 0x8f, 0x96, 0xf8,
-	0x28, 0x74, 0xf2, 0x26, 0x00, 0xed, 0x8a, 0x21, 0x2e, 0xb5, 0x58, 0x58, 0x59, 0x82, 0x35, 0xac,
-	0xfe, 0xba, 0xfd, 0x03, 0xaa, 0x6e, 0xc6, 0x7c, 0xbe, 0x6a, 0xc1, 0x18, 0x65, 0xbb, 0x1c, 0x6c,
-	0x19, 0xbd, 0x5d, 0xcf, 0xa6, 0xb7, 0x8a, 0x6c, 0x6c, 0x80, 0x36, 0xc0, 0xd8, 0x64, 0x8e, 0x7e,
-	0x1c, 0x8a, 0x4e, 0xad, 0x16, 0x90, 0x30, 0x54, 0xee, 0x08, 0xe6, 0xe2, 0x9b, 0x95, 0x40, 0x1c,
-	0x97, 0x53, 0x11, 0xd7, 0xa8, 0x6d, 0x87, 0x54, 0x6a, 0x08, 0xbb, 0x9b, 0x12, 0x71, 0x94, 0x09,
-	0x85, 0x63, 0x85, 0x61, 0xff, 0xc2, 0x20, 0x98, 0xbc, 0x51, 0x0d, 0xc6, 0x77, 0x82, 0xad, 0x39,
-	0xe6, 0x86, 0x7c, 0x14, 0x87, 0xf0, 0x85, 0xa3, 0xc3, 0xa9, 0xf1, 0x65, 0x93, 0x02, 0x4e, 0x92,
-	0x14, 0x5c, 0x96, 0xc9, 0x41, 0xe4, 0x6c, 0x3d, 0xca, 0x46, 0x24, 0xb9, 0xe8, 0x14, 0x70, 0x92,
-	0x24, 0xfa, 0x04, 0x8c, 0xec, 0x04, 0x5b, 0x52, 0x80, 0x26, 0xbd, 0xb0, 0xcb, 0x71, 0x11, 0xd6,
-	0xf1, 0xe8, 0x10, 0xee, 0x04, 0x5b, 0x74, 0xc3, 0x91, 0x61, 0xc5, 0x6a, 0x08, 0x97, 0x05, 0x1c,
-	0x2b, 0x0c, 0xd4, 0x06, 0xb4, 0x23, 0x47, 0x4f, 0x39, 0x5d, 0x85, 0x9c, 0xef, 0xdf, 0x67, 0xcb,
-	0x42, 0x30, 0x96, 0xbb, 0xe8, 0xe0, 0x14, 0xda, 0xe8, 0xb3, 0x70, 0x65, 0x27, 0xd8, 0x12, 0xdb,
-	0xf0, 0x46, 0xe0, 0x7a, 0x55, 0xb7, 0x6d, 0x84, 0x10, 0x4f, 0x89, 0xe6, 0x5e, 0x59, 0x4e, 0x47,
-	0xc3, 0xbd, 0xea, 0xdb, 0xff, 0x2d, 0x07, 0x2c, 0x36, 0x93, 0x6a, 0x16, 0x2d, 0x12, 0x35, 0xfc,
-	0x5a, 0x52, 0xb3, 0x58, 0x65, 0x50, 0x2c, 0x4a, 0x65, 0xb0, 0x47, 0xae, 0x47, 0xb0, 0xc7, 0x1e,
-	0x0c, 0x37, 0x88, 0x53, 0x23, 0x81, 0x34, 0x84, 0xad, 0x64, 0x13, 0x4d, 0xba, 0xc8, 0x88, 0xc6,
-	0x07, 0x5c, 0xfe, 0x3f, 0xc4, 0x92, 0x1b, 0xfa, 0x24, 0x9c, 0xa3, 0x3a, 0x82, 0xdf, 0x89, 0xa4,
-	0xd5, 0x77, 0x90, 0x59, 0x7d, 0xd9, 0x7e, 0xb7, 0x69, 0x94, 0xe0, 0x04, 0x26, 0x9a, 0x87, 0x09,
-	0x61, 0xa1, 0x55, 0x06, 0x36, 0x31, 0xb0, 0x2a, 0xb6, 0xbb, 0x92, 0x28, 0xc7, 0x5d, 0x35, 0xa8,
-	0x44, 0xde, 0xf2, 0x6b, 0x3c, 0x9e, 0x55, 0x93, 0xc8, 0x65, 0xbf, 0x76, 0x80, 0x59, 0x89, 0xfd,
-	0xeb, 0x74, 0x1f, 0xd1, 0x42, 0x63, 0x1f, 0x16, 0x39, 0x13, 0xc6, 0x83, 0xc9, 0xcf, 0x4b, 0x8b,
-	0x19, 0x0c, 0xe6, 0x43, 0x06, 0xd2, 0xfe, 0x36, 0x15, 0x8d, 0x6a, 0xc4, 0xfb, 0xb0, 0x27, 0x3e,
-	0xa7, 0x9f, 0xcc, 0x7b, 0x29, 0x79, 0x5f, 0x84, 0x22, 0xfb, 0xb1, 0x10, 0xf8, 0x2d, 0x61, 0xd6,
-	0xc3, 0x59, 0xce, 0x0c, 0x71, 0x02, 0x65, 0x62, 0xf2, 0x9e, 0x64, 0x84, 0x63, 0x9e, 0xb6, 0x0f,
-	0x13, 0x49, 0x6c, 0xf4, 0x06, 0x8c, 0x86, 0x52, 0xd2, 0xc4, 0xa1, 0x67, 0x7d, 0x4a, 0x24, 0x66,
-	0x64, 0xaa, 0x68, 0xd5, 0xb1, 0x41, 0xcc, 0x5e, 0x87, 0xa1, 0x4c, 0x87, 0xd0, 0xfe, 0x86, 0x05,
-	0x45, 0x66, 0xe6, 0xaf, 0x07, 0x4e, 0x2b, 0xae, 0x32, 0x70, 0xcc, 0xa8, 0x87, 0x30, 0xcc, 0x0f,
-	0x04, 0xd2, 0x0f, 0x9d, 0xc1, 0x04, 0xe2, 0x97, 0x92, 0xe2, 0x09, 0xc4, 0x4f, 0x1e, 0x21, 0x96,
-	0x9c, 0xec, 0x9f, 0xcb, 0xc1, 0xd0, 0x92, 0xd7, 0xee, 0xfc, 0xa9, 0xbf, 0x18, 0xb3, 0x0a, 0x83,
-	0x4b, 0x11, 0x69, 0x99, 0xf7, 0xb7, 0x46, 0xcb, 0xcf, 0xeb, 0x77, 0xb7, 0x4a, 0xe6, 0xdd, 0x2d,
-	0xec, 0xec, 0xc9, 0x08, 0x08, 0x61, 0x90, 0x8a, 0xc3, 0xef, 0x5e, 0x82, 0xe2, 0x8a, 0xb3, 0x45,
-	0x9a, 0xcb, 0xe4, 0x20, 0xa4, 0x27, 0x11, 0xee, 0xc9, 0xb4, 0xe2, 0x93, 0x88, 0xe1, 0x75, 0x9c,
-	0x86, 0x11, 0x86, 0xcd, 0x18, 0xf5, 0x81, 0xff, 0xc7, 0x39, 0x18, 0x33, 0x2c, 0x62, 0x86, 0x9f,
-	0xc0, 0x7a, 0xa8, 0x9f, 0xc0, 0xb0, 0xdb, 0xe7, 0x9e, 0xb4, 0xdd, 0x7e, 0xe0, 0xec, 0xed, 0xf6,
-	0x37, 0x01, 0x48, 0x7c, 0x31, 0x65, 0xd0, 0xd4, 0x55, 0xb5, 0x4b, 0x29, 0x1a, 0x96, 0xdd, 0x84,
-	0xc1, 0x15, 0xd7, 0xdb, 0xe9, 0x4f, 0x42, 0x84, 0x55, 0xbf, 0xdd, 0x25, 0x21, 0x2a, 0x14, 0x88,
-	0x79, 0x99, 0xdc, 0x4e, 0x06, 0xd2, 0xb7, 0x13, 0xfb, 0xcb, 0x16, 0x9c, 0x5f, 0x25, 0x2d, 0xdf,
-	0x7d, 0xc7, 0x89, 0x63, 0x72, 0x68, 0xa5, 0x86, 0x1b, 0x89, 0xf0, 0x0d, 0x55, 0x69, 0xd1, 0x8d,
-	0x30, 0x85, 0x3f, 0xc4, 0xce, 0xc2, 0x22, 0x88, 0xa9, 0x9a, 0xb7, 0x16, 0xeb, 0x5b, 0x71, 0xb4,
-	0x8d, 0x2c, 0xc0, 0x31, 0x8e, 0xfd, 0xbb, 0x16, 0x0c, 0xf3, 0x46, 0x10, 0x49, 0xdb, 0xea, 0x41,
-	0xbb, 0x01, 0x79, 0x56, 0x4f, 0x4c, 0xa7, 0x3b, 0x19, 0xd8, 0xdf, 0x29, 0x39, 0x3e, 0xf9, 0xd9,
-	0x4f, 0xcc, 0x19, 0x30, 0xe5, 0xc7, 0xd9, 0x9f, 0x55, 0xe1, 0x48, 0xb1, 0xf2, 0xc3, 0xa0, 0x58,
-	0x94, 0xda, 0x5f, 0x1f, 0x80, 0x82, 0xf4, 0x6c, 0xf2, 0x50, 0x7a, 0xcf, 0xf3, 0x23, 0x87, 0x3b,
-	0xfe, 0xb8, 0x78, 0x7b, 0xe3, 0xf1, 0x5b, 0x29, 0x39, 0x4c, 0xcf, 0xc6, 0xd4, 0xb9, 0x7d, 0x5d,
-	0xa9, 0xb2, 0x5a, 0x09, 0xd6, 0x1b, 0x81, 0xbe, 0x00, 0x43, 0x4d, 0xba, 0xec, 0xa5, 0xb4, 0xbb,
-	0x97, 0x61, 0x73, 0x98, 0x3c, 0x11, 0x2d, 0x51, 0x23, 0xc4, 0x81, 0x58, 0x70, 0x9d, 0xfc, 0x34,
-	0x4c, 0x24, 0x5b, 0x9d, 0x62, 0xcc, 0xbf, 0x68, 0xec, 0x77, 0x9a, 0xed, 0x7d, 0xf2, 0xcf, 0x0b,
-	0xb1, 0x75, 0xf2, 0xaa, 0xf6, 0x6b, 0x30, 0xb2, 0x4a, 0xa2, 0xc0, 0xad, 0x32, 0x02, 0x0f, 0x9b,
-	0x5c, 0x7d, 0x6d, 0xb9, 0x5f, 0x61, 0x93, 0x95, 0xd2, 0x0c, 0xd1, 0x7b, 0x00, 0xed, 0xc0, 0xa7,
-	0x5a, 0x30, 0xe9, 0xc8, 0x8f, 0x9d, 0x81, 0x72, 0xbb, 0xa1, 0x68, 0x72, 0x97, 0x50, 0xfc, 0x1f,
-	0x6b, 0xfc, 0xec, 0x1b, 0x90, 0x5f, 0xed, 0x44, 0x64, 0xff, 0xe1, 0xa2, 0xc2, 0x7e, 0x03, 0x46,
-	0x19, 0xea, 0xa2, 0xdf, 0xa4, 0x1b, 0x0b, 0xed, 0x69, 0x8b, 0xfe, 0x4f, 0x1a, 0xe1, 0x18, 0x12,
-	0xe6, 0x65, 0x74, 0x05, 0x34, 0xfc, 0x66, 0x8d, 0x04, 0x62, 0x3c, 0xd4, 0xf7, 0x5d, 0x64, 0x50,
-	0x2c, 0x4a, 0xed, 0x9f, 0xce, 0xc1, 0x08, 0xab, 0x28, 0xa4, 0xc7, 0x01, 0x0c, 0x37, 0x38, 0x1f,
-	0x31, 0x24, 0x19, 0x44, 0xb0, 0xe8, 0xad, 0xd7, 0x14, 0x55, 0x0e, 0xc0, 0x92, 0x1f, 0x65, 0xbd,
-	0xe7, 0xb8, 0x11, 0x65, 0x9d, 0x3b, 0x5d, 0xd6, 0xf7, 0x39, 0x1b, 0x2c, 0xf9, 0xd9, 0xff, 0xde,
-	0x02, 0x58, 0xf3, 0x6b, 0x04, 0x93, 0xb0, 0xd3, 0x8c, 0xd0, 0x4f, 0x40, 0xbe, 0xdd, 0x70, 0xc2,
-	0xa4, 0x61, 0x3d, 0xbf, 0x41, 0x81, 0x0f, 0x0e, 0xa7, 0x8a, 0x14, 0x97, 0xfd, 0xc1, 0x1c, 0x51,
-	0x0f, 0x80, 0xcc, 0x1d, 0x1f, 0x00, 0x89, 0xda, 0x30, 0xec, 0x77, 0x22, 0xaa, 0x4e, 0x89, 0x5d,
-	0x2d, 0x03, 0xbf, 0xd2, 0x3a, 0x27, 0xc8, 0xaf, 0x7d, 0x8a, 0x3f, 0x58, 0xb2, 0xb1, 0xff, 0x70,
-	0x9c, 0xf7, 0x4e, 0x7c, 0xe2, 0x49, 0xc8, 0xb9, 0xf2, 0x54, 0x08, 0xa2, 0x99, 0xb9, 0xa5, 0x79,
-	0x9c, 0x73, 0x6b, 0x6a, 0x36, 0xe6, 0x7a, 0x6e, 0x5c, 0x9f, 0x80, 0x91, 0x9a, 0x1b, 0xb6, 0x9b,
-	0xce, 0xc1, 0x5a, 0xca, 0x91, 0x7c, 0x3e, 0x2e, 0xc2, 0x3a, 0x1e, 0x7a, 0x49, 0x04, 0xad, 0x0e,
-	0x1a, 0xc7, 0x30, 0x19, 0xb4, 0x5a, 0xa0, 0xcd, 0xd3, 0xe2, 0x55, 0x5f, 0x81, 0x51, 0xb9, 0x15,
-	0x33, 0x2e, 0xfc, 0x08, 0xa6, 0x82, 0x19, 0x37, 0xb5, 0x32, 0x6c, 0x60, 0x76, 0x29, 0x0e, 0x43,
-	0x67, 0xaf, 0x38, 0x7c, 0x0a, 0xc6, 0xe4, 0x5f, 0xb6, 0x9b, 0x97, 0x2e, 0xb2, 0xd6, 0x2b, 0x53,
-	0xd1, 0xa6, 0x5e, 0x88, 0x4d, 0xdc, 0x78, 0xea, 0x0d, 0xf7, 0x3b, 0xf5, 0x6e, 0x02, 0x6c, 0xf9,
-	0x1d, 0xaf, 0xe6, 0x04, 0x07, 0x4b, 0xf3, 0x22, 0x3c, 0x48, 0xe9, 0x29, 0x65, 0x55, 0x82, 0x35,
-	0x2c, 0x7d, 0xba, 0x16, 0x1f, 0x32, 0x5d, 0xdf, 0x80, 0x22, 0x0b, 0xa5, 0x22, 0xb5, 0xd9, 0x48,
-	0x38, 0xce, 0x4f, 0x12, 0x75, 0xa3, 0x94, 0x87, 0x8a, 0x24, 0x82, 0x63, 0x7a, 0xe8, 0x73, 0x00,
-	0xdb, 0xae, 0xe7, 0x86, 0x0d, 0x46, 0x7d, 0xe4, 0xc4, 0xd4, 0x55, 0x3f, 0x17, 0x14, 0x15, 0xac,
-	0x51, 0x44, 0x6f, 0xc2, 0x79, 0x12, 0x46, 0x6e, 0xcb, 0x89, 0x48, 0x4d, 0xc5, 0xf2, 0x97, 0x98,
-	0x1d, 0x41, 0x05, 0xb3, 0xdd, 0x4e, 0x22, 0x3c, 0x48, 0x03, 0xe2, 0x6e, 0x42, 0xe8, 0x15, 0x28,
-	0xb4, 0x03, 0xbf, 0x4e, 0x95, 0xbf, 0xd2, 0x24, 0x1b, 0xc6, 0xab, 0x52, 0xa1, 0xde, 0x10, 0xf0,
-	0x07, 0xda, 0x6f, 0xac, 0xb0, 0xd1, 0x0f, 0x2d, 0x38, 0x1f, 0x10, 0xee, 0x4d, 0x0d, 0x55, 0xc3,
-	0x2e, 0x31, 0xa9, 0x57, 0xcd, 0x22, 0xa9, 0x82, 0x5c, 0xec, 0xd3, 0x38, 0xc9, 0x85, 0x6f, 0xf7,
-	0x44, 0xf6, 0xbe, 0xab, 0xfc, 0x41, 0x1a, 0xf0, 0xcb, 0xdf, 0x9d, 0x9a, 0xea, 0xce, 0xf0, 0xa1,
-	0x88, 0xd3, 0x95, 0xf7, 0x57, 0xbe, 0x3b, 0x35, 0x21, 0xff, 0xc7, 0x83, 0xd6, 0xd5, 0x49, 0xba,
-	0x7b, 0xb5, 0xfd, 0xda, 0xd2, 0x86, 0x88, 0x70, 0x50, 0xbb, 0xd7, 0x06, 0x05, 0x62, 0x5e, 0x86,
-	0x5e, 0x84, 0x42, 0xcd, 0x21, 0x2d, 0xdf, 0x23, 0xb5, 0xd2, 0x58, 0xec, 0x42, 0x9a, 0x17, 0x30,
-	0xac, 0x4a, 0x51, 0x13, 0x86, 0x5c, 0x76, 0x36, 0x15, 0xe1, 0x4c, 0x19, 0x1c, 0x88, 0xf9, 0x59,
-	0x57, 0x06, 0x33, 0x31, 0x51, 0x2a, 0x78, 0xe8, 0xb2, 0x7b, 0xfc, 0x4c, 0x64, 0x37, 0x1d, 0x89,
-	0x6a, 0xc3, 0x6d, 0xd6, 0x02, 0xe2, 0x95, 0x26, 0xd8, 0x51, 0x8f, 0x8d, 0xc4, 0x9c, 0x80, 0x61,
-	0x55, 0x8a, 0xfe, 0x1c, 0x8c, 0xf9, 0x9d, 0x88, 0x2d, 0x72, 0xfa, 0xfd, 0xc3, 0xd2, 0x79, 0x86,
-	0xce, 0x9c, 0xd3, 0xeb, 0x7a, 0x01, 0x36, 0xf1, 0xa8, 0xb0, 0x6d, 0xf8, 0x61, 0x44, 0xff, 0x30,
-	0x61, 0x7b, 0xd9, 0x14, 0xb6, 0x8b, 0x5a, 0x19, 0x36, 0x30, 0xd1, 0xd7, 0x2c, 0x38, 0xdf, 0x4a,
-	0x1e, 0x40, 0x4a, 0x57, 0xd8, 0xc8, 0x54, 0xb2, 0x50, 0x54, 0x13, 0xa4, 0x79, 0x0c, 0x5f, 0x17,
-	0x18, 0x77, 0x37, 0x82, 0xdd, 0x47, 0x0c, 0x0f, 0xbc, 0x6a, 0x23, 0xf0, 0x3d, 0xb3, 0x79, 0x4f,
-	0xb3, 0xe6, 0xbd, 0x91, 0xd1, 0x2a, 0x4b, 0x63, 0x51, 0x7e, 0xfa, 0xe8, 0x70, 0xea, 0x52, 0x6a,
-	0x11, 0x4e, 0x6f, 0xd4, 0xe4, 0x3c, 0x5c, 0x4e, 0x5f, 0xa9, 0x0f, 0xd3, 0x98, 0x07, 0x74, 0x8d,
-	0x79, 0x01, 0x9e, 0xee, 0xd9, 0x28, 0x2a, 0xf3, 0xa5, 0x7a, 0x65, 0x99, 0x32, 0xbf, 0x4b, 0x1d,
-	0x3a, 0x07, 0xa3, 0x7a, 0x5e, 0x16, 0x16, 0x29, 0xa0, 0xdd, 0x85, 0x45, 0xef, 0x41, 0xd1, 0xaf,
-	0x64, 0xee, 0x72, 0x5f, 0xaf, 0x74, 0xb9, 0xdc, 0x15, 0x08, 0xc7, 0x0c, 0xfb, 0x89, 0x14, 0x48,
-	0xbd, 0xb8, 0xfb, 0x84, 0x9b, 0x7d, 0xe2, 0x48, 0x81, 0x7f, 0x37, 0x08, 0x31, 0x25, 0xf4, 0x12,
-	0x14, 0x88, 0x57, 0x6b, 0xfb, 0xae, 0x17, 0x25, 0xad, 0x37, 0xb7, 0x05, 0x1c, 0x2b, 0x0c, 0x2d,
-	0xae, 0x20, 0x77, 0x6c, 0x5c, 0x41, 0x0d, 0xc6, 0x1d, 0x66, 0xf6, 0x8e, 0xbd, 0xc2, 0x03, 0x27,
-	0x76, 0xe3, 0xcc, 0x9a, 0x14, 0x70, 0x92, 0x24, 0xe5, 0x12, 0xc6, 0x55, 0x19, 0x97, 0xc1, 0x13,
-	0x73, 0xa9, 0x98, 0x14, 0x70, 0x92, 0x24, 0x7a, 0x13, 0x4a, 0x55, 0x76, 0x79, 0x84, 0xf7, 0x71,
-	0x69, 0x7b, 0xcd, 0x8f, 0x36, 0x02, 0x12, 0x12, 0x8f, 0x7b, 0xed, 0x0b, 0xe5, 0xeb, 0x62, 0x14,
-	0x4a, 0x73, 0x3d, 0xf0, 0x70, 0x4f, 0x0a, 0x54, 0xab, 0x63, 0x3e, 0x69, 0x37, 0x3a, 0xd8, 0xf4,
-	0x77, 0x88, 0x74, 0x28, 0x28, 0xad, 0xae, 0xa2, 0x17, 0x62, 0x13, 0x17, 0xfd, 0xbc, 0x05, 0x63,
-	0x4d, 0x69, 0x8c, 0xc3, 0x9d, 0xa6, 0xcc, 0xfc, 0x82, 0x33, 0x99, 0x7e, 0x2b, 0x3a, 0x65, 0x2e,
-	0xf0, 0x0d, 0x10, 0x36, 0x79, 0xdb, 0xdf, 0xb6, 0x60, 0x22, 0x59, 0x0d, 0xed, 0xc0, 0xb3, 0x2d,
-	0x27, 0xd8, 0x59, 0xf2, 0xb6, 0x03, 0x16, 0x56, 0x19, 0xf1, 0xaf, 0x3a, 0xbb, 0x1d, 0x91, 0x60,
-	0xde, 0x39, 0xe0, 0xc1, 0x53, 0x79, 0x95, 0xac, 0xea, 0xd9, 0xd5, 0xe3, 0x90, 0xf1, 0xf1, 0xb4,
-	0x50, 0x05, 0x2e, 0x51, 0x84, 0x79, 0xd2, 0x24, 0x54, 0x42, 0xc5, 0x4c, 0x72, 0x8c, 0x89, 0x0a,
-	0x0f, 0x58, 0x4d, 0x43, 0xc2, 0xe9, 0x75, 0xed, 0x02, 0x0c, 0xf1, 0x90, 0x72, 0xfb, 0xdf, 0xe6,
-	0x40, 0xee, 0xa4, 0x7f, 0xba, 0x4d, 0xd6, 0xc8, 0x86, 0xa1, 0x80, 0x9d, 0x69, 0xc5, 0x41, 0x8d,
-	0x29, 0x35, 0xfc, 0x94, 0x8b, 0x45, 0x09, 0x55, 0x31, 0xc8, 0xbe, 0x1b, 0xcd, 0xf9, 0x35, 0x79,
-	0x3c, 0x63, 0x2a, 0xc6, 0x6d, 0x01, 0xc3, 0xaa, 0xd4, 0xfe, 0x19, 0x0b, 0xc6, 0x68, 0x2f, 0x9b,
-	0x4d, 0xd2, 0xac, 0x44, 0xa4, 0x1d, 0xa2, 0x10, 0xf2, 0x21, 0xfd, 0x91, 0x9d, 0xb1, 0x20, 0xbe,
-	0x49, 0x40, 0xda, 0x9a, 0x59, 0x94, 0x32, 0xc1, 0x9c, 0x97, 0xfd, 0x5b, 0x03, 0x50, 0x54, 0x83,
-	0xdd, 0x87, 0xad, 0xf5, 0x66, 0x7c, 0x77, 0x9f, 0x4b, 0xc3, 0x92, 0x76, 0x6f, 0x9f, 0x9e, 0xa9,
-	0x66, 0xbd, 0x03, 0x7e, 0x15, 0x38, 0xbe, 0xc4, 0xff, 0x92, 0xe9, 0x8e, 0xb9, 0xac, 0xdb, 0xf8,
-	0x35, 0x7c, 0xe1, 0x97, 0xd9, 0xd7, 0xbd, 0x61, 0x83, 0x59, 0xed, 0x2c, 0xca, 0xef, 0xd5, 0xdb,
-	0x0d, 0x96, 0x48, 0x40, 0x95, 0xef, 0x2b, 0x01, 0xd5, 0x0d, 0x18, 0x24, 0x5e, 0xa7, 0xc5, 0xc2,
-	0xca, 0x8b, 0x4c, 0xa7, 0x1a, 0xbc, 0xed, 0x75, 0x5a, 0x66, 0xcf, 0x18, 0x0a, 0xfa, 0x34, 0x8c,
-	0xd4, 0x48, 0x58, 0x0d, 0x5c, 0x76, 0x0f, 0x4f, 0x1c, 0x4a, 0xaf, 0xb2, 0x93, 0x7e, 0x0c, 0x36,
-	0x2b, 0xea, 0x15, 0xec, 0x77, 0x60, 0x68, 0xa3, 0xd9, 0xa9, 0xbb, 0x1e, 0x6a, 0xc3, 0x10, 0xbf,
-	0x95, 0x27, 0x76, 0xde, 0x0c, 0x14, 0x75, 0xbe, 0xda, 0xb5, 0x68, 0x6a, 0x7e, 0xa1, 0x44, 0xf0,
-	0xb1, 0xff, 0xb1, 0x05, 0xf4, 0x54, 0x71, 0x67, 0x0e, 0xfd, 0x05, 0x28, 0x84, 0x42, 0x97, 0x11,
-	0xd3, 0xe4, 0x47, 0x54, 0xd4, 0xa5, 0x80, 0x3f, 0x38, 0x9c, 0x1a, 0x63, 0xc8, 0x12, 0x80, 0x55,
-	0x15, 0xd4, 0x84, 0x31, 0x66, 0x0d, 0x95, 0xfb, 0x91, 0xb0, 0x5f, 0xdf, 0xea, 0xf3, 0x22, 0x9b,
-	0x5e, 0x55, 0x48, 0x67, 0x1d, 0x84, 0x4d, 0xe2, 0xf6, 0x3f, 0x19, 0x04, 0xcd, 0x68, 0xd8, 0xc7,
-	0xf4, 0x7e, 0x3b, 0x61, 0x22, 0x5e, 0xcd, 0xc4, 0x44, 0x2c, 0xed, 0xae, 0x5c, 0x64, 0x98, 0x56,
-	0x61, 0xda, 0xa8, 0x06, 0x69, 0xb6, 0xc5, 0xe2, 0x50, 0x8d, 0x5a, 0x24, 0xcd, 0x36, 0x66, 0x25,
-	0x2a, 0x24, 0x7f, 0xb0, 0x67, 0x48, 0x7e, 0x03, 0xf2, 0x75, 0xa7, 0x53, 0x27, 0x22, 0xd2, 0x22,
-	0x03, 0x6f, 0x00, 0x8b, 0x51, 0xe4, 0xde, 0x00, 0xf6, 0x13, 0x73, 0x06, 0x74, 0x75, 0x36, 0xa4,
-	0x9f, 0x55, 0x18, 0x84, 0x32, 0x58, 0x9d, 0xca, 0x75, 0xcb, 0x57, 0xa7, 0xfa, 0x8b, 0x63, 0x66,
-	0xf4, 0xbc, 0x58, 0xe5, 0xf7, 0x5f, 0xc5, 0x86, 0xbf, 0x94, 0xc5, 0x9d, 0x03, 0x46, 0x90, 0x9f,
-	0x17, 0xc5, 0x1f, 0x2c, 0xd9, 0xd8, 0x33, 0x30, 0xa2, 0xa5, 0x91, 0xa2, 0x9f, 0x41, 0x5d, 0xbd,
-	0xd4, 0x3e, 0xc3, 0xbc, 0x13, 0x39, 0x98, 0x95, 0xd8, 0x7f, 0x7b, 0x00, 0xd4, 0xb9, 0x5d, 0x8f,
-	0x90, 0x77, 0xaa, 0x5a, 0xfe, 0x05, 0xe3, 0x6a, 0x96, 0xef, 0x61, 0x51, 0x4a, 0x95, 0xa2, 0x16,
-	0x09, 0xea, 0xea, 0xa4, 0x20, 0xe4, 0xab, 0x52, 0x8a, 0x56, 0xf5, 0x42, 0x6c, 0xe2, 0x52, 0x8d,
-	0xb6, 0xe5, 0x78, 0xee, 0x36, 0x09, 0xa3, 0x64, 0xa0, 0xd3, 0xaa, 0x80, 0x63, 0x85, 0x81, 0xee,
-	0xc0, 0xf9, 0x90, 0x44, 0xeb, 0x7b, 0x1e, 0x09, 0xd4, 0x95, 0x31, 0x71, 0x87, 0x50, 0x05, 0xff,
-	0x55, 0x92, 0x08, 0xb8, 0xbb, 0x4e, 0x6a, 0x70, 0x48, 0xfe, 0xc4, 0xc1, 0x21, 0xf3, 0x30, 0xb1,
-	0xed, 0xb8, 0xcd, 0x4e, 0x40, 0x7a, 0x86, 0x98, 0x2c, 0x24, 0xca, 0x71, 0x57, 0x0d, 0x16, 0x7f,
-	0xda, 0x74, 0xea, 0x61, 0x69, 0x58, 0x8b, 0x3f, 0xa5, 0x00, 0xcc, 0xe1, 0xf6, 0x3f, 0xb0, 0x60,
-	0x0c, 0x93, 0x28, 0x38, 0x98, 0xdd, 0xde, 0x76, 0x3d, 0x37, 0x3a, 0x40, 0xbf, 0x6a, 0xc1, 0x84,
-	0xe7, 0xd7, 0xc8, 0xac, 0x17, 0xb9, 0x12, 0x98, 0x5d, 0x8e, 0x1d, 0xc6, 0x6b, 0x2d, 0x41, 0x9e,
-	0xdf, 0x04, 0x4c, 0x42, 0x71, 0x57, 0x33, 0xec, 0x2b, 0x70, 0x29, 0x95, 0x80, 0xfd, 0xed, 0x01,
-	0xd1, 0x0d, 0xf5, 0xf1, 0x5f, 0x83, 0x7c, 0x93, 0xdd, 0x8a, 0xb4, 0x1e, 0x31, 0x69, 0x07, 0x1b,
-	0x2b, 0x7e, 0x6d, 0x92, 0x53, 0x42, 0xf3, 0x30, 0x12, 0x50, 0x1e, 0xe2, 0xce, 0x2a, 0x9f, 0x8a,
-	0x76, 0x9c, 0x84, 0x50, 0x15, 0x3d, 0x30, 0xff, 0x62, 0xbd, 0x1a, 0x7a, 0x17, 0x86, 0xb7, 0x78,
-	0x1e, 0x92, 0xec, 0xcc, 0xf3, 0x22, 0xb1, 0x09, 0xd3, 0x22, 0x64, 0x96, 0x93, 0x07, 0xf1, 0x4f,
-	0x2c, 0x39, 0xa2, 0x03, 0x28, 0x38, 0xf2, 0x9b, 0x0e, 0x66, 0x15, 0xb1, 0x68, 0xcc, 0x1f, 0xae,
-	0xdb, 0xa9, 0x6f, 0xa8, 0xd8, 0x25, 0xdc, 0xdd, 0xf9, 0xbe, 0xdc, 0xdd, 0xdf, 0xb0, 0x00, 0xe2,
-	0x0c, 0x65, 0x68, 0x1f, 0x0a, 0xe1, 0x2d, 0xe3, 0x78, 0x9d, 0xc5, 0x25, 0x30, 0x41, 0x51, 0xbb,
-	0x28, 0x21, 0x20, 0x58, 0x71, 0x7b, 0x98, 0x49, 0xe0, 0x8f, 0x2d, 0xb8, 0x98, 0x96, 0x49, 0xed,
-	0x09, 0xb6, 0xf8, 0xa4, 0xd6, 0x00, 0x51, 0x61, 0x23, 0x20, 0xdb, 0xee, 0x7e, 0xd2, 0x31, 0xbf,
-	0x2c, 0x0b, 0x70, 0x8c, 0x63, 0x7f, 0x73, 0x08, 0x14, 0xe3, 0x53, 0xb2, 0x1e, 0xbc, 0x40, 0x4f,
-	0x17, 0xf5, 0x38, 0x3f, 0x8e, 0xc2, 0xc3, 0x0c, 0x8a, 0x45, 0x29, 0x3d, 0x61, 0xc8, 0x88, 0x6e,
-	0x21, 0xb2, 0xd9, 0x2c, 0x94, 0xc1, 0xdf, 0x58, 0x95, 0xa6, 0xd9, 0x23, 0xf2, 0x67, 0x62, 0x8f,
-	0x18, 0xca, 0xde, 0x1e, 0x71, 0x03, 0x86, 0x03, 0xbf, 0x49, 0x66, 0xf1, 0x9a, 0xd0, 0x9b, 0xe3,
-	0xbc, 0x4e, 0x1c, 0x8c, 0x65, 0x39, 0xfa, 0x04, 0x8c, 0x74, 0x42, 0x52, 0x99, 0x5f, 0x9e, 0x0b,
-	0x48, 0x2d, 0x14, 0x41, 0xf2, 0xca, 0xa9, 0x76, 0x37, 0x2e, 0xc2, 0x3a, 0x1e, 0xfa, 0xa6, 0x75,
-	0x8c, 0xc9, 0xa3, 0x98, 0xd5, 0x9e, 0x90, 0x9a, 0x91, 0x83, 0x1d, 0x02, 0x1e, 0xc5, 0x8e, 0xf2,
-	0x75, 0x0b, 0xce, 0x13, 0xaf, 0x1a, 0x1c, 0x30, 0x3a, 0x82, 0x9a, 0x70, 0x2c, 0xdd, 0xcd, 0x62,
-	0xf1, 0xdd, 0x4e, 0x12, 0xe7, 0x56, 0xe3, 0x2e, 0x30, 0xee, 0x6e, 0x86, 0xfd, 0x87, 0x39, 0xb8,
-	0x90, 0x42, 0x81, 0x05, 0x14, 0xb7, 0xe8, 0x04, 0x5a, 0xaa, 0x25, 0x97, 0xcf, 0xb2, 0x80, 0x63,
-	0x85, 0x81, 0x36, 0xe0, 0xe2, 0x4e, 0x2b, 0x8c, 0xa9, 0xcc, 0xf9, 0x5e, 0x44, 0xf6, 0xe5, 0x62,
-	0x92, 0x3e, 0xa2, 0x8b, 0xcb, 0x29, 0x38, 0x38, 0xb5, 0x26, 0xd5, 0x36, 0x88, 0xe7, 0x6c, 0x35,
-	0x49, 0x5c, 0x24, 0xc2, 0xe1, 0x95, 0xb6, 0x71, 0x3b, 0x51, 0x8e, 0xbb, 0x6a, 0xa0, 0x0f, 0x2c,
-	0x78, 0x26, 0x24, 0xc1, 0x2e, 0x09, 0x2a, 0x6e, 0x8d, 0xcc, 0x75, 0xc2, 0xc8, 0x6f, 0x91, 0xe0,
-	0x11, 0x6d, 0x72, 0x53, 0x47, 0x87, 0x53, 0xcf, 0x54, 0x7a, 0x53, 0xc3, 0xc7, 0xb1, 0xb2, 0x3f,
-	0xb0, 0xe0, 0x5c, 0x85, 0x9d, 0x12, 0x95, 0xce, 0x99, 0x75, 0x1a, 0xa0, 0x17, 0xd4, 0xd5, 0xc8,
-	0x84, 0x10, 0x33, 0x2f, 0x33, 0xda, 0x6f, 0xc1, 0x44, 0x85, 0xb4, 0x9c, 0x76, 0x83, 0xdd, 0x34,
-	0xe1, 0xa1, 0x08, 0x33, 0x50, 0x0c, 0x25, 0x2c, 0x99, 0x47, 0x51, 0x21, 0xe3, 0x18, 0x07, 0x3d,
-	0xcf, 0xc3, 0x26, 0x64, 0x64, 0x6f, 0x91, 0x6b, 0xe7, 0x3c, 0xd6, 0x22, 0xc4, 0xb2, 0xcc, 0xde,
-	0x83, 0xd1, 0xb8, 0x3a, 0xd9, 0x46, 0x75, 0x18, 0xaf, 0x6a, 0xc1, 0xe4, 0x71, 0xcc, 0x6a, 0xff,
-	0x71, 0xe7, 0x4c, 0x16, 0xcd, 0x99, 0x44, 0x70, 0x92, 0xaa, 0xfd, 0x8b, 0x39, 0x18, 0x57, 0x9c,
-	0x85, 0x43, 0xe0, 0xfd, 0x64, 0xa8, 0x07, 0xce, 0xe2, 0xca, 0xb6, 0x39, 0x92, 0xc7, 0x84, 0x7b,
-	0xbc, 0x9f, 0x0c, 0xf7, 0x38, 0x55, 0xf6, 0x5d, 0x3e, 0x8e, 0x6f, 0xe4, 0xa0, 0xa0, 0x2e, 0x90,
-	0xbf, 0x06, 0x79, 0x76, 0x80, 0x7a, 0x3c, 0x6d, 0x94, 0x1d, 0xc6, 0x30, 0xa7, 0x44, 0x49, 0x32,
-	0x3f, 0xf7, 0x23, 0x67, 0xa5, 0x2b, 0x72, 0xbb, 0x97, 0x13, 0x44, 0x98, 0x53, 0x42, 0xcb, 0x30,
-	0x40, 0xbc, 0x9a, 0x50, 0x4b, 0x4f, 0x4e, 0x90, 0xe5, 0x10, 0xbd, 0xed, 0xd5, 0x30, 0xa5, 0xc2,
-	0x52, 0x38, 0x71, 0xed, 0x63, 0xd0, 0x5c, 0x1e, 0x42, 0xf5, 0x10, 0xa5, 0xf6, 0xcf, 0x0f, 0xc0,
-	0x50, 0xa5, 0xb3, 0x45, 0x15, 0xec, 0xdf, 0xb0, 0xe0, 0xc2, 0x5e, 0x22, 0x63, 0x59, 0x3c, 0x65,
-	0xef, 0x66, 0x9f, 0x0e, 0x0e, 0x93, 0xed, 0xf2, 0x33, 0xa2, 0x5d, 0x17, 0x52, 0x0a, 0x71, 0x5a,
-	0x73, 0x8c, 0xec, 0x4e, 0x03, 0xa7, 0x94, 0x07, 0xef, 0x74, 0x63, 0x63, 0xc7, 0x7a, 0xc5, 0xc5,
-	0xda, 0x3f, 0xcc, 0x03, 0xf0, 0xaf, 0xb1, 0xde, 0x8e, 0xfa, 0x31, 0x0e, 0xbd, 0x02, 0xa3, 0xf2,
-	0x21, 0x8a, 0xb5, 0x38, 0xb0, 0x47, 0x39, 0x77, 0xef, 0x68, 0x65, 0xd8, 0xc0, 0x64, 0x07, 0x02,
-	0x2f, 0x0a, 0x0e, 0xb8, 0xd2, 0x98, 0x8c, 0x7f, 0x55, 0x25, 0x58, 0xc3, 0x42, 0xd3, 0x86, 0xb1,
-	0x9d, 0x67, 0xba, 0x38, 0x77, 0x8c, 0x6d, 0xfc, 0x53, 0x30, 0xa6, 0xfe, 0x2d, 0xb8, 0x4d, 0x92,
-	0x74, 0xaa, 0x6c, 0xe8, 0x85, 0xd8, 0xc4, 0x45, 0x9f, 0x86, 0x73, 0xe6, 0x85, 0x55, 0xa1, 0x66,
-	0xa9, 0xeb, 0xe2, 0xe6, 0x3d, 0x57, 0x9c, 0xc0, 0xa6, 0x2b, 0xa0, 0x16, 0x1c, 0xe0, 0x8e, 0x27,
-	0xf4, 0x2d, 0xb5, 0x02, 0xe6, 0x19, 0x14, 0x8b, 0x52, 0x3a, 0x84, 0x7c, 0x2b, 0xe3, 0x70, 0x71,
-	0xe3, 0x50, 0x0d, 0x61, 0x45, 0x2b, 0xc3, 0x06, 0x26, 0xe5, 0x20, 0x2c, 0x73, 0x60, 0xae, 0xb1,
-	0x84, 0x39, 0xad, 0x0d, 0xe7, 0x7c, 0xd3, 0xb0, 0xc1, 0x43, 0x61, 0x3e, 0xde, 0xe7, 0xbc, 0x35,
-	0xea, 0xf2, 0x1b, 0x32, 0x09, 0x3b, 0x48, 0x82, 0x3e, 0x55, 0x38, 0xf5, 0x50, 0xd7, 0x51, 0x33,
-	0x8a, 0xab, 0x67, 0x34, 0xea, 0x06, 0x5c, 0x6c, 0xfb, 0xb5, 0x8d, 0xc0, 0xf5, 0x03, 0x37, 0x3a,
-	0x98, 0x6b, 0x3a, 0x61, 0xc8, 0x66, 0xd5, 0x98, 0xa9, 0xd9, 0x6c, 0xa4, 0xe0, 0xe0, 0xd4, 0x9a,
-	0xf4, 0x68, 0xd0, 0x16, 0x40, 0x16, 0xc1, 0x91, 0xe7, 0x47, 0x03, 0x89, 0x88, 0x55, 0xa9, 0x7d,
-	0x01, 0xce, 0x57, 0x3a, 0xed, 0x76, 0xd3, 0x25, 0x35, 0x65, 0x09, 0xb7, 0x7f, 0x12, 0xc6, 0x45,
-	0xe2, 0x28, 0xa5, 0x47, 0x9c, 0x28, 0x7b, 0xa8, 0xfd, 0x43, 0x0b, 0xc6, 0x13, 0xfe, 0x72, 0xf4,
-	0x6e, 0x72, 0xf7, 0xcf, 0xc4, 0xb1, 0xa1, 0x6f, 0xfc, 0x7c, 0x85, 0xa7, 0x6a, 0x12, 0x0d, 0x19,
-	0xdd, 0x99, 0x59, 0x90, 0x34, 0x8b, 0x81, 0xe4, 0xdb, 0x89, 0x1e, 0x22, 0x6a, 0x7f, 0x25, 0x07,
-	0xe9, 0x41, 0x0a, 0xe8, 0x0b, 0xdd, 0x03, 0xf0, 0x5a, 0x86, 0x03, 0x20, 0xa2, 0x24, 0x7a, 0x8f,
-	0x81, 0x67, 0x8e, 0xc1, 0x6a, 0x46, 0x63, 0x20, 0xf8, 0x76, 0x8f, 0xc4, 0x9f, 0x58, 0x30, 0xb2,
-	0xb9, 0xb9, 0xa2, 0x8c, 0x53, 0x18, 0x2e, 0x87, 0xfc, 0x2a, 0x19, 0xf3, 0x2e, 0xce, 0xf9, 0xad,
-	0x36, 0x77, 0x36, 0x0a, 0x27, 0x28, 0xcb, 0xe1, 0x55, 0x49, 0xc5, 0xc0, 0x3d, 0x6a, 0xa2, 0x25,
-	0xb8, 0xa0, 0x97, 0x08, 0x13, 0xa3, 0x70, 0x78, 0xf2, 0xcb, 0xd5, 0xdd, 0xc5, 0x38, 0xad, 0x4e,
-	0x92, 0x94, 0xb0, 0x33, 0x8a, 0xe7, 0x55, 0xba, 0x48, 0x89, 0x62, 0x9c, 0x56, 0xc7, 0x5e, 0x87,
-	0x11, 0xed, 0xb1, 0x1f, 0xf4, 0x19, 0x98, 0xa8, 0xfa, 0x2d, 0x69, 0xdf, 0x59, 0x21, 0xbb, 0xa4,
-	0x29, 0xba, 0xcc, 0x4c, 0x80, 0x73, 0x89, 0x32, 0xdc, 0x85, 0x6d, 0xff, 0xcf, 0x6b, 0xa0, 0x6e,
-	0x93, 0xf4, 0xb1, 0x3d, 0xb5, 0x55, 0xf8, 0x56, 0x3e, 0xe3, 0xf0, 0x2d, 0x25, 0x6b, 0x13, 0x21,
-	0x5c, 0x51, 0x1c, 0xc2, 0x35, 0x94, 0x75, 0x08, 0x97, 0xd2, 0x36, 0xbb, 0xc2, 0xb8, 0x7e, 0xd9,
-	0x82, 0x51, 0xcf, 0xaf, 0x11, 0xe5, 0x42, 0x1a, 0x66, 0x2a, 0xef, 0x9b, 0xd9, 0xc5, 0xa5, 0xf2,
-	0x70, 0x24, 0x41, 0x9e, 0x07, 0xf9, 0xa9, 0x2d, 0x4a, 0x2f, 0xc2, 0x46, 0x3b, 0xd0, 0x82, 0x66,
-	0x71, 0xe4, 0x89, 0x9b, 0xae, 0xa6, 0x1d, 0x3d, 0x1e, 0x6a, 0x3e, 0xdc, 0xd7, 0x94, 0xae, 0x62,
-	0x56, 0x96, 0x34, 0x79, 0x53, 0x41, 0x73, 0x0c, 0xc8, 0x34, 0x74, 0xb1, 0x32, 0x66, 0xc3, 0x10,
-	0x8f, 0x06, 0x14, 0xcf, 0xce, 0x30, 0x7f, 0x15, 0x8f, 0x14, 0xc4, 0xa2, 0x04, 0x45, 0xd2, 0x4d,
-	0x3d, 0x92, 0x55, 0xf6, 0x56, 0xc3, 0x0d, 0x9e, 0xee, 0xa7, 0x46, 0xaf, 0xea, 0x27, 0xda, 0xd1,
-	0x7e, 0x4e, 0xb4, 0x63, 0x3d, 0x4f, 0xb3, 0x5f, 0xb5, 0x60, 0xb4, 0xaa, 0xa5, 0xa7, 0x2d, 0xbd,
-	0x98, 0x55, 0x0e, 0xe6, 0xb4, 0xa4, 0xb7, 0xfc, 0x32, 0xa4, 0x5e, 0x82, 0x0d, 0xee, 0x2c, 0xef,
-	0x10, 0x3b, 0xbe, 0xb3, 0xad, 0x7f, 0xe4, 0xe6, 0x46, 0x06, 0xdb, 0x83, 0x61, 0x0e, 0xe0, 0x9f,
-	0x91, 0xc3, 0xb0, 0xe0, 0x85, 0xde, 0x83, 0x82, 0x0c, 0x28, 0x15, 0xe1, 0x9e, 0x38, 0x0b, 0xf3,
-	0xb8, 0xe9, 0xfc, 0x92, 0xd9, 0x4a, 0x38, 0x14, 0x2b, 0x8e, 0xa8, 0x01, 0x03, 0x35, 0xa7, 0x2e,
-	0x02, 0x3f, 0x57, 0xb3, 0x49, 0x06, 0x25, 0x79, 0xb2, 0xb3, 0xd9, 0xfc, 0xec, 0x1d, 0x4c, 0x59,
-	0xa0, 0xfd, 0x38, 0x4b, 0xe6, 0x44, 0x66, 0xbb, 0xaf, 0xa9, 0x26, 0x71, 0x03, 0x45, 0x57, 0xd2,
-	0xcd, 0x9a, 0xf0, 0x17, 0xfe, 0x19, 0xc6, 0x76, 0x21, 0x9b, 0x6c, 0x52, 0xfc, 0x9d, 0x8e, 0xd8,
-	0xe7, 0x48, 0xb9, 0xb0, 0xf7, 0x89, 0x7e, 0x2c, 0x2b, 0x2e, 0x8b, 0x9b, 0x9b, 0x1b, 0x5d, 0xef,
-	0x12, 0x35, 0x61, 0xa8, 0xcd, 0x62, 0x0f, 0x4a, 0x3f, 0x9e, 0xd5, 0xde, 0xc2, 0x63, 0x19, 0xf8,
-	0xdc, 0xe4, 0xbf, 0xb1, 0xe0, 0x81, 0x6e, 0xc3, 0x30, 0xcf, 0xaa, 0xcc, 0x03, 0x6f, 0x47, 0x6e,
-	0x4e, 0xf6, 0xce, 0xcd, 0x1c, 0x6f, 0x14, 0xfc, 0x7f, 0x88, 0x65, 0x5d, 0xf4, 0x8b, 0x16, 0x9c,
-	0xa3, 0x12, 0x35, 0x4e, 0x03, 0x5d, 0x42, 0x59, 0xc9, 0xac, 0xbb, 0x21, 0xd5, 0x48, 0xa4, 0xac,
-	0x51, 0xc7, 0xa4, 0x25, 0x83, 0x1d, 0x4e, 0xb0, 0x47, 0xef, 0x43, 0x21, 0x74, 0x6b, 0xa4, 0xea,
-	0x04, 0x61, 0xe9, 0xc2, 0xe9, 0x34, 0x25, 0x76, 0x94, 0x08, 0x46, 0x58, 0xb1, 0x44, 0x7f, 0x9d,
-	0x3d, 0xda, 0x20, 0x1e, 0xd8, 0x11, 0x6f, 0xbf, 0x5d, 0x3c, 0xb5, 0xb7, 0xdf, 0xb8, 0xff, 0xc0,
-	0x64, 0x87, 0x93, 0xfc, 0xd1, 0x5f, 0xb6, 0xe0, 0x12, 0x4f, 0x4e, 0x9a, 0xcc, 0x4c, 0x7b, 0xe9,
-	0x11, 0x6d, 0x33, 0x2c, 0x62, 0x78, 0x36, 0x8d, 0x24, 0x4e, 0xe7, 0xc4, 0xb2, 0x9b, 0x05, 0xba,
-	0x4b, 0x95, 0xc5, 0x6d, 0x67, 0xe7, 0x30, 0x54, 0x4f, 0xc9, 0xb1, 0x88, 0x15, 0x03, 0x84, 0x4d,
-	0xc6, 0xe8, 0x65, 0x18, 0x69, 0x8b, 0xed, 0xd0, 0x0d, 0x5b, 0x2c, 0xfe, 0x7b, 0x80, 0xdf, 0x91,
-	0xd9, 0x88, 0xc1, 0x58, 0xc7, 0x31, 0x52, 0xdd, 0xdd, 0x38, 0x2e, 0xd5, 0x1d, 0xba, 0x0b, 0x23,
-	0x91, 0xdf, 0x24, 0x81, 0x38, 0xa9, 0x96, 0xd8, 0x0c, 0xbc, 0x96, 0xb6, 0xb6, 0x36, 0x15, 0x5a,
-	0x7c, 0x92, 0x8d, 0x61, 0x21, 0xd6, 0xe9, 0xb0, 0x70, 0x4e, 0x91, 0xf4, 0x35, 0x60, 0x47, 0xd8,
-	0xa7, 0x13, 0xe1, 0x9c, 0x7a, 0x21, 0x36, 0x71, 0xd1, 0x1d, 0x38, 0xdf, 0xee, 0x3a, 0x03, 0xf3,
-	0x1b, 0x20, 0x2a, 0x16, 0xa1, 0xfb, 0x00, 0xdc, 0x5d, 0xc7, 0x38, 0xfd, 0x3e, 0x73, 0xdc, 0xe9,
-	0xb7, 0x47, 0xe2, 0xb7, 0xab, 0x8f, 0x92, 0xf8, 0x0d, 0xd5, 0xe0, 0xaa, 0xd3, 0x89, 0x7c, 0x76,
-	0xef, 0xdf, 0xac, 0xc2, 0x23, 0x5b, 0xaf, 0xf3, 0x60, 0xd9, 0xa3, 0xc3, 0xa9, 0xab, 0xb3, 0xc7,
-	0xe0, 0xe1, 0x63, 0xa9, 0xa0, 0x77, 0xa0, 0x40, 0x44, 0xf2, 0xba, 0xd2, 0x8f, 0x64, 0xa5, 0x24,
-	0x98, 0xe9, 0xf0, 0x64, 0xa0, 0x22, 0x87, 0x61, 0xc5, 0x0f, 0x6d, 0xc2, 0x48, 0xc3, 0x0f, 0xa3,
-	0xd9, 0xa6, 0xeb, 0x84, 0x24, 0x2c, 0x3d, 0xcb, 0x26, 0x4d, 0xaa, 0xee, 0xb5, 0x28, 0xd1, 0xe2,
-	0x39, 0xb3, 0x18, 0xd7, 0xc4, 0x3a, 0x19, 0x44, 0x98, 0xdb, 0x90, 0x85, 0xf5, 0x4a, 0x97, 0xce,
-	0x35, 0xd6, 0xb1, 0x17, 0xd2, 0x28, 0x6f, 0xf8, 0xb5, 0x8a, 0x89, 0xad, 0xfc, 0x86, 0x3a, 0x10,
-	0x27, 0x69, 0xa2, 0x57, 0x60, 0xb4, 0xed, 0xd7, 0x2a, 0x6d, 0x52, 0xdd, 0x70, 0xa2, 0x6a, 0xa3,
-	0x34, 0x65, 0x9a, 0xec, 0x36, 0xb4, 0x32, 0x6c, 0x60, 0xa2, 0x36, 0x0c, 0xb7, 0xf8, 0xed, 0xd6,
-	0xd2, 0x73, 0x59, 0x9d, 0x6d, 0xc4, 0x75, 0x59, 0xae, 0x2f, 0x88, 0x3f, 0x58, 0xb2, 0x41, 0x7f,
-	0xcf, 0x82, 0xf1, 0xc4, 0x8d, 0x86, 0xd2, 0x8f, 0x66, 0xa6, 0xb2, 0x98, 0x84, 0xcb, 0x2f, 0xb0,
-	0xe1, 0x33, 0x81, 0x0f, 0xba, 0x41, 0x38, 0xd9, 0x22, 0x3e, 0x2e, 0xec, 0x8a, 0x7a, 0xe9, 0xf9,
-	0xec, 0xc6, 0x85, 0x11, 0x94, 0xe3, 0xc2, 0xfe, 0x60, 0xc9, 0x06, 0xdd, 0x80, 0x61, 0x91, 0x93,
-	0xa6, 0xf4, 0x82, 0xe9, 0xfb, 0x15, 0xa9, 0x6b, 0xb0, 0x2c, 0x9f, 0xfc, 0x49, 0x38, 0xdf, 0x75,
-	0x74, 0x3b, 0xd1, 0x3d, 0xe9, 0x5f, 0xb1, 0x40, 0xbf, 0x8c, 0x98, 0x79, 0xc6, 0xe8, 0x57, 0x60,
-	0xb4, 0xca, 0x9f, 0x2b, 0xe1, 0xd7, 0x19, 0x07, 0x4d, 0xfb, 0xe7, 0x9c, 0x56, 0x86, 0x0d, 0x4c,
-	0x7b, 0x11, 0x50, 0x77, 0x3a, 0xcf, 0x44, 0xa4, 0x89, 0xd5, 0x57, 0xa4, 0xc9, 0x6f, 0x5a, 0x30,
-	0x66, 0xe8, 0x0c, 0x99, 0xbb, 0x0b, 0x17, 0x00, 0xb5, 0xdc, 0x20, 0xf0, 0x03, 0xfd, 0xa5, 0x0c,
-	0x91, 0xbf, 0x90, 0xe5, 0x76, 0x5a, 0xed, 0x2a, 0xc5, 0x29, 0x35, 0xec, 0x7f, 0x34, 0x08, 0x71,
-	0xa4, 0xae, 0xca, 0xea, 0x66, 0xf5, 0xcc, 0xea, 0xf6, 0x12, 0x14, 0xde, 0x0a, 0x7d, 0x6f, 0x23,
-	0xce, 0xfd, 0xa6, 0xbe, 0xc5, 0xab, 0x95, 0xf5, 0x35, 0x86, 0xa9, 0x30, 0x18, 0xf6, 0xdb, 0x0b,
-	0x6e, 0x33, 0xea, 0x4e, 0x0e, 0xf6, 0xea, 0x6b, 0x1c, 0x8e, 0x15, 0x06, 0x7b, 0xcb, 0x63, 0x97,
-	0x28, 0xc3, 0x78, 0xfc, 0x96, 0x07, 0xcf, 0xd4, 0xcb, 0xca, 0xd0, 0x0c, 0x14, 0x95, 0x5d, 0x5d,
-	0x98, 0xf9, 0xd5, 0x48, 0x29, 0xfb, 0x3b, 0x8e, 0x71, 0x98, 0x42, 0x28, 0x0c, 0xb1, 0xc2, 0x84,
-	0x52, 0xc9, 0xe2, 0x78, 0x92, 0x30, 0xed, 0x72, 0xd9, 0x2e, 0xc1, 0x58, 0xb1, 0x4c, 0xf3, 0x99,
-	0x16, 0x4f, 0xc3, 0x67, 0xaa, 0x87, 0x8d, 0xe7, 0xfb, 0x0d, 0x1b, 0x37, 0xe7, 0x76, 0xa1, 0xaf,
-	0xb9, 0xfd, 0xb3, 0x03, 0x30, 0x7c, 0x8f, 0x04, 0x2c, 0x63, 0xe5, 0x0d, 0x18, 0xde, 0xe5, 0x3f,
-	0x93, 0x97, 0xb4, 0x04, 0x06, 0x96, 0xe5, 0xf4, 0xbb, 0x6d, 0x75, 0xdc, 0x66, 0x6d, 0x3e, 0x5e,
-	0xc5, 0xea, 0xbb, 0x95, 0x65, 0x01, 0x8e, 0x71, 0x68, 0x85, 0x3a, 0xd5, 0xec, 0x5b, 0x2d, 0x37,
-	0x4a, 0x46, 0x10, 0xdd, 0x91, 0x05, 0x38, 0xc6, 0x41, 0x2f, 0xc0, 0x50, 0xdd, 0x8d, 0x36, 0x9d,
-	0x7a, 0xd2, 0x45, 0x78, 0x87, 0x41, 0xb1, 0x28, 0x65, 0x3e, 0x26, 0x37, 0xda, 0x0c, 0x08, 0xb3,
-	0xec, 0x76, 0xdd, 0xd6, 0xbe, 0xa3, 0x95, 0x61, 0x03, 0x93, 0x35, 0xc9, 0x17, 0x3d, 0x13, 0xbe,
-	0x9f, 0xb8, 0x49, 0xb2, 0x00, 0xc7, 0x38, 0x74, 0xfe, 0x57, 0xfd, 0x56, 0xdb, 0x6d, 0x8a, 0x88,
-	0x5a, 0x6d, 0xfe, 0xcf, 0x09, 0x38, 0x56, 0x18, 0x14, 0x9b, 0x8a, 0x30, 0x2a, 0x7e, 0x92, 0xef,
-	0x26, 0x6c, 0x08, 0x38, 0x56, 0x18, 0xf6, 0x3d, 0x18, 0xe3, 0x2b, 0x79, 0xae, 0xe9, 0xb8, 0xad,
-	0x3b, 0x73, 0xe8, 0x76, 0x57, 0xd8, 0xf8, 0x8d, 0x94, 0xb0, 0xf1, 0x4b, 0x46, 0xa5, 0xee, 0xf0,
-	0x71, 0xfb, 0x3b, 0x39, 0x28, 0x9c, 0xe1, 0xd3, 0x33, 0x6d, 0xe3, 0xe9, 0x99, 0xac, 0x1f, 0x20,
-	0x49, 0x7b, 0x76, 0x66, 0x3f, 0xf1, 0xec, 0xcc, 0x46, 0x96, 0xb7, 0x40, 0x8e, 0x7d, 0x72, 0xe6,
-	0x07, 0x16, 0x5c, 0x94, 0xa8, 0x4c, 0xa8, 0x95, 0x5d, 0x8f, 0x05, 0x17, 0x9c, 0xfe, 0x30, 0xbf,
-	0x67, 0x0c, 0xf3, 0xeb, 0xd9, 0x75, 0x59, 0xef, 0x47, 0xcf, 0xf7, 0xd0, 0xbe, 0x6f, 0x41, 0x29,
-	0xad, 0xc2, 0x19, 0xbc, 0xb9, 0xf3, 0xae, 0xf9, 0xe6, 0xce, 0xbd, 0xd3, 0xe9, 0x79, 0x8f, 0xb7,
-	0x77, 0x7e, 0xd0, 0xa3, 0xdf, 0xec, 0xa1, 0x9b, 0xa6, 0xdc, 0xee, 0xac, 0xac, 0x5c, 0x67, 0x9c,
-	0x45, 0xfa, 0xbe, 0xd9, 0x84, 0xa1, 0x90, 0x79, 0xe2, 0xc5, 0x14, 0x58, 0xcc, 0x62, 0x13, 0xa4,
-	0xf4, 0x84, 0xe9, 0x93, 0xfd, 0xc6, 0x82, 0x87, 0xfd, 0x1f, 0x2d, 0x18, 0x3d, 0xc3, 0x87, 0x95,
-	0x7c, 0xf3, 0x23, 0xbf, 0x9a, 0xdd, 0x47, 0xee, 0xf1, 0x61, 0xff, 0xd5, 0x75, 0x30, 0xde, 0x30,
-	0x42, 0xef, 0x42, 0x51, 0x6a, 0xa0, 0xf2, 0x76, 0x59, 0x96, 0x4f, 0x95, 0xa8, 0x6d, 0x46, 0x42,
-	0x42, 0x1c, 0xf3, 0x4b, 0xc4, 0x3e, 0xe4, 0xfa, 0x8a, 0x7d, 0x78, 0xb2, 0x0f, 0x9d, 0xa4, 0xdb,
-	0x07, 0x06, 0x4f, 0xc5, 0x3e, 0x70, 0x35, 0x73, 0xfb, 0xc0, 0xb3, 0x67, 0x6c, 0x1f, 0xd0, 0x8c,
-	0xb5, 0xf9, 0xc7, 0x30, 0xd6, 0xbe, 0x0b, 0x17, 0x77, 0xe3, 0xcd, 0x5f, 0xcd, 0x24, 0xf1, 0x5e,
-	0xcb, 0x8d, 0x54, 0xab, 0x00, 0x55, 0x64, 0xc2, 0x88, 0x78, 0x91, 0xa6, 0x36, 0xc4, 0x91, 0x13,
-	0xf7, 0x52, 0xc8, 0xe1, 0x54, 0x26, 0x49, 0xab, 0xdb, 0x70, 0x1f, 0x56, 0xb7, 0xdf, 0xea, 0xf9,
-	0x48, 0x73, 0xe1, 0x74, 0x1f, 0x69, 0x7e, 0xfa, 0xc4, 0x0f, 0x34, 0x3f, 0x1f, 0xbb, 0x40, 0x78,
-	0xbc, 0x4d, 0xba, 0xbf, 0xe2, 0xeb, 0x49, 0xbf, 0x2a, 0xb0, 0xa1, 0xff, 0x7c, 0xb6, 0x5a, 0x4f,
-	0x06, 0xbe, 0xd5, 0x91, 0xc7, 0xf0, 0xad, 0x26, 0x4c, 0xa0, 0xa3, 0x19, 0x99, 0x40, 0x3d, 0x98,
-	0x70, 0x5b, 0x4e, 0x9d, 0x6c, 0x74, 0x9a, 0x4d, 0x1e, 0x96, 0x2b, 0x1f, 0x93, 0x49, 0x3d, 0x49,
-	0xad, 0xf8, 0x55, 0xa7, 0x99, 0x7c, 0xb3, 0x4b, 0x85, 0x1f, 0x2f, 0x25, 0x28, 0xe1, 0x2e, 0xda,
-	0x74, 0xc2, 0xb2, 0xec, 0x21, 0x24, 0xa2, 0xa3, 0xcd, 0x1c, 0x78, 0xe2, 0x35, 0xfd, 0xc5, 0x18,
-	0x8c, 0x75, 0x1c, 0xb4, 0x0c, 0xc5, 0x9a, 0x17, 0x8a, 0x0b, 0x3d, 0xe3, 0x4c, 0x98, 0x7d, 0x8c,
-	0x8a, 0xc0, 0xf9, 0xb5, 0x8a, 0xba, 0xca, 0x73, 0x35, 0x25, 0x31, 0x8d, 0x2a, 0xc7, 0x71, 0x7d,
-	0xb4, 0xca, 0x88, 0x89, 0x7c, 0xe0, 0xdc, 0xaf, 0x76, 0xbd, 0x87, 0xe1, 0x6e, 0x7e, 0x4d, 0x66,
-	0x34, 0x1f, 0x13, 0xec, 0x44, 0x62, 0xef, 0x98, 0x82, 0xf6, 0xa8, 0xcf, 0xf9, 0x63, 0x1f, 0xf5,
-	0x61, 0x19, 0xa9, 0xa2, 0xa6, 0x32, 0xd3, 0x5f, 0xcb, 0x2c, 0x23, 0x55, 0x1c, 0xb1, 0x22, 0x32,
-	0x52, 0xc5, 0x00, 0xac, 0xb3, 0x44, 0xeb, 0xbd, 0xdc, 0x15, 0x17, 0x98, 0xd0, 0x38, 0xb9, 0xf3,
-	0x41, 0xb7, 0x5b, 0x5f, 0x3c, 0xd6, 0x6e, 0xdd, 0x65, 0x67, 0xbf, 0x74, 0x02, 0x3b, 0x7b, 0x83,
-	0xe5, 0x0a, 0xba, 0x33, 0x27, 0x5c, 0x1b, 0x19, 0x28, 0x74, 0xec, 0x8a, 0x2f, 0x8f, 0x00, 0x62,
-	0x3f, 0x31, 0x67, 0xd0, 0x33, 0xb0, 0xed, 0xca, 0x23, 0x07, 0xb6, 0x51, 0xf1, 0x1c, 0xc3, 0x59,
-	0xd2, 0xa9, 0xbc, 0x10, 0xcf, 0x31, 0x18, 0xeb, 0x38, 0x49, 0xab, 0xf5, 0xd3, 0xa7, 0x66, 0xb5,
-	0x9e, 0x3c, 0x03, 0xab, 0xf5, 0x33, 0x7d, 0x5b, 0xad, 0xdf, 0x87, 0x0b, 0x6d, 0xbf, 0x36, 0xef,
-	0x86, 0x41, 0x87, 0xdd, 0x53, 0x28, 0x77, 0x6a, 0x75, 0x12, 0x31, 0xb3, 0xf7, 0xc8, 0xcd, 0x9b,
-	0x7a, 0x23, 0xdb, 0x6c, 0x21, 0x4f, 0xef, 0xbe, 0xbc, 0x45, 0x22, 0xfe, 0x31, 0x93, 0xb5, 0xd8,
-	0x81, 0x89, 0x85, 0x40, 0xa5, 0x14, 0xe2, 0x34, 0x3e, 0xba, 0xd1, 0xfc, 0xfa, 0xd9, 0x18, 0xcd,
-	0x3f, 0x03, 0x85, 0xb0, 0xd1, 0x89, 0x6a, 0xfe, 0x9e, 0xc7, 0x3c, 0x23, 0x45, 0xf5, 0xac, 0x67,
-	0xa1, 0x22, 0xe0, 0x0f, 0x0e, 0xa7, 0x26, 0xe4, 0x6f, 0xcd, 0xa4, 0x20, 0x20, 0xe8, 0xd7, 0x7a,
-	0x44, 0x62, 0xdb, 0xa7, 0x19, 0x89, 0x7d, 0xe5, 0x44, 0x51, 0xd8, 0x69, 0x9e, 0x81, 0xe7, 0x3e,
-	0x72, 0x9e, 0x81, 0x5f, 0xb5, 0x60, 0x6c, 0x57, 0xb7, 0xdf, 0x08, 0xef, 0x45, 0x06, 0x5e, 0x54,
-	0xc3, 0x2c, 0x54, 0xb6, 0xa9, 0xb0, 0x33, 0x40, 0x0f, 0x92, 0x00, 0x6c, 0xb6, 0x24, 0xc5, 0xc3,
-	0xfb, 0xfc, 0x93, 0xf2, 0xf0, 0xbe, 0xcf, 0x84, 0x99, 0x0c, 0xbe, 0x62, 0x2e, 0x8d, 0x6c, 0x03,
-	0xbc, 0xa4, 0x60, 0x54, 0xf1, 0x5d, 0x3a, 0x3f, 0xf4, 0x55, 0x0b, 0x26, 0xe4, 0xe1, 0x4c, 0xd8,
-	0x5f, 0x43, 0x11, 0xa2, 0x92, 0xe5, 0x99, 0x90, 0xc5, 0x38, 0x6e, 0x26, 0xf8, 0xe0, 0x2e, 0xce,
-	0x54, 0xb4, 0xab, 0x88, 0x80, 0x7a, 0xc8, 0x22, 0xb1, 0x84, 0x22, 0x33, 0x1b, 0x83, 0xb1, 0x8e,
-	0x83, 0x7e, 0x5d, 0x3d, 0xd7, 0x77, 0x23, 0xab, 0x27, 0xe4, 0x0d, 0x05, 0x35, 0x93, 0x37, 0xfb,
-	0x1e, 0xd7, 0x13, 0xf5, 0x91, 0x7a, 0xf4, 0xef, 0x0f, 0x10, 0x9c, 0x4b, 0xbc, 0x4a, 0xfb, 0x71,
-	0x33, 0xad, 0xeb, 0xb5, 0x64, 0x6e, 0xcd, 0x31, 0x89, 0x6f, 0xe4, 0xd7, 0x34, 0x12, 0x60, 0xe6,
-	0x4e, 0x35, 0x01, 0xe6, 0xc0, 0xd9, 0x24, 0xc0, 0x9c, 0x38, 0x8d, 0x04, 0x98, 0xe7, 0x4f, 0x94,
-	0x00, 0x53, 0x4b, 0x40, 0x3a, 0xf8, 0x90, 0x04, 0xa4, 0xb3, 0x30, 0x2e, 0xa3, 0x8c, 0x89, 0xc8,
-	0x6c, 0xc8, 0x1d, 0x0c, 0x57, 0x44, 0x95, 0xf1, 0x39, 0xb3, 0x18, 0x27, 0xf1, 0xd1, 0x87, 0x16,
-	0xe4, 0x3d, 0x56, 0x73, 0x28, 0xab, 0x9c, 0xde, 0xe6, 0xd4, 0x62, 0x07, 0x44, 0xb1, 0xfe, 0x64,
-	0x5c, 0x55, 0x9e, 0xc1, 0x1e, 0xc8, 0x1f, 0x98, 0xb7, 0x00, 0xbd, 0x09, 0x25, 0x7f, 0x7b, 0xbb,
-	0xe9, 0x3b, 0xb5, 0x38, 0x4b, 0xa7, 0xf4, 0x80, 0xf0, 0x5b, 0x22, 0x2a, 0x4b, 0xd9, 0x7a, 0x0f,
-	0x3c, 0xdc, 0x93, 0x02, 0x3d, 0xe1, 0x8f, 0x87, 0x91, 0x1f, 0x90, 0x5a, 0x6c, 0x8d, 0x28, 0xb2,
-	0x3e, 0x93, 0xcc, 0xfb, 0x5c, 0x31, 0xf9, 0xf0, 0xde, 0xab, 0x8f, 0x92, 0x28, 0xc5, 0xc9, 0x66,
-	0xa1, 0x00, 0x2e, 0xb7, 0xd3, 0x8c, 0x21, 0xa1, 0x88, 0x8d, 0x3e, 0xce, 0x24, 0x23, 0x97, 0xee,
-	0xe5, 0x54, 0x73, 0x4a, 0x88, 0x7b, 0x50, 0xd6, 0xf3, 0x77, 0x16, 0xce, 0x26, 0x7f, 0xa7, 0xf9,
-	0x96, 0xf4, 0xd8, 0x99, 0xbf, 0x25, 0x8d, 0xfe, 0x4f, 0x6a, 0xaa, 0x59, 0x6e, 0x43, 0xa8, 0x67,
-	0x3e, 0x27, 0x3e, 0x72, 0xe9, 0x66, 0xff, 0xbe, 0x05, 0x93, 0x7c, 0xe6, 0x25, 0x35, 0x57, 0xf6,
-	0x4a, 0xff, 0xb9, 0x53, 0x71, 0x92, 0xb1, 0x78, 0x81, 0x8a, 0xc1, 0x95, 0xf9, 0x6e, 0x8e, 0x69,
-	0x09, 0xfa, 0xe5, 0x14, 0x7d, 0x79, 0x3c, 0x2b, 0xab, 0x5c, 0x7a, 0x9a, 0xd2, 0x0b, 0x47, 0xfd,
-	0xa8, 0xc8, 0xff, 0xb0, 0xa7, 0xd1, 0x10, 0xb1, 0xe6, 0xfd, 0xa5, 0x53, 0x32, 0x1a, 0xea, 0xb9,
-	0x54, 0x4f, 0x62, 0x3a, 0x9c, 0xfc, 0x39, 0x91, 0xcc, 0xbd, 0xa7, 0x16, 0xb2, 0x65, 0x6a, 0x21,
-	0x2b, 0x59, 0x26, 0x5c, 0xd6, 0xd5, 0xa1, 0xbf, 0x6a, 0xc1, 0xc5, 0x34, 0x21, 0x99, 0xd2, 0xa4,
-	0xcf, 0x9b, 0x4d, 0xca, 0x50, 0xab, 0xd5, 0x1b, 0x94, 0x4d, 0x96, 0xd9, 0xef, 0x17, 0x35, 0x57,
-	0x4d, 0x44, 0xda, 0xa7, 0xf8, 0x44, 0xfd, 0xd8, 0xff, 0x7f, 0xa2, 0xfe, 0x2c, 0x32, 0xd6, 0x1b,
-	0x8f, 0xcd, 0xe7, 0x9f, 0xd4, 0x63, 0xf3, 0x43, 0x8f, 0xf2, 0xd8, 0xfc, 0xf0, 0x13, 0x7b, 0x6c,
-	0xbe, 0xd0, 0xe7, 0x63, 0xf3, 0xc5, 0x8f, 0xe8, 0x63, 0xf3, 0xf1, 0x91, 0x74, 0x34, 0xf3, 0x23,
-	0x69, 0x44, 0xda, 0xff, 0xef, 0x3d, 0x23, 0xff, 0x47, 0x39, 0x18, 0x57, 0x5b, 0xb7, 0x13, 0xee,
-	0x54, 0x48, 0x74, 0x06, 0x71, 0x26, 0x7b, 0x46, 0x9c, 0x49, 0x96, 0xa6, 0x3d, 0xde, 0x85, 0x9e,
-	0x51, 0x3d, 0x5f, 0x4c, 0x44, 0xf5, 0xdc, 0xcf, 0x9e, 0xf5, 0xf1, 0xc1, 0x3d, 0xff, 0xdd, 0x82,
-	0x0b, 0x89, 0x1a, 0x67, 0x10, 0xf9, 0xb0, 0x6b, 0x46, 0x3e, 0xbc, 0x96, 0x79, 0xaf, 0x7b, 0x04,
-	0x40, 0xfc, 0x46, 0xae, 0xab, 0xb7, 0x4c, 0x2f, 0xfc, 0x59, 0x0b, 0xf2, 0x91, 0x13, 0xee, 0xc8,
-	0x20, 0x88, 0xcf, 0x9f, 0xca, 0x0c, 0x98, 0xa6, 0xbf, 0xc5, 0x6a, 0x55, 0xed, 0x63, 0x30, 0xcc,
-	0xb9, 0x4f, 0xfe, 0x8c, 0x05, 0x10, 0x23, 0x3d, 0x29, 0x15, 0xc6, 0xfe, 0xed, 0x1c, 0x5c, 0x4a,
-	0x9d, 0x46, 0xe8, 0x2b, 0xea, 0x90, 0xcf, 0x07, 0x6a, 0xeb, 0x94, 0xe6, 0xab, 0x7e, 0xd6, 0x1f,
-	0x33, 0xce, 0xfa, 0xe2, 0x88, 0xff, 0xa4, 0x14, 0x50, 0x91, 0xd6, 0x59, 0x1b, 0xac, 0xff, 0x61,
-	0xc1, 0x44, 0xf2, 0xb0, 0x71, 0x06, 0x22, 0x6b, 0xdf, 0x10, 0x59, 0xf7, 0xb2, 0xf7, 0x46, 0xf4,
-	0x0c, 0x8b, 0xfb, 0x23, 0x2d, 0x1e, 0x50, 0x22, 0x9f, 0x81, 0xcc, 0xd8, 0x33, 0x65, 0x06, 0xce,
-	0xbe, 0xc7, 0x3d, 0x84, 0xc6, 0xdb, 0x90, 0xe6, 0x90, 0xe9, 0x2f, 0x2d, 0x8c, 0x11, 0xd3, 0x9f,
-	0xeb, 0x3b, 0xa6, 0xff, 0x17, 0x72, 0xdd, 0x43, 0xcc, 0x04, 0xd5, 0x07, 0x54, 0x35, 0xd3, 0x4e,
-	0xbb, 0xd9, 0x65, 0xce, 0x30, 0xce, 0xd6, 0xaa, 0x8d, 0xc6, 0xc9, 0xda, 0xe0, 0x8c, 0xde, 0x8a,
-	0x5b, 0x42, 0xbf, 0xd4, 0x43, 0x53, 0x30, 0xf5, 0x9a, 0xe6, 0xcc, 0x21, 0x70, 0x5f, 0xa3, 0xc4,
-	0x5c, 0x13, 0x06, 0x6d, 0x7b, 0x0c, 0x46, 0x5e, 0x77, 0xdb, 0xca, 0x97, 0x32, 0xfd, 0xad, 0xef,
-	0x5d, 0x7b, 0xea, 0xf7, 0xbe, 0x77, 0xed, 0xa9, 0xef, 0x7c, 0xef, 0xda, 0x53, 0x5f, 0x3a, 0xba,
-	0x66, 0x7d, 0xeb, 0xe8, 0x9a, 0xf5, 0x7b, 0x47, 0xd7, 0xac, 0xef, 0x1c, 0x5d, 0xb3, 0xfe, 0xd3,
-	0xd1, 0x35, 0xeb, 0xaf, 0xfd, 0xe7, 0x6b, 0x4f, 0xbd, 0x5e, 0x90, 0x7d, 0xfb, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x4c, 0xa2, 0x9c, 0xc6, 0xfc, 0xab, 0x00, 0x00,
+	// 11525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x59, 0x70, 0x24, 0xc9,
+	0x75, 0xd8, 0x56, 0x37, 0x1a, 0x47, 0xe2, 0x9c, 0x9a, 0xab, 0x16, 0x3b, 0x3b, 0x18, 0xd5, 0x72,
+	0x57, 0xbb, 0xe2, 0x12, 0xa3, 0x9d, 0x25, 0xa5, 0x15, 0x69, 0x91, 0xc4, 0x31, 0xc0, 0x60, 0x01,
+	0xcc, 0x60, 0x5f, 0x63, 0x66, 0xc4, 0x25, 0x4d, 0xb2, 0xd0, 0x9d, 0xe8, 0xae, 0x45, 0x77, 0x55,
+	0x6f, 0x55, 0x35, 0x66, 0xb0, 0x5c, 0x1e, 0xa6, 0x28, 0x91, 0x6b, 0x51, 0xa4, 0xe5, 0x43, 0x07,
+	0xc3, 0x07, 0xc3, 0x96, 0x2c, 0x05, 0xe5, 0x10, 0x83, 0x11, 0xfe, 0xf3, 0x87, 0xf5, 0xe1, 0xa0,
+	0xe9, 0xb0, 0x23, 0x44, 0x85, 0x64, 0x9b, 0x11, 0x96, 0x87, 0xe6, 0x58, 0x76, 0x84, 0x0f, 0x39,
+	0x24, 0x85, 0x48, 0x31, 0xc6, 0xfa, 0x70, 0xbc, 0xbc, 0x2a, 0xb3, 0xba, 0x1a, 0xc7, 0x4c, 0x61,
+	0x86, 0x96, 0xbe, 0x80, 0x7e, 0xef, 0xe5, 0x7b, 0x99, 0x55, 0x59, 0x99, 0x2f, 0xdf, 0x95, 0x64,
+	0xa3, 0xe1, 0x27, 0xcd, 0xee, 0xd6, 0x6c, 0x2d, 0x6c, 0x5f, 0xf4, 0xa2, 0x46, 0xd8, 0x89, 0xc2,
+	0xd7, 0xd8, 0x3f, 0xef, 0xb8, 0x15, 0x46, 0x3b, 0xdb, 0xad, 0xf0, 0x56, 0x7c, 0x71, 0xf7, 0xc5,
+	0x8b, 0x9d, 0x9d, 0xc6, 0x45, 0xaf, 0xe3, 0xc7, 0x17, 0x25, 0xf4, 0xe2, 0xee, 0x0b, 0x5e, 0xab,
+	0xd3, 0xf4, 0x5e, 0xb8, 0xd8, 0xa0, 0x01, 0x8d, 0xbc, 0x84, 0xd6, 0x67, 0x3b, 0x51, 0x98, 0x84,
+	0xf6, 0xfb, 0x53, 0x8e, 0xb3, 0x92, 0x23, 0xfb, 0xe7, 0x23, 0x8a, 0xe3, 0xec, 0xee, 0x8b, 0xb3,
+	0x9d, 0x9d, 0xc6, 0x2c, 0x72, 0x9c, 0x95, 0xd0, 0x59, 0xc9, 0x71, 0xfa, 0x1d, 0x5a, 0x9f, 0x1a,
+	0x61, 0x23, 0xbc, 0xc8, 0x18, 0x6f, 0x75, 0xb7, 0xd9, 0x2f, 0xf6, 0x83, 0xfd, 0xc7, 0x05, 0x4e,
+	0xbb, 0x3b, 0x2f, 0xc5, 0xb3, 0x7e, 0x88, 0xfd, 0xbb, 0x58, 0x0b, 0x23, 0x7a, 0x71, 0xb7, 0xa7,
+	0x53, 0xd3, 0xcf, 0x69, 0x34, 0x9d, 0xb0, 0xe5, 0xd7, 0xf6, 0x2e, 0xee, 0xbe, 0xb0, 0x45, 0x93,
+	0xde, 0xfe, 0x4f, 0xbf, 0x33, 0x25, 0x6d, 0x7b, 0xb5, 0xa6, 0x1f, 0xd0, 0x68, 0x4f, 0x8e, 0xff,
+	0x62, 0x44, 0xe3, 0xb0, 0x1b, 0xd5, 0xe8, 0x91, 0x5a, 0xc5, 0x17, 0xdb, 0x34, 0xf1, 0xf2, 0xba,
+	0x75, 0xb1, 0x5f, 0xab, 0xa8, 0x1b, 0x24, 0x7e, 0xbb, 0x57, 0xcc, 0x8f, 0x1d, 0xd4, 0x20, 0xae,
+	0x35, 0x69, 0xdb, 0xeb, 0x69, 0xf7, 0x62, 0xbf, 0x76, 0xdd, 0xc4, 0x6f, 0x5d, 0xf4, 0x83, 0x24,
+	0x4e, 0xa2, 0x6c, 0x23, 0xf7, 0x32, 0x19, 0x9c, 0x6b, 0x87, 0xdd, 0x20, 0xb1, 0xdf, 0x43, 0x2a,
+	0xbb, 0x5e, 0xab, 0x4b, 0x1d, 0xeb, 0x82, 0xf5, 0xec, 0xc8, 0xfc, 0xd3, 0xdf, 0xb8, 0x33, 0xf3,
+	0xd8, 0xdd, 0x3b, 0x33, 0x95, 0x1b, 0x08, 0xbc, 0x77, 0x67, 0xe6, 0x14, 0x0d, 0x6a, 0x61, 0xdd,
+	0x0f, 0x1a, 0x17, 0x5f, 0x8b, 0xc3, 0x60, 0xf6, 0x6a, 0xb7, 0xbd, 0x45, 0x23, 0xe0, 0x6d, 0xdc,
+	0xdf, 0x2b, 0x91, 0xc9, 0xb9, 0xa8, 0xd6, 0xf4, 0x77, 0x69, 0x35, 0x41, 0xfe, 0x8d, 0x3d, 0xbb,
+	0x49, 0xca, 0x89, 0x17, 0x31, 0x76, 0xa3, 0x97, 0xd6, 0x67, 0x1f, 0x74, 0xca, 0xcc, 0x6e, 0x7a,
+	0x91, 0xe4, 0x3d, 0x3f, 0x74, 0xf7, 0xce, 0x4c, 0x79, 0xd3, 0x8b, 0x00, 0x45, 0xd8, 0x2d, 0x32,
+	0x10, 0x84, 0x01, 0x75, 0x4a, 0x4c, 0xd4, 0xd5, 0x07, 0x17, 0x75, 0x35, 0x0c, 0xd4, 0x38, 0xe6,
+	0x87, 0xef, 0xde, 0x99, 0x19, 0x40, 0x08, 0x30, 0x29, 0x38, 0xae, 0x37, 0xfc, 0x8e, 0x53, 0x2e,
+	0x6a, 0x5c, 0xaf, 0xfa, 0x1d, 0x73, 0x5c, 0xaf, 0xfa, 0x1d, 0x40, 0x11, 0xee, 0x5b, 0x25, 0x32,
+	0x32, 0x17, 0x35, 0xba, 0x6d, 0x1a, 0x24, 0xb1, 0xfd, 0x49, 0x42, 0x3a, 0x5e, 0xe4, 0xb5, 0x69,
+	0x42, 0xa3, 0xd8, 0xb1, 0x2e, 0x94, 0x9f, 0x1d, 0xbd, 0xb4, 0xfa, 0xe0, 0xe2, 0x37, 0x24, 0xcf,
+	0x79, 0x5b, 0xbc, 0x72, 0xa2, 0x40, 0x31, 0x68, 0x22, 0xed, 0x8f, 0x91, 0x11, 0x2f, 0x4a, 0xfc,
+	0x6d, 0xaf, 0x96, 0xc4, 0x4e, 0x89, 0xc9, 0x7f, 0xf9, 0xc1, 0xe5, 0xcf, 0x09, 0x96, 0xf3, 0x27,
+	0x84, 0xf8, 0x11, 0x09, 0x89, 0x21, 0x95, 0xe7, 0xfe, 0x8f, 0x0a, 0x19, 0x9d, 0x8b, 0x92, 0xe5,
+	0x85, 0x6a, 0xe2, 0x25, 0xdd, 0xd8, 0xfe, 0xb7, 0x16, 0x39, 0x19, 0xf3, 0xc7, 0xe6, 0xd3, 0x78,
+	0x23, 0x0a, 0x6b, 0x34, 0x8e, 0x69, 0x5d, 0x3c, 0x97, 0xed, 0x42, 0xfa, 0x25, 0x85, 0xcd, 0x56,
+	0x7b, 0x05, 0x5d, 0x0e, 0x92, 0x68, 0x6f, 0xfe, 0x05, 0xd1, 0xe7, 0x93, 0x39, 0x14, 0x9f, 0xfe,
+	0xf6, 0x8c, 0x2d, 0x87, 0xb2, 0xbc, 0x20, 0x08, 0xf6, 0x20, 0xaf, 0xd7, 0xf6, 0xaf, 0x58, 0x64,
+	0xac, 0x13, 0xd6, 0x63, 0xa0, 0xb5, 0xb0, 0xdb, 0xa1, 0x75, 0xf1, 0x78, 0x3f, 0x52, 0xec, 0x30,
+	0x36, 0x34, 0x09, 0xbc, 0xff, 0xa7, 0x44, 0xff, 0xc7, 0x74, 0x14, 0x18, 0x5d, 0xb1, 0x7f, 0xdd,
+	0x22, 0x04, 0x01, 0x4b, 0x9e, 0xdf, 0xa2, 0x75, 0xa7, 0xcc, 0x7a, 0xf6, 0xd7, 0x8b, 0xef, 0x19,
+	0xe7, 0xcf, 0xfb, 0xf5, 0xac, 0x9a, 0x8a, 0x0a, 0xd1, 0xe7, 0x71, 0x6a, 0x5d, 0x9b, 0x5e, 0x22,
+	0x4e, 0xbf, 0x37, 0x65, 0x4f, 0x91, 0xf2, 0x0e, 0xdd, 0xe3, 0x8b, 0x1b, 0xe0, 0xbf, 0xf6, 0x29,
+	0xb9, 0xe0, 0xe1, 0xb2, 0x31, 0x2c, 0x56, 0xb2, 0x77, 0x97, 0x5e, 0xb2, 0xa6, 0xdf, 0x47, 0x4e,
+	0xf4, 0x3c, 0xaa, 0x23, 0x31, 0xf8, 0x49, 0x32, 0x99, 0x19, 0xd1, 0x41, 0xcd, 0x2b, 0x5a, 0x73,
+	0xf7, 0x4f, 0x06, 0xc9, 0xb0, 0x1c, 0xaa, 0x7d, 0x81, 0x0c, 0x04, 0x5e, 0x5b, 0x2e, 0xcb, 0x63,
+	0xe2, 0xc1, 0x0c, 0x5c, 0xf5, 0xda, 0xb8, 0x20, 0x79, 0x6d, 0x8a, 0x14, 0x1d, 0x2f, 0x69, 0x3a,
+	0x25, 0x93, 0x62, 0xc3, 0x4b, 0x9a, 0xc0, 0x30, 0xf6, 0x39, 0x32, 0xd0, 0x0e, 0xeb, 0x94, 0xad,
+	0x59, 0x15, 0xbe, 0xa0, 0xad, 0x87, 0x75, 0x0a, 0x0c, 0x8a, 0xed, 0xb7, 0xa3, 0xb0, 0xed, 0x0c,
+	0x98, 0xed, 0x97, 0xa2, 0xb0, 0x0d, 0x0c, 0x63, 0xff, 0xb2, 0x45, 0xa6, 0xe4, 0xa7, 0xb8, 0x16,
+	0xd6, 0xbc, 0xc4, 0x0f, 0x03, 0xa7, 0xc2, 0x16, 0x40, 0x28, 0x6e, 0x05, 0x90, 0x9c, 0xe7, 0x1d,
+	0xd1, 0x85, 0xa9, 0x2c, 0x06, 0x7a, 0x7a, 0x61, 0x5f, 0x22, 0xa4, 0xd1, 0x0a, 0xb7, 0xbc, 0x16,
+	0x3e, 0x10, 0x67, 0x90, 0x0d, 0x41, 0x2d, 0x64, 0xcb, 0x0a, 0x03, 0x1a, 0x95, 0x7d, 0x9b, 0x0c,
+	0x79, 0x7c, 0xb3, 0x72, 0x86, 0xd8, 0x20, 0x5e, 0x29, 0x62, 0x10, 0xc6, 0xee, 0x37, 0x3f, 0x7a,
+	0xf7, 0xce, 0xcc, 0x90, 0x00, 0x82, 0x14, 0x67, 0x3f, 0x4f, 0x86, 0xc3, 0x0e, 0xf6, 0xdb, 0x6b,
+	0x39, 0xc3, 0x38, 0x6b, 0xe6, 0xa7, 0x44, 0x5f, 0x87, 0xaf, 0x09, 0x38, 0x28, 0x0a, 0xfb, 0x39,
+	0x32, 0x14, 0x77, 0xb7, 0xf0, 0x3d, 0x3a, 0x23, 0x6c, 0x60, 0x93, 0x82, 0x78, 0xa8, 0xca, 0xc1,
+	0x20, 0xf1, 0xf6, 0xbb, 0xc8, 0x68, 0x44, 0x6b, 0xdd, 0x28, 0xa6, 0xf8, 0x62, 0x1d, 0xc2, 0x78,
+	0x9f, 0x14, 0xe4, 0xa3, 0x90, 0xa2, 0x40, 0xa7, 0xb3, 0xdf, 0x4b, 0x26, 0xf0, 0x05, 0x5f, 0xbe,
+	0xdd, 0x89, 0x68, 0x1c, 0xe3, 0x5b, 0x1d, 0x65, 0x82, 0xce, 0x88, 0x96, 0x13, 0x4b, 0x06, 0x16,
+	0x32, 0xd4, 0xd8, 0xc3, 0x3a, 0x6d, 0xd1, 0x84, 0xd6, 0x9d, 0x31, 0x26, 0x52, 0xf5, 0x70, 0x91,
+	0x83, 0x41, 0xe2, 0x71, 0xe8, 0xb5, 0x26, 0xad, 0xed, 0xc4, 0xdd, 0xb6, 0x33, 0xce, 0x84, 0xa8,
+	0xa1, 0x2f, 0x08, 0x38, 0x28, 0x0a, 0xfb, 0x22, 0x19, 0x89, 0xfd, 0x37, 0xe8, 0xfc, 0x5e, 0x42,
+	0x63, 0x67, 0xe2, 0x82, 0xf5, 0x6c, 0x39, 0xdd, 0x1f, 0xaa, 0x12, 0x01, 0x29, 0x0d, 0x8e, 0x64,
+	0x97, 0x46, 0xfe, 0xf6, 0x9e, 0x64, 0xe6, 0x4c, 0xb2, 0x0e, 0xa9, 0x91, 0xdc, 0x30, 0xb0, 0x90,
+	0xa1, 0x76, 0x29, 0x19, 0x97, 0xb3, 0x6d, 0xc1, 0xab, 0x35, 0xe9, 0x21, 0xbe, 0xbb, 0x4b, 0x84,
+	0xb4, 0xbd, 0xdb, 0xf8, 0x79, 0xfb, 0x34, 0xe6, 0x5f, 0x71, 0x3a, 0xf5, 0xd6, 0x15, 0x06, 0x34,
+	0x2a, 0xf7, 0x5f, 0x59, 0x44, 0xad, 0x62, 0x8b, 0x5e, 0xe2, 0x55, 0x99, 0xaa, 0x69, 0xdf, 0x26,
+	0xc3, 0x72, 0x66, 0x0b, 0x85, 0xa9, 0xc8, 0x9d, 0x55, 0x3d, 0x68, 0x09, 0x01, 0x25, 0xcd, 0x7e,
+	0x27, 0x19, 0xdc, 0x0e, 0xa3, 0xb6, 0x97, 0x88, 0xe5, 0xe3, 0x9c, 0xa0, 0x1d, 0x5c, 0x62, 0xd0,
+	0x7b, 0x77, 0x66, 0x08, 0xf6, 0x93, 0xff, 0x02, 0x41, 0xeb, 0x7e, 0xaa, 0x94, 0x0e, 0xe3, 0x72,
+	0x50, 0x8b, 0xf6, 0xd8, 0x94, 0xb5, 0x81, 0x8c, 0xec, 0xd0, 0xbd, 0x2a, 0xad, 0x45, 0x54, 0x8e,
+	0xe3, 0xe9, 0x59, 0xae, 0x96, 0x62, 0x27, 0x67, 0x6b, 0x61, 0x44, 0x67, 0x77, 0x5f, 0x98, 0xe5,
+	0x14, 0xab, 0x48, 0xda, 0xa2, 0xb5, 0x24, 0x8c, 0xe6, 0xc7, 0xf1, 0xc5, 0xae, 0xca, 0xb6, 0x90,
+	0xb2, 0xb1, 0x9f, 0x22, 0x95, 0x1d, 0xba, 0xb7, 0xb2, 0x28, 0xfa, 0x37, 0x2e, 0xf5, 0xd2, 0x55,
+	0x04, 0x02, 0xc7, 0xd9, 0xaf, 0x13, 0xbb, 0x13, 0xd1, 0x5d, 0x3f, 0xec, 0xc6, 0x8a, 0x49, 0x2c,
+	0xb6, 0xaa, 0x43, 0xf6, 0x60, 0x5a, 0x30, 0xb6, 0x37, 0x7a, 0x18, 0x41, 0x0e, 0x73, 0x77, 0x83,
+	0x90, 0x74, 0x3b, 0xb2, 0xe7, 0xc9, 0xb0, 0xd8, 0xd7, 0xc5, 0x1a, 0x3f, 0xff, 0x8c, 0x7c, 0xe8,
+	0x72, 0x49, 0xb8, 0x77, 0x27, 0x77, 0x03, 0x53, 0xed, 0xdc, 0x5f, 0x18, 0x23, 0x3d, 0x2b, 0x9e,
+	0xfd, 0x02, 0x19, 0x15, 0x8b, 0xc7, 0x5a, 0xd8, 0x88, 0x19, 0xef, 0xe1, 0xf9, 0x49, 0xfc, 0xa8,
+	0xe7, 0x52, 0x30, 0xe8, 0x34, 0x76, 0x9d, 0x94, 0xe2, 0x17, 0x85, 0x32, 0xbc, 0xf6, 0xe0, 0xd3,
+	0xa8, 0xfa, 0xa2, 0x9a, 0x48, 0x83, 0x77, 0xef, 0xcc, 0x94, 0xaa, 0x2f, 0x42, 0x29, 0x7e, 0x11,
+	0xd5, 0xe0, 0x86, 0x9f, 0x14, 0xa7, 0x06, 0x2f, 0xfb, 0x89, 0x92, 0xc3, 0xd4, 0xe0, 0x65, 0x3f,
+	0x01, 0x14, 0x81, 0xea, 0x7d, 0x33, 0x49, 0x3a, 0xce, 0x40, 0x51, 0xea, 0xfd, 0x95, 0xcd, 0xcd,
+	0x0d, 0x25, 0x8b, 0xed, 0x86, 0x08, 0x01, 0x26, 0xc5, 0xfe, 0x9c, 0x85, 0x4f, 0x9c, 0x23, 0xc3,
+	0x68, 0x4f, 0x6c, 0x73, 0xd7, 0x8b, 0xfb, 0x1c, 0xc3, 0x68, 0x4f, 0x09, 0x17, 0x2f, 0x52, 0x21,
+	0x40, 0x17, 0xcd, 0x06, 0x5e, 0xdf, 0x8e, 0x9d, 0xc1, 0xc2, 0x06, 0xbe, 0xb8, 0x54, 0xcd, 0x0c,
+	0x7c, 0x71, 0xa9, 0x0a, 0x4c, 0x0a, 0xbe, 0xd0, 0xc8, 0xbb, 0xe5, 0x0c, 0x15, 0xf5, 0x42, 0xc1,
+	0xbb, 0x65, 0xbe, 0x50, 0xf0, 0x6e, 0x01, 0x8a, 0x40, 0x49, 0x61, 0x1c, 0x3b, 0xc3, 0x45, 0x49,
+	0xba, 0x56, 0xad, 0x9a, 0x92, 0xae, 0x55, 0xab, 0x80, 0x22, 0xd8, 0x24, 0xad, 0xc5, 0xce, 0x48,
+	0x51, 0x92, 0x96, 0x17, 0x32, 0x92, 0x96, 0x17, 0xaa, 0x80, 0x22, 0xec, 0x37, 0x09, 0xf1, 0xd4,
+	0xc7, 0xed, 0x90, 0xa2, 0x3e, 0xbe, 0x74, 0xc1, 0x98, 0x9f, 0xc0, 0x6d, 0x25, 0xfd, 0x0d, 0x9a,
+	0x3c, 0xbb, 0x43, 0x2a, 0xde, 0x1b, 0xdd, 0x88, 0xb2, 0xed, 0x7b, 0xf4, 0xd2, 0xb5, 0x02, 0x04,
+	0x23, 0x3b, 0x35, 0xd6, 0x11, 0x5c, 0x71, 0x19, 0x08, 0xb8, 0x20, 0xfb, 0x33, 0x16, 0x21, 0x54,
+	0xad, 0xfc, 0x6c, 0xf7, 0x1f, 0xbd, 0xb4, 0x59, 0xdc, 0x80, 0xd3, 0x5d, 0x85, 0x0f, 0x3c, 0xfd,
+	0x0d, 0x9a, 0x5c, 0x36, 0x95, 0x6a, 0xbe, 0x33, 0x5e, 0xd4, 0x0b, 0xbe, 0xb6, 0xb0, 0x92, 0x99,
+	0x4a, 0x0b, 0x2b, 0x80, 0x22, 0xec, 0x84, 0x0c, 0x76, 0x5a, 0xdd, 0x86, 0x1f, 0x30, 0x75, 0x64,
+	0xf4, 0xd2, 0x46, 0x01, 0x47, 0x6f, 0xc6, 0x4f, 0xc9, 0x23, 0xb8, 0xed, 0x72, 0x18, 0x08, 0x59,
+	0xee, 0x5b, 0x56, 0xaa, 0x97, 0xa0, 0xa2, 0x17, 0x3f, 0x3a, 0x55, 0xc1, 0xfd, 0xea, 0x70, 0xba,
+	0xe9, 0x03, 0xed, 0x84, 0xb1, 0xcf, 0x56, 0xa9, 0xfb, 0xd8, 0xa1, 0x02, 0x6d, 0x87, 0xba, 0x51,
+	0xe4, 0x0e, 0x95, 0x76, 0xcb, 0xd8, 0xab, 0xfe, 0x76, 0x66, 0x4d, 0xe7, 0x9b, 0xd6, 0x47, 0x8e,
+	0x65, 0x4d, 0xd7, 0xba, 0xb0, 0xff, 0xea, 0xbe, 0x2b, 0x56, 0x77, 0xbe, 0xad, 0xfd, 0x54, 0xb1,
+	0xab, 0xbb, 0xd6, 0x8b, 0xec, 0x3a, 0x1f, 0xf1, 0xd5, 0x97, 0xef, 0x6b, 0x37, 0x0b, 0x5d, 0x7d,
+	0x35, 0xa9, 0xe6, 0x3a, 0x1c, 0xf1, 0x75, 0x78, 0xb0, 0x28, 0x99, 0xcb, 0x0b, 0x7d, 0x65, 0xaa,
+	0x15, 0xf9, 0x0d, 0xb9, 0x26, 0xf2, 0x1d, 0xed, 0x03, 0x05, 0xaf, 0x89, 0x9a, 0xdc, 0x03, 0x57,
+	0xc7, 0xe1, 0x47, 0xb4, 0x3a, 0x7e, 0x42, 0xad, 0x59, 0x7c, 0x07, 0x7c, 0xb5, 0xe8, 0x35, 0x4b,
+	0x7b, 0x08, 0x79, 0xab, 0xd7, 0xeb, 0xe4, 0x74, 0x2f, 0x25, 0xd0, 0x6d, 0x3c, 0xde, 0xd5, 0xc2,
+	0x60, 0xdb, 0x6f, 0xac, 0x7b, 0x1d, 0xa1, 0x2f, 0xab, 0xe3, 0xdd, 0x82, 0x44, 0x40, 0x4a, 0x63,
+	0x3f, 0xc9, 0xcd, 0x27, 0xfc, 0x0c, 0x30, 0x2a, 0x48, 0xcb, 0xab, 0x74, 0x8f, 0xd9, 0x52, 0xde,
+	0x3d, 0xfc, 0xcb, 0x5f, 0x9e, 0x79, 0xec, 0x53, 0x7f, 0x70, 0xe1, 0x31, 0xf7, 0x77, 0xcb, 0xe4,
+	0x89, 0x5c, 0x99, 0xc2, 0x6e, 0xf8, 0xcf, 0x2c, 0x72, 0xda, 0xcb, 0xc3, 0x3b, 0x56, 0x51, 0x93,
+	0x33, 0x57, 0xfc, 0xfc, 0x93, 0xa2, 0xd3, 0xf9, 0x4f, 0x04, 0x4e, 0x7b, 0xfd, 0x1e, 0x14, 0x9e,
+	0x35, 0xe3, 0x8e, 0x57, 0xa3, 0x4e, 0xc9, 0x7c, 0x50, 0x57, 0x25, 0x02, 0x52, 0x1a, 0x7e, 0x22,
+	0xdf, 0xf6, 0xba, 0x2d, 0xae, 0x9a, 0x1b, 0x27, 0x72, 0x06, 0x06, 0x89, 0xb7, 0xff, 0xbe, 0x45,
+	0xec, 0x5e, 0xa9, 0xce, 0x40, 0xd1, 0x93, 0x55, 0x9b, 0x24, 0x67, 0xee, 0x6a, 0x87, 0x20, 0x6d,
+	0xa4, 0x39, 0xfd, 0xd0, 0xde, 0x29, 0xda, 0x7a, 0x73, 0x56, 0x5a, 0x9c, 0x14, 0xdd, 0xa8, 0xe5,
+	0x58, 0xe6, 0xa4, 0xb8, 0x0e, 0x6b, 0x80, 0x70, 0xfb, 0xef, 0x5a, 0x64, 0x52, 0x5b, 0x70, 0xe7,
+	0xba, 0xc2, 0x46, 0x56, 0x90, 0xbd, 0xc7, 0x60, 0x3c, 0x7f, 0x56, 0x88, 0x9f, 0xcc, 0x20, 0x20,
+	0xdb, 0x05, 0xf7, 0x3b, 0x16, 0x79, 0x72, 0xdf, 0x7d, 0x23, 0xb7, 0xe3, 0xd6, 0x23, 0xef, 0x38,
+	0x4e, 0xad, 0x88, 0x76, 0xc2, 0xeb, 0xb0, 0x26, 0x66, 0xa2, 0x9a, 0x5a, 0xc0, 0xc1, 0x20, 0xf1,
+	0xee, 0x7f, 0xb4, 0x48, 0x96, 0x9f, 0xed, 0x91, 0x89, 0x6e, 0x4c, 0x23, 0x9c, 0xaa, 0xf7, 0x63,
+	0x21, 0xb0, 0xd1, 0x88, 0x73, 0xdd, 0x60, 0x00, 0x19, 0x86, 0x28, 0xa2, 0xe3, 0xc5, 0xf1, 0xad,
+	0x30, 0xaa, 0x0b, 0x11, 0xa5, 0x23, 0x8b, 0xd8, 0x30, 0x18, 0x40, 0x86, 0xa1, 0xfb, 0x95, 0x12,
+	0x99, 0x9a, 0xeb, 0x26, 0x21, 0xd0, 0x24, 0xda, 0x9b, 0x4b, 0x12, 0xda, 0xee, 0xa0, 0x11, 0xa5,
+	0xd2, 0x69, 0x7a, 0xb1, 0x34, 0x16, 0x9d, 0x97, 0x36, 0x8a, 0x0d, 0x04, 0xde, 0xbb, 0x33, 0x33,
+	0x7e, 0x53, 0xbc, 0x06, 0x06, 0x00, 0x4e, 0x8c, 0xcf, 0xb3, 0x4d, 0xe3, 0xd8, 0x6b, 0xd0, 0xec,
+	0xf3, 0x5c, 0xe7, 0x60, 0x90, 0x78, 0xfb, 0xa7, 0xc8, 0xf0, 0x36, 0x33, 0x26, 0xcf, 0xc9, 0x13,
+	0xf7, 0x8f, 0x68, 0x43, 0x52, 0xee, 0xbe, 0xf4, 0xbd, 0xb7, 0x69, 0xe2, 0xe1, 0x20, 0x37, 0xfd,
+	0x36, 0x4d, 0x95, 0xba, 0x25, 0xc1, 0x03, 0x14, 0x37, 0xfb, 0x83, 0x64, 0x24, 0xa2, 0x49, 0xe4,
+	0x33, 0xd6, 0x03, 0x47, 0x66, 0xad, 0x16, 0x23, 0x90, 0x4c, 0x20, 0xe5, 0xe7, 0xfe, 0xc3, 0x32,
+	0x39, 0xa1, 0x1e, 0x96, 0x72, 0x0c, 0xbe, 0x42, 0x2a, 0x2d, 0xbf, 0xed, 0xcb, 0xf7, 0xff, 0xa3,
+	0x7d, 0xc5, 0xa1, 0xe3, 0x72, 0x96, 0x3b, 0x2e, 0x67, 0x57, 0x82, 0xe4, 0x1a, 0xfa, 0xff, 0xfc,
+	0xa0, 0xc1, 0xf7, 0xdb, 0x35, 0x64, 0x01, 0x9c, 0x93, 0xdd, 0x21, 0x43, 0x5b, 0x5e, 0x6d, 0x27,
+	0xdc, 0xde, 0x16, 0x6f, 0x7c, 0xe5, 0xc1, 0x3f, 0x94, 0x79, 0xce, 0x90, 0x5b, 0x72, 0xc5, 0x0f,
+	0x90, 0x62, 0xd0, 0xf8, 0x47, 0x53, 0xab, 0x69, 0xd9, 0xb4, 0x3b, 0x6b, 0x16, 0x53, 0x8d, 0xca,
+	0x5e, 0x26, 0x27, 0x22, 0x1a, 0x27, 0x5e, 0x94, 0x54, 0xbb, 0x35, 0x74, 0x4e, 0x6c, 0x77, 0x5b,
+	0xec, 0x99, 0x0f, 0xcf, 0x3f, 0x2e, 0x9a, 0x9e, 0x80, 0x2c, 0x01, 0xf4, 0xb6, 0x41, 0x46, 0x41,
+	0x58, 0xa7, 0x4b, 0x3e, 0x6d, 0xd5, 0xe5, 0xec, 0x65, 0x0a, 0xdd, 0x48, 0xca, 0xe8, 0x6a, 0x96,
+	0x00, 0x7a, 0xdb, 0xb8, 0xbf, 0x8f, 0xc7, 0x0b, 0x5d, 0xab, 0xb1, 0xbf, 0x8c, 0x9b, 0x02, 0x42,
+	0xe6, 0x5b, 0xe1, 0xd6, 0x42, 0x18, 0x24, 0x1e, 0xbe, 0x0f, 0xc7, 0x2a, 0x6c, 0x53, 0xe8, 0xe1,
+	0x9d, 0x5a, 0xde, 0x7a, 0x71, 0x90, 0xd3, 0x17, 0xb4, 0xcc, 0x6e, 0xb5, 0xc2, 0xad, 0xac, 0xbf,
+	0x03, 0x89, 0x80, 0x61, 0xdc, 0xbf, 0xb0, 0xc8, 0xd9, 0x3e, 0xca, 0xda, 0xff, 0x0f, 0x03, 0x7c,
+	0x2f, 0x99, 0xc0, 0x61, 0xe0, 0xfe, 0xbe, 0xa4, 0xdb, 0x66, 0x95, 0x2d, 0x7b, 0xde, 0xc0, 0x42,
+	0x86, 0xda, 0xfd, 0x42, 0x99, 0xe4, 0x88, 0x42, 0x0b, 0x3c, 0x0d, 0xea, 0x9d, 0xd0, 0x0f, 0x12,
+	0xb1, 0x50, 0xa9, 0x85, 0xe1, 0xb2, 0x80, 0x83, 0xa2, 0x10, 0x2a, 0x9a, 0x78, 0x3a, 0xa5, 0x1e,
+	0x15, 0x4d, 0x74, 0x3f, 0xa5, 0xb1, 0x1b, 0x64, 0xca, 0xab, 0xd5, 0x30, 0x96, 0x40, 0x59, 0x49,
+	0x9d, 0xf2, 0x51, 0x96, 0xdf, 0x53, 0xcc, 0xe5, 0x93, 0x61, 0x01, 0x3d, 0x4c, 0x71, 0x95, 0x8f,
+	0xbd, 0x78, 0x33, 0xdc, 0xa1, 0x81, 0x10, 0x33, 0x70, 0xe4, 0x55, 0xbe, 0x3a, 0x57, 0xd5, 0x18,
+	0x40, 0x86, 0xa1, 0xbd, 0x4e, 0x4e, 0x76, 0x63, 0x8a, 0xab, 0x76, 0x2b, 0xf4, 0xea, 0x2b, 0x75,
+	0x1a, 0x24, 0x7e, 0xc2, 0x6d, 0x81, 0xc3, 0xf3, 0x4f, 0x48, 0xa7, 0xef, 0xf5, 0x5e, 0x12, 0xc8,
+	0x6b, 0xe7, 0xfe, 0x6f, 0x8b, 0xc8, 0x15, 0x04, 0xdf, 0x42, 0xbd, 0x1b, 0x71, 0x17, 0x5a, 0xe6,
+	0x2d, 0x2c, 0x0a, 0x38, 0x28, 0x0a, 0x7b, 0x93, 0x0c, 0xf2, 0x3d, 0xd4, 0x29, 0xdd, 0xe7, 0x62,
+	0xc9, 0xf4, 0xf2, 0x25, 0xc6, 0x03, 0x04, 0x2f, 0xf4, 0x16, 0xb5, 0xbd, 0xdb, 0x52, 0x9c, 0x58,
+	0xbd, 0x94, 0xb7, 0x68, 0x3d, 0x45, 0x81, 0x4e, 0x67, 0x3f, 0x43, 0x06, 0x5f, 0xf3, 0x93, 0x84,
+	0x46, 0xc2, 0x55, 0x38, 0x21, 0x7d, 0x05, 0x2f, 0x33, 0x28, 0x08, 0xac, 0xfb, 0xbb, 0x16, 0x19,
+	0x99, 0xf7, 0x62, 0xbf, 0xf6, 0x97, 0x68, 0xdf, 0xff, 0xed, 0x12, 0xa9, 0x70, 0xc7, 0xd0, 0xf5,
+	0xec, 0xd9, 0x65, 0xf4, 0xd2, 0xb3, 0x79, 0x72, 0xd4, 0x39, 0xa6, 0xc7, 0xcf, 0x91, 0x7b, 0xc2,
+	0xa1, 0xa4, 0x1c, 0xbf, 0xde, 0x72, 0x4a, 0x45, 0x99, 0x74, 0xaa, 0xaf, 0xac, 0xb1, 0xfe, 0xf2,
+	0x53, 0x71, 0xf5, 0x95, 0x35, 0x40, 0xfe, 0xf6, 0x9e, 0x66, 0x3e, 0x2a, 0x17, 0x66, 0x2c, 0xd4,
+	0x3d, 0x67, 0xf3, 0x63, 0x7d, 0xec, 0x47, 0xdf, 0xb5, 0xc8, 0xd9, 0x85, 0x56, 0x37, 0x4e, 0x68,
+	0x24, 0xf5, 0xa1, 0x4d, 0xda, 0xee, 0xb4, 0xbc, 0x84, 0xda, 0x1f, 0x25, 0xc3, 0xa8, 0x57, 0xd4,
+	0xbd, 0xc4, 0x3b, 0x50, 0x2d, 0x30, 0xb4, 0x90, 0x6b, 0x5b, 0xaf, 0xd1, 0x5a, 0xb2, 0x4e, 0x13,
+	0x2f, 0xdd, 0x7e, 0x53, 0x18, 0x28, 0xae, 0xf6, 0x6d, 0x32, 0x10, 0x77, 0x68, 0xad, 0x38, 0xab,
+	0x53, 0x76, 0x0c, 0xd5, 0x0e, 0xad, 0xa5, 0xbb, 0x11, 0xfe, 0x02, 0x26, 0xd1, 0xfd, 0xbf, 0x16,
+	0x79, 0xa2, 0xcf, 0xb8, 0xd7, 0xfc, 0x38, 0xb1, 0x3f, 0xd4, 0x33, 0xf6, 0xd9, 0xc3, 0x8d, 0x1d,
+	0x5b, 0xb3, 0x91, 0xab, 0x15, 0x44, 0x42, 0xb4, 0x71, 0x7f, 0x82, 0x54, 0xfc, 0x84, 0xb6, 0x65,
+	0xc4, 0x4e, 0x01, 0x66, 0x90, 0x3e, 0x63, 0x49, 0x5d, 0x73, 0x2b, 0x28, 0x0f, 0xb8, 0x58, 0xf7,
+	0xdf, 0x58, 0x04, 0x27, 0x7c, 0xdd, 0x17, 0xee, 0xac, 0x81, 0x64, 0xaf, 0x23, 0x15, 0x65, 0x79,
+	0x26, 0x1e, 0xd8, 0xdc, 0xeb, 0x30, 0x3d, 0x59, 0x11, 0x22, 0x00, 0x18, 0xa9, 0xfd, 0x61, 0x32,
+	0x18, 0xb3, 0xb3, 0xbb, 0xd8, 0x85, 0x96, 0xe4, 0xaa, 0xc3, 0x4f, 0xf4, 0xf7, 0xee, 0xcc, 0x1c,
+	0x2a, 0x30, 0x6f, 0x56, 0xf1, 0xe6, 0xed, 0x40, 0x70, 0xd5, 0xd5, 0xf0, 0xf2, 0xfe, 0x6a, 0xb8,
+	0xfb, 0xaf, 0x2d, 0x72, 0x52, 0x7d, 0xbc, 0x9a, 0xfb, 0xf6, 0x0d, 0x72, 0xaa, 0x96, 0xf3, 0xb5,
+	0x1f, 0x79, 0x75, 0x90, 0xce, 0xd7, 0x53, 0x79, 0x58, 0xc8, 0x95, 0x71, 0x9f, 0x0e, 0xdc, 0xaf,
+	0x97, 0xc9, 0xb8, 0xda, 0xc5, 0x51, 0x55, 0xb4, 0xaf, 0xea, 0xfb, 0x3d, 0xef, 0xf8, 0x93, 0x7d,
+	0x3a, 0xce, 0x89, 0x0e, 0x50, 0x07, 0xde, 0x49, 0xc6, 0xea, 0xb4, 0x43, 0x83, 0x3a, 0x0d, 0x6a,
+	0xdc, 0x3f, 0x5e, 0xc6, 0xbd, 0x0e, 0x83, 0x8d, 0x16, 0x35, 0x38, 0x18, 0x54, 0xdc, 0x7c, 0x81,
+	0xbf, 0xe3, 0xec, 0xcb, 0xe0, 0x8d, 0x62, 0x90, 0x78, 0xfb, 0x17, 0x2c, 0x32, 0x1e, 0xe9, 0x07,
+	0x8b, 0xe2, 0x2c, 0xa9, 0xe9, 0x80, 0x74, 0xfe, 0xf3, 0x27, 0xee, 0xde, 0x99, 0x19, 0x37, 0x40,
+	0x60, 0xf6, 0x00, 0xcf, 0x21, 0x61, 0x37, 0xe9, 0x74, 0x13, 0x69, 0x5f, 0x2d, 0xe0, 0x1c, 0x72,
+	0x8d, 0x33, 0xe4, 0xe7, 0x10, 0xf1, 0x03, 0xa4, 0x18, 0xf7, 0x1f, 0x59, 0xe4, 0x4c, 0x7e, 0x77,
+	0xed, 0x9b, 0x64, 0x88, 0x1f, 0xc5, 0xe2, 0xfb, 0x3e, 0x69, 0x8d, 0xf2, 0xd3, 0x3d, 0x63, 0x02,
+	0x92, 0x9b, 0xa1, 0xc2, 0x94, 0x0e, 0x52, 0x61, 0xdc, 0x3f, 0xb7, 0xc8, 0x29, 0xd5, 0xc3, 0x2a,
+	0x4d, 0xd4, 0x9a, 0xff, 0xd3, 0x16, 0x21, 0x6a, 0xbe, 0xa0, 0x1d, 0xbc, 0x5c, 0xcc, 0x6e, 0x64,
+	0xcc, 0xeb, 0x74, 0x57, 0x50, 0xe0, 0x18, 0x34, 0xb1, 0xf6, 0x07, 0xc8, 0xd8, 0x6e, 0xd8, 0xea,
+	0xb6, 0xe9, 0x3a, 0x6a, 0x99, 0x32, 0x68, 0x60, 0x26, 0x6f, 0xea, 0xdf, 0x48, 0xe9, 0xd2, 0xc8,
+	0x39, 0x0d, 0x18, 0x83, 0xc1, 0xca, 0xfd, 0x00, 0x61, 0x42, 0xfd, 0xa0, 0x4b, 0xaf, 0x05, 0x18,
+	0xc8, 0x40, 0xa3, 0x48, 0xac, 0x0a, 0xc3, 0xe9, 0x6a, 0x79, 0x19, 0x81, 0xc0, 0x71, 0xa8, 0x62,
+	0xf1, 0xa3, 0x39, 0x0f, 0x2a, 0x4b, 0x55, 0x2c, 0x7e, 0x74, 0x07, 0x81, 0x75, 0x67, 0xc9, 0xd0,
+	0x02, 0x0a, 0xa1, 0x11, 0xf2, 0xd5, 0x03, 0x77, 0xc7, 0x8d, 0xc0, 0x5d, 0x19, 0xa0, 0xbb, 0x49,
+	0x4e, 0x2f, 0x44, 0x14, 0x77, 0xa9, 0x17, 0xe7, 0xbb, 0xb5, 0x1d, 0x9a, 0xf0, 0x70, 0xa3, 0xd8,
+	0x7e, 0x0f, 0x19, 0x0f, 0xd9, 0x76, 0xb9, 0x16, 0xd6, 0x76, 0xfc, 0xa0, 0x21, 0xac, 0x86, 0xa7,
+	0x05, 0x97, 0xf1, 0x6b, 0x3a, 0x12, 0x4c, 0x5a, 0xf7, 0xfb, 0x65, 0x32, 0xc9, 0xd9, 0xd6, 0x41,
+	0x44, 0x4d, 0xe3, 0x08, 0xf0, 0x9c, 0xb9, 0xb2, 0x28, 0xfa, 0xa3, 0x46, 0x70, 0x95, 0x41, 0x41,
+	0x60, 0xf1, 0x00, 0xed, 0x75, 0xfc, 0x1b, 0x34, 0x8a, 0xd3, 0x69, 0xa4, 0xde, 0xd5, 0xdc, 0xc6,
+	0x8a, 0xc0, 0x80, 0x46, 0x85, 0x27, 0xbf, 0x1d, 0x3f, 0xa8, 0x8b, 0xa5, 0x41, 0xed, 0xb5, 0xab,
+	0x7e, 0x50, 0x07, 0x86, 0x31, 0xed, 0xa5, 0x03, 0x87, 0xb0, 0x97, 0xca, 0x30, 0x9f, 0x4a, 0xdf,
+	0x30, 0x1f, 0xb4, 0x32, 0xfa, 0x75, 0x67, 0x30, 0x63, 0x65, 0x5c, 0x59, 0x04, 0x84, 0x63, 0x30,
+	0x59, 0xad, 0x45, 0xbd, 0xa0, 0xdb, 0x29, 0x2e, 0x98, 0x4c, 0x3e, 0xcc, 0x05, 0xce, 0x98, 0x7f,
+	0x86, 0xe2, 0x07, 0x48, 0x71, 0xf6, 0x06, 0x19, 0x13, 0xff, 0x32, 0xb3, 0x12, 0xf3, 0x32, 0x8c,
+	0xcc, 0x3f, 0x2f, 0x27, 0xe6, 0x82, 0x86, 0xc3, 0xf8, 0xed, 0x0c, 0x53, 0x06, 0x07, 0x83, 0x83,
+	0xbe, 0x15, 0x8e, 0x1c, 0xb0, 0x15, 0xfe, 0x61, 0x89, 0x8c, 0x2d, 0x44, 0x61, 0x20, 0xb5, 0x80,
+	0x87, 0xa0, 0xc1, 0x25, 0x86, 0x06, 0x57, 0x40, 0xe0, 0xa1, 0xde, 0xff, 0x7e, 0xda, 0x9b, 0xfd,
+	0xa6, 0x52, 0x3f, 0xca, 0x45, 0x99, 0x08, 0x0c, 0xb9, 0x8c, 0x77, 0xfa, 0x95, 0x98, 0xca, 0x89,
+	0xfb, 0xdf, 0x2c, 0x32, 0xa5, 0x93, 0x3f, 0x04, 0x85, 0x31, 0x36, 0x15, 0xc6, 0xab, 0xc5, 0x8e,
+	0xb7, 0x8f, 0x96, 0xf8, 0xd6, 0xa0, 0x39, 0x4e, 0x7c, 0x01, 0x18, 0x76, 0x3a, 0x76, 0x4b, 0x03,
+	0x88, 0xc1, 0x5e, 0x2d, 0x4e, 0x77, 0x67, 0x6f, 0xfd, 0x6d, 0xf2, 0x8b, 0xd1, 0xa1, 0xf7, 0x32,
+	0xbf, 0xc1, 0xe8, 0x09, 0x6e, 0x81, 0x98, 0x86, 0x51, 0xef, 0xb6, 0x68, 0x76, 0x0b, 0xac, 0x0a,
+	0x38, 0x28, 0x0a, 0xfb, 0x43, 0xe4, 0x44, 0x2d, 0x0c, 0x6a, 0xdd, 0x28, 0xa2, 0x41, 0x6d, 0x6f,
+	0x83, 0x25, 0xa7, 0x88, 0x45, 0x6c, 0x56, 0xda, 0xeb, 0x16, 0xb2, 0x04, 0xf7, 0xf2, 0x80, 0xd0,
+	0xcb, 0x88, 0x87, 0x89, 0xc6, 0xa8, 0x14, 0x09, 0x63, 0xa2, 0x16, 0x26, 0xca, 0xc0, 0x20, 0xf1,
+	0xf6, 0x75, 0x72, 0x96, 0xd9, 0x12, 0xfd, 0xa0, 0xb1, 0x48, 0xbd, 0x7a, 0xcb, 0x0f, 0xf0, 0x08,
+	0x1d, 0xa2, 0xba, 0x55, 0x61, 0x41, 0x96, 0x4f, 0xdc, 0xbd, 0x33, 0x73, 0xb6, 0x9a, 0x4f, 0x02,
+	0xfd, 0xda, 0xda, 0x1f, 0x26, 0xd3, 0xb1, 0xb2, 0x4e, 0xbe, 0x1c, 0x6e, 0xc5, 0x57, 0xfc, 0x18,
+	0x8d, 0x6d, 0xcc, 0x46, 0xcb, 0x56, 0xce, 0xca, 0xfc, 0xf9, 0xbb, 0x77, 0x66, 0xa6, 0xab, 0x7d,
+	0xa9, 0x60, 0x1f, 0x0e, 0x36, 0x90, 0x33, 0x7c, 0xdf, 0xeb, 0xe1, 0x3d, 0xc4, 0x78, 0x4f, 0xdf,
+	0xbd, 0x33, 0x73, 0x66, 0x29, 0x97, 0x02, 0xfa, 0xb4, 0xc4, 0x37, 0x98, 0xf8, 0x6d, 0xfa, 0x46,
+	0x18, 0xc8, 0x95, 0x53, 0xbd, 0xc1, 0x4d, 0x01, 0x07, 0x45, 0x61, 0xbf, 0x96, 0xce, 0x44, 0xfc,
+	0x5c, 0x9c, 0x91, 0xfb, 0x5c, 0xe1, 0x98, 0x8d, 0xeb, 0xa6, 0xc6, 0x09, 0x3f, 0x39, 0x30, 0x78,
+	0x63, 0x32, 0x8d, 0xdd, 0xbb, 0x44, 0xd8, 0xab, 0x64, 0xd0, 0xab, 0x25, 0x18, 0xb4, 0xcc, 0x73,
+	0x1c, 0x9e, 0xca, 0x53, 0x51, 0xb8, 0x28, 0xa0, 0xdb, 0x14, 0x67, 0x08, 0x4d, 0xd7, 0x95, 0x39,
+	0xd6, 0x14, 0x04, 0x0b, 0x3b, 0x24, 0x27, 0x5a, 0x5e, 0x9c, 0xc8, 0xb9, 0x5a, 0xc7, 0x21, 0x3b,
+	0xa5, 0x23, 0x9b, 0xff, 0x4f, 0xe3, 0xcc, 0x5d, 0xcb, 0x32, 0x82, 0x5e, 0xde, 0x98, 0xbd, 0x52,
+	0x93, 0x07, 0x30, 0xa9, 0x64, 0xad, 0x16, 0xa2, 0xeb, 0x71, 0x9e, 0x86, 0x9e, 0x27, 0xc4, 0x80,
+	0x26, 0xd2, 0xfd, 0x77, 0x84, 0x0c, 0x2d, 0xce, 0x2d, 0x6f, 0x7a, 0xf1, 0xce, 0x21, 0x62, 0x7b,
+	0x71, 0x76, 0x08, 0x3d, 0x35, 0xfb, 0x7d, 0x4b, 0xfd, 0x15, 0x14, 0x85, 0x1d, 0x90, 0x41, 0x3f,
+	0xc0, 0x0f, 0xc2, 0x99, 0x28, 0xca, 0x7c, 0xa3, 0x4e, 0xd5, 0xcc, 0x7e, 0xb7, 0xc2, 0xb8, 0x83,
+	0x90, 0x62, 0xbf, 0x89, 0x99, 0x38, 0x22, 0x2f, 0x48, 0x6c, 0x4b, 0xab, 0x45, 0x58, 0x71, 0x04,
+	0x4b, 0x3d, 0x15, 0x47, 0x80, 0x20, 0x15, 0x68, 0x7f, 0xca, 0x22, 0xa3, 0x72, 0xe8, 0xe8, 0x38,
+	0x1f, 0x28, 0x2c, 0xc3, 0x2b, 0x65, 0xca, 0x63, 0x67, 0x34, 0x00, 0xe8, 0x22, 0x7b, 0x0e, 0x97,
+	0x95, 0x43, 0x1d, 0x2e, 0x6f, 0x91, 0x91, 0x5b, 0x7e, 0xd2, 0x64, 0x1b, 0x8f, 0x33, 0xc8, 0xa6,
+	0xe0, 0xd2, 0x83, 0xf7, 0x1a, 0xd9, 0xa5, 0x4f, 0xec, 0xa6, 0x14, 0x00, 0xa9, 0x2c, 0xd4, 0x4a,
+	0xf1, 0x07, 0xcb, 0xab, 0x72, 0x86, 0x4c, 0xad, 0xf4, 0xa6, 0x44, 0x40, 0x4a, 0x83, 0x8f, 0x78,
+	0x0c, 0x7f, 0x55, 0xe9, 0xeb, 0x5d, 0xfc, 0x8e, 0x9d, 0xe1, 0xa2, 0xe6, 0x95, 0xe4, 0xc8, 0x1f,
+	0xd6, 0x4d, 0x4d, 0x06, 0x18, 0x12, 0xf1, 0x1b, 0xb9, 0xd5, 0xa4, 0x81, 0x33, 0x62, 0x7e, 0x23,
+	0x37, 0x9b, 0x34, 0x00, 0x86, 0xc1, 0x90, 0xc7, 0x9a, 0x3a, 0xde, 0x14, 0x17, 0xf2, 0x98, 0x1e,
+	0x99, 0x78, 0x6c, 0x4b, 0xfa, 0x1b, 0x34, 0x79, 0x78, 0xce, 0x08, 0x83, 0xcb, 0xb7, 0xfd, 0x44,
+	0xa4, 0x2c, 0xa8, 0x95, 0xee, 0x1a, 0x83, 0x82, 0xc0, 0xea, 0x16, 0x85, 0xb1, 0x03, 0x2c, 0x0a,
+	0xff, 0xc0, 0x22, 0x95, 0x66, 0x18, 0xee, 0xc4, 0xce, 0xf8, 0x85, 0x72, 0x31, 0xaa, 0x9e, 0x58,
+	0x71, 0x66, 0xaf, 0x20, 0x5b, 0x33, 0x67, 0xac, 0xc2, 0x60, 0xf7, 0xee, 0xcc, 0x4c, 0xac, 0xf9,
+	0xdb, 0xb4, 0xb6, 0x57, 0x6b, 0x51, 0x06, 0xf9, 0xf4, 0xb7, 0x35, 0xc8, 0xe5, 0x5d, 0x1a, 0x24,
+	0xc0, 0x7b, 0x35, 0xfd, 0x96, 0x45, 0x48, 0xca, 0x28, 0x27, 0xa5, 0x88, 0xea, 0x29, 0x45, 0x85,
+	0x9c, 0xa5, 0x8d, 0xae, 0xe9, 0x39, 0x4a, 0xbf, 0x63, 0x91, 0x51, 0x1c, 0x9c, 0x5c, 0x02, 0x9f,
+	0x21, 0x83, 0x89, 0x17, 0x35, 0x68, 0x92, 0x3d, 0xf6, 0x6d, 0x32, 0x28, 0x08, 0xac, 0x1d, 0x90,
+	0x4a, 0xe2, 0xc5, 0x3b, 0x52, 0xbb, 0x5c, 0x29, 0xec, 0x11, 0xa7, 0x8a, 0x25, 0xfe, 0x8a, 0x81,
+	0x8b, 0xb1, 0x9f, 0xe5, 0x9e, 0xf3, 0x25, 0x2f, 0x96, 0x01, 0x31, 0x63, 0xd2, 0x13, 0x8e, 0x30,
+	0x50, 0x58, 0xf7, 0xef, 0x94, 0xc8, 0xc0, 0x22, 0x3f, 0x67, 0x0c, 0xf2, 0x93, 0x92, 0x63, 0x15,
+	0x35, 0xa7, 0x53, 0x5b, 0xa1, 0xa6, 0xe9, 0xb3, 0xdf, 0x20, 0x64, 0x61, 0xd0, 0xc7, 0x44, 0x12,
+	0x79, 0x41, 0xcc, 0x2d, 0x74, 0xfc, 0x50, 0x5c, 0xd0, 0x2c, 0xdc, 0x34, 0xf8, 0x56, 0x13, 0xda,
+	0x49, 0x7d, 0x89, 0x26, 0x0e, 0x32, 0x7d, 0x70, 0xef, 0x0d, 0x10, 0x92, 0xf6, 0x1e, 0xa3, 0xe3,
+	0xc7, 0x3d, 0x3d, 0x1e, 0xd5, 0xb1, 0x8a, 0x9a, 0x6a, 0x46, 0x98, 0x2b, 0xb7, 0xb5, 0x19, 0x20,
+	0x30, 0x05, 0xdb, 0x2d, 0x73, 0xb2, 0xaf, 0x16, 0xf3, 0x96, 0x98, 0xd5, 0x84, 0x47, 0x18, 0xe8,
+	0x06, 0x14, 0x3b, 0x10, 0x49, 0x08, 0xe5, 0xa2, 0x82, 0x7f, 0x31, 0xe5, 0x40, 0x9b, 0x16, 0xd9,
+	0x34, 0x84, 0x4f, 0x5b, 0xba, 0x9b, 0x69, 0xa0, 0xa8, 0x24, 0x84, 0x1c, 0xeb, 0xf5, 0x3e, 0x3e,
+	0xa9, 0x4f, 0x68, 0xce, 0xa2, 0x4a, 0xd1, 0x61, 0x61, 0x5a, 0x0f, 0xfa, 0x79, 0x8c, 0xb6, 0xc9,
+	0x88, 0x7a, 0x27, 0x87, 0xb2, 0x73, 0xdd, 0xa7, 0x35, 0xfc, 0x5d, 0xa4, 0xc2, 0x16, 0x5a, 0x76,
+	0xac, 0xd3, 0x8d, 0xf7, 0xfa, 0xb1, 0x4e, 0xc0, 0x41, 0x51, 0xb8, 0x1f, 0x22, 0x13, 0x97, 0x6f,
+	0xd3, 0x5a, 0x37, 0x09, 0x23, 0xfe, 0xf8, 0xec, 0x97, 0x89, 0x1d, 0xd3, 0x68, 0xd7, 0xaf, 0x51,
+	0xe1, 0xc7, 0xbe, 0x9a, 0xaa, 0x99, 0x2a, 0x0a, 0xa0, 0xda, 0x43, 0x01, 0x39, 0xad, 0xdc, 0xaf,
+	0x58, 0x64, 0x54, 0x8b, 0x73, 0x45, 0xa5, 0xaf, 0xb1, 0x50, 0xe5, 0xd6, 0x3b, 0xc7, 0x2a, 0x6a,
+	0xce, 0x2f, 0x4b, 0x96, 0xa9, 0x46, 0xa2, 0x40, 0x90, 0x0a, 0x3c, 0x20, 0x00, 0xd3, 0xfd, 0xba,
+	0x45, 0x4e, 0xe7, 0x06, 0xe5, 0x3e, 0xe2, 0x6e, 0x5f, 0x64, 0x19, 0x69, 0x46, 0x14, 0x85, 0x6a,
+	0xb0, 0x2a, 0x11, 0x90, 0xd2, 0xb8, 0x5f, 0xb3, 0x48, 0xca, 0x09, 0x77, 0xb5, 0xad, 0xb4, 0xe7,
+	0xda, 0xae, 0x26, 0x24, 0x09, 0xac, 0xfd, 0x26, 0x39, 0x6b, 0xbe, 0xc1, 0x34, 0x04, 0xe2, 0x48,
+	0x9e, 0x68, 0x7e, 0xfc, 0xce, 0xe7, 0x04, 0xfd, 0x44, 0xb8, 0x37, 0x48, 0x65, 0xd9, 0xeb, 0x36,
+	0x0e, 0xf9, 0x89, 0x3c, 0x4b, 0x86, 0x23, 0xea, 0xb5, 0x12, 0x79, 0xe2, 0x13, 0x3b, 0x22, 0x08,
+	0x18, 0x28, 0xac, 0xfb, 0x27, 0x23, 0x64, 0x54, 0x4b, 0xcb, 0x42, 0x95, 0x30, 0xa2, 0x9d, 0x30,
+	0x7b, 0x6c, 0xc2, 0x97, 0x0d, 0x0c, 0x83, 0xdf, 0x0f, 0x66, 0xca, 0xc5, 0x39, 0x9e, 0x01, 0x10,
+	0x70, 0x50, 0x14, 0xf6, 0x0c, 0xa9, 0xd4, 0x69, 0x27, 0x69, 0xb2, 0x45, 0x75, 0x80, 0x2f, 0xba,
+	0x8b, 0x08, 0x00, 0x0e, 0x47, 0x82, 0x6d, 0x9a, 0xd4, 0x9a, 0xcc, 0x37, 0x30, 0xc2, 0x09, 0x96,
+	0x10, 0x00, 0x1c, 0x9e, 0x13, 0x5b, 0x50, 0x39, 0xfe, 0xd8, 0x82, 0xc1, 0x82, 0x63, 0x0b, 0xec,
+	0x0e, 0x39, 0x19, 0xc7, 0xcd, 0x8d, 0xc8, 0xdf, 0xf5, 0x12, 0x9a, 0xce, 0x9c, 0xa1, 0xa3, 0xc8,
+	0x39, 0xcb, 0xaa, 0x10, 0x54, 0xaf, 0x64, 0xb9, 0x40, 0x1e, 0x6b, 0xbb, 0x4a, 0x4e, 0xfb, 0x41,
+	0x4c, 0x6b, 0xdd, 0x88, 0xae, 0x34, 0x82, 0x30, 0xa2, 0x57, 0xc2, 0x18, 0xd9, 0x89, 0xa4, 0x64,
+	0x15, 0xab, 0xbc, 0x92, 0x47, 0x04, 0xf9, 0x6d, 0x31, 0x2a, 0xad, 0xee, 0xc7, 0xde, 0x56, 0x8b,
+	0x56, 0xbb, 0x5b, 0xed, 0x10, 0xcf, 0xfe, 0x3c, 0xf5, 0x4a, 0x0b, 0x6f, 0x5b, 0xcc, 0x12, 0x40,
+	0x6f, 0x1b, 0xf6, 0xd5, 0x45, 0x5e, 0x50, 0x6b, 0x3a, 0x24, 0xf3, 0xd5, 0x31, 0x28, 0x08, 0xac,
+	0xfd, 0x12, 0x19, 0xab, 0x31, 0xef, 0x03, 0x87, 0xb3, 0x83, 0xc0, 0x70, 0xea, 0x99, 0x59, 0xd0,
+	0x70, 0x60, 0x50, 0xe2, 0x6a, 0x56, 0xf7, 0x23, 0x67, 0xcc, 0x5c, 0xcd, 0x16, 0xfd, 0x08, 0x10,
+	0x8e, 0x4e, 0x91, 0x5a, 0xd8, 0x6e, 0xfb, 0x89, 0x30, 0x7b, 0x8b, 0x84, 0x65, 0xe5, 0x14, 0x59,
+	0xd0, 0x91, 0x60, 0xd2, 0x32, 0xc7, 0x46, 0x37, 0x69, 0x86, 0x11, 0x5b, 0xfb, 0x27, 0x32, 0x8e,
+	0x0d, 0x85, 0x01, 0x8d, 0x0a, 0x03, 0x72, 0xf8, 0xaf, 0xcb, 0x6d, 0xcf, 0x6f, 0x39, 0x93, 0x66,
+	0x40, 0xce, 0x5c, 0x8a, 0x02, 0x9d, 0x0e, 0x53, 0x6f, 0x3a, 0xdd, 0xb8, 0x29, 0x1c, 0x74, 0xce,
+	0x14, 0x33, 0x86, 0xb1, 0x93, 0xf3, 0x46, 0x0a, 0x06, 0x9d, 0xc6, 0x7e, 0x37, 0x99, 0x88, 0x3b,
+	0x5e, 0x14, 0x53, 0x96, 0xf9, 0x1c, 0x76, 0x13, 0xe7, 0x04, 0xfb, 0xb6, 0x78, 0x54, 0x94, 0x81,
+	0x81, 0x0c, 0x25, 0x73, 0x4e, 0xf9, 0xad, 0x84, 0x46, 0x8e, 0x6d, 0xbe, 0x97, 0x25, 0x06, 0x05,
+	0x81, 0xb5, 0x6b, 0xa4, 0xdc, 0xda, 0x8e, 0x9d, 0x93, 0x6c, 0xfe, 0x5e, 0x29, 0x24, 0x35, 0x74,
+	0x6d, 0xa9, 0xca, 0x03, 0x59, 0xd6, 0x96, 0xaa, 0x80, 0xdc, 0x99, 0x29, 0x53, 0x4e, 0x19, 0x9e,
+	0xdf, 0xee, 0x87, 0x01, 0x5b, 0x3d, 0x9c, 0x53, 0xec, 0x39, 0xf0, 0xb5, 0x34, 0x9f, 0x04, 0xfa,
+	0xb5, 0x75, 0x6f, 0x90, 0x41, 0x2e, 0xce, 0x7e, 0x9a, 0x0c, 0xf9, 0x41, 0xad, 0xd5, 0xad, 0x73,
+	0x83, 0xdb, 0x08, 0xf7, 0xc2, 0xac, 0x70, 0x10, 0x48, 0x1c, 0x92, 0xd1, 0xdb, 0x9c, 0xac, 0x94,
+	0x92, 0x5d, 0xbe, 0x2d, 0xc8, 0x04, 0xce, 0xfd, 0x96, 0x45, 0xc6, 0xf4, 0x04, 0x1d, 0x3c, 0xe2,
+	0x93, 0xe6, 0xe2, 0x52, 0x95, 0x6b, 0x0e, 0xc5, 0x1d, 0x35, 0xae, 0x28, 0x9e, 0xe9, 0xac, 0x4b,
+	0x61, 0xa0, 0xc9, 0x3c, 0x44, 0xe1, 0x88, 0xa7, 0x48, 0x65, 0x3b, 0xc4, 0x93, 0x50, 0xd9, 0xf4,
+	0x59, 0x2e, 0x21, 0x10, 0x38, 0xce, 0xfd, 0x33, 0x8b, 0x9c, 0xc9, 0xcf, 0x3d, 0xfa, 0x41, 0x18,
+	0xe4, 0x25, 0x2c, 0x9b, 0x93, 0x34, 0x0d, 0x15, 0x40, 0xab, 0x74, 0x23, 0x31, 0xa0, 0x51, 0x1d,
+	0x6e, 0xd8, 0xdf, 0xc3, 0xd3, 0x78, 0x2a, 0xe7, 0xf3, 0x16, 0x19, 0x47, 0xb1, 0xab, 0xd1, 0x96,
+	0x31, 0xda, 0x6b, 0xc5, 0x8c, 0x56, 0xb1, 0x4d, 0x57, 0x21, 0x03, 0x0c, 0xa6, 0x70, 0xfb, 0xed,
+	0x64, 0xc4, 0xab, 0xd7, 0x23, 0x1a, 0xc7, 0x2a, 0xf6, 0x82, 0xe9, 0xf9, 0x73, 0x12, 0x08, 0x29,
+	0x1e, 0xb7, 0x6d, 0x4c, 0x0d, 0xc3, 0x9d, 0xd0, 0x29, 0x9b, 0xdb, 0x36, 0x0a, 0x41, 0x38, 0x28,
+	0x0a, 0xf7, 0xe7, 0x07, 0x88, 0x29, 0xdb, 0xae, 0x93, 0xc9, 0x9d, 0x68, 0x6b, 0x81, 0x85, 0x7f,
+	0xdd, 0x4f, 0x8c, 0xdf, 0x49, 0xcc, 0x3f, 0x58, 0x35, 0x39, 0x40, 0x96, 0xa5, 0x90, 0xb2, 0x4a,
+	0xf7, 0x12, 0x6f, 0xeb, 0x7e, 0x94, 0x2b, 0x29, 0x45, 0xe7, 0x00, 0x59, 0x96, 0xb8, 0x14, 0xef,
+	0x44, 0x5b, 0x52, 0x29, 0xc8, 0xc6, 0x46, 0xae, 0xa6, 0x28, 0xd0, 0xe9, 0xf0, 0x11, 0xee, 0x44,
+	0x5b, 0xa8, 0x44, 0xc9, 0x42, 0x2a, 0xea, 0x11, 0xae, 0x0a, 0x38, 0x28, 0x0a, 0xbb, 0x43, 0xec,
+	0x1d, 0xf9, 0xf4, 0xd4, 0xc9, 0xcb, 0xa9, 0x1c, 0x31, 0x5c, 0x88, 0x65, 0xd3, 0xac, 0xf6, 0xf0,
+	0x81, 0x1c, 0xde, 0xf6, 0x07, 0xc8, 0xd9, 0x9d, 0x68, 0x4b, 0xa8, 0x96, 0x1b, 0x91, 0x1f, 0xd4,
+	0xfc, 0x8e, 0x51, 0x34, 0x65, 0x46, 0x74, 0xf7, 0xec, 0x6a, 0x3e, 0x19, 0xf4, 0x6b, 0xef, 0xfe,
+	0xcf, 0x12, 0x61, 0x27, 0x57, 0xdc, 0x1f, 0xda, 0x34, 0x69, 0x86, 0xf5, 0xac, 0xb6, 0xbc, 0xce,
+	0xa0, 0x20, 0xb0, 0x32, 0x6f, 0xa7, 0xd4, 0x27, 0x6f, 0xe7, 0x16, 0x19, 0x6a, 0x52, 0xaf, 0x4e,
+	0x23, 0xe9, 0x27, 0x58, 0x2b, 0xe6, 0xb4, 0x7d, 0x85, 0x31, 0x4d, 0xed, 0x7f, 0xfc, 0x77, 0x0c,
+	0x52, 0x1a, 0xee, 0x8d, 0xa8, 0xf7, 0x86, 0xdd, 0x44, 0x3a, 0xc5, 0x06, 0x98, 0x53, 0x8c, 0xed,
+	0x8d, 0x9b, 0x06, 0x06, 0x32, 0x94, 0xf6, 0x22, 0x99, 0x12, 0x0e, 0x2c, 0xe5, 0x7f, 0x10, 0x0f,
+	0x56, 0x55, 0xb3, 0xa9, 0x66, 0xf0, 0xd0, 0xd3, 0x82, 0x85, 0xb6, 0x87, 0xf5, 0xbd, 0x6c, 0x34,
+	0xc2, 0x7c, 0x58, 0xdf, 0x03, 0x86, 0x71, 0xff, 0xac, 0x44, 0xc6, 0xf4, 0xfa, 0x05, 0x07, 0x25,
+	0x41, 0xc5, 0xe9, 0xc3, 0xe4, 0xe6, 0xa4, 0x02, 0xf6, 0xe3, 0x03, 0x1f, 0x64, 0x93, 0x0c, 0xa0,
+	0x9a, 0xe2, 0x94, 0x8b, 0xb2, 0x5a, 0xb3, 0x11, 0x63, 0xb6, 0x12, 0x33, 0x93, 0xe0, 0x7f, 0xc0,
+	0x24, 0x68, 0x53, 0x6e, 0x60, 0xdf, 0x29, 0xf7, 0x5e, 0x32, 0xd1, 0xee, 0xb6, 0x12, 0xbf, 0xe3,
+	0x45, 0x09, 0x4b, 0x81, 0x10, 0x8f, 0x58, 0x99, 0xc1, 0xd6, 0x0d, 0x2c, 0x64, 0xa8, 0xdd, 0x3f,
+	0xb6, 0xc8, 0xb0, 0xec, 0x84, 0x7d, 0x9b, 0x8c, 0x6c, 0xc9, 0xf0, 0xe6, 0xe2, 0x8e, 0xb4, 0x2a,
+	0x62, 0x9a, 0x2f, 0xd4, 0xea, 0x27, 0xa4, 0xc2, 0xec, 0xd7, 0xc8, 0x89, 0x2d, 0xea, 0x45, 0x34,
+	0xd2, 0xa3, 0xdf, 0x8f, 0xb4, 0x08, 0x32, 0x8f, 0xdd, 0x7c, 0x96, 0x07, 0xf4, 0xb2, 0x75, 0xbf,
+	0x54, 0x22, 0x13, 0xa6, 0x91, 0xea, 0xa0, 0xb9, 0x76, 0x2b, 0x3b, 0xd7, 0x1e, 0xdd, 0x87, 0x5b,
+	0x3e, 0xf4, 0x87, 0x9b, 0x5a, 0x8c, 0x06, 0x8e, 0x60, 0x31, 0xfa, 0x7d, 0xdc, 0xfc, 0x55, 0xd7,
+	0x0e, 0xe1, 0x50, 0x7c, 0x4a, 0xb7, 0x56, 0xf6, 0x3b, 0x9a, 0x7f, 0x92, 0x8c, 0xb0, 0x7f, 0xb0,
+	0xea, 0x92, 0x53, 0x2e, 0x2a, 0xcc, 0x25, 0xed, 0xa7, 0x6e, 0xf0, 0xbb, 0x21, 0x05, 0x41, 0x2a,
+	0xd3, 0x0d, 0xc9, 0x54, 0x96, 0xda, 0xfe, 0x20, 0x19, 0x8b, 0xe5, 0x34, 0x4a, 0xf3, 0x64, 0x0f,
+	0x39, 0xdd, 0x98, 0x97, 0xa9, 0xaa, 0x35, 0x07, 0x83, 0x99, 0xfb, 0x4d, 0x8b, 0x0c, 0xfe, 0x25,
+	0x7b, 0x86, 0xbf, 0x66, 0x91, 0x11, 0x16, 0x69, 0xd0, 0x40, 0x4f, 0x9e, 0xea, 0x73, 0x79, 0x9f,
+	0x3e, 0xc7, 0x64, 0x88, 0x1b, 0x92, 0x64, 0x14, 0x64, 0x01, 0x8b, 0x34, 0x2f, 0xeb, 0x99, 0x7e,
+	0x34, 0xdc, 0x62, 0x15, 0x83, 0x94, 0xe4, 0xfe, 0x6c, 0x89, 0x0c, 0xae, 0x04, 0x18, 0x44, 0xfa,
+	0x57, 0xbc, 0xb4, 0xe4, 0x3a, 0x19, 0x40, 0x37, 0xad, 0x59, 0x01, 0x75, 0x6c, 0xfe, 0x69, 0xbd,
+	0xfa, 0xa9, 0x63, 0x56, 0x3f, 0x05, 0xef, 0x96, 0x3c, 0xfd, 0x0b, 0x9f, 0x58, 0x9a, 0xad, 0xfc,
+	0x3c, 0x19, 0x59, 0xf3, 0xb6, 0x68, 0x6b, 0x95, 0xee, 0xc5, 0x68, 0xc1, 0xe2, 0xc1, 0x54, 0x56,
+	0x6a, 0xc1, 0x32, 0x02, 0x9f, 0x66, 0xc9, 0x28, 0xa3, 0x66, 0x82, 0x0e, 0x41, 0xff, 0xa7, 0x25,
+	0x32, 0x6e, 0x38, 0xe5, 0x8c, 0x50, 0x05, 0xeb, 0xc0, 0x50, 0x05, 0x23, 0x74, 0xa0, 0xf4, 0xa8,
+	0x43, 0x07, 0xca, 0x0f, 0x3f, 0x74, 0xc0, 0x4c, 0xdc, 0x1c, 0x38, 0x4c, 0xe2, 0xa6, 0xdb, 0x22,
+	0x03, 0x6b, 0x7e, 0xb0, 0x73, 0xb8, 0x25, 0x2a, 0xae, 0x85, 0x9d, 0x9e, 0x25, 0xaa, 0x8a, 0x40,
+	0xe0, 0x38, 0xb9, 0x8d, 0x96, 0xf3, 0xb7, 0x51, 0xf7, 0xb7, 0x2d, 0x72, 0x62, 0x9d, 0xb6, 0x43,
+	0xff, 0x0d, 0x2f, 0x4d, 0x57, 0xc0, 0x46, 0x4d, 0x91, 0x33, 0x3b, 0x9c, 0x36, 0xba, 0x82, 0x45,
+	0xb2, 0x9a, 0xfe, 0x41, 0xf6, 0x79, 0x96, 0xcd, 0x87, 0x47, 0xa9, 0xab, 0xe9, 0x99, 0x26, 0x0d,
+	0xdf, 0x97, 0x08, 0x48, 0x69, 0xec, 0xbf, 0x26, 0x1a, 0x60, 0x22, 0x86, 0x33, 0x60, 0xa4, 0x35,
+	0x8f, 0x2c, 0x48, 0xc4, 0x3d, 0xfd, 0x07, 0xa4, 0x0d, 0xdc, 0x3f, 0xb0, 0xc8, 0x10, 0x1f, 0x02,
+	0x95, 0x3d, 0xb3, 0xfa, 0xf4, 0xac, 0x49, 0x2a, 0xac, 0x9d, 0x98, 0x8c, 0xcb, 0x05, 0xf8, 0xb8,
+	0x90, 0x1d, 0xff, 0x74, 0xd8, 0xbf, 0xc0, 0x05, 0x30, 0x5d, 0xd1, 0xbb, 0x3d, 0xa7, 0xf2, 0x3c,
+	0x52, 0x5d, 0x91, 0x41, 0x41, 0x60, 0xf1, 0x2d, 0x7b, 0xdd, 0x24, 0x14, 0xc1, 0x74, 0xea, 0x2d,
+	0xb3, 0x44, 0x66, 0x86, 0x71, 0xbf, 0x54, 0x26, 0xc3, 0x32, 0x78, 0x8b, 0x17, 0x97, 0x09, 0x82,
+	0x30, 0xf1, 0x78, 0x6c, 0x13, 0x5f, 0x3e, 0x3f, 0xf8, 0xe0, 0xe3, 0x90, 0x12, 0x66, 0xe7, 0x52,
+	0xee, 0x3c, 0x84, 0x20, 0xb5, 0x0c, 0xa6, 0x18, 0xd0, 0x3b, 0x81, 0x95, 0x3f, 0x5a, 0xb8, 0xac,
+	0xc8, 0xd5, 0xf4, 0x46, 0x81, 0xdd, 0x61, 0xeb, 0x95, 0xe8, 0x89, 0x7a, 0x86, 0x1c, 0x08, 0x42,
+	0xea, 0xf4, 0x7b, 0xc9, 0x54, 0xb6, 0xd7, 0x07, 0x95, 0x40, 0x1d, 0xd1, 0x2b, 0xa8, 0xfe, 0x84,
+	0x58, 0x16, 0x8f, 0xde, 0xd4, 0x7d, 0x85, 0x8c, 0xae, 0xd3, 0x24, 0xf2, 0x6b, 0x8c, 0xc1, 0x41,
+	0xd3, 0xef, 0x30, 0x3a, 0x85, 0xfb, 0x59, 0x36, 0x9d, 0x91, 0x27, 0x2b, 0xf4, 0xd5, 0x89, 0x42,
+	0x3c, 0x56, 0xd0, 0xae, 0x7c, 0xd9, 0x05, 0xe8, 0xb9, 0x1b, 0x8a, 0x27, 0x8f, 0x7a, 0x49, 0x7f,
+	0x83, 0x26, 0xcf, 0x7d, 0x8e, 0x54, 0xd6, 0xbb, 0x09, 0xbd, 0x7d, 0xf0, 0x52, 0xe4, 0x7e, 0x90,
+	0x8c, 0x31, 0xd2, 0x2b, 0x61, 0x0b, 0x37, 0x2e, 0x1c, 0x69, 0x1b, 0x7f, 0x67, 0x9d, 0x43, 0x8c,
+	0x08, 0x38, 0x0e, 0xbf, 0x91, 0x66, 0xd8, 0xaa, 0xab, 0x94, 0x5f, 0xf5, 0x7e, 0xaf, 0x30, 0x28,
+	0x08, 0xac, 0xfb, 0xd3, 0x25, 0x32, 0xca, 0x1a, 0x8a, 0xd5, 0x69, 0x8f, 0x0c, 0x35, 0xb9, 0x1c,
+	0xf1, 0x48, 0x0a, 0x08, 0xd2, 0xd5, 0x7b, 0xaf, 0x29, 0xff, 0x1c, 0x00, 0x52, 0x1e, 0x8a, 0xbe,
+	0xe5, 0xf9, 0x18, 0x96, 0xea, 0x94, 0x8e, 0x57, 0xf4, 0x4d, 0x2e, 0x06, 0xa4, 0x3c, 0xf7, 0x3f,
+	0x59, 0x84, 0x60, 0x5a, 0x03, 0xd0, 0x18, 0x0b, 0xaa, 0xfc, 0xa8, 0x59, 0x06, 0x62, 0x3a, 0x5b,
+	0x06, 0x62, 0x04, 0x69, 0xef, 0xb7, 0x04, 0x84, 0x96, 0x5a, 0x54, 0x7e, 0x38, 0xa9, 0x45, 0xff,
+	0xf2, 0x04, 0x1f, 0x9d, 0x78, 0xc5, 0xd3, 0xa4, 0xe4, 0x4b, 0xcb, 0x0e, 0x11, 0xdd, 0x2c, 0xad,
+	0x2c, 0x42, 0xc9, 0xaf, 0xab, 0xd9, 0x58, 0xea, 0xbb, 0x31, 0xbe, 0x8b, 0x8c, 0xd6, 0xfd, 0xb8,
+	0xd3, 0xf2, 0xf6, 0xae, 0xe6, 0x98, 0xd5, 0x16, 0x53, 0x14, 0xe8, 0x74, 0xf6, 0xf3, 0x22, 0x5f,
+	0x70, 0xc0, 0x30, 0xa5, 0xc8, 0x7c, 0xc1, 0x61, 0xec, 0x9e, 0x96, 0x2a, 0xf8, 0x12, 0x19, 0x93,
+	0x5b, 0xfd, 0xd5, 0x34, 0xa9, 0x43, 0x39, 0x84, 0x36, 0x35, 0x1c, 0x18, 0x94, 0x3d, 0x8a, 0xc9,
+	0xe0, 0xc3, 0x57, 0x4c, 0xde, 0x43, 0xc6, 0xe5, 0x4f, 0xa6, 0x2d, 0x38, 0xa7, 0x4c, 0xa7, 0xd3,
+	0xa6, 0x8e, 0x04, 0x93, 0x36, 0x9d, 0x7a, 0x43, 0x87, 0x9d, 0x7a, 0x97, 0x08, 0xd9, 0x0a, 0xbb,
+	0x41, 0xdd, 0x8b, 0xb0, 0xb8, 0xea, 0xb0, 0xa9, 0x07, 0xcd, 0x2b, 0x0c, 0x68, 0x54, 0x47, 0xc8,
+	0x0f, 0xc1, 0xba, 0x22, 0x2c, 0x5a, 0x9c, 0xd5, 0x15, 0x21, 0xf7, 0x5f, 0x57, 0xa4, 0x2a, 0x99,
+	0x40, 0xca, 0xcf, 0xfe, 0x30, 0x21, 0xdb, 0x7e, 0xe0, 0xc7, 0x4d, 0xc6, 0x7d, 0xf4, 0xc8, 0xdc,
+	0xd5, 0x38, 0x97, 0x14, 0x17, 0xd0, 0x38, 0x62, 0xbc, 0x3e, 0x8d, 0x13, 0xbf, 0xed, 0x25, 0xb4,
+	0xae, 0xb2, 0xe4, 0x1d, 0x66, 0x52, 0x50, 0xf1, 0xfa, 0x97, 0xb3, 0x04, 0xf7, 0xf2, 0x80, 0xd0,
+	0xcb, 0xc8, 0x7e, 0x89, 0x0c, 0x77, 0xa2, 0xb0, 0x81, 0xca, 0xa5, 0x33, 0x6d, 0xd8, 0x1c, 0x86,
+	0x37, 0x04, 0xfc, 0x9e, 0xf6, 0x3f, 0x28, 0x6a, 0xfb, 0xfb, 0x16, 0xab, 0x20, 0xc2, 0x4e, 0xa0,
+	0xb1, 0xea, 0xd8, 0x69, 0xb6, 0xea, 0xd5, 0x8a, 0xb8, 0xf6, 0x40, 0x7e, 0xec, 0xb3, 0x90, 0x95,
+	0xc2, 0xb7, 0x7b, 0xaa, 0x95, 0x29, 0x31, 0xf1, 0xf7, 0xf2, 0x80, 0x9f, 0xfe, 0xf6, 0xcc, 0x4c,
+	0xef, 0xcd, 0x1d, 0x8a, 0x39, 0x7e, 0x79, 0x7f, 0xf3, 0xdb, 0x33, 0x53, 0xf2, 0x77, 0xfa, 0xd0,
+	0x7a, 0x06, 0x89, 0xbb, 0x57, 0x27, 0xac, 0xaf, 0x6c, 0x38, 0x63, 0xe6, 0xee, 0xb5, 0x81, 0x40,
+	0xe0, 0x38, 0x0c, 0x6d, 0xa8, 0x7b, 0xb4, 0x1d, 0x06, 0xb4, 0xee, 0x8c, 0xa7, 0xa1, 0x0d, 0x8b,
+	0x02, 0x06, 0x0a, 0x6b, 0xb7, 0x30, 0x62, 0x9b, 0x2d, 0xa6, 0x13, 0x45, 0x79, 0x29, 0xf9, 0x59,
+	0x5a, 0xc6, 0x6b, 0xe3, 0xff, 0x20, 0x64, 0xe8, 0x6b, 0xf7, 0xe4, 0x43, 0x59, 0xbb, 0xf1, 0x49,
+	0xd4, 0x9a, 0x7e, 0xab, 0x1e, 0xd1, 0xc0, 0x99, 0x62, 0x47, 0xc9, 0x31, 0x5e, 0x69, 0x9b, 0xc3,
+	0x40, 0x61, 0xed, 0x1f, 0x27, 0xe3, 0x61, 0x37, 0x61, 0x1f, 0x39, 0xbe, 0xff, 0x58, 0xf8, 0x83,
+	0x59, 0xfc, 0xdd, 0x35, 0x1d, 0x01, 0x26, 0x1d, 0x2e, 0xb6, 0xcd, 0x30, 0x4e, 0xf0, 0x07, 0x5b,
+	0x6c, 0xcf, 0x98, 0x8b, 0xed, 0x15, 0x0d, 0x07, 0x06, 0x25, 0xe6, 0xf5, 0x9c, 0x68, 0x67, 0x0f,
+	0x38, 0xce, 0x59, 0xf6, 0x64, 0xaa, 0x45, 0x28, 0xaa, 0x19, 0xd6, 0xdc, 0xe8, 0xd9, 0x03, 0x86,
+	0xde, 0x4e, 0xb0, 0xf2, 0x70, 0xf1, 0x5e, 0x50, 0x6b, 0x46, 0x61, 0x60, 0x76, 0xef, 0xf1, 0x0b,
+	0x56, 0x31, 0x6a, 0x3d, 0xfb, 0xca, 0xf2, 0x44, 0xcc, 0x3f, 0x8e, 0x21, 0x17, 0xb9, 0x28, 0xc8,
+	0xef, 0x94, 0xbd, 0x46, 0xc6, 0x31, 0x7e, 0xb5, 0x1b, 0x51, 0xa0, 0x5e, 0x1c, 0x06, 0xce, 0x13,
+	0x46, 0xed, 0xe9, 0xf1, 0x25, 0x1d, 0x79, 0x2f, 0x0b, 0x00, 0xb3, 0x31, 0x0b, 0xee, 0x64, 0xf9,
+	0xcc, 0x8b, 0xb4, 0xc6, 0x63, 0x78, 0xce, 0x15, 0xe5, 0xc2, 0x04, 0x9d, 0xad, 0x96, 0x48, 0x2d,
+	0x41, 0x60, 0x0a, 0x9e, 0x5e, 0x24, 0x67, 0xf2, 0x97, 0xa0, 0x83, 0x8e, 0x02, 0x65, 0xfd, 0x28,
+	0xb0, 0x44, 0x1e, 0xef, 0xfb, 0xb4, 0x71, 0x33, 0x93, 0x7a, 0xa3, 0x65, 0x6e, 0x66, 0x3d, 0x7a,
+	0xde, 0x04, 0x19, 0xd3, 0xaf, 0x84, 0x71, 0x7f, 0xaf, 0x42, 0x46, 0xb5, 0x4a, 0xb1, 0xb8, 0x97,
+	0x46, 0x69, 0x01, 0x3d, 0xcb, 0xdc, 0x4b, 0xb5, 0x32, 0x77, 0x1a, 0x15, 0x9e, 0x4b, 0x12, 0xaf,
+	0x91, 0x3d, 0xb0, 0x6f, 0x7a, 0x0d, 0xbc, 0xd3, 0xa6, 0x81, 0x8a, 0x78, 0xdd, 0x6f, 0x50, 0x11,
+	0xb5, 0xac, 0x29, 0xe2, 0x8b, 0x0c, 0x0a, 0x02, 0x6b, 0xb7, 0x89, 0x5d, 0x0f, 0x6b, 0x3b, 0x54,
+	0x44, 0x20, 0xde, 0x4f, 0x41, 0x1c, 0xe6, 0x46, 0x5c, 0xec, 0x61, 0x02, 0x39, 0x8c, 0x31, 0xe9,
+	0x9e, 0xb4, 0x69, 0xdd, 0xf7, 0x50, 0xeb, 0xe2, 0x79, 0x17, 0x85, 0x5c, 0x06, 0xa2, 0x3d, 0xcd,
+	0xd9, 0x75, 0xc5, 0x9f, 0x6f, 0x3a, 0x69, 0x4d, 0x7d, 0x85, 0x00, 0xad, 0x13, 0xf6, 0xdf, 0xcb,
+	0x1c, 0xc0, 0x79, 0x66, 0xc7, 0x87, 0x8b, 0xed, 0xd4, 0x7d, 0x9c, 0xc1, 0x2f, 0x92, 0x91, 0x4e,
+	0xcb, 0xf3, 0x03, 0xb4, 0x1d, 0x33, 0xbd, 0x6c, 0x38, 0x55, 0x6b, 0x36, 0x24, 0x02, 0x52, 0x1a,
+	0xbc, 0x36, 0x24, 0x33, 0xf6, 0x23, 0x9d, 0x99, 0x1f, 0xf0, 0xcc, 0xcd, 0x02, 0x4e, 0xb5, 0x62,
+	0xae, 0x68, 0x2a, 0x0c, 0xab, 0x85, 0x47, 0x6e, 0x5e, 0xab, 0xf6, 0x44, 0x6e, 0x2a, 0x10, 0xa4,
+	0x02, 0x0f, 0x13, 0x70, 0x9a, 0x5b, 0x79, 0xf6, 0x11, 0x77, 0xfb, 0xc8, 0x01, 0xa7, 0xff, 0x61,
+	0x80, 0xa4, 0x9c, 0x8e, 0x58, 0xa3, 0x2b, 0x0d, 0x4f, 0x2d, 0xed, 0x1b, 0x9e, 0x5a, 0x27, 0x93,
+	0x1e, 0xf3, 0x34, 0xdf, 0x67, 0x65, 0x2e, 0x16, 0x39, 0x31, 0x67, 0x72, 0x80, 0x2c, 0x4b, 0x94,
+	0x12, 0xa7, 0x4d, 0x8f, 0xbe, 0x0e, 0x31, 0x29, 0x55, 0x93, 0x03, 0x64, 0x59, 0xda, 0x1f, 0x22,
+	0x8e, 0x08, 0xe5, 0x63, 0x63, 0x5b, 0xd9, 0xbe, 0x1a, 0x26, 0x1b, 0x11, 0x8d, 0x69, 0x90, 0x88,
+	0xfa, 0x5c, 0x17, 0xc4, 0x53, 0x70, 0x16, 0xfa, 0xd0, 0x41, 0x5f, 0x0e, 0x78, 0x08, 0x63, 0xa1,
+	0x8d, 0x7e, 0xb2, 0xc7, 0x7c, 0xa1, 0xce, 0xa0, 0x79, 0x08, 0xab, 0xea, 0x48, 0x30, 0x69, 0xed,
+	0x9f, 0xb3, 0xc8, 0x78, 0x4b, 0xda, 0xe6, 0x01, 0x53, 0x83, 0x87, 0x8a, 0xf2, 0x3f, 0x5d, 0xab,
+	0x56, 0xd7, 0x74, 0xce, 0x7c, 0x0b, 0x35, 0x40, 0x60, 0xca, 0x46, 0x17, 0xe5, 0x54, 0xb6, 0x99,
+	0xbd, 0x43, 0x9e, 0x6c, 0x7b, 0xd1, 0xce, 0x4a, 0xb0, 0x1d, 0xb1, 0x44, 0xaf, 0x84, 0xbf, 0xd5,
+	0xb9, 0xed, 0x84, 0x46, 0x8b, 0xde, 0x1e, 0x4f, 0xe7, 0xa8, 0xa8, 0xdb, 0xdf, 0x9e, 0x5c, 0xdf,
+	0x8f, 0x18, 0xf6, 0xe7, 0x85, 0x51, 0xa6, 0x48, 0xc0, 0xae, 0x82, 0xf1, 0xc3, 0x20, 0x15, 0xc2,
+	0xef, 0x4a, 0x51, 0x51, 0xa6, 0xeb, 0x79, 0x44, 0x90, 0xdf, 0xd6, 0x1d, 0x26, 0x83, 0x3c, 0xc9,
+	0xd5, 0xfd, 0xf7, 0x25, 0x22, 0x15, 0xdf, 0xbf, 0xda, 0x1e, 0x2c, 0xdb, 0x25, 0x83, 0x11, 0x33,
+	0x41, 0x09, 0x65, 0x81, 0x9d, 0x41, 0xb8, 0x51, 0x0a, 0x04, 0x06, 0x4f, 0x04, 0xf4, 0xb6, 0x9f,
+	0x2c, 0xe0, 0xf5, 0x40, 0xe2, 0xa6, 0x27, 0xb6, 0xaa, 0x08, 0x18, 0x28, 0xac, 0xfb, 0x19, 0x8b,
+	0x8c, 0xe3, 0x28, 0x5b, 0x2d, 0xda, 0xc2, 0x5c, 0xa1, 0x18, 0x4b, 0x02, 0xc4, 0xf8, 0x4f, 0x71,
+	0xb6, 0xbd, 0x34, 0xb7, 0x99, 0x76, 0x34, 0x2f, 0x09, 0x0a, 0x01, 0x2e, 0xcb, 0xfd, 0x8d, 0x32,
+	0x19, 0x51, 0x0f, 0xfb, 0x50, 0xd7, 0xf1, 0xa8, 0xca, 0xc7, 0x7c, 0x35, 0x74, 0xb4, 0xaa, 0xc7,
+	0x68, 0x02, 0x99, 0x0b, 0xf6, 0x78, 0x59, 0x9b, 0xb4, 0x04, 0xf2, 0xf3, 0xa6, 0x77, 0xf6, 0x8c,
+	0xee, 0xf2, 0xd3, 0xe8, 0x39, 0x11, 0xc6, 0x7d, 0xa4, 0xae, 0xe5, 0x81, 0xa2, 0x76, 0x16, 0xe5,
+	0x44, 0xee, 0xef, 0x53, 0xce, 0xdc, 0x72, 0x55, 0x39, 0xd4, 0x2d, 0x57, 0xcf, 0x91, 0x01, 0x1a,
+	0x74, 0xdb, 0x4c, 0x1d, 0x1a, 0x61, 0x47, 0xa0, 0x81, 0xcb, 0x41, 0xb7, 0x6d, 0x8e, 0x8c, 0x91,
+	0xd8, 0xef, 0x25, 0xa3, 0x75, 0x1a, 0xd7, 0x22, 0x9f, 0x97, 0x2b, 0xe7, 0x36, 0xa4, 0x73, 0xcc,
+	0x30, 0x97, 0x82, 0xcd, 0x86, 0x7a, 0x03, 0xf7, 0x0d, 0x22, 0x2a, 0x7f, 0xdb, 0x1d, 0x32, 0xc8,
+	0x4b, 0xc4, 0x38, 0x56, 0x51, 0xe7, 0x6a, 0xfe, 0xb5, 0x6b, 0xf9, 0x9d, 0xec, 0x37, 0x08, 0x39,
+	0xee, 0x17, 0x2c, 0x32, 0x61, 0x16, 0x25, 0x3f, 0xc4, 0x5c, 0x61, 0x01, 0xde, 0xa8, 0xd6, 0x9a,
+	0x65, 0x8c, 0xb4, 0x00, 0x6f, 0x0d, 0x09, 0x26, 0xad, 0xd4, 0x4c, 0xca, 0x7d, 0x34, 0x93, 0xaf,
+	0x58, 0xc4, 0xe9, 0x57, 0x25, 0xfd, 0xb8, 0xbb, 0x66, 0x68, 0x1f, 0xe5, 0x43, 0x68, 0x1f, 0xff,
+	0xdc, 0x22, 0x68, 0x42, 0x59, 0x5e, 0xb0, 0x7f, 0xb2, 0xe7, 0x06, 0xa3, 0x1f, 0xca, 0xb9, 0xc1,
+	0x68, 0x9c, 0x11, 0xf7, 0x5e, 0x5e, 0x64, 0xb7, 0xc8, 0x38, 0x73, 0xfd, 0xa8, 0xda, 0x67, 0xdc,
+	0x9d, 0xf7, 0xe2, 0x21, 0x0b, 0x93, 0xe8, 0x4d, 0xc5, 0xde, 0xa6, 0x83, 0xc0, 0x64, 0xee, 0xfe,
+	0x8b, 0x01, 0xa2, 0x79, 0x48, 0x0e, 0xf1, 0x54, 0x5f, 0xcf, 0xf8, 0xc3, 0xd6, 0x0b, 0xf1, 0x87,
+	0x49, 0x27, 0x13, 0x5f, 0x70, 0x4d, 0x17, 0x18, 0x76, 0xaa, 0x49, 0x5b, 0x9d, 0x6c, 0xb1, 0xa2,
+	0x2b, 0xb4, 0xd5, 0x01, 0x86, 0x51, 0x29, 0xd6, 0x03, 0x7d, 0x53, 0xac, 0x9b, 0xa4, 0xd2, 0xc0,
+	0xcc, 0x1e, 0xa7, 0x52, 0x94, 0x73, 0x94, 0x25, 0x0a, 0x71, 0xe7, 0x28, 0xfb, 0x17, 0xb8, 0x00,
+	0x5c, 0xdb, 0x9a, 0x32, 0x68, 0xc5, 0x19, 0x2c, 0x6a, 0x6d, 0x53, 0x71, 0x30, 0x7c, 0x6d, 0x53,
+	0x3f, 0x21, 0x15, 0x86, 0xc6, 0xb1, 0x1a, 0x2f, 0x65, 0xe5, 0x0c, 0x15, 0x65, 0x1c, 0x13, 0xb5,
+	0xb1, 0x44, 0xe1, 0x24, 0xfe, 0x03, 0xa4, 0x18, 0xf7, 0x22, 0x19, 0xd5, 0x2e, 0x27, 0xc2, 0xd7,
+	0xa0, 0x4a, 0xe9, 0x68, 0xaf, 0x01, 0xa3, 0xbe, 0x80, 0x61, 0xdc, 0xdf, 0xb2, 0xc8, 0x64, 0xa6,
+	0x7c, 0x92, 0xbd, 0xdc, 0xf3, 0xbd, 0xbc, 0x3d, 0xe7, 0x7b, 0x39, 0x9b, 0x69, 0x96, 0xf3, 0xe5,
+	0x00, 0x39, 0x13, 0xf3, 0x58, 0x34, 0xa6, 0xe4, 0x2c, 0x84, 0xed, 0x0e, 0xd7, 0x79, 0x9c, 0x52,
+	0x5a, 0xdc, 0xa4, 0x9a, 0x4b, 0x01, 0x7d, 0x5a, 0xba, 0x9f, 0x2f, 0x11, 0x5b, 0x4a, 0xbe, 0x1c,
+	0xd7, 0xbc, 0x96, 0x2a, 0xe0, 0x8a, 0x26, 0x2f, 0x65, 0xa3, 0xd0, 0xa2, 0x25, 0x11, 0x0a, 0x02,
+	0x8b, 0x2b, 0x5c, 0xad, 0xd3, 0xcd, 0x9e, 0xbd, 0x16, 0x36, 0xae, 0x03, 0xc2, 0xd1, 0xb6, 0xdf,
+	0xf6, 0x6e, 0xf3, 0x36, 0x4e, 0xf9, 0xe0, 0x02, 0x44, 0xb3, 0xd2, 0x9c, 0x3b, 0xfb, 0x4a, 0xd7,
+	0x63, 0x15, 0x71, 0xf9, 0x74, 0x58, 0x97, 0x4c, 0x20, 0xe5, 0x67, 0x03, 0xf3, 0xd2, 0x2f, 0x6c,
+	0x5c, 0x77, 0x06, 0xee, 0x8b, 0x33, 0x11, 0x1e, 0x7d, 0xec, 0xad, 0xe0, 0xe4, 0xbe, 0x55, 0x21,
+	0xca, 0xc8, 0xac, 0x67, 0xac, 0x7b, 0x35, 0xad, 0x0c, 0xaf, 0x51, 0x2a, 0x25, 0x0c, 0x40, 0x60,
+	0x71, 0x41, 0x6e, 0xd3, 0xa8, 0xa1, 0xac, 0x3f, 0xd9, 0x05, 0x79, 0x5d, 0x47, 0x82, 0x49, 0x8b,
+	0xe7, 0xb9, 0xb6, 0x17, 0xf8, 0xdb, 0xa9, 0x21, 0x47, 0x9d, 0xe7, 0xd6, 0x05, 0x1c, 0x14, 0x05,
+	0x66, 0x50, 0xc5, 0x34, 0xb9, 0x76, 0x8b, 0x95, 0xf2, 0x13, 0x25, 0x5c, 0xb2, 0x05, 0xc2, 0xab,
+	0x59, 0x02, 0xe8, 0x6d, 0x93, 0x1b, 0x8d, 0x5c, 0x39, 0x72, 0x34, 0xf2, 0x22, 0x99, 0x12, 0x06,
+	0xc2, 0xbe, 0x31, 0xcd, 0x4b, 0x19, 0x3c, 0xf4, 0xb4, 0x60, 0x49, 0x7c, 0x2d, 0xaf, 0x11, 0x3b,
+	0x43, 0x5a, 0x12, 0x1f, 0x02, 0x80, 0xc3, 0xd1, 0x90, 0xbc, 0x8d, 0x41, 0xb6, 0xeb, 0x5e, 0xe0,
+	0x35, 0x68, 0xe4, 0x0c, 0x9b, 0x86, 0xe4, 0x25, 0x0d, 0x07, 0x06, 0x25, 0xb6, 0x44, 0x13, 0xdd,
+	0x52, 0x18, 0x01, 0xf5, 0xea, 0x7b, 0x22, 0xd9, 0x4c, 0xb5, 0xbc, 0xa9, 0xe1, 0xc0, 0xa0, 0xd4,
+	0xab, 0xb6, 0x91, 0x87, 0x5a, 0xb5, 0xcd, 0xfd, 0x4d, 0x8b, 0x70, 0xd3, 0xe7, 0xdc, 0x36, 0x7a,
+	0x9c, 0x92, 0x3d, 0xbc, 0xfc, 0x77, 0x0a, 0x8b, 0xe2, 0xcd, 0x05, 0x89, 0x2f, 0x81, 0xc5, 0xdd,
+	0x46, 0xc2, 0x64, 0x5d, 0xcd, 0xb0, 0xe7, 0x75, 0x88, 0xb2, 0x50, 0xe8, 0xe9, 0x86, 0xfb, 0x87,
+	0xb2, 0xb7, 0xd2, 0x32, 0x6b, 0xff, 0x38, 0x9e, 0x35, 0x98, 0xad, 0xd9, 0x32, 0xf2, 0x06, 0x06,
+	0xfb, 0x19, 0x99, 0x05, 0x39, 0x5e, 0x42, 0x1b, 0xc9, 0x72, 0x59, 0xe2, 0x12, 0x5a, 0x76, 0x6c,
+	0x65, 0x50, 0xfb, 0x7a, 0x5a, 0xc1, 0xbf, 0x7c, 0x94, 0x92, 0x66, 0xd2, 0x38, 0xdc, 0xa7, 0x4c,
+	0xff, 0xd3, 0x64, 0xa8, 0xd6, 0xf4, 0x82, 0x06, 0x8d, 0x45, 0x0e, 0x29, 0x7f, 0x29, 0x1c, 0x04,
+	0x12, 0xe7, 0x9e, 0x25, 0xa7, 0x73, 0x9f, 0x93, 0xfb, 0x9b, 0x25, 0xc2, 0xae, 0x36, 0xd8, 0x63,
+	0xa7, 0xe7, 0x1f, 0x23, 0x43, 0x7c, 0x30, 0x32, 0x3e, 0xef, 0x1c, 0xaf, 0x8f, 0xc9, 0x40, 0xbd,
+	0x23, 0x97, 0xc4, 0x98, 0x8c, 0x23, 0x4f, 0x57, 0x5c, 0x01, 0xa9, 0xf0, 0x05, 0x50, 0x1e, 0xbe,
+	0x62, 0x48, 0xf1, 0xf6, 0x4f, 0xa8, 0x75, 0xa9, 0x6c, 0xa8, 0x61, 0x62, 0x5d, 0xba, 0x77, 0x67,
+	0x66, 0x52, 0xf5, 0x28, 0xb3, 0x54, 0x7d, 0x82, 0x0c, 0x53, 0xbe, 0xda, 0xd3, 0xe2, 0xae, 0x71,
+	0xe9, 0xdd, 0x47, 0xc4, 0xc9, 0x51, 0x48, 0x02, 0x25, 0xd3, 0xfd, 0xfa, 0x00, 0x19, 0x3f, 0xf6,
+	0xbb, 0x1e, 0x16, 0xf1, 0xaa, 0xdb, 0x24, 0x92, 0x65, 0xd4, 0xf8, 0x6a, 0xec, 0xa6, 0x57, 0xdd,
+	0x2a, 0xd4, 0x3d, 0xf3, 0x27, 0xe8, 0xcd, 0xec, 0x8f, 0x65, 0xe7, 0x5b, 0x81, 0x37, 0x46, 0x9c,
+	0xd1, 0xa6, 0xe2, 0xbd, 0xbc, 0x59, 0x89, 0x45, 0xb8, 0xe5, 0x87, 0x3e, 0x50, 0xa8, 0x8b, 0x45,
+	0x7d, 0xe0, 0xbc, 0xa4, 0x82, 0xf8, 0x05, 0x4a, 0x5c, 0x26, 0xfc, 0xb1, 0x72, 0xa8, 0x7b, 0x2b,
+	0x3a, 0xa4, 0x12, 0xb1, 0x64, 0xde, 0xc1, 0xa2, 0xec, 0x2a, 0x6a, 0x02, 0xa7, 0x67, 0x7f, 0xfc,
+	0x15, 0x03, 0x17, 0x84, 0x31, 0xd4, 0x24, 0xbd, 0xd5, 0x0d, 0xef, 0xbc, 0x8b, 0x5f, 0x34, 0x2c,
+	0xba, 0x45, 0x54, 0x42, 0x12, 0x1c, 0xb5, 0x12, 0x0f, 0x02, 0x02, 0x4a, 0xda, 0x41, 0x56, 0xe8,
+	0x3f, 0xb5, 0xc8, 0xa9, 0xbc, 0xdb, 0xe7, 0x1e, 0x61, 0x8f, 0x8f, 0x6a, 0x80, 0x16, 0x0d, 0x36,
+	0x22, 0xba, 0xed, 0xdf, 0xce, 0x39, 0x33, 0x72, 0x04, 0xa4, 0x34, 0xee, 0xd7, 0x06, 0x89, 0x12,
+	0x7c, 0x4c, 0x06, 0xeb, 0x67, 0x70, 0x93, 0x69, 0xa4, 0x6b, 0xe0, 0x44, 0xba, 0xc9, 0x34, 0x7c,
+	0xbe, 0xa7, 0xe0, 0x5f, 0x34, 0x6a, 0xc9, 0x5c, 0x74, 0x19, 0xae, 0x89, 0x92, 0x65, 0xda, 0x3a,
+	0x28, 0x6c, 0x9e, 0x09, 0xbc, 0xf2, 0x50, 0x4c, 0xe0, 0x83, 0xc5, 0x9b, 0xc0, 0xf1, 0x22, 0xa6,
+	0xb0, 0x45, 0xe7, 0xe0, 0xaa, 0x33, 0x64, 0x7a, 0x2e, 0x81, 0x83, 0x41, 0xe2, 0x31, 0xec, 0xaa,
+	0x1b, 0xd3, 0xea, 0xe2, 0xea, 0x42, 0x44, 0xeb, 0xb1, 0x48, 0xef, 0x57, 0xae, 0xab, 0xeb, 0x29,
+	0x0a, 0x74, 0x3a, 0xfb, 0x6b, 0xd6, 0x3e, 0x56, 0xf6, 0x91, 0xa2, 0x54, 0x93, 0xdc, 0x8a, 0xc4,
+	0xf3, 0xe7, 0xee, 0xd3, 0x74, 0xff, 0x25, 0x8b, 0x9c, 0x48, 0xef, 0xbe, 0x13, 0xdc, 0x1c, 0x52,
+	0x54, 0xe5, 0x9c, 0xea, 0x8b, 0x97, 0xb3, 0xcc, 0x79, 0x5c, 0x41, 0x0f, 0x18, 0x7a, 0xbb, 0xe1,
+	0xfe, 0xf7, 0x12, 0x39, 0x99, 0xc3, 0x81, 0xa5, 0x8d, 0xb6, 0x71, 0x02, 0xad, 0xd4, 0xb3, 0x9f,
+	0xcf, 0xaa, 0x80, 0x83, 0xa2, 0xb0, 0x37, 0xc8, 0xa9, 0x9d, 0x76, 0x9c, 0x72, 0xc1, 0xd2, 0x68,
+	0xf4, 0x76, 0xb6, 0xd6, 0xcd, 0xa9, 0xd5, 0x1c, 0x1a, 0xc8, 0x6d, 0x89, 0x2a, 0x3e, 0x0d, 0xbc,
+	0xad, 0x16, 0x4d, 0x51, 0x22, 0xe9, 0x59, 0xa9, 0xf8, 0x97, 0x33, 0x78, 0xe8, 0x69, 0x81, 0x81,
+	0x03, 0x4f, 0xc4, 0x34, 0xda, 0xa5, 0x51, 0xd5, 0xaf, 0xd3, 0x85, 0x6e, 0x9c, 0x84, 0x6d, 0x1a,
+	0xdd, 0xa7, 0x1b, 0x68, 0xe6, 0xee, 0x9d, 0x99, 0x27, 0xaa, 0xfd, 0xb9, 0xc1, 0x7e, 0xa2, 0xdc,
+	0x8f, 0x92, 0x61, 0x79, 0xf3, 0xc5, 0x31, 0x5d, 0xe1, 0xfe, 0x39, 0x8b, 0x4c, 0x54, 0x99, 0xe9,
+	0x53, 0x1d, 0x25, 0x8b, 0xae, 0x9d, 0xff, 0x8c, 0xaa, 0x40, 0x96, 0x59, 0x26, 0xcd, 0x9a, 0x61,
+	0xee, 0x6b, 0x64, 0xaa, 0x4a, 0xdb, 0x5e, 0xa7, 0xc9, 0xaa, 0x70, 0xf0, 0x70, 0x58, 0xbc, 0x39,
+	0x5f, 0xc2, 0xb2, 0x57, 0x2b, 0x2a, 0x62, 0x48, 0x69, 0x50, 0x45, 0xe6, 0x41, 0xbd, 0xb1, 0x5e,
+	0xc0, 0x80, 0xc7, 0xfb, 0xc6, 0x20, 0x71, 0xee, 0x2d, 0x32, 0x96, 0x36, 0xa7, 0xdb, 0x76, 0x83,
+	0x4c, 0xea, 0xf7, 0x11, 0xa4, 0x99, 0x61, 0x87, 0xcf, 0x5f, 0x66, 0xab, 0xdd, 0x82, 0xc9, 0x04,
+	0xb2, 0x5c, 0xdd, 0x2f, 0x96, 0xc8, 0xa4, 0x92, 0x2c, 0x62, 0x37, 0x3e, 0x9e, 0x0d, 0x37, 0x2e,
+	0xc0, 0xc5, 0x96, 0x7d, 0x92, 0xfb, 0x84, 0x1c, 0x7f, 0x3c, 0x1b, 0x72, 0x7c, 0xac, 0xe2, 0x7b,
+	0xc2, 0x51, 0x7e, 0xad, 0x44, 0x86, 0x55, 0x9d, 0xc6, 0x57, 0x48, 0x85, 0xd9, 0xb5, 0x1e, 0x4c,
+	0xc3, 0x66, 0x36, 0x32, 0xe0, 0x9c, 0x90, 0x25, 0x8b, 0xb5, 0x74, 0x4a, 0x0f, 0xc2, 0x92, 0x45,
+	0x6e, 0x02, 0xe7, 0x64, 0xaf, 0x92, 0x32, 0x15, 0x85, 0xdb, 0xef, 0x87, 0x21, 0x2b, 0xfd, 0x71,
+	0x39, 0xa8, 0x03, 0x72, 0x61, 0x75, 0x48, 0xf4, 0x94, 0xcd, 0x09, 0x33, 0x65, 0x53, 0x25, 0x69,
+	0xfe, 0x5c, 0x99, 0x0c, 0x62, 0x01, 0x10, 0x3f, 0xb1, 0x7f, 0xd5, 0x22, 0x27, 0x6f, 0x65, 0x2e,
+	0x2c, 0x49, 0xa7, 0xec, 0xf5, 0xe2, 0x6f, 0x83, 0xc1, 0x78, 0x5f, 0x75, 0x51, 0x54, 0x0e, 0x12,
+	0xf2, 0xba, 0x63, 0x14, 0x51, 0x2f, 0x1f, 0xd3, 0x35, 0x38, 0xc7, 0x9b, 0xff, 0x35, 0xde, 0x2f,
+	0xf7, 0xcb, 0xfd, 0x7e, 0x85, 0x10, 0xfe, 0x36, 0xae, 0x75, 0x92, 0xc3, 0xd8, 0xec, 0x5f, 0x22,
+	0x63, 0x0d, 0x1a, 0xd0, 0x48, 0x46, 0x73, 0x97, 0x4c, 0xbb, 0xd0, 0xb2, 0x86, 0x03, 0x83, 0x92,
+	0x1d, 0x72, 0x30, 0xfc, 0x85, 0xab, 0xa5, 0xd9, 0x1c, 0x2f, 0x85, 0x01, 0x8d, 0xca, 0x9e, 0x35,
+	0x3c, 0xc8, 0xbc, 0xa0, 0xec, 0xc4, 0x3e, 0x0e, 0xdf, 0xf7, 0x90, 0x71, 0xf5, 0x6b, 0xc9, 0x6f,
+	0xd1, 0x6c, 0xa4, 0xc0, 0x86, 0x8e, 0x04, 0x93, 0x16, 0xd3, 0xd1, 0xcd, 0x62, 0x5e, 0xce, 0x90,
+	0x99, 0x8e, 0x6e, 0xd6, 0x00, 0x83, 0x0c, 0x35, 0x8b, 0x0e, 0xc3, 0x03, 0x54, 0x20, 0x34, 0xba,
+	0x34, 0x3a, 0x8c, 0x41, 0x41, 0x60, 0xf1, 0x11, 0xf2, 0xcd, 0x92, 0xc3, 0xb3, 0x06, 0xb2, 0xaa,
+	0x86, 0x03, 0x83, 0x12, 0x25, 0x08, 0x87, 0x49, 0xa6, 0x06, 0x53, 0xc6, 0xcb, 0xd1, 0x21, 0x13,
+	0xa1, 0x69, 0xaf, 0xe4, 0xe1, 0xd8, 0xef, 0x3c, 0xe4, 0xbc, 0x35, 0xda, 0xf2, 0x84, 0x6d, 0x13,
+	0x06, 0x19, 0xfe, 0xac, 0x56, 0x92, 0x16, 0xed, 0x35, 0x96, 0xa9, 0x95, 0xd4, 0x2f, 0x1a, 0x6b,
+	0x83, 0x9c, 0xea, 0x84, 0xf5, 0x8d, 0xc8, 0x0f, 0x31, 0x60, 0x63, 0xa1, 0xe5, 0xc5, 0x31, 0x9b,
+	0x55, 0xe3, 0xa6, 0xee, 0xb4, 0x91, 0x43, 0x03, 0xb9, 0x2d, 0xf1, 0xf0, 0xd1, 0x11, 0x40, 0x16,
+	0x45, 0x5c, 0xe1, 0x87, 0x0f, 0x49, 0x08, 0x0a, 0xeb, 0x9e, 0x24, 0x27, 0xaa, 0xdd, 0x4e, 0xa7,
+	0xe5, 0xd3, 0xba, 0x72, 0xef, 0xba, 0xef, 0x23, 0x93, 0xa2, 0x3e, 0xbb, 0xd2, 0x23, 0x8e, 0x74,
+	0x37, 0x9c, 0xfb, 0x7d, 0x8b, 0x4c, 0x66, 0x42, 0x1b, 0x31, 0x0c, 0xc1, 0xdc, 0xfd, 0x0b, 0xf1,
+	0xd6, 0xeb, 0x1b, 0x3f, 0xff, 0xc2, 0x73, 0x35, 0x89, 0xa6, 0xcc, 0x30, 0x2a, 0x2c, 0x95, 0x8f,
+	0xe5, 0xe1, 0xf0, 0xed, 0x44, 0x4f, 0x53, 0x72, 0x3f, 0x5b, 0x22, 0xf9, 0x81, 0xb2, 0xf6, 0x27,
+	0x7a, 0x1f, 0xc0, 0x2b, 0x05, 0x3e, 0x00, 0x2e, 0x65, 0x9f, 0x67, 0x10, 0x98, 0xcf, 0x60, 0xbd,
+	0xa0, 0x67, 0x20, 0xe4, 0xf6, 0x3e, 0x89, 0x3f, 0xb7, 0xc8, 0xe8, 0xe6, 0xe6, 0x5a, 0xf5, 0x60,
+	0x6f, 0x92, 0x75, 0xbf, 0xde, 0x24, 0x7b, 0x85, 0x9c, 0xd4, 0x31, 0xc2, 0x73, 0x20, 0xd4, 0x65,
+	0x5e, 0x78, 0xae, 0x17, 0x0d, 0x79, 0x6d, 0xb2, 0xac, 0x84, 0xbd, 0xd4, 0x29, 0xe7, 0xb3, 0x12,
+	0x68, 0xc8, 0x6b, 0x83, 0xb7, 0x42, 0x8f, 0x6e, 0x7a, 0x91, 0x1a, 0xf9, 0xfb, 0xc9, 0x54, 0x2d,
+	0x6c, 0x4b, 0xa3, 0xd5, 0x1a, 0xdd, 0xa5, 0x2d, 0x31, 0x66, 0x66, 0xec, 0x5e, 0xc8, 0xe0, 0xa0,
+	0x87, 0xda, 0x5e, 0x26, 0xa3, 0x1a, 0x4c, 0xec, 0x37, 0x32, 0x14, 0x6a, 0x54, 0x63, 0x80, 0x45,
+	0x9b, 0x37, 0xbd, 0x48, 0x83, 0x80, 0xde, 0x12, 0xeb, 0xb2, 0x69, 0xd7, 0x34, 0x88, 0xd1, 0x4d,
+	0x72, 0x26, 0x0a, 0x0c, 0x3a, 0x8d, 0xfb, 0xc7, 0xe7, 0x89, 0x4a, 0xd7, 0x3e, 0xc4, 0xde, 0xd8,
+	0x51, 0xf9, 0x0b, 0x95, 0x82, 0xf3, 0x17, 0xd4, 0x42, 0x9f, 0xc9, 0x61, 0x48, 0xd2, 0x1c, 0x86,
+	0xc1, 0xa2, 0x73, 0x18, 0x94, 0xaa, 0xdb, 0x93, 0xc7, 0xf0, 0x8b, 0x16, 0x19, 0x43, 0xa7, 0x84,
+	0x0a, 0x2b, 0x18, 0x62, 0xfa, 0xf6, 0x87, 0x8a, 0x4b, 0xcc, 0x9a, 0xbd, 0xaa, 0xb1, 0xe7, 0xa1,
+	0xbd, 0x6a, 0x7f, 0xd4, 0x51, 0x60, 0xf4, 0xc3, 0x5e, 0xd2, 0x4c, 0xb8, 0xbc, 0x38, 0xfb, 0xb9,
+	0xbc, 0x73, 0xcf, 0x81, 0xf6, 0xd8, 0xdb, 0x9a, 0xc6, 0x37, 0x52, 0x94, 0xa1, 0x50, 0xa6, 0xea,
+	0x6a, 0xce, 0x46, 0x01, 0xd1, 0x34, 0x41, 0x97, 0x0c, 0xf2, 0x74, 0x18, 0xb6, 0xc3, 0x0f, 0x73,
+	0xc7, 0x29, 0x4f, 0x95, 0x01, 0x81, 0xb1, 0x13, 0x19, 0xf8, 0x35, 0x5a, 0xd4, 0xe5, 0x5c, 0x46,
+	0x60, 0x59, 0x7e, 0xe4, 0x97, 0xfd, 0xb2, 0x7e, 0x9c, 0x1e, 0x3b, 0xcc, 0x71, 0x7a, 0xbc, 0xef,
+	0x51, 0xfa, 0xf3, 0x16, 0x19, 0xab, 0x69, 0xb7, 0x8f, 0x39, 0xcf, 0x16, 0x75, 0xff, 0x63, 0xde,
+	0x9d, 0x66, 0xbc, 0xde, 0x89, 0x8e, 0x01, 0x43, 0x3a, 0xab, 0x2d, 0xce, 0x6c, 0x07, 0xce, 0x78,
+	0x51, 0x85, 0xa4, 0x4d, 0x5b, 0x04, 0x7f, 0x8d, 0x1c, 0x06, 0x42, 0x96, 0xfd, 0x26, 0x96, 0x65,
+	0x15, 0x16, 0x85, 0x89, 0xa2, 0x42, 0x52, 0xb3, 0x0e, 0x75, 0x59, 0x46, 0x96, 0x43, 0x41, 0x49,
+	0xb4, 0x9b, 0xa4, 0x5c, 0xf7, 0x1a, 0xce, 0x64, 0x51, 0x1b, 0xa2, 0x56, 0x76, 0x9e, 0x1f, 0x0c,
+	0x17, 0xe7, 0x96, 0x01, 0x45, 0xa0, 0x57, 0x57, 0xde, 0x84, 0x33, 0x55, 0xd8, 0xd6, 0x6f, 0xea,
+	0x68, 0xdc, 0x3a, 0xd2, 0x73, 0xb1, 0x4e, 0x5d, 0xc4, 0x90, 0xfc, 0xf0, 0x05, 0xab, 0x98, 0x5b,
+	0x25, 0x30, 0xfa, 0x84, 0x3b, 0x49, 0xd3, 0x38, 0x14, 0x94, 0xc2, 0x8a, 0x90, 0xff, 0x48, 0x51,
+	0x52, 0x30, 0xed, 0xa0, 0xa7, 0xf4, 0x78, 0x8b, 0x0c, 0x76, 0x58, 0xfc, 0x9a, 0xf3, 0xf6, 0xa2,
+	0xf6, 0x16, 0x1e, 0x0f, 0xc7, 0xe7, 0x26, 0xff, 0x1f, 0x84, 0x0c, 0xfb, 0x32, 0x19, 0xe2, 0x97,
+	0xe6, 0xf1, 0xcc, 0xb3, 0xd1, 0x4b, 0xd3, 0xfd, 0xaf, 0xde, 0x4b, 0x37, 0x0a, 0xfe, 0x3b, 0x06,
+	0xd9, 0xd6, 0xfe, 0xa2, 0x45, 0x26, 0x70, 0x45, 0x5d, 0x48, 0x2f, 0x14, 0xb4, 0x8b, 0x5a, 0xb3,
+	0xb0, 0xc8, 0x5f, 0xba, 0xd6, 0xa8, 0x33, 0xda, 0x8a, 0x21, 0x0e, 0x32, 0xe2, 0xed, 0x8f, 0x93,
+	0xe1, 0xd8, 0xaf, 0xd3, 0x9a, 0x17, 0x61, 0x29, 0xd4, 0x63, 0xe9, 0x4a, 0xea, 0x07, 0x12, 0x82,
+	0x40, 0x89, 0xc4, 0x4c, 0x9d, 0x49, 0x2f, 0xaa, 0x35, 0xfd, 0x5d, 0xba, 0x16, 0xd6, 0xf8, 0x99,
+	0xe2, 0x54, 0x51, 0xdf, 0xbe, 0xf4, 0x78, 0x49, 0xce, 0xc2, 0x3d, 0x62, 0x8a, 0x83, 0xac, 0x7c,
+	0xfb, 0x6f, 0x58, 0xe4, 0x34, 0xbf, 0x80, 0x28, 0x7b, 0xfb, 0xd4, 0xe9, 0xfb, 0x34, 0x0c, 0xb1,
+	0x94, 0xb9, 0xb9, 0x3c, 0x96, 0x90, 0x2f, 0x29, 0x4d, 0x72, 0x53, 0xf1, 0x3c, 0x67, 0x0a, 0xf5,
+	0xc0, 0x1e, 0xe1, 0xb6, 0x50, 0x2c, 0xdf, 0x2b, 0xb6, 0x43, 0x3f, 0x6e, 0xb3, 0x04, 0xc8, 0xb2,
+	0x28, 0xdf, 0x9b, 0x82, 0x41, 0xa7, 0x31, 0xae, 0xb3, 0x78, 0x6e, 0xbf, 0xeb, 0x2c, 0xec, 0xeb,
+	0x64, 0x34, 0x09, 0x5b, 0x34, 0x12, 0xc7, 0x64, 0x87, 0xcd, 0xc0, 0xf3, 0x79, 0xdf, 0xd6, 0xa6,
+	0x22, 0x4b, 0x8f, 0xd1, 0x29, 0x2c, 0x06, 0x9d, 0x0f, 0x4b, 0x90, 0x10, 0x17, 0x3b, 0xf1, 0x02,
+	0xc7, 0x8f, 0x67, 0x12, 0x24, 0x74, 0x24, 0x98, 0xb4, 0x18, 0xdf, 0xd4, 0xe9, 0x39, 0x80, 0xf3,
+	0x14, 0x68, 0x15, 0xdf, 0xd4, 0x7b, 0xfa, 0xee, 0x6d, 0x63, 0x1c, 0xbd, 0x9f, 0xd8, 0xef, 0xe8,
+	0xdd, 0xa7, 0x22, 0xff, 0xb9, 0xfb, 0xa9, 0xc8, 0x6f, 0xd7, 0xc9, 0x39, 0xaf, 0x9b, 0x84, 0xac,
+	0xb0, 0x96, 0xd9, 0x84, 0xe7, 0x8a, 0x5c, 0xe0, 0xe9, 0x27, 0x77, 0xef, 0xcc, 0x9c, 0x9b, 0xdb,
+	0x87, 0x0e, 0xf6, 0xe5, 0x62, 0xbf, 0x81, 0x81, 0xfa, 0xfc, 0x56, 0x01, 0xe7, 0x87, 0x8a, 0x52,
+	0x12, 0xcc, 0x7b, 0x0a, 0x64, 0xe8, 0x3f, 0x87, 0x81, 0x92, 0x67, 0x6f, 0x92, 0xd1, 0x66, 0x18,
+	0x27, 0x73, 0x2d, 0xdf, 0x8b, 0x69, 0xec, 0x3c, 0x79, 0xa1, 0xdc, 0x4f, 0xf7, 0xba, 0x22, 0xc9,
+	0xd2, 0x39, 0x73, 0x25, 0x6d, 0x09, 0x3a, 0x1b, 0x9b, 0x92, 0x49, 0x99, 0x28, 0x23, 0x3d, 0x56,
+	0xe7, 0xd9, 0xc0, 0x9e, 0xc9, 0xe3, 0xbc, 0x11, 0xd6, 0xab, 0x26, 0xb5, 0x72, 0x8b, 0xea, 0x40,
+	0xc8, 0xf2, 0x44, 0x63, 0x57, 0x27, 0xac, 0xe3, 0xf5, 0x7c, 0x1b, 0x1e, 0x16, 0x8d, 0x9f, 0x31,
+	0xed, 0x85, 0x1b, 0x1a, 0x0e, 0x0c, 0x4a, 0x0c, 0x41, 0x6d, 0xf3, 0xf2, 0x2e, 0xce, 0x53, 0x45,
+	0x9d, 0x6d, 0x44, 0xbd, 0x18, 0xae, 0x2f, 0x88, 0x1f, 0x20, 0xc5, 0xd8, 0xff, 0xc4, 0x22, 0x93,
+	0x99, 0x94, 0x5e, 0xe7, 0x6d, 0x85, 0xa9, 0x2c, 0x26, 0xe3, 0xf9, 0x67, 0xd8, 0xe3, 0x33, 0x81,
+	0xf7, 0x7a, 0x41, 0x90, 0xed, 0x11, 0x7f, 0x2e, 0xac, 0x8a, 0x93, 0xf3, 0x74, 0x71, 0xcf, 0x85,
+	0x31, 0x94, 0xcf, 0x85, 0xfd, 0x00, 0x29, 0x06, 0x5d, 0xdb, 0xa2, 0x3e, 0xa3, 0xf3, 0x8c, 0xe9,
+	0xda, 0x16, 0x65, 0x1c, 0x41, 0xe2, 0xa7, 0xdf, 0x47, 0x4e, 0xf4, 0x1c, 0xdd, 0x8e, 0x94, 0xef,
+	0xf8, 0x4b, 0x68, 0x40, 0xd0, 0x8c, 0xef, 0x45, 0xdf, 0x0a, 0x87, 0xe5, 0xe9, 0xf9, 0x55, 0xe9,
+	0xbc, 0x9e, 0xc7, 0x40, 0xa6, 0x3c, 0xbd, 0x86, 0x03, 0x83, 0xd2, 0xfd, 0x6a, 0x89, 0xd8, 0xbd,
+	0x77, 0xf6, 0x64, 0x62, 0x77, 0xac, 0x43, 0xc5, 0xee, 0xa4, 0x35, 0xdb, 0x4b, 0xfb, 0xd6, 0x6c,
+	0x7f, 0x92, 0x94, 0xdb, 0x5e, 0x27, 0x9b, 0xd4, 0x80, 0xd5, 0x84, 0x11, 0x8e, 0xef, 0xa5, 0x11,
+	0x85, 0xdd, 0xce, 0xfc, 0x9e, 0x33, 0x60, 0xbe, 0x97, 0x65, 0x0e, 0x06, 0x89, 0xe7, 0xce, 0xcb,
+	0x28, 0x99, 0x97, 0x55, 0x6c, 0x35, 0xe7, 0x25, 0x42, 0x41, 0x60, 0xb1, 0xa2, 0x43, 0xad, 0xd9,
+	0x0d, 0x76, 0xc4, 0xfd, 0x90, 0xea, 0x28, 0xb8, 0x80, 0x40, 0xe0, 0x38, 0x66, 0xe8, 0xa6, 0xf5,
+	0x6e, 0x47, 0x16, 0x36, 0x49, 0x0d, 0xdd, 0x0c, 0x0a, 0x02, 0xeb, 0xfe, 0xba, 0x45, 0xc6, 0x0d,
+	0xdd, 0xa8, 0x70, 0x9f, 0xec, 0x12, 0xb1, 0xdb, 0x7e, 0x14, 0x85, 0x91, 0x7e, 0xe1, 0xb3, 0xb8,
+	0x40, 0x83, 0x65, 0x50, 0xaf, 0xf7, 0x60, 0x21, 0xa7, 0x85, 0xfb, 0xd5, 0x01, 0x92, 0xe6, 0xf8,
+	0xa8, 0x12, 0xec, 0x56, 0xdf, 0x12, 0xec, 0xcf, 0x93, 0x61, 0x2c, 0x39, 0xb8, 0x91, 0x16, 0x6a,
+	0x57, 0x73, 0xee, 0xe5, 0xea, 0xb5, 0xab, 0x8c, 0x52, 0x51, 0x30, 0xea, 0xd7, 0xf9, 0xab, 0xcd,
+	0xc6, 0x1b, 0xbf, 0xfc, 0x8a, 0x78, 0xe5, 0x8a, 0x02, 0x5f, 0x01, 0xc5, 0x7b, 0x6f, 0xc4, 0xc3,
+	0x4d, 0xaf, 0xa4, 0xe6, 0xb7, 0x8e, 0x31, 0x1c, 0x4b, 0x63, 0x96, 0xce, 0x8b, 0xec, 0x95, 0xca,
+	0xca, 0xc9, 0x01, 0x29, 0x0d, 0x53, 0x7c, 0x85, 0xb5, 0xdb, 0x19, 0x2c, 0xaa, 0xa8, 0x43, 0x8f,
+	0xfd, 0x9c, 0xef, 0x61, 0x12, 0x0c, 0x4a, 0x64, 0x9e, 0x63, 0x7a, 0xe4, 0x38, 0x1c, 0xd3, 0x7a,
+	0xc2, 0x59, 0xe5, 0xb0, 0x09, 0x67, 0xe6, 0x27, 0x3c, 0x7c, 0xa8, 0xea, 0x83, 0x3f, 0x53, 0x26,
+	0x43, 0xf2, 0x06, 0xec, 0xe7, 0xc8, 0xd0, 0x2e, 0xff, 0x37, 0x5b, 0xb4, 0x40, 0x50, 0x80, 0xc4,
+	0xe3, 0x7b, 0xdb, 0xea, 0xfa, 0xad, 0xfa, 0x62, 0xba, 0x5a, 0xa9, 0xf7, 0x36, 0x2f, 0x11, 0x90,
+	0xd2, 0x60, 0x83, 0x06, 0x9e, 0x60, 0xf0, 0x32, 0x8b, 0x6c, 0x20, 0xd8, 0xb2, 0x44, 0x40, 0x4a,
+	0x83, 0x1f, 0x67, 0xc3, 0x4f, 0x36, 0xbd, 0x46, 0xd6, 0x0f, 0xbb, 0xcc, 0xa0, 0x20, 0xb0, 0xcc,
+	0x91, 0xe7, 0x27, 0x9b, 0x11, 0x65, 0xe6, 0xf3, 0x9e, 0xb2, 0x4c, 0xcb, 0x1a, 0x0e, 0x0c, 0x4a,
+	0xd6, 0xa5, 0x50, 0x8c, 0xcc, 0x19, 0xcc, 0x74, 0x49, 0x22, 0x20, 0xa5, 0xc1, 0xf9, 0x8f, 0x86,
+	0x58, 0xbf, 0x25, 0xb2, 0x49, 0xb4, 0xf9, 0xbf, 0x20, 0xe0, 0xa0, 0x28, 0x90, 0x1a, 0x97, 0x6a,
+	0x5c, 0x65, 0xb3, 0x77, 0xc0, 0x6e, 0x08, 0x38, 0x28, 0x0a, 0xf7, 0x06, 0x19, 0xe7, 0x5f, 0xf2,
+	0x42, 0xcb, 0xf3, 0xdb, 0xcb, 0x0b, 0xf6, 0xe5, 0x9e, 0x14, 0x90, 0xe7, 0x72, 0x52, 0x40, 0x4e,
+	0x1b, 0x8d, 0x7a, 0x13, 0x40, 0xdc, 0x6f, 0x95, 0xc8, 0xf0, 0x43, 0xbc, 0x46, 0xbb, 0x63, 0x5c,
+	0xa3, 0x5d, 0xf4, 0x65, 0xca, 0x79, 0x57, 0x68, 0xdf, 0xce, 0x5c, 0xa1, 0xbd, 0x51, 0xa0, 0xcc,
+	0xfd, 0xaf, 0xcf, 0xfe, 0x9e, 0x45, 0x4e, 0x49, 0x52, 0xb6, 0xa8, 0xcd, 0xfb, 0x01, 0x8b, 0xe0,
+	0x38, 0xfe, 0xc7, 0xfc, 0xa6, 0xf1, 0x98, 0x5f, 0x2d, 0x6e, 0xc8, 0xfa, 0x38, 0xfa, 0x3d, 0x72,
+	0xf7, 0xbb, 0x16, 0x71, 0xf2, 0x1a, 0x3c, 0x84, 0xfb, 0xc3, 0x3f, 0x66, 0xde, 0x1f, 0x7e, 0xe3,
+	0x78, 0x46, 0xde, 0xe7, 0x1e, 0xf1, 0xef, 0xf5, 0x19, 0x37, 0x3e, 0x1a, 0xbc, 0x31, 0x90, 0x6f,
+	0x77, 0x56, 0x51, 0xfe, 0x49, 0x2e, 0x22, 0x7f, 0xdf, 0x6c, 0x91, 0xc1, 0x98, 0x85, 0x3b, 0x38,
+	0xa5, 0xa2, 0xcc, 0x68, 0x3c, 0x7c, 0x42, 0x98, 0x78, 0xd9, 0xff, 0x20, 0x64, 0xb8, 0xff, 0xd9,
+	0x22, 0x63, 0x0f, 0xf1, 0x92, 0xf8, 0xd0, 0x7c, 0xc9, 0x2f, 0x17, 0xf7, 0x92, 0xfb, 0xbc, 0xd8,
+	0xdf, 0x71, 0x89, 0x71, 0x1f, 0x3b, 0x7a, 0xba, 0xa5, 0xa6, 0x2d, 0xf3, 0xd2, 0x8b, 0xbc, 0x76,
+	0x59, 0x6d, 0x33, 0x12, 0x12, 0x43, 0x2a, 0x2f, 0x13, 0x60, 0x52, 0x3a, 0x54, 0x80, 0xc9, 0xa3,
+	0xbd, 0xb4, 0x39, 0xdf, 0x0e, 0x32, 0x70, 0x2c, 0x76, 0x90, 0x73, 0x85, 0xdb, 0x41, 0x9e, 0x7c,
+	0xc8, 0x76, 0x10, 0xcd, 0x28, 0x5d, 0x79, 0x00, 0xa3, 0xf4, 0xc7, 0xc8, 0xa9, 0xdd, 0x74, 0xf3,
+	0x57, 0x33, 0x49, 0xe4, 0x51, 0x3c, 0x97, 0x6b, 0xfd, 0x40, 0x45, 0x26, 0x4e, 0x68, 0x90, 0x68,
+	0x6a, 0x43, 0x1a, 0x9e, 0x72, 0x23, 0x87, 0x1d, 0xe4, 0x0a, 0xc9, 0x5a, 0x17, 0x87, 0x0e, 0x61,
+	0x5d, 0xfc, 0x0d, 0xb4, 0xcf, 0xf6, 0x24, 0x33, 0xa0, 0x06, 0x3d, 0x5c, 0x54, 0xcc, 0xf7, 0x5c,
+	0x1e, 0x7b, 0x61, 0xc6, 0xcd, 0x43, 0x41, 0x7e, 0x87, 0x30, 0x6a, 0x55, 0xba, 0x7a, 0x78, 0x50,
+	0x53, 0xbe, 0x5f, 0xe6, 0x4b, 0x59, 0xff, 0x31, 0x61, 0x8f, 0xfe, 0xa3, 0xc5, 0x6a, 0x3d, 0x05,
+	0xf8, 0x90, 0x47, 0x1f, 0xc0, 0x87, 0x9c, 0x31, 0xf5, 0x8e, 0x15, 0x64, 0xea, 0x0d, 0xc8, 0x94,
+	0xdf, 0xf6, 0x1a, 0x74, 0xa3, 0xdb, 0x6a, 0xf1, 0xe8, 0x6a, 0x79, 0x31, 0x76, 0xee, 0x49, 0x0a,
+	0xad, 0xfc, 0x2d, 0x51, 0x9c, 0x41, 0x05, 0x74, 0xa9, 0x28, 0xf2, 0x95, 0x0c, 0x27, 0xe8, 0xe1,
+	0x8d, 0x13, 0x96, 0x95, 0x09, 0xa4, 0x09, 0x3e, 0x6d, 0xe6, 0xa8, 0x1c, 0x9e, 0x9f, 0x94, 0x96,
+	0x45, 0x01, 0x06, 0x9d, 0xc6, 0x5e, 0x25, 0x23, 0xf5, 0x20, 0x16, 0x99, 0x60, 0xfc, 0xd6, 0xbc,
+	0x77, 0xe0, 0x12, 0xb8, 0x78, 0xb5, 0xaa, 0x72, 0xc0, 0xce, 0xe5, 0x54, 0xa0, 0x54, 0x78, 0x48,
+	0xdb, 0xdb, 0xeb, 0x8c, 0x99, 0xb8, 0xbc, 0x8b, 0xfb, 0x0f, 0x2f, 0xf4, 0x31, 0x50, 0x2e, 0x5e,
+	0x95, 0xd7, 0x8f, 0x8d, 0x0b, 0x71, 0xfc, 0x27, 0xa4, 0x1c, 0xb4, 0x0b, 0xca, 0x4f, 0xec, 0x7b,
+	0x41, 0x39, 0x2b, 0x3d, 0x9b, 0xb4, 0x94, 0x3b, 0xe2, 0x7c, 0x61, 0xa5, 0x67, 0xd3, 0xb0, 0x20,
+	0x51, 0x7a, 0x36, 0x05, 0x80, 0x2e, 0xd2, 0xbe, 0xd6, 0xcf, 0x2d, 0x73, 0x92, 0x2d, 0x1a, 0x47,
+	0x77, 0xb2, 0xe8, 0xf6, 0xf9, 0x53, 0xfb, 0xda, 0xe7, 0x7b, 0xfc, 0x09, 0xa7, 0x8f, 0xe0, 0x4f,
+	0x68, 0xb2, 0xa2, 0xa0, 0xcb, 0x0b, 0xce, 0x99, 0xa2, 0x14, 0x3a, 0x56, 0xde, 0x82, 0x87, 0x59,
+	0xb1, 0x7f, 0x81, 0x0b, 0xe8, 0x1b, 0x3d, 0x78, 0xf6, 0xbe, 0xa3, 0x07, 0x71, 0x79, 0x4e, 0xe1,
+	0x8e, 0x93, 0xc6, 0x08, 0x69, 0x4c, 0x40, 0xa7, 0xc9, 0x5a, 0xe7, 0x1f, 0x3f, 0x36, 0xeb, 0xfc,
+	0xf4, 0x43, 0xb0, 0xce, 0x3f, 0x71, 0x68, 0xeb, 0xfc, 0xc7, 0xc9, 0xc9, 0x4e, 0x58, 0x5f, 0xf4,
+	0xe3, 0xa8, 0xcb, 0xd2, 0x4d, 0xe6, 0xbb, 0xf5, 0x06, 0x4d, 0x98, 0x79, 0x7f, 0xf4, 0xd2, 0x25,
+	0xbd, 0x93, 0x1d, 0xf6, 0x21, 0xcf, 0xee, 0xbe, 0xb0, 0x45, 0x13, 0xfe, 0x32, 0xb3, 0xad, 0xd8,
+	0x81, 0x89, 0xc5, 0x99, 0xe5, 0x20, 0x21, 0x4f, 0x8e, 0xee, 0x1c, 0xb8, 0xf0, 0x70, 0x9c, 0x03,
+	0xef, 0x27, 0xc3, 0x71, 0xb3, 0x9b, 0xd4, 0xc3, 0x5b, 0x01, 0xf3, 0x00, 0x8d, 0xcc, 0xbf, 0x4d,
+	0xd9, 0x15, 0x04, 0xfc, 0x1e, 0x66, 0xf0, 0x8b, 0xff, 0x35, 0x93, 0x82, 0x80, 0xd8, 0x5f, 0xee,
+	0x13, 0xee, 0xee, 0x1e, 0x67, 0xb8, 0xfb, 0xd9, 0x23, 0x85, 0xba, 0xe7, 0x79, 0x40, 0x9e, 0xfa,
+	0x81, 0xf3, 0x80, 0xfc, 0x8a, 0x45, 0xc6, 0x77, 0x75, 0xfb, 0x8d, 0xf3, 0xb6, 0xa2, 0xbc, 0xc5,
+	0x86, 0x59, 0x68, 0xde, 0xc5, 0xc5, 0xce, 0x00, 0xdd, 0xcb, 0x02, 0xc0, 0xec, 0x49, 0x8e, 0x27,
+	0xfb, 0xe9, 0x47, 0xe5, 0xc9, 0xfe, 0x38, 0x5b, 0xcc, 0x64, 0x90, 0x19, 0x73, 0xdd, 0x14, 0x1b,
+	0xc8, 0x26, 0x17, 0x46, 0x09, 0x00, 0x5d, 0x1e, 0x06, 0x79, 0x4d, 0xc9, 0xc3, 0x99, 0xb0, 0xbf,
+	0xc6, 0xce, 0x0f, 0x17, 0xd5, 0x09, 0x75, 0x26, 0x64, 0x71, 0xa4, 0x9b, 0x19, 0x39, 0xd0, 0x23,
+	0x19, 0x97, 0x76, 0x15, 0xf9, 0xd0, 0x88, 0x9d, 0x67, 0x53, 0x45, 0x66, 0x2e, 0x05, 0x83, 0x4e,
+	0x63, 0xff, 0x63, 0x8b, 0x54, 0x9a, 0x61, 0xb8, 0x13, 0x3b, 0xcf, 0xb1, 0x55, 0xfd, 0x03, 0x05,
+	0x2b, 0xa8, 0x78, 0x53, 0x90, 0x28, 0x5c, 0xfa, 0x82, 0x3c, 0x5f, 0x33, 0x18, 0x86, 0xb2, 0x1a,
+	0xf7, 0x09, 0xc5, 0x9f, 0xfe, 0xb6, 0x06, 0x11, 0x16, 0x0d, 0xd6, 0x35, 0xd4, 0x54, 0x46, 0xf0,
+	0xc8, 0xc6, 0xa6, 0x82, 0xf3, 0x23, 0x45, 0x99, 0xf6, 0xe7, 0x24, 0x4b, 0x35, 0xe1, 0x78, 0x12,
+	0x89, 0x04, 0x43, 0x2a, 0x14, 0x6f, 0xdf, 0x90, 0xe7, 0x81, 0xe5, 0x05, 0x11, 0x9f, 0xb4, 0x56,
+	0xdc, 0xa9, 0x64, 0x79, 0x81, 0xe7, 0x7c, 0xa4, 0xbf, 0x41, 0x93, 0xf7, 0xc0, 0x2e, 0xc7, 0xe9,
+	0xb7, 0xf0, 0xda, 0x38, 0xf5, 0x2a, 0x72, 0x9a, 0x52, 0xbd, 0x69, 0x21, 0x9f, 0xb2, 0xf1, 0x72,
+	0x75, 0xf7, 0xe7, 0x17, 0xcf, 0x90, 0x09, 0xd3, 0x8c, 0x6a, 0xbf, 0xd3, 0xbc, 0xc0, 0xe2, 0x7c,
+	0xf6, 0x16, 0x81, 0x71, 0x49, 0x6f, 0xdc, 0x24, 0x60, 0x94, 0xfa, 0x2f, 0x1d, 0x6b, 0xa9, 0xff,
+	0xf2, 0xc3, 0x29, 0xf5, 0x3f, 0x75, 0x1c, 0xa5, 0xfe, 0x4f, 0x1c, 0xa9, 0xd4, 0xbf, 0x76, 0xd5,
+	0xc2, 0xc0, 0x01, 0x57, 0x2d, 0xcc, 0x91, 0x49, 0x19, 0x7c, 0x4e, 0x45, 0x0d, 0x77, 0xee, 0x61,
+	0x39, 0x2b, 0x9a, 0x4c, 0x2e, 0x98, 0x68, 0xc8, 0xd2, 0xdb, 0x6f, 0x59, 0xa4, 0x12, 0x84, 0x75,
+	0x65, 0x9a, 0xf8, 0x60, 0xd1, 0x16, 0x7a, 0x76, 0x42, 0x16, 0x0b, 0x90, 0x0c, 0xa0, 0xab, 0x30,
+	0xd8, 0x3d, 0xf9, 0x0f, 0xf0, 0x1e, 0x60, 0x81, 0xd7, 0x70, 0x7b, 0xbb, 0x15, 0x7a, 0xf5, 0xf4,
+	0x3e, 0x02, 0xe9, 0x02, 0xe2, 0xb9, 0x48, 0xaa, 0xc0, 0xeb, 0xb5, 0x3e, 0x74, 0xd0, 0x97, 0x03,
+	0x9a, 0x38, 0x26, 0xe3, 0x24, 0x8c, 0x68, 0x3d, 0x35, 0xc7, 0x8c, 0xb0, 0x31, 0xd3, 0xc2, 0xc7,
+	0x5c, 0x35, 0xe5, 0xf0, 0xd1, 0xab, 0x97, 0x92, 0xc1, 0x42, 0xb6, 0x5b, 0x76, 0x44, 0xce, 0x74,
+	0xf2, 0xac, 0x41, 0xb1, 0x33, 0x74, 0xa0, 0x4d, 0x4a, 0x7e, 0xba, 0x67, 0x72, 0xed, 0x49, 0x31,
+	0xf4, 0xe1, 0xac, 0xdf, 0x54, 0x30, 0xfc, 0x70, 0x6e, 0x2a, 0xf8, 0x24, 0x21, 0x35, 0x59, 0x2b,
+	0x4a, 0xda, 0x17, 0x56, 0x0b, 0x89, 0xce, 0xe6, 0x3c, 0xd3, 0x15, 0x40, 0x81, 0x62, 0xd0, 0x44,
+	0xda, 0x7f, 0x91, 0x7b, 0xa9, 0x06, 0x37, 0xa2, 0x34, 0x0a, 0x9f, 0x13, 0x3f, 0x70, 0x17, 0x6b,
+	0xfc, 0x53, 0x8b, 0x4c, 0xf3, 0x99, 0x97, 0x55, 0xdd, 0x51, 0x71, 0x70, 0x26, 0x8e, 0xc5, 0x4b,
+	0xc8, 0x02, 0x26, 0xaa, 0x86, 0x54, 0x84, 0xc3, 0x3e, 0x3d, 0xb1, 0x7f, 0x31, 0xe7, 0xc0, 0x30,
+	0x59, 0x94, 0x59, 0x32, 0xff, 0x42, 0x86, 0x93, 0x77, 0x0f, 0x73, 0x46, 0xf8, 0xad, 0xbe, 0x56,
+	0x53, 0xfb, 0x82, 0x55, 0x4c, 0x79, 0xfc, 0x5c, 0xd3, 0xa8, 0x7e, 0x6b, 0xc4, 0x91, 0x6c, 0xa7,
+	0xbf, 0x64, 0x91, 0x29, 0x5e, 0x46, 0xa3, 0xae, 0x66, 0x96, 0x08, 0x51, 0x7e, 0xa5, 0xa8, 0xaa,
+	0x1e, 0x8a, 0x73, 0x6a, 0x02, 0xcc, 0x20, 0x62, 0xe8, 0xe9, 0x04, 0xee, 0x43, 0xa3, 0x52, 0xd9,
+	0xf3, 0x69, 0xec, 0x9c, 0x2a, 0x2a, 0xbb, 0x5e, 0x29, 0x93, 0x73, 0x09, 0xaa, 0xeb, 0x89, 0x96,
+	0x30, 0x9a, 0x8a, 0x03, 0x5d, 0xb6, 0xfd, 0x45, 0x8b, 0x4c, 0xa5, 0xba, 0x1f, 0x7f, 0xd8, 0xce,
+	0xe9, 0xa2, 0x8c, 0x73, 0x73, 0x91, 0x62, 0xca, 0xcf, 0x15, 0x73, 0x19, 0x51, 0xd0, 0x23, 0x7c,
+	0xfa, 0x67, 0xc5, 0x75, 0x63, 0x7d, 0xb5, 0xc7, 0x2d, 0x53, 0x7b, 0x5c, 0x2b, 0xf2, 0x4a, 0x20,
+	0x5d, 0x8d, 0xfd, 0x02, 0x96, 0x3d, 0xca, 0xd9, 0xdc, 0x72, 0xba, 0xf4, 0x51, 0xb3, 0x4b, 0x05,
+	0x1e, 0xc7, 0xf4, 0x0e, 0x15, 0x73, 0x5d, 0xc8, 0x77, 0x47, 0x34, 0x1f, 0x63, 0x42, 0x3b, 0x85,
+	0x47, 0x04, 0x06, 0x98, 0xb5, 0x87, 0x76, 0x52, 0x67, 0xbc, 0xe8, 0xa7, 0x21, 0xef, 0x1d, 0x42,
+	0xee, 0x20, 0xa4, 0x3c, 0x62, 0x97, 0x63, 0xf6, 0x4e, 0xb5, 0x81, 0x87, 0x7f, 0xa7, 0xda, 0x2d,
+	0x32, 0x72, 0xcb, 0x4f, 0x9a, 0xcc, 0x93, 0x2c, 0x3c, 0x79, 0x05, 0x64, 0xcd, 0x20, 0xbb, 0x74,
+	0xec, 0x37, 0xa5, 0x00, 0x48, 0x65, 0x61, 0xe0, 0x12, 0xfe, 0x60, 0xf1, 0x71, 0xd9, 0xc0, 0xa5,
+	0x9b, 0x12, 0x01, 0x29, 0x0d, 0x3e, 0xac, 0x31, 0xfc, 0x25, 0xeb, 0x7a, 0x38, 0x43, 0x45, 0xcd,
+	0x10, 0xc9, 0x91, 0xe7, 0xa6, 0xdd, 0xd4, 0x64, 0x80, 0x21, 0x51, 0x95, 0x23, 0x1e, 0xee, 0x5b,
+	0x8e, 0xf8, 0x4d, 0xa6, 0xab, 0x25, 0x7e, 0xd0, 0xa5, 0xd7, 0x02, 0x67, 0xa4, 0xa8, 0x45, 0x66,
+	0x41, 0xf1, 0xe4, 0xa7, 0xef, 0xf4, 0x37, 0x68, 0xf2, 0x34, 0x87, 0xca, 0xe8, 0xbe, 0x0e, 0x95,
+	0xd4, 0x96, 0x32, 0x56, 0xb8, 0x2d, 0x25, 0xa1, 0x9d, 0x42, 0x6c, 0x29, 0x3f, 0x50, 0x96, 0x80,
+	0x3f, 0x2a, 0x91, 0x49, 0xa5, 0x72, 0x79, 0xf1, 0x0e, 0x26, 0x2a, 0x1e, 0x7f, 0x80, 0xd4, 0x2d,
+	0x23, 0x40, 0xaa, 0x48, 0x9b, 0x34, 0x1f, 0x42, 0xdf, 0x70, 0xb4, 0x4f, 0x66, 0xc2, 0xd1, 0x6e,
+	0x16, 0x2f, 0x7a, 0xff, 0xa8, 0xb4, 0xff, 0x65, 0x91, 0x93, 0x99, 0x16, 0x0f, 0x21, 0x64, 0x67,
+	0xd7, 0x0c, 0xd9, 0x79, 0xa5, 0xf0, 0x51, 0xf7, 0x89, 0xdc, 0xf9, 0xd5, 0x52, 0xcf, 0x68, 0x99,
+	0x3e, 0xff, 0x33, 0x16, 0xa9, 0x24, 0x5e, 0xbc, 0x23, 0xa3, 0x77, 0x3e, 0x7a, 0x2c, 0x33, 0x60,
+	0x16, 0xff, 0x17, 0x5f, 0xab, 0xea, 0x1f, 0x83, 0x01, 0x97, 0x3e, 0xfd, 0x19, 0x8b, 0x90, 0x94,
+	0xe8, 0x51, 0xa9, 0x30, 0xee, 0x57, 0x4a, 0xe4, 0x74, 0xee, 0x34, 0xb2, 0x3f, 0xab, 0x8c, 0x33,
+	0xfc, 0x41, 0x6d, 0x1d, 0xd3, 0x7c, 0xd5, 0x6d, 0x34, 0xe3, 0x86, 0x8d, 0x46, 0x98, 0x66, 0x1e,
+	0x95, 0x02, 0x2a, 0x6e, 0x32, 0xd1, 0x1e, 0xd6, 0xff, 0xb1, 0xc8, 0x54, 0xf6, 0x90, 0xf8, 0x10,
+	0x96, 0xac, 0xdb, 0xc6, 0x92, 0x75, 0xa3, 0x78, 0x37, 0x5a, 0xdf, 0x78, 0xce, 0x3f, 0xd2, 0x02,
+	0x59, 0x25, 0xf1, 0x43, 0x58, 0x33, 0x6e, 0x99, 0x6b, 0x06, 0x14, 0x3f, 0xe2, 0x3e, 0x8b, 0xc6,
+	0xeb, 0x24, 0xcf, 0x93, 0x78, 0xb8, 0xa2, 0x51, 0x46, 0xd2, 0x4d, 0xe9, 0xd0, 0x49, 0x37, 0x3f,
+	0x5f, 0xea, 0x7d, 0xc4, 0x6c, 0xa1, 0xfa, 0x1c, 0xaa, 0x66, 0x9a, 0x95, 0xa2, 0xb8, 0xba, 0x3a,
+	0x86, 0x4d, 0x24, 0x2d, 0x5b, 0xae, 0x41, 0xc1, 0x90, 0x6c, 0xbf, 0x96, 0xf6, 0x04, 0xdf, 0xd4,
+	0x81, 0x05, 0xda, 0xfa, 0x4d, 0x73, 0x76, 0xe2, 0xbc, 0xa9, 0x71, 0x62, 0x3e, 0x35, 0x83, 0xb7,
+	0x3b, 0x4e, 0x46, 0x5f, 0xf5, 0xd5, 0x85, 0x05, 0xf3, 0xb3, 0xdf, 0xf8, 0xce, 0xf9, 0xc7, 0xbe,
+	0xf9, 0x9d, 0xf3, 0x8f, 0x7d, 0xeb, 0x3b, 0xe7, 0x1f, 0xfb, 0xd4, 0xdd, 0xf3, 0xd6, 0x37, 0xee,
+	0x9e, 0xb7, 0xbe, 0x79, 0xf7, 0xbc, 0xf5, 0xad, 0xbb, 0xe7, 0xad, 0xff, 0x72, 0xf7, 0xbc, 0xf5,
+	0xb7, 0xfe, 0xeb, 0xf9, 0xc7, 0x5e, 0x1d, 0x96, 0x63, 0xfb, 0x7f, 0x03, 0x00, 0xce, 0x65, 0x8a,
+	0x7d, 0x76, 0xda, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -4301,6 +5217,105 @@ func (m *Arguments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtGCStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtGCStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtGCStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PodsFailed) > 0 {
+		keysForPodsFailed := make([]string, 0, len(m.PodsFailed))
+		for k := range m.PodsFailed {
+			keysForPodsFailed = append(keysForPodsFailed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPodsFailed)
+		for iNdEx := len(keysForPodsFailed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.PodsFailed[ArtifactGCStrategy(keysForPodsFailed[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForPodsFailed[iNdEx])
+			copy(dAtA[i:], keysForPodsFailed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPodsFailed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PodsRecouped) > 0 {
+		keysForPodsRecouped := make([]string, 0, len(m.PodsRecouped))
+		for k := range m.PodsRecouped {
+			keysForPodsRecouped = append(keysForPodsRecouped, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPodsRecouped)
+		for iNdEx := len(keysForPodsRecouped) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.PodsRecouped[string(keysForPodsRecouped[iNdEx])]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForPodsRecouped[iNdEx])
+			copy(dAtA[i:], keysForPodsRecouped[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPodsRecouped[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StrategiesProcessed) > 0 {
+		keysForStrategiesProcessed := make([]string, 0, len(m.StrategiesProcessed))
+		for k := range m.StrategiesProcessed {
+			keysForStrategiesProcessed = append(keysForStrategiesProcessed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForStrategiesProcessed)
+		for iNdEx := len(keysForStrategiesProcessed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.StrategiesProcessed[ArtifactGCStrategy(keysForStrategiesProcessed[iNdEx])]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForStrategiesProcessed[iNdEx])
+			copy(dAtA[i:], keysForStrategiesProcessed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForStrategiesProcessed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Artifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4321,6 +5336,30 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.VerifyChecksum {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x78
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SizeBytes))
+	i--
+	dAtA[i] = 0x70
+	i -= len(m.Checksum)
+	copy(dAtA[i:], m.Checksum)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Checksum)))
+	i--
+	dAtA[i] = 0x6a
+	i--
+	if m.Deleted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
 	i -= len(m.FromExpression)
 	copy(dAtA[i:], m.FromExpression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FromExpression)))
@@ -4397,6 +5436,157 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxEntries))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArtifactDataSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactDataSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArtifactEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousKeySecrets) > 0 {
+		for iNdEx := len(m.PreviousKeySecrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousKeySecrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.KeyID)
+	copy(dAtA[i:], m.KeyID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyID)))
+	i--
+	dAtA[i] = 0x12
+	if m.KeySecret != nil {
+		{
+			size, err := m.KeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArtifactGC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactGC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4417,6 +5607,66 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.OCI != nil {
+		{
+			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -4579,6 +5829,42 @@ func (m *ArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Plugin != nil {
+		{
+			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -4866,6 +6152,265 @@ func (m *ArtifactoryAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoRetryAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRetryAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRetryAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RetriedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.FailedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.NodeFieldSelector)
+	copy(dAtA[i:], m.NodeFieldSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeFieldSelector)))
+	i--
+	dAtA[i] = 0x2a
+	i--
+	if m.RestartSuccessful {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != nil {
+		{
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Blob)
+	copy(dAtA[i:], m.Blob)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Blob)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureArtifactRepository) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureArtifactRepository) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.BlobNameFormat)
+	copy(dAtA[i:], m.BlobNameFormat)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BlobNameFormat)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureBlobContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureBlobContainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureBlobContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.UseWorkloadIdentity {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.SASTokenSecret != nil {
+		{
+			size, err := m.SASTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AccountKeySecret != nil {
+		{
+			size, err := m.AccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4886,6 +6431,11 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Jitter)
+	copy(dAtA[i:], m.Jitter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Jitter)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.MaxDuration)
 	copy(dAtA[i:], m.MaxDuration)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
@@ -4911,6 +6461,53 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BasicAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasicAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PasswordSecret != nil {
+		{
+			size, err := m.PasswordSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UsernameSecret != nil {
+		{
+			size, err := m.UsernameSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Cache) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4931,6 +6528,30 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMap != nil {
 		{
 			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
@@ -5074,6 +6695,44 @@ func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConfigMapDataSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigMapDataSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigMapDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ConfigMapKeySelector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ContainerNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5094,6 +6753,35 @@ func (m *ContainerNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Outputs != nil {
+		{
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Depends)
+	copy(dAtA[i:], m.Depends)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Depends)))
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Dependencies) > 0 {
 		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Dependencies[iNdEx])
@@ -5116,6 +6804,46 @@ func (m *ContainerNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerRetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerRetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerRetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x12
+	if m.Retries != nil {
+		{
+			size, err := m.Retries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContainerSetTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5265,6 +6993,86 @@ func (m *CreateS3BucketOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreatedResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatedResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatedResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServiceAccountName)
+	copy(dAtA[i:], m.ServiceAccountName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServiceAccountName)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.CleanupPhase)
+	copy(dAtA[i:], m.CleanupPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CleanupPhase)))
+	i--
+	dAtA[i] = 0x42
+	if m.Cleanup != nil {
+		{
+			size, err := m.Cleanup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.UID)
+	copy(dAtA[i:], m.UID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UID)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.APIVersion)
+	copy(dAtA[i:], m.APIVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersion)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.NodeID)
+	copy(dAtA[i:], m.NodeID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5793,6 +7601,54 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ConfigMap != nil {
+		{
+			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ArtifactPaths != nil {
 		{
 			size, err := m.ArtifactPaths.MarshalToSizedBuffer(dAtA[:i])
@@ -5808,6 +7664,39 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DataValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6038,6 +7927,85 @@ func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SubmoduleRecursionDepth != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SubmoduleRecursionDepth))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.LFS != nil {
+		{
+			size, err := m.LFS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	i -= len(m.Filter)
+	copy(dAtA[i:], m.Filter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Filter)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.SparseCheckout) > 0 {
+		for iNdEx := len(m.SparseCheckout) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SparseCheckout[iNdEx])
+			copy(dAtA[i:], m.SparseCheckout[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SparseCheckout[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.PushRetries != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.PushRetries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	i -= len(m.AuthorEmail)
+	copy(dAtA[i:], m.AuthorEmail)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthorEmail)))
+	i--
+	dAtA[i] = 0x7a
+	i -= len(m.AuthorName)
+	copy(dAtA[i:], m.AuthorName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthorName)))
+	i--
+	dAtA[i] = 0x72
+	i -= len(m.CommitMessage)
+	copy(dAtA[i:], m.CommitMessage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CommitMessage)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.Dir)
+	copy(dAtA[i:], m.Dir)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dir)))
+	i--
+	dAtA[i] = 0x62
+	i--
+	if m.CreateBranch {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	i -= len(m.Branch)
+	copy(dAtA[i:], m.Branch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Branch)))
+	i--
+	dAtA[i] = 0x52
 	i--
 	if m.DisableSubmodules {
 		dAtA[i] = 1
@@ -6117,6 +8085,47 @@ func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GitLFS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitLFS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GitLFS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exclude) > 0 {
+		for iNdEx := len(m.Exclude) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Exclude[iNdEx])
+			copy(dAtA[i:], m.Exclude[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Exclude[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Include) > 0 {
+		for iNdEx := len(m.Include) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Include[iNdEx])
+			copy(dAtA[i:], m.Include[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Include[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HDFSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6412,6 +8421,127 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.MultipartField)
+	copy(dAtA[i:], m.MultipartField)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MultipartField)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x22
+	if m.Auth != nil {
+		{
+			size, err := m.Auth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BearerTokenSecret != nil {
+		{
+			size, err := m.BearerTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BasicAuth != nil {
+		{
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPDataSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPDataSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPDataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x22
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Headers) > 0 {
 		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6534,6 +8664,18 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValueFrom != nil {
+		{
+			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Value)
 	copy(dAtA[i:], m.Value)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
@@ -6847,6 +8989,11 @@ func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CacheType)
+	copy(dAtA[i:], m.CacheType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheType)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.CacheName)
 	copy(dAtA[i:], m.CacheName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
@@ -6888,6 +9035,14 @@ func (m *Memoize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Auto {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
 	i -= len(m.MaxAge)
 	copy(dAtA[i:], m.MaxAge)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxAge)))
@@ -7231,6 +9386,27 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryDecision != nil {
+		{
+			size, err := m.RetryDecision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	i -= len(m.FailureReason)
+	copy(dAtA[i:], m.FailureReason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureReason)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
 	i -= len(m.Progress)
 	copy(dAtA[i:], m.Progress)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
@@ -7496,6 +9672,112 @@ func (m *NoneStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OCIArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.PlainHTTP {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MediaTypes) > 0 {
+		keysForMediaTypes := make([]string, 0, len(m.MediaTypes))
+		for k := range m.MediaTypes {
+			keysForMediaTypes = append(keysForMediaTypes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMediaTypes)
+		for iNdEx := len(keysForMediaTypes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MediaTypes[string(keysForMediaTypes[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMediaTypes[iNdEx])
+			copy(dAtA[i:], keysForMediaTypes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMediaTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DockerConfigSecret != nil {
+		{
+			size, err := m.DockerConfigSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Tag)
+	copy(dAtA[i:], m.Tag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Repository)
+	copy(dAtA[i:], m.Repository)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repository)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OSSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7916,6 +10198,82 @@ func (m *Plugin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PluginArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Configuration)
+	copy(dAtA[i:], m.Configuration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Configuration)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PluginArtifactRepository) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginArtifactRepository) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PluginArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.KeyFormat)
+	copy(dAtA[i:], m.KeyFormat)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyFormat)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Configuration)
+	copy(dAtA[i:], m.Configuration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Configuration)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PodGC) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8072,6 +10430,96 @@ func (m *RawArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceCleanup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceCleanup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceCleanup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecondsAfterCompletion != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SecondsAfterCompletion))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceEscalation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceEscalation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceEscalation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCPU != nil {
+		{
+			size, err := m.MaxCPU.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxMemory != nil {
+		{
+			size, err := m.MaxMemory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.CPU)
+	copy(dAtA[i:], m.CPU)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CPU)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Memory)
+	copy(dAtA[i:], m.Memory)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Memory)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8092,6 +10540,33 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cleanup != nil {
+		{
+			size, err := m.Cleanup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.WaitForReady != nil {
+		i--
+		if *m.WaitForReady {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	i -= len(m.FieldManager)
+	copy(dAtA[i:], m.FieldManager)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldManager)))
+	i--
+	dAtA[i] = 0x42
 	if len(m.Flags) > 0 {
 		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Flags[iNdEx])
@@ -8172,6 +10647,60 @@ func (m *RetryAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Changes[iNdEx])
+			copy(dAtA[i:], m.Changes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Changes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Rule != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Rule))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryNodeAntiAffinity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8195,6 +10724,62 @@ func (m *RetryNodeAntiAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Escalate != nil {
+		{
+			size, err := m.Escalate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ExitCodes) > 0 {
+		for iNdEx := len(m.ExitCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.ExitCodes[iNdEx]))
+			i--
+			dAtA[i] = 0x10
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8215,6 +10800,20 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	i -= len(m.Expression)
 	copy(dAtA[i:], m.Expression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
@@ -8507,6 +11106,37 @@ func (m *S3EncryptionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxEntries))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9095,6 +11725,16 @@ func (m *TarStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Concurrency != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Concurrency))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Compression)
+	copy(dAtA[i:], m.Compression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Compression)))
+	i--
+	dAtA[i] = 0x12
 	if m.CompressionLevel != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.CompressionLevel))
 		i--
@@ -9665,6 +12305,34 @@ func (m *TransformationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Dedupe)
+	copy(dAtA[i:], m.Dedupe)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dedupe)))
+	i--
+	dAtA[i] = 0x3a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Chunk))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.SortBy)
+	copy(dAtA[i:], m.SortBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SortBy)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.GroupBy)
+	copy(dAtA[i:], m.GroupBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GroupBy)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Map)
+	copy(dAtA[i:], m.Map)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Map)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Filter)
+	copy(dAtA[i:], m.Filter)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Filter)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.Expression)
 	copy(dAtA[i:], m.Expression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
@@ -10146,6 +12814,34 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.AutoRetry != nil {
+		{
+			size, err := m.AutoRetry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
 	if len(m.Hooks) > 0 {
 		keysForHooks := make([]string, 0, len(m.Hooks))
 		for k := range m.Hooks {
@@ -10648,6 +13344,52 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArtifactGCStatus != nil {
+		{
+			size, err := m.ArtifactGCStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.AutoRetries) > 0 {
+		for iNdEx := len(m.AutoRetries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.CreatedResources) > 0 {
+		for iNdEx := len(m.CreatedResources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatedResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	if m.ArtifactRepositoryRef != nil {
 		{
 			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
@@ -11472,6 +14214,39 @@ func (m *Arguments) Size() (n int) {
 	return n
 }
 
+func (m *ArtGCStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StrategiesProcessed) > 0 {
+		for k, v := range m.StrategiesProcessed {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PodsRecouped) > 0 {
+		for k, v := range m.PodsRecouped {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PodsFailed) > 0 {
+		for k, v := range m.PodsFailed {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *Artifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11501,6 +14276,68 @@ func (m *Artifact) Size() (n int) {
 	n += 2
 	l = len(m.FromExpression)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Checksum)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SizeBytes))
+	n += 2
+	return n
+}
+
+func (m *ArtifactCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxEntries))
+	return n
+}
+
+func (m *ArtifactDataSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Artifact.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ArtifactEncryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeySecret != nil {
+		l = m.KeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.KeyID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.PreviousKeySecrets) > 0 {
+		for _, e := range m.PreviousKeySecrets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArtifactGC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11545,6 +14382,26 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OCI != nil {
+		l = m.OCI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11588,6 +14445,18 @@ func (m *ArtifactRepository) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Plugin != nil {
+		l = m.Plugin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11665,6 +14534,93 @@ func (m *ArtifactoryAuth) Size() (n int) {
 	return n
 }
 
+func (m *AutoRetryAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.FailedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.RetriedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AutoRetryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.NodeFieldSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AzureBlobContainer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Blob)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureArtifactRepository) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AzureBlobContainer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BlobNameFormat)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureBlobContainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccountKeySecret != nil {
+		l = m.AccountKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SASTokenSecret != nil {
+		l = m.SASTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11679,6 +14635,25 @@ func (m *Backoff) Size() (n int) {
 	}
 	l = len(m.MaxDuration)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Jitter)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *BasicAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameSecret != nil {
+		l = m.UsernameSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PasswordSecret != nil {
+		l = m.PasswordSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11692,6 +14667,14 @@ func (m *Cache) Size() (n int) {
 		l = m.ConfigMap.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11740,6 +14723,19 @@ func (m *Condition) Size() (n int) {
 	return n
 }
 
+func (m *ConfigMapDataSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConfigMapKeySelector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ContainerNode) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11754,6 +14750,31 @@ func (m *ContainerNode) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Depends)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ContainerRetryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Retries != nil {
+		l = m.Retries.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11810,6 +14831,37 @@ func (m *CreateS3BucketOptions) Size() (n int) {
 	return n
 }
 
+func (m *CreatedResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.APIVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.UID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Cleanup != nil {
+		l = m.Cleanup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.CleanupPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ServiceAccountName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *CronWorkflow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12005,6 +15057,35 @@ func (m *DataSource) Size() (n int) {
 		l = m.ArtifactPaths.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ConfigMap != nil {
+		l = m.ConfigMap.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *DataValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12118,6 +15199,56 @@ func (m *GitArtifact) Size() (n int) {
 	}
 	n += 2
 	n += 2
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.Dir)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CommitMessage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AuthorName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AuthorEmail)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PushRetries != nil {
+		n += 2 + sovGenerated(uint64(*m.PushRetries))
+	}
+	if len(m.SparseCheckout) > 0 {
+		for _, s := range m.SparseCheckout {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Filter)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.LFS != nil {
+		l = m.LFS.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.SubmoduleRecursionDepth != nil {
+		n += 2 + sovGenerated(uint64(*m.SubmoduleRecursionDepth))
+	}
+	return n
+}
+
+func (m *GitLFS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Include) > 0 {
+		for _, s := range m.Include {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Exclude) > 0 {
+		for _, s := range m.Exclude {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12235,6 +15366,53 @@ func (m *HTTPArtifact) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Auth != nil {
+		l = m.Auth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MultipartField)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HTTPAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.BearerTokenSecret != nil {
+		l = m.BearerTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HTTPDataSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12278,6 +15456,10 @@ func (m *Header) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12407,6 +15589,8 @@ func (m *MemoizationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.CacheName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CacheType)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12424,6 +15608,7 @@ func (m *Memoize) Size() (n int) {
 	}
 	l = len(m.MaxAge)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -12620,6 +15805,12 @@ func (m *NodeStatus) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.FailureReason)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.RetryDecision != nil {
+		l = m.RetryDecision.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12643,6 +15834,42 @@ func (m *NoneStrategy) Size() (n int) {
 	return n
 }
 
+func (m *OCIArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Repository)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DockerConfigSecret != nil {
+		l = m.DockerConfigSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.MediaTypes) > 0 {
+		for k, v := range m.MediaTypes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	n += 2
+	return n
+}
+
 func (m *OSSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12807,6 +16034,36 @@ func (m *Plugin) Size() (n int) {
 	return n
 }
 
+func (m *PluginArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Configuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PluginArtifactRepository) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Configuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.KeyFormat)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *PodGC) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12866,6 +16123,41 @@ func (m *RawArtifact) Size() (n int) {
 	return n
 }
 
+func (m *ResourceCleanup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecondsAfterCompletion != nil {
+		n += 1 + sovGenerated(uint64(*m.SecondsAfterCompletion))
+	}
+	return n
+}
+
+func (m *ResourceEscalation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Memory)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CPU)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.MaxMemory != nil {
+		l = m.MaxMemory.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxCPU != nil {
+		l = m.MaxCPU.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ResourceTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12889,6 +16181,15 @@ func (m *ResourceTemplate) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.FieldManager)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.WaitForReady != nil {
+		n += 2
+	}
+	if m.Cleanup != nil {
+		l = m.Cleanup.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12905,6 +16206,30 @@ func (m *RetryAffinity) Size() (n int) {
 	return n
 }
 
+func (m *RetryDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Rule != nil {
+		n += 1 + sovGenerated(uint64(*m.Rule))
+	}
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, s := range m.Changes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RetryNodeAntiAffinity) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12914,6 +16239,32 @@ func (m *RetryNodeAntiAffinity) Size() (n int) {
 	return n
 }
 
+func (m *RetryRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ExitCodes) > 0 {
+		for _, e := range m.ExitCodes {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Escalate != nil {
+		l = m.Escalate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12936,6 +16287,12 @@ func (m *RetryStrategy) Size() (n int) {
 	}
 	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -13022,6 +16379,18 @@ func (m *S3EncryptionOptions) Size() (n int) {
 	return n
 }
 
+func (m *SQLCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxEntries))
+	return n
+}
+
 func (m *ScriptTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13247,6 +16616,11 @@ func (m *TarStrategy) Size() (n int) {
 	if m.CompressionLevel != nil {
 		n += 1 + sovGenerated(uint64(*m.CompressionLevel))
 	}
+	l = len(m.Compression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Concurrency != nil {
+		n += 1 + sovGenerated(uint64(*m.Concurrency))
+	}
 	return n
 }
 
@@ -13430,6 +16804,17 @@ func (m *TransformationStep) Size() (n int) {
 	_ = l
 	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Filter)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Map)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GroupBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SortBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Chunk))
+	l = len(m.Dedupe)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13756,6 +17141,14 @@ func (m *WorkflowSpec) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.AutoRetry != nil {
+		l = m.AutoRetry.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13834,6 +17227,22 @@ func (m *WorkflowStatus) Size() (n int) {
 		l = m.ArtifactRepositoryRef.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if len(m.CreatedResources) > 0 {
+		for _, e := range m.CreatedResources {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.AutoRetries) > 0 {
+		for _, e := range m.AutoRetries {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ArtifactGCStatus != nil {
+		l = m.ArtifactGCStatus.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -14072,6 +17481,48 @@ func (this *Arguments) String() string {
 	}, "")
 	return s
 }
+func (this *ArtGCStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForStrategiesProcessed := make([]string, 0, len(this.StrategiesProcessed))
+	for k := range this.StrategiesProcessed {
+		keysForStrategiesProcessed = append(keysForStrategiesProcessed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStrategiesProcessed)
+	mapStringForStrategiesProcessed := "map[ArtifactGCStrategy]bool{"
+	for _, k := range keysForStrategiesProcessed {
+		mapStringForStrategiesProcessed += fmt.Sprintf("%v: %v,", k, this.StrategiesProcessed[ArtifactGCStrategy(k)])
+	}
+	mapStringForStrategiesProcessed += "}"
+	keysForPodsRecouped := make([]string, 0, len(this.PodsRecouped))
+	for k := range this.PodsRecouped {
+		keysForPodsRecouped = append(keysForPodsRecouped, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPodsRecouped)
+	mapStringForPodsRecouped := "map[string]bool{"
+	for _, k := range keysForPodsRecouped {
+		mapStringForPodsRecouped += fmt.Sprintf("%v: %v,", k, this.PodsRecouped[k])
+	}
+	mapStringForPodsRecouped += "}"
+	keysForPodsFailed := make([]string, 0, len(this.PodsFailed))
+	for k := range this.PodsFailed {
+		keysForPodsFailed = append(keysForPodsFailed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPodsFailed)
+	mapStringForPodsFailed := "map[ArtifactGCStrategy]int32{"
+	for _, k := range keysForPodsFailed {
+		mapStringForPodsFailed += fmt.Sprintf("%v: %v,", k, this.PodsFailed[ArtifactGCStrategy(k)])
+	}
+	mapStringForPodsFailed += "}"
+	s := strings.Join([]string{`&ArtGCStatus{`,
+		`StrategiesProcessed:` + mapStringForStrategiesProcessed + `,`,
+		`PodsRecouped:` + mapStringForPodsRecouped + `,`,
+		`PodsFailed:` + mapStringForPodsFailed + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Artifact) String() string {
 	if this == nil {
 		return "nil"
@@ -14088,6 +17539,59 @@ func (this *Artifact) String() string {
 		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
 		`RecurseMode:` + fmt.Sprintf("%v", this.RecurseMode) + `,`,
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`SizeBytes:` + fmt.Sprintf("%v", this.SizeBytes) + `,`,
+		`VerifyChecksum:` + fmt.Sprintf("%v", this.VerifyChecksum) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`MaxEntries:` + fmt.Sprintf("%v", this.MaxEntries) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactDataSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactDataSource{`,
+		`Artifact:` + strings.Replace(strings.Replace(this.Artifact.String(), "Artifact", "Artifact", 1), `&`, ``, 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactEncryption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPreviousKeySecrets := "[]SecretKeySelector{"
+	for _, f := range this.PreviousKeySecrets {
+		repeatedStringForPreviousKeySecrets += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForPreviousKeySecrets += "}"
+	s := strings.Join([]string{`&ArtifactEncryption{`,
+		`KeySecret:` + strings.Replace(fmt.Sprintf("%v", this.KeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KeyID:` + fmt.Sprintf("%v", this.KeyID) + `,`,
+		`PreviousKeySecrets:` + repeatedStringForPreviousKeySecrets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactGC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactGC{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14106,6 +17610,11 @@ func (this *ArtifactLocation) String() string {
 		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifact", "AzureArtifact", 1) + `,`,
+		`Encryption:` + strings.Replace(this.Encryption.String(), "ArtifactEncryption", "ArtifactEncryption", 1) + `,`,
+		`OCI:` + strings.Replace(this.OCI.String(), "OCIArtifact", "OCIArtifact", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginArtifact", "PluginArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14131,6 +17640,9 @@ func (this *ArtifactRepository) String() string {
 		`HDFS:` + strings.Replace(this.HDFS.String(), "HDFSArtifactRepository", "HDFSArtifactRepository", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifactRepository", "OSSArtifactRepository", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifactRepository", "GCSArtifactRepository", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifactRepository", "AzureArtifactRepository", 1) + `,`,
+		`Encryption:` + strings.Replace(this.Encryption.String(), "ArtifactEncryption", "ArtifactEncryption", 1) + `,`,
+		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginArtifactRepository", "PluginArtifactRepository", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14168,6 +17680,69 @@ func (this *ArtifactoryAuth) String() string {
 	}, "")
 	return s
 }
+func (this *AutoRetryAttempt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutoRetryAttempt{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`FailedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FailedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`RetriedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RetriedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AutoRetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutoRetryStrategy{`,
+		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`RestartSuccessful:` + fmt.Sprintf("%v", this.RestartSuccessful) + `,`,
+		`NodeFieldSelector:` + fmt.Sprintf("%v", this.NodeFieldSelector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifact{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`Blob:` + fmt.Sprintf("%v", this.Blob) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureArtifactRepository) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifactRepository{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`BlobNameFormat:` + fmt.Sprintf("%v", this.BlobNameFormat) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureBlobContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureBlobContainer{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SASTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SASTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UseWorkloadIdentity:` + fmt.Sprintf("%v", this.UseWorkloadIdentity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -14176,6 +17751,18 @@ func (this *Backoff) String() string {
 		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
 		`Factor:` + strings.Replace(fmt.Sprintf("%v", this.Factor), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`Jitter:` + fmt.Sprintf("%v", this.Jitter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BasicAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BasicAuth{`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14186,6 +17773,8 @@ func (this *Cache) String() string {
 	}
 	s := strings.Join([]string{`&Cache{`,
 		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLCache", "SQLCache", 1) + `,`,
+		`Artifact:` + strings.Replace(this.Artifact.String(), "ArtifactCache", "ArtifactCache", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14229,6 +17818,17 @@ func (this *Condition) String() string {
 	}, "")
 	return s
 }
+func (this *ConfigMapDataSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfigMapDataSource{`,
+		`ConfigMapKeySelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeySelector), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1), `&`, ``, 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ContainerNode) String() string {
 	if this == nil {
 		return "nil"
@@ -14236,6 +17836,20 @@ func (this *ContainerNode) String() string {
 	s := strings.Join([]string{`&ContainerNode{`,
 		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
 		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
+		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
+		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "ContainerRetryStrategy", "ContainerRetryStrategy", 1) + `,`,
+		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerRetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerRetryStrategy{`,
+		`Retries:` + strings.Replace(fmt.Sprintf("%v", this.Retries), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14292,6 +17906,25 @@ func (this *CreateS3BucketOptions) String() string {
 	}, "")
 	return s
 }
+func (this *CreatedResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreatedResource{`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`Cleanup:` + strings.Replace(this.Cleanup.String(), "ResourceCleanup", "ResourceCleanup", 1) + `,`,
+		`CleanupPhase:` + fmt.Sprintf("%v", this.CleanupPhase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflow) String() string {
 	if this == nil {
 		return "nil"
@@ -14437,6 +18070,21 @@ func (this *DataSource) String() string {
 	}
 	s := strings.Join([]string{`&DataSource{`,
 		`ArtifactPaths:` + strings.Replace(this.ArtifactPaths.String(), "ArtifactPaths", "ArtifactPaths", 1) + `,`,
+		`Value:` + strings.Replace(this.Value.String(), "DataValue", "DataValue", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPDataSource", "HTTPDataSource", 1) + `,`,
+		`ConfigMap:` + strings.Replace(this.ConfigMap.String(), "ConfigMapDataSource", "ConfigMapDataSource", 1) + `,`,
+		`Artifact:` + strings.Replace(this.Artifact.String(), "ArtifactDataSource", "ArtifactDataSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DataValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14519,6 +18167,28 @@ func (this *GitArtifact) String() string {
 		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
 		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
 		`DisableSubmodules:` + fmt.Sprintf("%v", this.DisableSubmodules) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`CreateBranch:` + fmt.Sprintf("%v", this.CreateBranch) + `,`,
+		`Dir:` + fmt.Sprintf("%v", this.Dir) + `,`,
+		`CommitMessage:` + fmt.Sprintf("%v", this.CommitMessage) + `,`,
+		`AuthorName:` + fmt.Sprintf("%v", this.AuthorName) + `,`,
+		`AuthorEmail:` + fmt.Sprintf("%v", this.AuthorEmail) + `,`,
+		`PushRetries:` + valueToStringGenerated(this.PushRetries) + `,`,
+		`SparseCheckout:` + fmt.Sprintf("%v", this.SparseCheckout) + `,`,
+		`Filter:` + fmt.Sprintf("%v", this.Filter) + `,`,
+		`LFS:` + strings.Replace(this.LFS.String(), "GitLFS", "GitLFS", 1) + `,`,
+		`SubmoduleRecursionDepth:` + valueToStringGenerated(this.SubmoduleRecursionDepth) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitLFS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitLFS{`,
+		`Include:` + fmt.Sprintf("%v", this.Include) + `,`,
+		`Exclude:` + fmt.Sprintf("%v", this.Exclude) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14606,6 +18276,38 @@ func (this *HTTPArtifact) String() string {
 	s := strings.Join([]string{`&HTTPArtifact{`,
 		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
 		`Headers:` + repeatedStringForHeaders + `,`,
+		`Auth:` + strings.Replace(this.Auth.String(), "HTTPAuth", "HTTPAuth", 1) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`MultipartField:` + fmt.Sprintf("%v", this.MultipartField) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPAuth{`,
+		`BasicAuth:` + strings.Replace(this.BasicAuth.String(), "BasicAuth", "BasicAuth", 1) + `,`,
+		`BearerTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.BearerTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPDataSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]HTTPHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeader", "HTTPHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTPDataSource{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14639,6 +18341,7 @@ func (this *Header) String() string {
 	s := strings.Join([]string{`&Header{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "HTTPHeaderSource", "HTTPHeaderSource", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14733,6 +18436,7 @@ func (this *MemoizationStatus) String() string {
 		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`CacheType:` + fmt.Sprintf("%v", this.CacheType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14745,6 +18449,7 @@ func (this *Memoize) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Cache:` + strings.Replace(this.Cache.String(), "Cache", "Cache", 1) + `,`,
 		`MaxAge:` + fmt.Sprintf("%v", this.MaxAge) + `,`,
+		`Auto:` + fmt.Sprintf("%v", this.Auto) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14899,6 +18604,8 @@ func (this *NodeStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`SynchronizationStatus:` + strings.Replace(this.SynchronizationStatus.String(), "NodeSynchronizationStatus", "NodeSynchronizationStatus", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`RetryDecision:` + strings.Replace(this.RetryDecision.String(), "RetryDecision", "RetryDecision", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14922,6 +18629,42 @@ func (this *NoneStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *OCIArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMediaTypes := make([]string, 0, len(this.MediaTypes))
+	for k := range this.MediaTypes {
+		keysForMediaTypes = append(keysForMediaTypes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMediaTypes)
+	mapStringForMediaTypes := "map[string]string{"
+	for _, k := range keysForMediaTypes {
+		mapStringForMediaTypes += fmt.Sprintf("%v: %v,", k, this.MediaTypes[k])
+	}
+	mapStringForMediaTypes += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&OCIArtifact{`,
+		`Repository:` + fmt.Sprintf("%v", this.Repository) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`DockerConfigSecret:` + strings.Replace(fmt.Sprintf("%v", this.DockerConfigSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`MediaTypes:` + mapStringForMediaTypes + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`PlainHTTP:` + fmt.Sprintf("%v", this.PlainHTTP) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OSSArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -15044,6 +18787,30 @@ func (this *Plugin) String() string {
 	}, "")
 	return s
 }
+func (this *PluginArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PluginArtifact{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Configuration:` + fmt.Sprintf("%v", this.Configuration) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PluginArtifactRepository) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PluginArtifactRepository{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Configuration:` + fmt.Sprintf("%v", this.Configuration) + `,`,
+		`KeyFormat:` + fmt.Sprintf("%v", this.KeyFormat) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PodGC) String() string {
 	if this == nil {
 		return "nil"
@@ -15086,6 +18853,30 @@ func (this *RawArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceCleanup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceCleanup{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`SecondsAfterCompletion:` + valueToStringGenerated(this.SecondsAfterCompletion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceEscalation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceEscalation{`,
+		`Memory:` + fmt.Sprintf("%v", this.Memory) + `,`,
+		`CPU:` + fmt.Sprintf("%v", this.CPU) + `,`,
+		`MaxMemory:` + strings.Replace(fmt.Sprintf("%v", this.MaxMemory), "Quantity", "resource.Quantity", 1) + `,`,
+		`MaxCPU:` + strings.Replace(fmt.Sprintf("%v", this.MaxCPU), "Quantity", "resource.Quantity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -15098,6 +18889,9 @@ func (this *ResourceTemplate) String() string {
 		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
 		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
 		`Flags:` + fmt.Sprintf("%v", this.Flags) + `,`,
+		`FieldManager:` + fmt.Sprintf("%v", this.FieldManager) + `,`,
+		`WaitForReady:` + valueToStringGenerated(this.WaitForReady) + `,`,
+		`Cleanup:` + strings.Replace(this.Cleanup.String(), "ResourceCleanup", "ResourceCleanup", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15112,6 +18906,19 @@ func (this *RetryAffinity) String() string {
 	}, "")
 	return s
 }
+func (this *RetryDecision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryDecision{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Rule:` + valueToStringGenerated(this.Rule) + `,`,
+		`Backoff:` + strings.Replace(fmt.Sprintf("%v", this.Backoff), "Duration", "v11.Duration", 1) + `,`,
+		`Changes:` + fmt.Sprintf("%v", this.Changes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryNodeAntiAffinity) String() string {
 	if this == nil {
 		return "nil"
@@ -15121,16 +18928,35 @@ func (this *RetryNodeAntiAffinity) String() string {
 	}, "")
 	return s
 }
+func (this *RetryRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryRule{`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`ExitCodes:` + fmt.Sprintf("%v", this.ExitCodes) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Escalate:` + strings.Replace(this.Escalate.String(), "ResourceEscalation", "ResourceEscalation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryStrategy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRules := "[]RetryRule{"
+	for _, f := range this.Rules {
+		repeatedStringForRules += strings.Replace(strings.Replace(f.String(), "RetryRule", "RetryRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRules += "}"
 	s := strings.Join([]string{`&RetryStrategy{`,
 		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`RetryPolicy:` + fmt.Sprintf("%v", this.RetryPolicy) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
 		`Affinity:` + strings.Replace(this.Affinity.String(), "RetryAffinity", "RetryAffinity", 1) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Rules:` + repeatedStringForRules + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15190,6 +19016,17 @@ func (this *S3EncryptionOptions) String() string {
 	}, "")
 	return s
 }
+func (this *SQLCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`MaxEntries:` + fmt.Sprintf("%v", this.MaxEntries) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ScriptTemplate) String() string {
 	if this == nil {
 		return "nil"
@@ -15349,6 +19186,8 @@ func (this *TarStrategy) String() string {
 	}
 	s := strings.Join([]string{`&TarStrategy{`,
 		`CompressionLevel:` + valueToStringGenerated(this.CompressionLevel) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`Concurrency:` + valueToStringGenerated(this.Concurrency) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15459,6 +19298,12 @@ func (this *TransformationStep) String() string {
 	}
 	s := strings.Join([]string{`&TransformationStep{`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Filter:` + fmt.Sprintf("%v", this.Filter) + `,`,
+		`Map:` + fmt.Sprintf("%v", this.Map) + `,`,
+		`GroupBy:` + fmt.Sprintf("%v", this.GroupBy) + `,`,
+		`SortBy:` + fmt.Sprintf("%v", this.SortBy) + `,`,
+		`Chunk:` + fmt.Sprintf("%v", this.Chunk) + `,`,
+		`Dedupe:` + fmt.Sprintf("%v", this.Dedupe) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15680,6 +19525,8 @@ func (this *WorkflowSpec) String() string {
 		`TemplateDefaults:` + strings.Replace(this.TemplateDefaults.String(), "Template", "Template", 1) + `,`,
 		`ArchiveLogs:` + valueToStringGenerated(this.ArchiveLogs) + `,`,
 		`Hooks:` + mapStringForHooks + `,`,
+		`AutoRetry:` + strings.Replace(this.AutoRetry.String(), "AutoRetryStrategy", "AutoRetryStrategy", 1) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15698,6 +19545,16 @@ func (this *WorkflowStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForCreatedResources := "[]CreatedResource{"
+	for _, f := range this.CreatedResources {
+		repeatedStringForCreatedResources += strings.Replace(strings.Replace(f.String(), "CreatedResource", "CreatedResource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCreatedResources += "}"
+	repeatedStringForAutoRetries := "[]AutoRetryAttempt{"
+	for _, f := range this.AutoRetries {
+		repeatedStringForAutoRetries += strings.Replace(strings.Replace(f.String(), "AutoRetryAttempt", "AutoRetryAttempt", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAutoRetries += "}"
 	keysForNodes := make([]string, 0, len(this.Nodes))
 	for k := range this.Nodes {
 		keysForNodes = append(keysForNodes, k)
@@ -15746,6 +19603,9 @@ func (this *WorkflowStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
+		`CreatedResources:` + repeatedStringForCreatedResources + `,`,
+		`AutoRetries:` + repeatedStringForAutoRetries + `,`,
+		`ArtifactGCStatus:` + strings.Replace(this.ArtifactGCStatus.String(), "ArtGCStatus", "ArtGCStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16278,7 +20138,7 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Artifact) Unmarshal(dAtA []byte) error {
+func (m *ArtGCStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16301,49 +20161,17 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtGCStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtGCStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategiesProcessed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16353,79 +20181,110 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			if m.StrategiesProcessed == nil {
+				m.StrategiesProcessed = make(map[ArtifactGCStrategy]bool)
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Mode = &v
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey ArtifactGCStrategy
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = ArtifactGCStrategy(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.StrategiesProcessed[ArtifactGCStrategy(mapkey)] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodsRecouped", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16452,45 +20311,95 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+			if m.PodsRecouped == nil {
+				m.PodsRecouped = make(map[string]bool)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			m.PodsRecouped[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodsFailed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16517,116 +20426,89 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Archive == nil {
-				m.Archive = &ArchiveStrategy{}
-			}
-			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Optional = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecurseMode", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RecurseMode = bool(v != 0)
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromExpression", wireType)
+			if m.PodsFailed == nil {
+				m.PodsFailed = make(map[ArtifactGCStrategy]int32)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey ArtifactGCStrategy
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = ArtifactGCStrategy(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FromExpression = string(dAtA[iNdEx:postIndex])
+			m.PodsFailed[ArtifactGCStrategy(mapkey)] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16649,7 +20531,7 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *Artifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16672,17 +20554,17 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16692,18 +20574,29 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ArchiveLogs = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16713,33 +20606,29 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &S3Artifact{}
-			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16749,33 +20638,17 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Git == nil {
-				m.Git = &GitArtifact{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Mode = &v
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16785,31 +20658,27 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPArtifact{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16836,18 +20705,15 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Artifactory == nil {
-				m.Artifactory = &ArtifactoryArtifact{}
-			}
-			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16857,31 +20723,27 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HDFS == nil {
-				m.HDFS = &HDFSArtifact{}
-			}
-			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16908,18 +20770,38 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Raw == nil {
-				m.Raw = &RawArtifact{}
+			if m.Archive == nil {
+				m.Archive = &ArchiveStrategy{}
 			}
-			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Archive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16929,33 +20811,49 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OSS == nil {
-				m.OSS = &OSSArtifact{}
+			m.SubPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurseMode", wireType)
 			}
-			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+			m.RecurseMode = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromExpression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16965,83 +20863,49 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GCS == nil {
-				m.GCS = &GCSArtifact{}
-			}
-			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactPaths: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactPaths: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Deleted = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17051,25 +20915,63 @@ func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyChecksum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyChecksum = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17091,7 +20993,7 @@ func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ArtifactCache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17114,146 +21016,17 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactCache: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactCache: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ArchiveLogs = &b
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.S3 == nil {
-				m.S3 = &S3ArtifactRepository{}
-			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Artifactory == nil {
-				m.Artifactory = &ArtifactoryArtifactRepository{}
-			}
-			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HDFS == nil {
-				m.HDFS = &HDFSArtifactRepository{}
-			}
-			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17263,33 +21036,29 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OSS == nil {
-				m.OSS = &OSSArtifactRepository{}
-			}
-			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
 			}
-			var msglen int
+			m.MaxEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17299,28 +21068,11 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxEntries |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GCS == nil {
-				m.GCS = &GCSArtifactRepository{}
-			}
-			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17342,7 +21094,7 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
+func (m *ArtifactDataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17365,17 +21117,17 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactRepositoryRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactDataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactRepositoryRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17385,27 +21137,28 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17433,7 +21186,7 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Format = DataFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17456,7 +21209,7 @@ func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
+func (m *ArtifactEncryption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17479,15 +21232,15 @@ func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactRepositoryRefStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactEncryption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactRepositoryRefStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17514,13 +21267,16 @@ func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.KeySecret == nil {
+				m.KeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17548,31 +21304,11 @@ func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Default = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepository", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousKeySecrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17599,10 +21335,8 @@ func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactRepository == nil {
-				m.ArtifactRepository = &ArtifactRepository{}
-			}
-			if err := m.ArtifactRepository.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PreviousKeySecrets = append(m.PreviousKeySecrets, v1.SecretKeySelector{})
+			if err := m.PreviousKeySecrets[len(m.PreviousKeySecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17627,7 +21361,7 @@ func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
+func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17650,15 +21384,15 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17686,40 +21420,7 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Strategy = ArtifactGCStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17742,7 +21443,7 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17765,15 +21466,36 @@ func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ArchiveLogs = &b
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17800,15 +21522,18 @@ func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.S3 == nil {
+				m.S3 = &S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17818,77 +21543,67 @@ func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if m.Git == nil {
+				m.Git = &GitArtifact{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.HTTP == nil {
+				m.HTTP = &HTTPArtifact{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryAuth: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryAuth: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17915,16 +21630,16 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
+			if m.Artifactory == nil {
+				m.Artifactory = &ArtifactoryArtifact{}
 			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17951,68 +21666,90 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			if m.HDFS == nil {
+				m.HDFS = &HDFSArtifact{}
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Backoff) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.Raw == nil {
+				m.Raw = &RawArtifact{}
 			}
-			if iNdEx >= l {
+			if err := m.Raw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.OSS == nil {
+				m.OSS = &OSSArtifact{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18022,27 +21759,31 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			if m.GCS == nil {
+				m.GCS = &GCSArtifact{}
+			}
+			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18069,18 +21810,18 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Factor == nil {
-				m.Factor = &intstr.IntOrString{}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
 			}
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18090,23 +21831,135 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			if m.Azure == nil {
+				m.Azure = &AzureArtifact{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Encryption == nil {
+				m.Encryption = &ArtifactEncryption{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OCI == nil {
+				m.OCI = &OCIArtifact{}
+			}
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = &PluginArtifact{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18129,7 +21982,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cache) Unmarshal(dAtA []byte) error {
+func (m *ArtifactPaths) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18152,15 +22005,15 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactPaths: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactPaths: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18187,10 +22040,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMap == nil {
-				m.ConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18215,7 +22065,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18238,15 +22088,36 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ArchiveLogs = &b
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18273,13 +22144,16 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.S3 == nil {
+				m.S3 = &S3ArtifactRepository{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifactory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18306,63 +22180,16 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Artifactory == nil {
+				m.Artifactory = &ArtifactoryArtifactRepository{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Artifactory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18389,13 +22216,16 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HDFS == nil {
+				m.HDFS = &HDFSArtifactRepository{}
+			}
+			if err := m.HDFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OSS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18422,66 +22252,54 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OSS == nil {
+				m.OSS = &OSSArtifactRepository{}
+			}
+			if err := m.OSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GCS", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Condition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.GCS == nil {
+				m.GCS = &GCSArtifactRepository{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.GCS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18491,29 +22309,33 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			if m.Azure == nil {
+				m.Azure = &AzureArtifactRepository{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18523,29 +22345,33 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			if m.Encryption == nil {
+				m.Encryption = &ArtifactEncryption{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18555,23 +22381,27 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Plugin == nil {
+				m.Plugin = &PluginArtifactRepository{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18594,7 +22424,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+func (m *ArtifactRepositoryRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18617,17 +22447,17 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactRepositoryRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactRepositoryRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18637,28 +22467,27 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18686,7 +22515,7 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18709,7 +22538,7 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18732,15 +22561,15 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactRepositoryRefStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactRepositoryRefStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18767,16 +22596,15 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18786,79 +22614,27 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Containers = append(m.Containers, ContainerNode{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContinueOn) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -18875,12 +22651,12 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Error = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			m.Default = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepository", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18890,12 +22666,28 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Failed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactRepository == nil {
+				m.ArtifactRepository = &ArtifactRepository{}
+			}
+			if err := m.ArtifactRepository.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18917,7 +22709,7 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Counter) Unmarshal(dAtA []byte) error {
+func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18940,15 +22732,15 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18976,63 +22768,13 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19042,12 +22784,25 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ObjectLocking = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19069,7 +22824,7 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19092,15 +22847,15 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactoryArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactoryArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19127,48 +22882,15 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19178,24 +22900,23 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19218,7 +22939,7 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19241,15 +22962,15 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactoryAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactoryAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19276,13 +22997,16 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19309,8 +23033,10 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19335,7 +23061,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *AutoRetryAttempt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19358,48 +23084,15 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoRetryAttempt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoRetryAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19427,11 +23120,11 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			m.Phase = WorkflowPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19459,93 +23152,13 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Suspend = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19555,27 +23168,28 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			if err := m.FailedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19602,10 +23216,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
-			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetriedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19630,7 +23241,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *AutoRetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19653,15 +23264,15 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoRetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19688,14 +23299,16 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Limit == nil {
+				m.Limit = &intstr.IntOrString{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19722,18 +23335,18 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
 			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19743,25 +23356,75 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartSuccessful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartSuccessful = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeFieldSelector", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeFieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19784,7 +23447,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *AzureArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19807,17 +23470,17 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19827,27 +23490,28 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19875,11 +23539,61 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Blob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AzureArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AzureArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AzureArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19906,15 +23620,15 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobNameFormat", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19924,31 +23638,77 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
-			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlobNameFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AzureBlobContainer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AzureBlobContainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AzureBlobContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19976,13 +23736,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19992,31 +23752,29 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20026,27 +23784,31 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
+			if m.AccountKeySecret == nil {
+				m.AccountKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASTokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20073,16 +23835,86 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
+			if m.SASTokenSecret == nil {
+				m.SASTokenSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SASTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseWorkloadIdentity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseWorkloadIdentity = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20110,11 +23942,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20141,16 +23973,16 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
+			if m.Factor == nil {
+				m.Factor = &intstr.IntOrString{}
 			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20178,11 +24010,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20210,11 +24042,61 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depends = string(dAtA[iNdEx:postIndex])
+			m.Jitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasicAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20241,109 +24123,16 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hooks == nil {
-				m.Hooks = make(LifecycleHooks)
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
 			}
-			var mapkey LifecycleEvent
-			mapvalue := &LifecycleHook{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &LifecycleHook{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20370,10 +24159,10 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inline == nil {
-				m.Inline = &Template{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20398,7 +24187,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+func (m *Cache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20421,17 +24210,17 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20441,27 +24230,31 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20488,16 +24281,18 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SQL == nil {
+				m.SQL = &SQLCache{}
+			}
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20507,13 +24302,28 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactCache{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20535,7 +24345,7 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Data) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20558,15 +24368,15 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20593,13 +24403,13 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20626,8 +24436,7 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transformation = append(m.Transformation, TransformationStep{})
-			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20652,7 +24461,7 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataSource) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20675,15 +24484,15 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20710,17 +24519,48 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactPaths == nil {
-				m.ArtifactPaths = &ArtifactPaths{}
-			}
-			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -20738,7 +24578,7 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20761,15 +24601,15 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20797,61 +24637,43 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20879,7 +24701,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20902,7 +24724,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+func (m *ConfigMapDataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20925,15 +24747,15 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigMapDataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigMapDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeySelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20960,13 +24782,13 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapKeySelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20994,7 +24816,7 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Format = DataFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21017,7 +24839,7 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21040,15 +24862,15 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21075,13 +24897,13 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21109,63 +24931,45 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Depends = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21175,27 +24979,31 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &ContainerRetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21222,10 +25030,10 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
 			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21250,7 +25058,7 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Gauge) Unmarshal(dAtA []byte) error {
+func (m *ContainerRetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21273,15 +25081,51 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerRetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retries == nil {
+				m.Retries = &intstr.IntOrString{}
+			}
+			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21309,29 +25153,8 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Realtime = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21353,7 +25176,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21376,131 +25199,15 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Depth = &v
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21527,16 +25234,14 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21563,52 +25268,64 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21625,10 +25342,10 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 9:
+			m.Error = bool(v != 0)
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21645,7 +25362,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableSubmodules = bool(v != 0)
+			m.Failed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21667,7 +25384,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *Counter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21690,48 +25407,15 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21759,28 +25443,8 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21802,7 +25466,7 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21825,80 +25489,15 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathFormat", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PathFormat = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21915,7 +25514,7 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			m.ObjectLocking = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21937,7 +25536,7 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
+func (m *CreatedResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21960,17 +25559,17 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatedResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatedResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21980,28 +25579,27 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22029,11 +25627,11 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22061,63 +25659,13 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22127,33 +25675,29 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22163,31 +25707,27 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22215,13 +25755,13 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cleanup", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22231,29 +25771,33 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			if m.Cleanup == nil {
+				m.Cleanup = &ResourceCleanup{}
+			}
+			if err := m.Cleanup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanupPhase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22263,31 +25807,59 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			m.CleanupPhase = ResourceCleanupPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22315,7 +25887,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22338,7 +25910,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTP) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22361,17 +25933,17 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22381,59 +25953,28 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22460,36 +26001,15 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, HTTPHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TimeoutSeconds = &v
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22499,55 +26019,24 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22570,7 +26059,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22593,17 +26082,17 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22613,27 +26102,28 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22660,8 +26150,8 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, Header{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22686,7 +26176,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22709,15 +26199,48 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22745,11 +26268,11 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22777,11 +26300,123 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedJobsHistoryLimit = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22808,10 +26443,10 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &HTTPHeaderSource{}
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &v11.ObjectMeta{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22836,7 +26471,7 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22859,15 +26494,15 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22894,68 +26529,16 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeyRef == nil {
-				m.SecretKeyRef = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Header) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22965,29 +26548,33 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v11.Time{}
+			}
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22997,23 +26584,25 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23036,7 +26625,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Histogram) Unmarshal(dAtA []byte) error {
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23059,15 +26648,15 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23095,13 +26684,13 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23111,79 +26700,27 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, Amount{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Inputs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23210,14 +26747,13 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23244,66 +26780,18 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Item) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23313,79 +26801,61 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LabelKeys) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23413,61 +26883,47 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.WithParam = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LabelValues) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LabelValues: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23495,61 +26951,47 @@ func (m *LabelValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23577,13 +27019,13 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23593,28 +27035,27 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depends = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23641,18 +27082,111 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey LifecycleEvent
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23662,23 +27196,27 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			if m.Inline == nil {
+				m.Inline = &Template{}
+			}
+			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23701,7 +27239,7 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Link) Unmarshal(dAtA []byte) error {
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23724,15 +27262,15 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23760,13 +27298,13 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23776,29 +27314,31 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23808,24 +27348,13 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.FailFast = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23847,7 +27376,7 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
+func (m *Data) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23870,37 +27399,17 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Hit = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23910,29 +27419,30 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23942,23 +27452,25 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheName = string(dAtA[iNdEx:postIndex])
+			m.Transformation = append(m.Transformation, TransformationStep{})
+			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23981,7 +27493,7 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Memoize) Unmarshal(dAtA []byte) error {
+func (m *DataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24004,17 +27516,17 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24024,27 +27536,31 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.ArtifactPaths == nil {
+				m.ArtifactPaths = &ArtifactPaths{}
+			}
+			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24071,18 +27587,18 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cache == nil {
-				m.Cache = &Cache{}
+			if m.Value == nil {
+				m.Value = &DataValue{}
 			}
-			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24092,77 +27608,31 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxAge = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Metadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.HTTP == nil {
+				m.HTTP = &HTTPDataSource{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24189,107 +27659,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.ConfigMap == nil {
+				m.ConfigMap = &ConfigMapDataSource{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24316,103 +27695,12 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactDataSource{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24435,7 +27723,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricLabel) Unmarshal(dAtA []byte) error {
+func (m *DataValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24458,15 +27746,15 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24494,11 +27782,11 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24526,7 +27814,7 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Format = DataFormat(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24549,7 +27837,7 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metrics) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24572,17 +27860,17 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24592,25 +27880,23 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prometheus = append(m.Prometheus, &Prometheus{})
-			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24633,7 +27919,7 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Mutex) Unmarshal(dAtA []byte) error {
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24656,15 +27942,15 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24692,7 +27978,7 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24715,7 +28001,7 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24738,17 +28024,17 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24758,27 +28044,28 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutex = string(dAtA[iNdEx:postIndex])
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24806,7 +28093,7 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24829,7 +28116,7 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24852,15 +28139,15 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24887,16 +28174,15 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, MutexHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24906,25 +28192,23 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, MutexHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24947,7 +28231,7 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeResult) Unmarshal(dAtA []byte) error {
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24970,15 +28254,15 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25006,13 +28290,13 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25022,29 +28306,83 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.ServiceAccountKeySecret == nil {
+				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25054,28 +28392,45 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
 			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Realtime = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25097,7 +28452,7 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25120,15 +28475,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25156,11 +28511,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25188,13 +28543,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var stringLen uint64
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25204,27 +28559,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Depth = &v
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25252,13 +28595,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25268,27 +28611,31 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25315,18 +28662,18 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25336,29 +28683,33 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25368,29 +28719,17 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BoundaryID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.InsecureIgnoreHostKey = bool(v != 0)
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25400,29 +28739,17 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DisableSubmodules = bool(v != 0)
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25432,30 +28759,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBranch", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25465,28 +28791,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.CreateBranch = bool(v != 0)
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25514,13 +28827,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodIP = string(dAtA[iNdEx:postIndex])
+			m.Dir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMessage", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25530,18 +28843,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Daemoned = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25551,33 +28875,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inputs == nil {
-				m.Inputs = &Inputs{}
-			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorEmail", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25587,33 +28907,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
-			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthorEmail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushRetries", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25623,27 +28939,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.PushRetries = &v
 		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SparseCheckout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25671,11 +28975,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
+			m.SparseCheckout = append(m.SparseCheckout, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 20:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25703,11 +29007,4718 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			m.Filter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LFS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LFS == nil {
+				m.LFS = &GitLFS{}
+			}
+			if err := m.LFS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmoduleRecursionDepth", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SubmoduleRecursionDepth = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitLFS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitLFS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitLFS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Include = append(m.Include, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exclude = append(m.Exclude, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HDFSArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HDFSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutSeconds = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auth == nil {
+				m.Auth = &HTTPAuth{}
+			}
+			if err := m.Auth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultipartField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultipartField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerTokenSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BearerTokenSecret == nil {
+				m.BearerTokenSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.BearerTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPDataSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPDataSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPDataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeoutSeconds = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = DataFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, Amount{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Inputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Item) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Link) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = CacheType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Memoize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cache == nil {
+				m.Cache = &Cache{}
+			}
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Auto = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricLabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prometheus = append(m.Prometheus, &Prometheus{})
+			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mutex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holding = append(m.Holding, MutexHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = append(m.Waiting, MutexHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BoundaryID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Daemoned = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inputs == nil {
+				m.Inputs = &Inputs{}
+			}
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25737,8 +33748,658 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if m.ResourcesDuration == nil {
 				m.ResourcesDuration = make(ResourcesDuration)
 			}
-			var mapkey k8s_io_api_core_v1.ResourceName
-			var mapvalue int64
+			var mapkey k8s_io_api_core_v1.ResourceName
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemoizationStatus == nil {
+				m.MemoizationStatus = &MemoizationStatus{}
+			}
+			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SynchronizationStatus == nil {
+				m.SynchronizationStatus = &NodeSynchronizationStatus{}
+			}
+			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = FailureReason(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryDecision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryDecision == nil {
+				m.RetryDecision = &RetryDecision{}
+			}
+			if err := m.RetryDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DockerConfigSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DockerConfigSecret == nil {
+				m.DockerConfigSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.DockerConfigSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MediaTypes == nil {
+				m.MediaTypes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -25784,9 +34445,10 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -25796,11 +34458,151 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= int64(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MediaTypes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25816,11 +34618,343 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainHTTP", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PlainHTTP = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25848,11 +34982,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25879,18 +35013,54 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoizationStatus == nil {
-				m.MemoizationStatus = &MemoizationStatus{}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v1.SecretKeySelector{}
 			}
-			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
 			}
-			m.EstimatedDuration = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25900,16 +35070,17 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+			m.CreateBucketIfNotPresent = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25919,33 +35090,29 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SynchronizationStatus == nil {
-				m.SynchronizationStatus = &NodeSynchronizationStatus{}
-			}
-			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25955,23 +35122,27 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			if m.LifecycleRule == nil {
+				m.LifecycleRule = &OSSLifecycleRule{}
+			}
+			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25994,7 +35165,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26017,17 +35188,17 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
 			}
-			var stringLen uint64
+			m.MarkInfrequentAccessAfterDays = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26037,24 +35208,30 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MarkDeletionAfterDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Waiting = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26076,7 +35253,7 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
+func (m *Object) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26099,10 +35276,10 @@ func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -26126,7 +35303,7 @@ func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+func (m *Outputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26149,15 +35326,15 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26184,13 +35361,48 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26218,7 +35430,41 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Result = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ExitCode = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26241,7 +35487,7 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26264,15 +35510,15 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26299,42 +35545,11 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, WorkflowStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26356,7 +35571,7 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+func (m *Parameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26379,15 +35594,15 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26415,11 +35630,11 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26447,13 +35662,14 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26463,31 +35679,28 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26514,18 +35727,18 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
 			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26535,15 +35748,27 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateBucketIfNotPresent = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26571,11 +35796,94 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Description = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26602,10 +35910,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LifecycleRule == nil {
-				m.LifecycleRule = &OSSLifecycleRule{}
-			}
-			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26630,7 +35935,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
+func (m *PluginArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26653,17 +35958,17 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.MarkInfrequentAccessAfterDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26673,16 +35978,29 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
 			}
-			m.MarkDeletionAfterDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26692,61 +36010,56 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Object) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26768,7 +36081,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Outputs) Unmarshal(dAtA []byte) error {
+func (m *PluginArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26791,17 +36104,17 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: PluginArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PluginArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26811,63 +36124,27 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26895,12 +36172,11 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Result = &s
+			m.Configuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26928,8 +36204,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ExitCode = &s
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26952,7 +36227,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+func (m *PodGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26975,15 +36250,47 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27010,8 +36317,10 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, WorkflowStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v11.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27036,7 +36345,7 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Parameter) Unmarshal(dAtA []byte) error {
+func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27059,10 +36368,10 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -27099,7 +36408,41 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, &MetricLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27127,12 +36470,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			m.Help = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27160,12 +36502,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27192,18 +36533,18 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27213,29 +36554,33 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27245,27 +36590,81 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			if m.Counter == nil {
+				m.Counter = &Counter{}
+			}
+			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27293,8 +36692,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27317,7 +36715,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plugin) Unmarshal(dAtA []byte) error {
+func (m *ResourceCleanup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27340,17 +36738,17 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceCleanup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceCleanup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27360,25 +36758,44 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Strategy = ResourceCleanupStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterCompletion = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27400,7 +36817,7 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodGC) Unmarshal(dAtA []byte) error {
+func (m *ResourceEscalation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27423,15 +36840,15 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceEscalation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceEscalation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27459,11 +36876,43 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPU", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPU = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27490,10 +36939,46 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v11.LabelSelector{}
+			if m.MaxMemory == nil {
+				m.MaxMemory = &resource.Quantity{}
 			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxMemory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCPU", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxCPU == nil {
+				m.MaxCPU = &resource.Quantity{}
+			}
+			if err := m.MaxCPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27518,7 +37003,7 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Prometheus) Unmarshal(dAtA []byte) error {
+func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27541,15 +37026,79 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27577,13 +37126,33 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Manifest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetOwnerReference = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27593,29 +37162,27 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, &MetricLabel{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27643,11 +37210,11 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Help = string(dAtA[iNdEx:postIndex])
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27675,13 +37242,13 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldManager", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27691,33 +37258,29 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
-			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FieldManager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForReady", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27727,31 +37290,16 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Histogram == nil {
-				m.Histogram = &Histogram{}
-			}
-			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			b := bool(v != 0)
+			m.WaitForReady = &b
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cleanup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27778,10 +37326,10 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Counter == nil {
-				m.Counter = &Counter{}
+			if m.Cleanup == nil {
+				m.Cleanup = &ResourceCleanup{}
 			}
-			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cleanup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27806,7 +37354,7 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawArtifact) Unmarshal(dAtA []byte) error {
+func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27829,17 +37377,17 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27849,23 +37397,27 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			if m.NodeAntiAffinity == nil {
+				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			}
+			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27888,7 +37440,7 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
+func (m *RetryDecision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27911,15 +37463,15 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryDecision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryDecision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27947,13 +37499,33 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Reason = FailureReason(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rule = &v
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27963,27 +37535,31 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &v11.Duration{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28011,31 +37587,111 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifest = string(dAtA[iNdEx:postIndex])
+			m.Changes = append(m.Changes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.SetOwnerReference = bool(v != 0)
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28063,11 +37719,87 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			m.Reasons = append(m.Reasons, FailureReason(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExitCodes = append(m.ExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExitCodes) == 0 {
+					m.ExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExitCodes = append(m.ExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28095,13 +37827,13 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			m.Action = RetryRuleAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escalate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28111,23 +37843,27 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			if m.Escalate == nil {
+				m.Escalate = &ResourceEscalation{}
+			}
+			if err := m.Escalate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28150,7 +37886,7 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28173,15 +37909,15 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28208,116 +37944,84 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAntiAffinity == nil {
-				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			if m.Limit == nil {
+				m.Limit = &intstr.IntOrString{}
 			}
-			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28344,16 +38048,16 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &intstr.IntOrString{}
+			if m.Affinity == nil {
+				m.Affinity = &RetryAffinity{}
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28381,47 +38085,11 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetryPolicy = RetryPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
-			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28448,45 +38116,11 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Affinity == nil {
-				m.Affinity = &RetryAffinity{}
-			}
-			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, RetryRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29303,6 +38937,107 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SQLCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQLCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQLCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -30888,7 +40623,117 @@ func (m *SynchronizationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
+func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterCompletion = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterSuccess = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondsAfterFailure = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30911,15 +40756,15 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: TarStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TarStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionLevel", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -30936,32 +40781,12 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.SecondsAfterCompletion = &v
+			m.CompressionLevel = &v
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SecondsAfterSuccess = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30971,65 +40796,27 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterFailure = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TarStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TarStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TarStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Compression = TarCompression(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressionLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -31046,7 +40833,7 @@ func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.CompressionLevel = &v
+			m.Concurrency = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32582,13 +42369,179 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransformationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransformationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransformationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Map = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32598,65 +42551,78 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterScope = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransformationStep) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SortBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransformationStep: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransformationStep: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Chunk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunk |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dedupe", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32684,7 +42650,7 @@ func (m *TransformationStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Dedupe = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35647,6 +45613,78 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
 			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRetry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoRetry == nil {
+				m.AutoRetry = &AutoRetryStrategy{}
+			}
+			if err := m.AutoRetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
+			}
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36525,6 +46563,110 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedResources = append(m.CreatedResources, CreatedResource{})
+			if err := m.CreatedResources[len(m.CreatedResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRetries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRetries = append(m.AutoRetries, AutoRetryAttempt{})
+			if err := m.AutoRetries[len(m.AutoRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGCStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGCStatus == nil {
+				m.ArtifactGCStatus = &ArtGCStatus{}
+			}
+			if err := m.ArtifactGCStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
+	"net/http"
 	"net/url"
 	"path"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -110,6 +112,28 @@ const (
 	VolumeClaimGCOnSuccess    VolumeClaimGCStrategy = "OnWorkflowSuccess"
 )
 
+// ArtifactGCStrategy is the strategy when to delete the output artifacts of a workflow
+type ArtifactGCStrategy string
+
+func (s ArtifactGCStrategy) IsValid() bool {
+	switch s {
+	case ArtifactGCStrategyUndefined,
+		ArtifactGCOnWorkflowCompletion,
+		ArtifactGCOnWorkflowDeletion,
+		ArtifactGCNever:
+		return true
+	}
+	return false
+}
+
+// ArtifactGCStrategy
+const (
+	ArtifactGCStrategyUndefined    ArtifactGCStrategy = ""
+	ArtifactGCOnWorkflowCompletion ArtifactGCStrategy = "OnWorkflowCompletion"
+	ArtifactGCOnWorkflowDeletion   ArtifactGCStrategy = "OnWorkflowDeletion"
+	ArtifactGCNever                ArtifactGCStrategy = "Never"
+)
+
 // Workflow is the definition of a workflow resource
 // +genclient
 // +genclient:noStatus
@@ -377,6 +401,50 @@ type WorkflowSpec struct {
 	// Hooks holds the lifecycle hook which is invoked at lifecycle of
 	// step, irrespective of the success, failure, or error status of the primary step
 	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,41,opt,name=hooks"`
+
+	// AutoRetry retries the workflow from its failed nodes when it fails, in the same way as `argo retry`
+	AutoRetry *AutoRetryStrategy `json:"autoRetry,omitempty" protobuf:"bytes,42,opt,name=autoRetry"`
+
+	// ArtifactGC describes the strategy to use when deleting the output artifacts of the workflow, unless overridden
+	// by the archive location of a template or by an artifact
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,43,opt,name=artifactGC"`
+}
+
+// AutoRetryStrategy is how a failed workflow is automatically retried. Its successful nodes are kept, and its failed
+// nodes and exit handler are run again.
+type AutoRetryStrategy struct {
+	// Limit is the maximum number of times that the workflow is retried, defaults to 3
+	Limit *intstr.IntOrString `json:"limit,omitempty" protobuf:"varint,1,opt,name=limit"`
+
+	// Backoff is how long to wait after the workflow fails before it is retried. Its maxDuration is the maximum time
+	// since the workflow first failed that it is retried for.
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+
+	// Expression is a condition for retrying the workflow. It can use `workflow.status`, `workflow.message`,
+	// `workflow.retries` (the number of times it was retried), and `failedNodes`, a list of the failed nodes with
+	// their `displayName`, `templateName`, `phase`, `message` and `reason`.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,3,opt,name=expression"`
+
+	// RestartSuccessful also restarts the successful nodes that match the NodeFieldSelector
+	RestartSuccessful bool `json:"restartSuccessful,omitempty" protobuf:"varint,4,opt,name=restartSuccessful"`
+
+	// NodeFieldSelector selects the nodes to restart, e.g. "templateName=main"
+	NodeFieldSelector string `json:"nodeFieldSelector,omitempty" protobuf:"bytes,5,opt,name=nodeFieldSelector"`
+}
+
+// AutoRetryAttempt records an attempt of a workflow that failed, and was automatically retried
+type AutoRetryAttempt struct {
+	// Phase is how the attempt completed, either Failed or Error
+	Phase WorkflowPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=WorkflowPhase"`
+
+	// Message is the message of the attempt
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+
+	// FailedAt is when the attempt failed
+	FailedAt metav1.Time `json:"failedAt,omitempty" protobuf:"bytes,3,opt,name=failedAt"`
+
+	// RetriedAt is when the workflow was retried, which is zero while it backs off
+	RetriedAt metav1.Time `json:"retriedAt,omitempty" protobuf:"bytes,4,opt,name=retriedAt"`
 }
 
 func (wfs *WorkflowSpec) GetExitHook(args Arguments) *LifecycleHook {
@@ -877,6 +945,18 @@ type Artifact struct {
 
 	// FromExpression, if defined, is evaluated to specify the value for the artifact
 	FromExpression string `json:"fromExpression,omitempty" protobuf:"bytes,11,opt,name=fromExpression"`
+
+	// Deleted is set once the artifact has been deleted by artifact garbage collection
+	Deleted bool `json:"deleted,omitempty" protobuf:"varint,12,opt,name=deleted"`
+
+	// Checksum is the SHA-256 of the content of the artifact, as "sha256:<hex>", set when the artifact is saved
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,13,opt,name=checksum"`
+
+	// SizeBytes is the size of the content of the artifact, set when the artifact is saved
+	SizeBytes int64 `json:"sizeBytes,omitempty" protobuf:"varint,14,opt,name=sizeBytes"`
+
+	// VerifyChecksum fails loading the input artifact if it has no checksum. An input artifact with a checksum is always verified.
+	VerifyChecksum bool `json:"verifyChecksum,omitempty" protobuf:"varint,15,opt,name=verifyChecksum"`
 }
 
 // PodGC describes how to delete completed pods as they complete
@@ -905,6 +985,20 @@ func (podGC *PodGC) GetStrategy() PodGCStrategy {
 	return PodGCOnPodNone
 }
 
+// ArtifactGC describes how to delete output artifacts
+type ArtifactGC struct {
+	// Strategy is the strategy to use. One of "OnWorkflowCompletion", "OnWorkflowDeletion", "Never"
+	Strategy ArtifactGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ArtifactGCStrategy"`
+}
+
+// GetStrategy returns the ArtifactGCStrategy to use, which is undefined for a nil ArtifactGC
+func (agc *ArtifactGC) GetStrategy() ArtifactGCStrategy {
+	if agc != nil {
+		return agc.Strategy
+	}
+	return ArtifactGCStrategyUndefined
+}
+
 // VolumeClaimGC describes how to delete volumes from completed Workflows
 type VolumeClaimGC struct {
 	// Strategy is the strategy to use. One of "OnWorkflowCompletion", "OnWorkflowSuccess"
@@ -927,11 +1021,42 @@ type ArchiveStrategy struct {
 	Zip  *ZipStrategy  `json:"zip,omitempty" protobuf:"bytes,3,opt,name=zip"`
 }
 
-// TarStrategy will tar and gzip the file or directory when saving
+// TarStrategy will tar and compress the file or directory when saving
 type TarStrategy struct {
-	// CompressionLevel specifies the gzip compression level to use for the artifact.
-	// Defaults to gzip.DefaultCompression.
+	// CompressionLevel specifies the compression level to use for the artifact.
+	// Defaults to gzip.DefaultCompression for gzip, and 3 for zstd.
 	CompressionLevel *int32 `json:"compressionLevel,omitempty" protobuf:"varint,1,opt,name=compressionLevel"`
+
+	// Compression is the compression of the tarball. One of "gzip", "zstd" or "none". Defaults to "gzip".
+	Compression TarCompression `json:"compression,omitempty" protobuf:"bytes,2,opt,name=compression,casttype=TarCompression"`
+
+	// Concurrency is the number of goroutines that compress the tarball. Defaults to the number of CPUs.
+	Concurrency *int32 `json:"concurrency,omitempty" protobuf:"varint,3,opt,name=concurrency"`
+}
+
+// TarCompression is the compression of a tarball
+type TarCompression string
+
+const (
+	TarCompressionGzip TarCompression = "gzip"
+	TarCompressionZstd TarCompression = "zstd"
+	TarCompressionNone TarCompression = "none"
+)
+
+func (c TarCompression) IsValid() bool {
+	switch c {
+	case "", TarCompressionGzip, TarCompressionZstd, TarCompressionNone:
+		return true
+	}
+	return false
+}
+
+// GetCompression returns the compression of the tarball, which is gzip for a nil strategy
+func (s *TarStrategy) GetCompression() TarCompression {
+	if s == nil || s.Compression == "" {
+		return TarCompressionGzip
+	}
+	return s.Compression
 }
 
 // ZipStrategy will unzip zipped input artifacts
@@ -979,6 +1104,35 @@ type ArtifactLocation struct {
 
 	// GCS contains GCS artifact location details
 	GCS *GCSArtifact `json:"gcs,omitempty" protobuf:"bytes,9,opt,name=gcs"`
+
+	// ArtifactGC describes the strategy to use when deleting the artifact, or the artifacts of an archive location
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,10,opt,name=artifactGC"`
+
+	// Azure contains Azure Blob Storage artifact location details
+	Azure *AzureArtifact `json:"azure,omitempty" protobuf:"bytes,11,opt,name=azure"`
+
+	// Encryption encrypts the artifact, or the artifacts of an archive location, in the executor before it is saved
+	Encryption *ArtifactEncryption `json:"encryption,omitempty" protobuf:"bytes,12,opt,name=encryption"`
+
+	// OCI contains OCI registry artifact location details
+	OCI *OCIArtifact `json:"oci,omitempty" protobuf:"bytes,13,opt,name=oci"`
+
+	// Plugin contains the location details of an artifact stored by an artifact plugin
+	Plugin *PluginArtifact `json:"plugin,omitempty" protobuf:"bytes,14,opt,name=plugin"`
+}
+
+// ArtifactEncryption is client-side envelope encryption of artifacts. Each artifact is encrypted with AES-256-GCM by
+// a random data key, which is wrapped by the key in a secret and stored with the artifact.
+type ArtifactEncryption struct {
+	// KeySecret is the secret containing the 32 byte key that wraps the data keys, either raw or base64 encoded
+	KeySecret *apiv1.SecretKeySelector `json:"keySecret" protobuf:"bytes,1,opt,name=keySecret"`
+
+	// KeyID is the ID of the key that wrapped the data key of the artifact, set when the artifact is saved
+	KeyID string `json:"keyID,omitempty" protobuf:"bytes,2,opt,name=keyID"`
+
+	// PreviousKeySecrets are the secrets of the keys that the key secret replaced. They are only used to decrypt the
+	// artifacts that were encrypted before the key was rotated, picked by the key ID of the artifact.
+	PreviousKeySecrets []apiv1.SecretKeySelector `json:"previousKeySecrets,omitempty" protobuf:"bytes,3,rep,name=previousKeySecrets"`
 }
 
 func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
@@ -986,6 +1140,8 @@ func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
 		return nil, fmt.Errorf("key unsupported: cannot get key for artifact location, because it is invalid")
 	} else if a.Artifactory != nil {
 		return a.Artifactory, nil
+	} else if a.Azure != nil {
+		return a.Azure, nil
 	} else if a.Git != nil {
 		return a.Git, nil
 	} else if a.GCS != nil {
@@ -994,8 +1150,12 @@ func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
 		return a.HDFS, nil
 	} else if a.HTTP != nil {
 		return a.HTTP, nil
+	} else if a.OCI != nil {
+		return a.OCI, nil
 	} else if a.OSS != nil {
 		return a.OSS, nil
+	} else if a.Plugin != nil {
+		return a.Plugin, nil
 	} else if a.Raw != nil {
 		return a.Raw, nil
 	} else if a.S3 != nil {
@@ -1010,14 +1170,20 @@ func (a *ArtifactLocation) SetType(x ArtifactLocationType) error {
 	switch v := x.(type) {
 	case *ArtifactoryArtifact:
 		a.Artifactory = &ArtifactoryArtifact{}
+	case *AzureArtifact:
+		a.Azure = &AzureArtifact{}
 	case *GCSArtifact:
 		a.GCS = &GCSArtifact{}
 	case *HDFSArtifact:
 		a.HDFS = &HDFSArtifact{}
 	case *HTTPArtifact:
 		a.HTTP = &HTTPArtifact{}
+	case *OCIArtifact:
+		a.OCI = &OCIArtifact{}
 	case *OSSArtifact:
 		a.OSS = &OSSArtifact{}
+	case *PluginArtifact:
+		a.Plugin = &PluginArtifact{}
 	case *RawArtifact:
 		a.Raw = &RawArtifact{}
 	case *S3Artifact:
@@ -1068,7 +1234,12 @@ func (a *ArtifactLocation) Relocate(l *ArtifactLocation) error {
 	if err != nil {
 		return err
 	}
+	encryption := a.Encryption
 	*a = *l.DeepCopy()
+	if encryption != nil {
+		// the artifact was saved with its own encryption
+		a.Encryption = encryption
+	}
 	return a.SetKey(key)
 }
 
@@ -1517,6 +1688,70 @@ type WorkflowStatus struct {
 
 	// ArtifactRepositoryRef is used to cache the repository to use so we do not need to determine it everytime we reconcile.
 	ArtifactRepositoryRef *ArtifactRepositoryRefStatus `json:"artifactRepositoryRef,omitempty" protobuf:"bytes,18,opt,name=artifactRepositoryRef"`
+
+	// CreatedResources are the resources created by resource templates with a cleanup policy, and their cleanup status
+	CreatedResources CreatedResources `json:"createdResources,omitempty" protobuf:"bytes,19,rep,name=createdResources"`
+
+	// AutoRetries records the attempts of the workflow that failed and were automatically retried
+	AutoRetries []AutoRetryAttempt `json:"autoRetries,omitempty" protobuf:"bytes,20,rep,name=autoRetries"`
+
+	// ArtifactGCStatus is the status of the garbage collection of the output artifacts of the workflow
+	ArtifactGCStatus *ArtGCStatus `json:"artifactGCStatus,omitempty" protobuf:"bytes,21,opt,name=artifactGCStatus"`
+}
+
+// ArtGCStatus is the status of the garbage collection of the output artifacts of a workflow
+type ArtGCStatus struct {
+	// StrategiesProcessed are the strategies whose artifact deletion pods have been created
+	StrategiesProcessed map[ArtifactGCStrategy]bool `json:"strategiesProcessed,omitempty" protobuf:"bytes,1,rep,name=strategiesProcessed,castkey=ArtifactGCStrategy"`
+
+	// PodsRecouped are the artifact deletion pods whose results have been recorded, and which have been deleted
+	PodsRecouped map[string]bool `json:"podsRecouped,omitempty" protobuf:"bytes,2,rep,name=podsRecouped"`
+
+	// PodsFailed are the numbers of the artifact deletion pods of the strategies that failed
+	PodsFailed map[ArtifactGCStrategy]int32 `json:"podsFailed,omitempty" protobuf:"bytes,3,rep,name=podsFailed,castkey=ArtifactGCStrategy"`
+}
+
+// IsStrategyProcessed returns whether the artifact deletion pod of the strategy has been created
+func (s *ArtGCStatus) IsStrategyProcessed(strategy ArtifactGCStrategy) bool {
+	return s != nil && s.StrategiesProcessed[strategy]
+}
+
+// SetStrategyProcessed records that the artifact deletion pod of the strategy has been created
+func (s *ArtGCStatus) SetStrategyProcessed(strategy ArtifactGCStrategy) {
+	if s.StrategiesProcessed == nil {
+		s.StrategiesProcessed = make(map[ArtifactGCStrategy]bool)
+	}
+	s.StrategiesProcessed[strategy] = true
+}
+
+// GetPodsFailed returns the number of the artifact deletion pods of the strategy that failed
+func (s *ArtGCStatus) GetPodsFailed(strategy ArtifactGCStrategy) int32 {
+	if s == nil {
+		return 0
+	}
+	return s.PodsFailed[strategy]
+}
+
+// AddPodFailed records that an artifact deletion pod of the strategy failed, so that another one is created
+func (s *ArtGCStatus) AddPodFailed(strategy ArtifactGCStrategy) {
+	if s.PodsFailed == nil {
+		s.PodsFailed = make(map[ArtifactGCStrategy]int32)
+	}
+	s.PodsFailed[strategy]++
+	delete(s.StrategiesProcessed, strategy)
+}
+
+// IsPodRecouped returns whether the results of the artifact deletion pod have been recorded
+func (s *ArtGCStatus) IsPodRecouped(podName string) bool {
+	return s != nil && s.PodsRecouped[podName]
+}
+
+// SetPodRecouped records that the results of the artifact deletion pod have been recorded
+func (s *ArtGCStatus) SetPodRecouped(podName string) {
+	if s.PodsRecouped == nil {
+		s.PodsRecouped = make(map[string]bool)
+	}
+	s.PodsRecouped[podName] = true
 }
 
 func (ws *WorkflowStatus) IsOffloadNodeStatus() bool {
@@ -1548,6 +1783,9 @@ type Backoff struct {
 	Factor *intstr.IntOrString `json:"factor,omitempty" protobuf:"varint,2,opt,name=factor"`
 	// MaxDuration is the maximum amount of time allowed for the backoff strategy
 	MaxDuration string `json:"maxDuration,omitempty" protobuf:"varint,3,opt,name=maxDuration"`
+	// Jitter is the maximum fraction of the backoff duration that is randomly added to it, e.g. "0.2", so that nodes
+	// that failed at the same time are not all retried at the same time
+	Jitter string `json:"jitter,omitempty" protobuf:"bytes,4,opt,name=jitter"`
 }
 
 // RetryNodeAntiAffinity is a placeholder for future expansion, only empty nodeAntiAffinity is allowed.
@@ -1576,6 +1814,122 @@ type RetryStrategy struct {
 	// Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not
 	// be retried and the retry strategy will be ignored
 	Expression string `json:"expression,omitempty" protobuf:"bytes,5,opt,name=expression"`
+
+	// Rules decide whether, and how, a node is retried based on why its last attempt failed. The first rule that
+	// matches the last attempt is used instead of the retry policy.
+	Rules []RetryRule `json:"rules,omitempty" protobuf:"bytes,6,rep,name=rules"`
+}
+
+// MatchRule returns the first rule that matches the node, and its index
+func (r RetryStrategy) MatchRule(node NodeStatus) (*RetryRule, int) {
+	for i, rule := range r.Rules {
+		if rule.Matches(node) {
+			return &r.Rules[i], i
+		}
+	}
+	return nil, -1
+}
+
+// FailureReason is a reason that the pod of a node failed
+type FailureReason string
+
+const (
+	// FailureReasonOOMKilled means a container was killed because it ran out of memory
+	FailureReasonOOMKilled FailureReason = "OOMKilled"
+	// FailureReasonEvicted means the pod was evicted, e.g. because its node ran low on resources
+	FailureReasonEvicted FailureReason = "Evicted"
+	// FailureReasonNodeShutdown means the pod's node was shut down, or was lost
+	FailureReasonNodeShutdown FailureReason = "NodeShutdown"
+	// FailureReasonImagePullBackOff means an image of the pod could not be pulled
+	FailureReasonImagePullBackOff FailureReason = "ImagePullBackOff"
+	// FailureReasonDeadlineExceeded means the pod exceeded its active deadline
+	FailureReasonDeadlineExceeded FailureReason = "DeadlineExceeded"
+)
+
+// RetryRuleAction is what to do with a node whose last attempt matches a retry rule
+type RetryRuleAction string
+
+const (
+	RetryRuleActionRetry RetryRuleAction = "Retry"
+	RetryRuleActionFail  RetryRuleAction = "Fail"
+)
+
+// RetryRule is a rule that matches failed attempts by their failure reason or exit code
+type RetryRule struct {
+	// Reasons are the failure reasons matched by this rule: OOMKilled, Evicted, NodeShutdown, ImagePullBackOff or
+	// DeadlineExceeded
+	Reasons []FailureReason `json:"reasons,omitempty" protobuf:"bytes,1,rep,name=reasons,casttype=FailureReason"`
+
+	// ExitCodes are the exit codes of the main container matched by this rule
+	ExitCodes []int32 `json:"exitCodes,omitempty" protobuf:"varint,2,rep,name=exitCodes"`
+
+	// Action is either Retry (default), to retry the node regardless of the retry policy, or Fail, to not retry it
+	Action RetryRuleAction `json:"action,omitempty" protobuf:"bytes,3,opt,name=action,casttype=RetryRuleAction"`
+
+	// Escalate multiplies the resources of the next attempt's main containers, for every attempt matched by this rule
+	Escalate *ResourceEscalation `json:"escalate,omitempty" protobuf:"bytes,4,opt,name=escalate"`
+}
+
+func (r RetryRule) GetAction() RetryRuleAction {
+	if r.Action == "" {
+		return RetryRuleActionRetry
+	}
+	return r.Action
+}
+
+// Matches returns whether the node failed for one of the rule's reasons, or with one of its exit codes
+func (r RetryRule) Matches(node NodeStatus) bool {
+	if !node.FailedOrError() {
+		return false
+	}
+	for _, reason := range r.Reasons {
+		if reason == node.FailureReason {
+			return true
+		}
+	}
+	if len(r.ExitCodes) > 0 && node.Outputs != nil && node.Outputs.ExitCode != nil {
+		exitCode, err := strconv.Atoi(*node.Outputs.ExitCode)
+		if err != nil {
+			return false
+		}
+		for _, c := range r.ExitCodes {
+			if int(c) == exitCode {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResourceEscalation is how the resources of a retried pod's main containers are increased, by multiplying their
+// requests and limits by a factor
+type ResourceEscalation struct {
+	// Memory is the factor that memory requests and limits are multiplied by, e.g. "2"
+	Memory string `json:"memory,omitempty" protobuf:"bytes,1,opt,name=memory"`
+
+	// CPU is the factor that CPU requests and limits are multiplied by, e.g. "1.5"
+	CPU string `json:"cpu,omitempty" protobuf:"bytes,2,opt,name=cpu"`
+
+	// MaxMemory is the maximum that memory requests and limits are increased to
+	MaxMemory *resource.Quantity `json:"maxMemory,omitempty" protobuf:"bytes,3,opt,name=maxMemory"`
+
+	// MaxCPU is the maximum that CPU requests and limits are increased to
+	MaxCPU *resource.Quantity `json:"maxCPU,omitempty" protobuf:"bytes,4,opt,name=maxCPU"`
+}
+
+// RetryDecision records why, and how, a node was retried
+type RetryDecision struct {
+	// Reason is why the previous attempt failed, if it is known
+	Reason FailureReason `json:"reason,omitempty" protobuf:"bytes,1,opt,name=reason,casttype=FailureReason"`
+
+	// Rule is the index of the retry rule that matched the previous attempt, if any
+	Rule *int32 `json:"rule,omitempty" protobuf:"varint,2,opt,name=rule"`
+
+	// Backoff is how long the retry was delayed by
+	Backoff *metav1.Duration `json:"backoff,omitempty" protobuf:"bytes,3,opt,name=backoff"`
+
+	// Changes describes how the pod of the attempt was changed, e.g. "memory x2"
+	Changes []string `json:"changes,omitempty" protobuf:"bytes,4,rep,name=changes"`
 }
 
 // The amount of requested resource * the duration that request was used.
@@ -1700,6 +2054,8 @@ const (
 	ConditionTypeSpecError ConditionType = "SpecError"
 	// ConditionTypeMetricsError is an error during metric emission
 	ConditionTypeMetricsError ConditionType = "MetricsError"
+	// ConditionTypeArtifactGCError is an error deleting the output artifacts of the workflow
+	ConditionTypeArtifactGCError ConditionType = "ArtifactGCError"
 )
 
 type Condition struct {
@@ -1801,6 +2157,12 @@ type NodeStatus struct {
 
 	// SynchronizationStatus is the synchronization status of the node
 	SynchronizationStatus *NodeSynchronizationStatus `json:"synchronizationStatus,omitempty" protobuf:"bytes,25,opt,name=synchronizationStatus"`
+
+	// FailureReason is why the node's pod failed, if it is known, e.g. OOMKilled
+	FailureReason FailureReason `json:"failureReason,omitempty" protobuf:"bytes,27,opt,name=failureReason,casttype=FailureReason"`
+
+	// RetryDecision records why, and how, this node was retried, if it is a retry of a previous attempt
+	RetryDecision *RetryDecision `json:"retryDecision,omitempty" protobuf:"bytes,28,opt,name=retryDecision"`
 }
 
 // Fulfilled returns whether a phase is fulfilled, i.e. it completed execution or was skipped or omitted
@@ -2052,6 +2414,52 @@ type GitArtifact struct {
 
 	// DisableSubmodules disables submodules during git clone
 	DisableSubmodules bool `json:"disableSubmodules,omitempty" protobuf:"varint,9,opt,name=disableSubmodules"`
+
+	// Branch is the branch that an output artifact is committed and pushed to
+	Branch string `json:"branch,omitempty" protobuf:"bytes,10,opt,name=branch"`
+
+	// CreateBranch creates the branch of an output artifact from the revision, or the default branch, if it does not exist
+	CreateBranch bool `json:"createBranch,omitempty" protobuf:"varint,11,opt,name=createBranch"`
+
+	// Dir is the directory of the repository that an output artifact is committed to, the root of the repository by
+	// default. The content of the directory is replaced by the artifact.
+	Dir string `json:"dir,omitempty" protobuf:"bytes,12,opt,name=dir"`
+
+	// CommitMessage is the message of the commit of an output artifact, which may reference workflow variables
+	CommitMessage string `json:"commitMessage,omitempty" protobuf:"bytes,13,opt,name=commitMessage"`
+
+	// AuthorName is the name of the author of the commit of an output artifact
+	AuthorName string `json:"authorName,omitempty" protobuf:"bytes,14,opt,name=authorName"`
+
+	// AuthorEmail is the email of the author of the commit of an output artifact
+	AuthorEmail string `json:"authorEmail,omitempty" protobuf:"bytes,15,opt,name=authorEmail"`
+
+	// PushRetries is the number of times the commit of an output artifact is rebased onto the branch and pushed again,
+	// if the branch was updated after it was fetched. Defaults to 3.
+	PushRetries *int32 `json:"pushRetries,omitempty" protobuf:"varint,16,opt,name=pushRetries"`
+
+	// SparseCheckout is the list of directories of the repository that an input artifact checks out, in cone mode, so
+	// only those directories and the files of the root of the repository are checked out
+	SparseCheckout []string `json:"sparseCheckout,omitempty" protobuf:"bytes,17,rep,name=sparseCheckout"`
+
+	// Filter is the filter of a partial clone, such as "blob:none", so objects are only fetched as they are checked out
+	Filter string `json:"filter,omitempty" protobuf:"bytes,18,opt,name=filter"`
+
+	// LFS pulls the Git LFS objects of the checked out files of an input artifact
+	LFS *GitLFS `json:"lfs,omitempty" protobuf:"bytes,19,opt,name=lfs"`
+
+	// SubmoduleRecursionDepth is the number of levels of nested submodules that an input artifact checks out.
+	// Defaults to 10.
+	SubmoduleRecursionDepth *int32 `json:"submoduleRecursionDepth,omitempty" protobuf:"varint,20,opt,name=submoduleRecursionDepth"`
+}
+
+// GitLFS describes the Git LFS objects that are pulled
+type GitLFS struct {
+	// Include is the list of patterns of the paths of the LFS objects that are pulled, all by default
+	Include []string `json:"include,omitempty" protobuf:"bytes,1,rep,name=include"`
+
+	// Exclude is the list of patterns of the paths of the LFS objects that are not pulled
+	Exclude []string `json:"exclude,omitempty" protobuf:"bytes,2,rep,name=exclude"`
 }
 
 func (g *GitArtifact) HasLocation() bool {
@@ -2073,6 +2481,25 @@ func (g *GitArtifact) GetDepth() int {
 	return int(*g.Depth)
 }
 
+func (g *GitArtifact) GetPushRetries() int {
+	if g == nil || g.PushRetries == nil {
+		return 3
+	}
+	return int(*g.PushRetries)
+}
+
+// GetSubmoduleRecursionDepth returns the number of levels of nested submodules that are checked out, 0 if submodules
+// are disabled
+func (g *GitArtifact) GetSubmoduleRecursionDepth() int {
+	if g == nil || g.DisableSubmodules {
+		return 0
+	}
+	if g.SubmoduleRecursionDepth == nil {
+		return 10
+	}
+	return int(*g.SubmoduleRecursionDepth)
+}
+
 // ArtifactoryAuth describes the secret selectors required for authenticating to artifactory
 type ArtifactoryAuth struct {
 	// UsernameSecret is the secret selector to the repository username
@@ -2201,16 +2628,48 @@ type Header struct {
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 
 	// Value is the literal value to use for the header
-	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+
+	// ValueFrom is the source of the value of the header, such as a secret
+	ValueFrom *HTTPHeaderSource `json:"valueFrom,omitempty" protobuf:"bytes,3,opt,name=valueFrom"`
 }
 
-// HTTPArtifact allows an file served on HTTP to be placed as an input artifact in a container
+// HTTPArtifact allows a file served on HTTP to be placed as an input artifact in a container, and an output artifact
+// to be uploaded with a HTTP request
 type HTTPArtifact struct {
 	// URL of the artifact
 	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
 
 	// Headers are an optional list of headers to send with HTTP requests for artifacts
 	Headers []Header `json:"headers,omitempty" protobuf:"bytes,2,opt,name=headers"`
+
+	// Auth is the authentication of HTTP requests for the artifact
+	Auth *HTTPAuth `json:"auth,omitempty" protobuf:"bytes,3,opt,name=auth"`
+
+	// Method is the method of the request that uploads an output artifact, PUT, POST or PATCH. Defaults to PUT.
+	Method string `json:"method,omitempty" protobuf:"bytes,4,opt,name=method"`
+
+	// MultipartField uploads an output artifact as a file in the field of this name of a multipart/form-data body,
+	// rather than as the raw body of the request
+	MultipartField string `json:"multipartField,omitempty" protobuf:"bytes,5,opt,name=multipartField"`
+}
+
+// HTTPAuth describes the authentication of HTTP requests for artifacts
+type HTTPAuth struct {
+	// BasicAuth is the username and password of basic authentication
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,1,opt,name=basicAuth"`
+
+	// BearerTokenSecret is the secret selector to a bearer token, sent in the Authorization header
+	BearerTokenSecret *apiv1.SecretKeySelector `json:"bearerTokenSecret,omitempty" protobuf:"bytes,2,opt,name=bearerTokenSecret"`
+}
+
+// BasicAuth describes the secret selectors of the username and password of basic authentication
+type BasicAuth struct {
+	// UsernameSecret is the secret selector to the username
+	UsernameSecret *apiv1.SecretKeySelector `json:"usernameSecret,omitempty" protobuf:"bytes,1,opt,name=usernameSecret"`
+
+	// PasswordSecret is the secret selector to the password
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty" protobuf:"bytes,2,opt,name=passwordSecret"`
 }
 
 func (h *HTTPArtifact) GetKey() (string, error) {
@@ -2235,6 +2694,139 @@ func (h *HTTPArtifact) HasLocation() bool {
 	return h != nil && h.URL != ""
 }
 
+// GetMethod returns the method of the request that uploads an output artifact
+func (h *HTTPArtifact) GetMethod() string {
+	if h.Method == "" {
+		return http.MethodPut
+	}
+	return h.Method
+}
+
+// OCIArtifact is the location of an artifact in an OCI registry. Each file of the artifact is a layer of its manifest,
+// titled with the path of the file in the artifact.
+type OCIArtifact struct {
+	// Repository is the repository of the artifact, including the registry, e.g. "registry.example.com/models/bert"
+	Repository string `json:"repository" protobuf:"bytes,1,opt,name=repository"`
+
+	// Tag is the tag that an output artifact is pushed to, and that an input artifact without a digest is pulled from.
+	// Defaults to "latest".
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+
+	// Digest is the digest of the manifest of the artifact, e.g. "sha256:...", which an input artifact is pulled by.
+	// It is set to the digest of the pushed manifest when an output artifact is saved.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+
+	// DockerConfigSecret is the secret selector to a Docker config with the credentials of the registry, such as the
+	// ".dockerconfigjson" key of a kubernetes.io/dockerconfigjson secret
+	DockerConfigSecret *apiv1.SecretKeySelector `json:"dockerConfigSecret,omitempty" protobuf:"bytes,4,opt,name=dockerConfigSecret"`
+
+	// MediaTypes are the media types of the files of an output artifact, by their paths in the artifact. The media
+	// type of other files is "application/vnd.oci.image.layer.v1.tar".
+	MediaTypes map[string]string `json:"mediaTypes,omitempty" protobuf:"bytes,5,rep,name=mediaTypes"`
+
+	// Annotations are the annotations of the manifest of an output artifact
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,6,rep,name=annotations"`
+
+	// PlainHTTP connects to the registry with HTTP rather than HTTPS
+	PlainHTTP bool `json:"plainHTTP,omitempty" protobuf:"varint,7,opt,name=plainHTTP"`
+}
+
+func (o *OCIArtifact) GetKey() (string, error) {
+	return "", fmt.Errorf("key unsupported: OCI artifact does not have a key")
+}
+
+func (o *OCIArtifact) SetKey(string) error {
+	return fmt.Errorf("key unsupported: cannot set key on OCI artifact")
+}
+
+func (o *OCIArtifact) HasLocation() bool {
+	return o != nil && o.Repository != ""
+}
+
+// GetReference returns the digest of the artifact, or else its tag
+func (o *OCIArtifact) GetReference() string {
+	if o.Digest != "" {
+		return o.Digest
+	}
+	return o.GetTag()
+}
+
+func (o *OCIArtifact) GetTag() string {
+	if o.Tag == "" {
+		return "latest"
+	}
+	return o.Tag
+}
+
+// PluginArtifact is the location of an artifact that is loaded and saved by an artifact plugin
+type PluginArtifact struct {
+	// Name is the name of the artifact plugin, whose config map "<name>-artifact-plugin" is in the namespace of the
+	// workflow
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Configuration is passed to the plugin as is, e.g. the YAML of the bucket and options of the plugin's storage
+	Configuration string `json:"configuration,omitempty" protobuf:"bytes,2,opt,name=configuration"`
+
+	// Key is the key of the artifact in the plugin's storage
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
+}
+
+func (p *PluginArtifact) GetKey() (string, error) {
+	return p.Key, nil
+}
+
+func (p *PluginArtifact) SetKey(key string) error {
+	p.Key = key
+	return nil
+}
+
+func (p *PluginArtifact) HasLocation() bool {
+	return p != nil && p.Name != "" && p.Key != ""
+}
+
+// AzureBlobContainer contains the access information for interfacing with an Azure Blob Storage container
+type AzureBlobContainer struct {
+	// Endpoint is the service URL of the storage account, e.g. "https://<ACCOUNT_NAME>.blob.core.windows.net". The
+	// account name is the first label of the host, unless the URL has a path, as for the Azurite emulator
+	// "http://127.0.0.1:10000/devstoreaccount1", in which case it is the first element of the path.
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+
+	// Container is the name of the container in which artifacts are stored
+	Container string `json:"container" protobuf:"bytes,2,opt,name=container"`
+
+	// AccountKeySecret is the secret selector to the access key of the storage account
+	AccountKeySecret *apiv1.SecretKeySelector `json:"accountKeySecret,omitempty" protobuf:"bytes,3,opt,name=accountKeySecret"`
+
+	// SASTokenSecret is the secret selector to a shared access signature (SAS) token of the storage account or the
+	// container
+	SASTokenSecret *apiv1.SecretKeySelector `json:"sasTokenSecret,omitempty" protobuf:"bytes,4,opt,name=sasTokenSecret"`
+
+	// UseWorkloadIdentity authenticates with Azure AD workload identity, using the federated token, client ID and
+	// tenant ID that the workload identity webhook injects into the pod
+	UseWorkloadIdentity bool `json:"useWorkloadIdentity,omitempty" protobuf:"varint,5,opt,name=useWorkloadIdentity"`
+}
+
+// AzureArtifact is the location of an Azure Blob Storage artifact
+type AzureArtifact struct {
+	AzureBlobContainer `json:",inline" protobuf:"bytes,1,opt,name=azureBlobContainer"`
+
+	// Blob is the name of the blob in the container where the artifact resides
+	Blob string `json:"blob" protobuf:"bytes,2,opt,name=blob"`
+}
+
+func (a *AzureArtifact) GetKey() (string, error) {
+	return a.Blob, nil
+}
+
+func (a *AzureArtifact) SetKey(key string) error {
+	a.Blob = key
+	return nil
+}
+
+func (a *AzureArtifact) HasLocation() bool {
+	return a != nil && a.Endpoint != "" && a.Container != "" && a.Blob != ""
+}
+
 // GCSBucket contains the access information for interfacring with a GCS bucket
 type GCSBucket struct {
 	// Bucket is the name of the bucket
@@ -2343,7 +2935,7 @@ type ResourceTemplate struct {
 	// Must be one of: strategic, merge, json
 	MergeStrategy string `json:"mergeStrategy,omitempty" protobuf:"bytes,2,opt,name=mergeStrategy"`
 
-	// Manifest contains the kubernetes manifest
+	// Manifest contains the kubernetes manifest. It may contain multiple documents, which are acted upon in order.
 	Manifest string `json:"manifest,omitempty" protobuf:"bytes,3,opt,name=manifest"`
 
 	// SetOwnerReference sets the reference to the workflow on the OwnerReference of generated resource.
@@ -2357,12 +2949,161 @@ type ResourceTemplate struct {
 	// of the k8s resource in which the step was considered failed
 	FailureCondition string `json:"failureCondition,omitempty" protobuf:"bytes,6,opt,name=failureCondition"`
 
-	// Flags is a set of additional options passed to kubectl before submitting a resource
-	// I.e. to disable resource validation:
+	// Flags is a set of additional kubectl-style options. The supported options are the kind and name of the resources,
+	// i.e. "pod my-pod" or "pod/my-pod", "--selector", "--namespace", "--ignore-not-found" and "--force-conflicts".
+	// I.e. to delete config maps by label:
 	// flags: [
-	// 	"--validate=false"  # disable resource validation
+	// 	"configmap", "--selector", "app=my-app"
 	// ]
 	Flags []string `json:"flags,omitempty" protobuf:"varint,7,opt,name=flags"`
+
+	// FieldManager is the name of the manager used to track field ownership of the resources. Defaults to "argo-workflows".
+	FieldManager string `json:"fieldManager,omitempty" protobuf:"bytes,8,opt,name=fieldManager"`
+
+	// WaitForReady waits for the resources to become ready, based on their standard status fields and conditions.
+	// It is only used when neither a success nor a failure condition is given. Defaults to true.
+	WaitForReady *bool `json:"waitForReady,omitempty" protobuf:"varint,9,opt,name=waitForReady"`
+
+	// Cleanup is the policy for deleting the resources created or applied by this template.
+	// The resources are tracked in the workflow's status and deleted by the controller.
+	Cleanup *ResourceCleanup `json:"cleanup,omitempty" protobuf:"bytes,10,opt,name=cleanup"`
+}
+
+// ResourceCleanupStrategy is when to delete the resources created by a resource template
+type ResourceCleanupStrategy string
+
+const (
+	// ResourceCleanupOnWorkflowCompletion deletes the resources when the workflow completes, regardless of its phase
+	ResourceCleanupOnWorkflowCompletion ResourceCleanupStrategy = "OnWorkflowCompletion"
+	// ResourceCleanupOnWorkflowSuccess deletes the resources only if the workflow succeeds
+	ResourceCleanupOnWorkflowSuccess ResourceCleanupStrategy = "OnWorkflowSuccess"
+)
+
+// ResourceCleanup is the policy for deleting the resources created by a resource template
+type ResourceCleanup struct {
+	// Strategy is when to delete the resources, one of OnWorkflowCompletion or OnWorkflowSuccess.
+	// Defaults to OnWorkflowCompletion.
+	Strategy ResourceCleanupStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ResourceCleanupStrategy"`
+
+	// SecondsAfterCompletion is how long after the workflow completes the resources are deleted.
+	// If the workflow is deleted first, the resources are deleted at that time.
+	SecondsAfterCompletion *int32 `json:"secondsAfterCompletion,omitempty" protobuf:"varint,2,opt,name=secondsAfterCompletion"`
+}
+
+// GetStrategy returns the strategy, or the default
+func (c *ResourceCleanup) GetStrategy() ResourceCleanupStrategy {
+	if c == nil || c.Strategy == "" {
+		return ResourceCleanupOnWorkflowCompletion
+	}
+	return c.Strategy
+}
+
+// GetDelay returns how long after the workflow completes the resources are deleted
+func (c *ResourceCleanup) GetDelay() time.Duration {
+	if c == nil || c.SecondsAfterCompletion == nil {
+		return 0
+	}
+	return time.Duration(*c.SecondsAfterCompletion) * time.Second
+}
+
+// ResourceCleanupPhase is the phase of the cleanup of a created resource
+type ResourceCleanupPhase string
+
+const (
+	// ResourceCleanupPending means the resource is yet to be deleted
+	ResourceCleanupPending ResourceCleanupPhase = "Pending"
+	// ResourceCleanupDeleted means the resource was deleted, or no longer existed
+	ResourceCleanupDeleted ResourceCleanupPhase = "Deleted"
+	// ResourceCleanupRetained means the resource is not deleted, because of the cleanup strategy
+	ResourceCleanupRetained ResourceCleanupPhase = "Retained"
+	// ResourceCleanupFailed means the resource could not be deleted
+	ResourceCleanupFailed ResourceCleanupPhase = "Failed"
+)
+
+// CreatedResource is a resource created by a resource template with a cleanup policy
+type CreatedResource struct {
+	// NodeID is the ID of the resource template's node
+	NodeID string `json:"nodeID,omitempty" protobuf:"bytes,1,opt,name=nodeID"`
+
+	APIVersion string `json:"apiVersion" protobuf:"bytes,2,opt,name=apiVersion"`
+
+	Kind string `json:"kind" protobuf:"bytes,3,opt,name=kind"`
+
+	// Namespace is empty for cluster scoped resources
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+
+	Name string `json:"name" protobuf:"bytes,5,opt,name=name"`
+
+	// UID is used to make sure that only this instance of the resource is deleted
+	UID string `json:"uid,omitempty" protobuf:"bytes,6,opt,name=uid"`
+
+	Cleanup *ResourceCleanup `json:"cleanup,omitempty" protobuf:"bytes,7,opt,name=cleanup"`
+
+	// CleanupPhase is one of Pending, Deleted, Retained or Failed
+	CleanupPhase ResourceCleanupPhase `json:"cleanupPhase,omitempty" protobuf:"bytes,8,opt,name=cleanupPhase,casttype=ResourceCleanupPhase"`
+
+	// Message is a human readable explanation of the cleanup phase
+	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
+
+	// ServiceAccountName is the service account that created the resource, and that deletes it
+	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,10,opt,name=serviceAccountName"`
+}
+
+// DisplayName returns the API version, kind, namespace and name of the resource
+func (r CreatedResource) DisplayName() string {
+	name := r.Name
+	if r.Namespace != "" {
+		name = r.Namespace + "/" + r.Name
+	}
+	return fmt.Sprintf("%s/%s %s", r.APIVersion, r.Kind, name)
+}
+
+// CleanupPending returns whether the resource is yet to be deleted
+func (r CreatedResource) CleanupPending() bool {
+	return r.CleanupPhase == "" || r.CleanupPhase == ResourceCleanupPending
+}
+
+// SameResource returns whether both refer to the same instance of a resource
+func (r CreatedResource) SameResource(o CreatedResource) bool {
+	return r.APIVersion == o.APIVersion && r.Kind == o.Kind && r.Namespace == o.Namespace && r.Name == o.Name && r.UID == o.UID
+}
+
+type CreatedResources []CreatedResource
+
+// Add adds the resource, unless it is already present
+func (rs CreatedResources) Add(r CreatedResource) (CreatedResources, bool) {
+	for _, existing := range rs {
+		if existing.SameResource(r) {
+			return rs, false
+		}
+	}
+	return append(rs, r), true
+}
+
+// CleanupPending returns whether any resource is yet to be deleted
+func (rs CreatedResources) CleanupPending() bool {
+	for _, r := range rs {
+		if r.CleanupPending() {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultResourceFieldManager is the default field manager of resources created, updated or applied by resource templates
+const DefaultResourceFieldManager = "argo-workflows"
+
+// GetFieldManager returns the field manager of the resources, or the default
+func (r *ResourceTemplate) GetFieldManager() string {
+	if r.FieldManager != "" {
+		return r.FieldManager
+	}
+	return DefaultResourceFieldManager
+}
+
+// ShouldWaitForReady returns whether to wait for the resources to become ready, which is the default
+func (r *ResourceTemplate) ShouldWaitForReady() bool {
+	return r.WaitForReady == nil || *r.WaitForReady
 }
 
 // GetType returns the type of this template
@@ -2949,12 +3690,15 @@ type Counter struct {
 // Memoization enables caching for the Outputs of the template
 type Memoize struct {
 	// Key is the key to use as the caching key
-	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
 	// Cache sets and configures the kind of cache
 	Cache *Cache `json:"cache" protobuf:"bytes,2,opt,name=cache"`
 	// MaxAge is the maximum age (e.g. "180s", "24h") of an entry that is still considered valid. If an entry is older
 	// than the MaxAge, it will be ignored.
 	MaxAge string `json:"maxAge" protobuf:"bytes,3,opt,name=maxAge"`
+	// Auto computes the caching key from the content of the template: its spec, the values of its input parameters and
+	// the digests of its input artifacts. It cannot be used with Key.
+	Auto bool `json:"auto,omitempty" protobuf:"varint,4,opt,name=auto"`
 }
 
 // MemoizationStatus is the status of this memoized node
@@ -2965,12 +3709,83 @@ type MemoizationStatus struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	// Cache is the name of the cache that was used
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// CacheType is the type of the cache that was used, ConfigMap if empty
+	CacheType CacheType `json:"cacheType,omitempty" protobuf:"bytes,4,opt,name=cacheType,casttype=CacheType"`
 }
 
+// CacheType is the backend a memoization cache is stored in
+type CacheType string
+
+const (
+	CacheTypeConfigMap CacheType = "ConfigMap"
+	CacheTypeSQL       CacheType = "SQL"
+	CacheTypeArtifact  CacheType = "Artifact"
+)
+
 // Cache is the configuration for the type of cache to be used
 type Cache struct {
 	// ConfigMap sets a ConfigMap-based cache
-	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// SQL sets a cache stored in the persistence database of the controller
+	SQL *SQLCache `json:"sql,omitempty" protobuf:"bytes,2,opt,name=sql"`
+	// Artifact sets a cache stored as an object in the default artifact repository of the controller
+	Artifact *ArtifactCache `json:"artifact,omitempty" protobuf:"bytes,3,opt,name=artifact"`
+}
+
+// GetType returns the type of the cache
+func (c *Cache) GetType() CacheType {
+	if c == nil {
+		return ""
+	} else if c.SQL != nil {
+		return CacheTypeSQL
+	} else if c.Artifact != nil {
+		return CacheTypeArtifact
+	} else if c.ConfigMap != nil {
+		return CacheTypeConfigMap
+	}
+	return ""
+}
+
+// GetName returns the name of the cache
+func (c *Cache) GetName() string {
+	switch c.GetType() {
+	case CacheTypeSQL:
+		return c.SQL.Name
+	case CacheTypeArtifact:
+		return c.Artifact.Name
+	case CacheTypeConfigMap:
+		return c.ConfigMap.Name
+	}
+	return ""
+}
+
+// GetMaxEntries returns the maximum number of entries of the cache, zero if it is not limited
+func (c *Cache) GetMaxEntries() int {
+	switch c.GetType() {
+	case CacheTypeSQL:
+		return int(c.SQL.MaxEntries)
+	case CacheTypeArtifact:
+		return int(c.Artifact.MaxEntries)
+	}
+	return 0
+}
+
+// SQLCache is a memoization cache stored in a table of the persistence database
+type SQLCache struct {
+	// Name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MaxEntries is the maximum number of entries in the cache, the least recently hit entries are evicted when it is exceeded.
+	// Defaults to no limit.
+	MaxEntries int32 `json:"maxEntries,omitempty" protobuf:"varint,2,opt,name=maxEntries"`
+}
+
+// ArtifactCache is a memoization cache stored as a single object in the artifact repository
+type ArtifactCache struct {
+	// Name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MaxEntries is the maximum number of entries in the cache, the least recently hit entries are evicted when it is exceeded.
+	// Defaults to no limit.
+	MaxEntries int32 `json:"maxEntries,omitempty" protobuf:"varint,2,opt,name=maxEntries"`
 }
 
 type SynchronizationAction interface {
@@ -0,0 +1,58 @@
+package spec
+
+import (
+	"fmt"
+	"path/filepath"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArtifactPlugin is an artifact driver plugin, which loads and saves the artifacts of plugin artifact locations.
+type ArtifactPlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ArtifactPluginSpec `json:"spec"`
+}
+
+func (p ArtifactPlugin) Validate() error {
+	if p.Spec.Binary == nil && p.Spec.Service == nil {
+		return fmt.Errorf("at least one of binary or service must be specified")
+	}
+	if p.Spec.Binary != nil {
+		if err := p.Spec.Binary.Validate(); err != nil {
+			return fmt.Errorf("binary is invalid: %w", err)
+		}
+	}
+	if p.Spec.Service != nil {
+		if err := p.Spec.Service.Validate(); err != nil {
+			return fmt.Errorf("service is invalid: %w", err)
+		}
+	}
+	return nil
+}
+
+type ArtifactPluginSpec struct {
+	// Binary is an executable that is copied into the pods of the templates whose artifacts use the plugin, and that
+	// the executor runs to load, save, list and delete them.
+	Binary *Binary `json:"binary,omitempty"`
+	// Service is a shared long-running service that is called over HTTP. It is used where the binary is not, such as
+	// in the argo server.
+	Service *Service `json:"service,omitempty"`
+}
+
+type Binary struct {
+	// Image is the image that contains the binary, it must also contain "cp".
+	Image string `json:"image"`
+	// Path is the absolute path of the binary in the image.
+	Path string `json:"path"`
+}
+
+func (b Binary) Validate() error {
+	if b.Image == "" {
+		return fmt.Errorf("image is mandatory")
+	}
+	if !filepath.IsAbs(b.Path) {
+		return fmt.Errorf("path %q must be absolute", b.Path)
+	}
+	return nil
+}
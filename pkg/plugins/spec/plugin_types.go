@@ -2,6 +2,8 @@ package spec
 
 import (
 	"fmt"
+	"net/url"
+	"time"
 
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,6 +16,15 @@ type Plugin struct {
 }
 
 func (p Plugin) Validate() error {
+	if p.Spec.Service != nil {
+		if p.Spec.Sidecar.Container.Image != "" || len(p.Spec.Sidecar.Container.Ports) > 0 {
+			return fmt.Errorf("only one of sidecar or service may be specified")
+		}
+		if err := p.Spec.Service.Validate(); err != nil {
+			return fmt.Errorf("service is invalid: %w", err)
+		}
+		return nil
+	}
 	if err := p.Spec.Sidecar.Validate(); err != nil {
 		return fmt.Errorf("sidecar is invalid: %w", err)
 	}
@@ -22,6 +33,9 @@ func (p Plugin) Validate() error {
 
 type PluginSpec struct {
 	Sidecar Sidecar `json:"sidecar"`
+	// Service, if specified, is used instead of the sidecar: the plugin is a shared long-running service
+	// that is called over HTTP, rather than a container started in every agent pod.
+	Service *Service `json:"service,omitempty"`
 }
 
 type Sidecar struct {
@@ -44,3 +58,60 @@ func (s Sidecar) Validate() error {
 	}
 	return nil
 }
+
+type Service struct {
+	// Address is the base URL of the service, e.g. "http://hello-executor-plugin.argo:4355".
+	Address string `json:"address"`
+	// Timeout is the maximum duration of a single call, defaults to 30s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Parallelism limits the number of concurrent calls each agent makes to the service. Zero means unlimited.
+	Parallelism int `json:"parallelism,omitempty"`
+	// HealthCheck, if specified, is polled before calling the service. Unhealthy services are not called.
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+	// Token is a secret, in the workflow's namespace, containing a bearer token sent on every call.
+	Token *apiv1.SecretKeySelector `json:"token,omitempty"`
+}
+
+func (s Service) Validate() error {
+	if s.Address == "" {
+		return fmt.Errorf("address is mandatory")
+	}
+	if u, err := url.Parse(s.Address); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("address %q must be an absolute URL", s.Address)
+	}
+	if s.Parallelism < 0 {
+		return fmt.Errorf("parallelism must be non-negative")
+	}
+	if s.Token != nil && (s.Token.Name == "" || s.Token.Key == "") {
+		return fmt.Errorf("token must have a name and key")
+	}
+	return nil
+}
+
+func (s Service) GetTimeout() time.Duration {
+	if s.Timeout != nil {
+		return s.Timeout.Duration
+	}
+	return 30 * time.Second
+}
+
+type HealthCheck struct {
+	// Path is the HTTP GET path that returns 200 when the service is healthy, defaults to "/healthz".
+	Path string `json:"path,omitempty"`
+	// Period is how long a health check result is cached for, defaults to 10s.
+	Period *metav1.Duration `json:"period,omitempty"`
+}
+
+func (h HealthCheck) GetPath() string {
+	if h.Path != "" {
+		return h.Path
+	}
+	return "/healthz"
+}
+
+func (h HealthCheck) GetPeriod() time.Duration {
+	if h.Period != nil {
+		return h.Period.Duration
+	}
+	return 10 * time.Second
+}
@@ -45,3 +45,48 @@ func TestSidecar_Validate(t *testing.T) {
 		}.Validate(), "security context is mandatory")
 	})
 }
+
+func TestService_Validate(t *testing.T) {
+	t.Run("NoAddress", func(t *testing.T) {
+		assert.EqualError(t, Service{}.Validate(), "address is mandatory")
+	})
+	t.Run("RelativeAddress", func(t *testing.T) {
+		assert.EqualError(t, Service{Address: "my-svc:4355"}.Validate(), `address "my-svc:4355" must be an absolute URL`)
+	})
+	t.Run("NegativeParallelism", func(t *testing.T) {
+		assert.EqualError(t, Service{Address: "http://my-svc:4355", Parallelism: -1}.Validate(), "parallelism must be non-negative")
+	})
+	t.Run("IncompleteToken", func(t *testing.T) {
+		assert.EqualError(t, Service{Address: "http://my-svc:4355", Token: &apiv1.SecretKeySelector{}}.Validate(), "token must have a name and key")
+	})
+	t.Run("Valid", func(t *testing.T) {
+		assert.NoError(t, Plugin{Spec: PluginSpec{Service: &Service{Address: "http://my-svc:4355"}}}.Validate())
+	})
+	t.Run("SidecarAndService", func(t *testing.T) {
+		assert.EqualError(t, Plugin{Spec: PluginSpec{
+			Sidecar: Sidecar{Container: apiv1.Container{Image: "my-image"}},
+			Service: &Service{Address: "http://my-svc:4355"},
+		}}.Validate(), "only one of sidecar or service may be specified")
+	})
+}
+
+func TestArtifactPlugin_Validate(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		assert.EqualError(t, ArtifactPlugin{}.Validate(), "at least one of binary or service must be specified")
+	})
+	t.Run("NoImage", func(t *testing.T) {
+		assert.EqualError(t, ArtifactPlugin{Spec: ArtifactPluginSpec{Binary: &Binary{}}}.Validate(), "binary is invalid: image is mandatory")
+	})
+	t.Run("RelativePath", func(t *testing.T) {
+		assert.EqualError(t, ArtifactPlugin{Spec: ArtifactPluginSpec{Binary: &Binary{Image: "my-image", Path: "bin/my-plugin"}}}.Validate(), `binary is invalid: path "bin/my-plugin" must be absolute`)
+	})
+	t.Run("InvalidService", func(t *testing.T) {
+		assert.EqualError(t, ArtifactPlugin{Spec: ArtifactPluginSpec{Service: &Service{}}}.Validate(), "service is invalid: address is mandatory")
+	})
+	t.Run("Valid", func(t *testing.T) {
+		assert.NoError(t, ArtifactPlugin{Spec: ArtifactPluginSpec{
+			Binary:  &Binary{Image: "my-image", Path: "/bin/my-plugin"},
+			Service: &Service{Address: "http://my-svc:4355"},
+		}}.Validate())
+	})
+}
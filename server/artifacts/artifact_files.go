@@ -0,0 +1,408 @@
+package artifacts
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/types"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// ArtifactFiles is the listing of the files of an artifact
+type ArtifactFiles struct {
+	// Files are the paths of the files, relative to the artifact
+	Files []string `json:"files"`
+}
+
+func (a *ArtifactServer) GetOutputArtifactFile(w http.ResponseWriter, r *http.Request) {
+	a.getArtifactFile(w, r, false)
+}
+
+func (a *ArtifactServer) GetInputArtifactFile(w http.ResponseWriter, r *http.Request) {
+	a.getArtifactFile(w, r, true)
+}
+
+// getArtifactFile lists the files of an artifact if the path is empty or ends with a slash, or else returns the file of
+// the path. The files of a directory artifact are its objects, and the files of a tar or zip archive are its entries.
+func (a *ArtifactServer) getArtifactFile(w http.ResponseWriter, r *http.Request, isInput bool) {
+	requestPath := strings.SplitN(r.URL.Path, "/", 7)
+	if len(requestPath) < 6 {
+		a.serverInternalError(errors.New("request path is not valid"), w)
+		return
+	}
+	namespace := requestPath[2]
+	workflowName := requestPath[3]
+	nodeId := requestPath[4]
+	artifactName := requestPath[5]
+	filePath := ""
+	if len(requestPath) == 7 {
+		filePath = requestPath[6]
+	}
+
+	ctx, err := a.gateKeeping(r, types.NamespaceHolder(namespace))
+	if err != nil {
+		a.unauthorizedError(err, w)
+		return
+	}
+
+	log.WithFields(log.Fields{"namespace": namespace, "workflowName": workflowName, "nodeId": nodeId, "artifactName": artifactName, "isInput": isInput, "filePath": filePath}).Info("Download artifact file")
+
+	wf, err := a.getWorkflowAndValidate(ctx, namespace, workflowName)
+	if err != nil {
+		a.serverInternalError(err, w)
+		return
+	}
+	art, driver, err := a.getArtifactAndDriver(ctx, wf, nodeId, artifactName, isInput)
+	if err != nil {
+		a.serverInternalError(err, w)
+		return
+	}
+
+	if filePath == "" || strings.HasSuffix(filePath, "/") {
+		err = a.listArtifactFiles(w, driver, art, filePath)
+	} else {
+		err = a.returnArtifactFile(w, r, driver, art, entryName(filePath))
+	}
+	if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	if err != nil {
+		a.serverInternalError(err, w)
+		return
+	}
+}
+
+func (a *ArtifactServer) listArtifactFiles(w http.ResponseWriter, driver artifactscommon.ArtifactDriver, art *wfv1.Artifact, dir string) error {
+	content, err := openArtifactContent(driver, art)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = content.Close() }()
+	files, err := content.list()
+	if err != nil {
+		return err
+	}
+	listing := ArtifactFiles{Files: []string{}}
+	for _, f := range files {
+		if strings.HasPrefix(f, dir) {
+			listing.Files = append(listing.Files, f)
+		}
+	}
+	sort.Strings(listing.Files)
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(listing)
+}
+
+func (a *ArtifactServer) returnArtifactFile(w http.ResponseWriter, r *http.Request, driver artifactscommon.ArtifactDriver, art *wfv1.Artifact, filePath string) error {
+	content, err := openArtifactContent(driver, art)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = content.Close() }()
+	switch {
+	case content.objectsKey != "":
+		// the file is an object of the directory, which is served like an artifact of its own
+		file := art.DeepCopy()
+		if err := file.SetKey(content.objectsKey + "/" + filePath); err != nil {
+			return err
+		}
+		// the checksum is of the directory, not of the object
+		file.Checksum = ""
+		file.SizeBytes = 0
+		return a.serveArtifact(w, r, driver, file)
+	case content.dir != "":
+		f, err := os.Open(filepath.Join(content.dir, filepath.FromSlash(filePath)))
+		if os.IsNotExist(err) {
+			return argoerrs.Errorf(argoerrs.CodeNotFound, "file %s not found", filePath)
+		}
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		return serveFile(w, r, filePath, f)
+	}
+	kind, err := content.kind()
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case tarArchive:
+		return content.serveTarEntry(w, filePath)
+	case zipArchive:
+		return content.serveZipEntry(w, filePath)
+	}
+	if filePath != artifactFileName(art) {
+		return argoerrs.Errorf(argoerrs.CodeNotFound, "file %s not found", filePath)
+	}
+	if _, err := content.reader.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return serveFile(w, r, filePath, content.reader)
+}
+
+// serveFile serves a file with support for range requests
+func serveFile(w http.ResponseWriter, r *http.Request, name string, content io.ReadSeeker) error {
+	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(name)))
+	w.Header().Set("Content-Type", contentType("", name))
+	http.ServeContent(w, r, "", time.Time{}, content)
+	return nil
+}
+
+// serveEntry serves an entry of an archive, which does not support range requests as the entry cannot be sought
+func serveEntry(w http.ResponseWriter, name string, size int64, entry io.Reader) error {
+	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(name)))
+	w.Header().Set("Content-Type", contentType("", name))
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(http.StatusOK)
+	_, err := io.Copy(w, entry)
+	return err
+}
+
+// artifactFileName is the name of the file of an artifact that is a single file
+func artifactFileName(art *wfv1.Artifact) string {
+	if key, _ := art.GetKey(); key != "" {
+		return path.Base(key)
+	}
+	return art.Name
+}
+
+type contentKind int
+
+const (
+	plainFile contentKind = iota
+	tarArchive
+	zipArchive
+)
+
+// readSeekerAt is the content of an artifact that is a single file
+type readSeekerAt interface {
+	io.ReadSeeker
+	io.ReaderAt
+}
+
+// artifactContent is the content of an artifact, which is either a single file that may be an archive, the objects of
+// a directory in the storage, or a directory loaded to a temporary directory by a driver that cannot stream
+type artifactContent struct {
+	art    *wfv1.Artifact
+	driver artifactscommon.ArtifactDriver
+	// reader and size are set for a single file
+	reader readSeekerAt
+	size   int64
+	// objectsKey is set for a directory in the storage, it is the key its objects are prefixed with
+	objectsKey string
+	// dir is set for a directory loaded to a temporary directory
+	dir string
+	// format is the format of a single file that is a tarball
+	format archive.Format
+	close  func() error
+}
+
+func (c *artifactContent) Close() error {
+	if c.close == nil {
+		return nil
+	}
+	return c.close()
+}
+
+// openArtifactContent streams an artifact if the driver can, or else loads it to a temporary file or directory
+func openArtifactContent(driver artifactscommon.ArtifactDriver, art *wfv1.Artifact) (*artifactContent, error) {
+	content := &artifactContent{art: art, driver: driver}
+	stream, err := artifact.OpenStream(driver, art)
+	switch {
+	case err == nil:
+		content.reader, content.size, content.close = stream, stream.Size, stream.Close
+		return content, nil
+	case argoerrs.IsCode(argoerrs.CodeNotFound, err):
+		// the key of a directory is not an object, but the prefix of its objects
+		key, keyErr := art.GetKey()
+		if keyErr != nil || key == "" {
+			return nil, err
+		}
+		content.objectsKey = strings.TrimSuffix(key, "/")
+		return content, nil
+	case !errors.Is(err, artifactscommon.ErrOpenStreamNotSupported):
+		return nil, err
+	}
+	tmp, err := ioutil.TempDir("", "artifact")
+	if err != nil {
+		return nil, err
+	}
+	content.close = func() error { return os.RemoveAll(tmp) }
+	tmpPath := filepath.Join(tmp, "artifact")
+	if err := driver.Load(art, tmpPath); err != nil {
+		_ = content.Close()
+		return nil, err
+	}
+	info, err := os.Stat(tmpPath)
+	if err != nil {
+		_ = content.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		content.dir = tmpPath
+		return content, nil
+	}
+	f, err := os.Open(filepath.Clean(tmpPath))
+	if err != nil {
+		_ = content.Close()
+		return nil, err
+	}
+	content.reader, content.size = f, info.Size()
+	content.close = func() error {
+		_ = f.Close()
+		return os.RemoveAll(tmp)
+	}
+	return content, nil
+}
+
+// kind returns the kind of a single file from its first bytes
+func (c *artifactContent) kind() (contentKind, error) {
+	format, err := archive.DetectFormat(c.reader)
+	if err != nil {
+		return plainFile, err
+	}
+	switch format {
+	case archive.FormatZip:
+		return zipArchive, nil
+	case archive.FormatTarGzip, archive.FormatTarZstd, archive.FormatTar:
+		c.format = format
+		return tarArchive, nil
+	}
+	return plainFile, nil
+}
+
+func (c *artifactContent) openTar() (*tar.Reader, func(), error) {
+	return archive.NewTarReader(io.NewSectionReader(c.reader, 0, c.size), c.format)
+}
+
+// entryName returns the name of an entry of an archive as a relative path
+func entryName(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+// list returns the paths of the files of the content
+func (c *artifactContent) list() ([]string, error) {
+	var files []string
+	switch {
+	case c.objectsKey != "":
+		objects, err := c.driver.ListObjects(c.art)
+		if err != nil {
+			return nil, err
+		}
+		prefix := c.objectsKey + "/"
+		for _, o := range objects {
+			if strings.HasPrefix(o, prefix) && !strings.HasSuffix(o, "/") {
+				files = append(files, strings.TrimPrefix(o, prefix))
+			}
+		}
+		if len(files) == 0 {
+			return nil, argoerrs.Errorf(argoerrs.CodeNotFound, "no results for key: %s", c.objectsKey)
+		}
+		return files, nil
+	case c.dir != "":
+		err := filepath.Walk(c.dir, func(p string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(c.dir, p)
+			files = append(files, filepath.ToSlash(rel))
+			return err
+		})
+		return files, err
+	}
+	kind, err := c.kind()
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case tarArchive:
+		tr, closeTar, err := c.openTar()
+		if err != nil {
+			return nil, err
+		}
+		defer closeTar()
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return files, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			if hdr.Typeflag == tar.TypeReg {
+				files = append(files, entryName(hdr.Name))
+			}
+		}
+	case zipArchive:
+		zr, err := zip.NewReader(c.reader, c.size)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range zr.File {
+			if !f.FileInfo().IsDir() {
+				files = append(files, entryName(f.Name))
+			}
+		}
+		return files, nil
+	}
+	return []string{artifactFileName(c.art)}, nil
+}
+
+func (c *artifactContent) serveTarEntry(w http.ResponseWriter, filePath string) error {
+	tr, closeTar, err := c.openTar()
+	if err != nil {
+		return err
+	}
+	defer closeTar()
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return argoerrs.Errorf(argoerrs.CodeNotFound, "file %s not found", filePath)
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeReg && entryName(hdr.Name) == filePath {
+			return serveEntry(w, filePath, hdr.Size, tr)
+		}
+	}
+}
+
+func (c *artifactContent) serveZipEntry(w http.ResponseWriter, filePath string) error {
+	zr, err := zip.NewReader(c.reader, c.size)
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		if !f.FileInfo().IsDir() && entryName(f.Name) == filePath {
+			rc, err := f.Open()
+			if err != nil {
+				return err
+			}
+			defer func() { _ = rc.Close() }()
+			return serveEntry(w, filePath, int64(f.UncompressedSize64), rc)
+		}
+	}
+	return argoerrs.Errorf(argoerrs.CodeNotFound, "file %s not found", filePath)
+}
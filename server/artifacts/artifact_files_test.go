@@ -0,0 +1,199 @@
+package artifacts
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// objectsDriver stores objects by key, like a bucket, and resolves the dot segments of keys, as a file system does
+type objectsDriver struct {
+	artifactscommon.ArtifactDriver
+	objects  map[string][]byte
+	noStream bool
+}
+
+func (d *objectsDriver) OpenStream(art *wfv1.Artifact, offset int64) (*artifactscommon.ArtifactStream, error) {
+	if d.noStream {
+		return nil, artifactscommon.ErrOpenStreamNotSupported
+	}
+	key, _ := art.GetKey()
+	data, ok := d.objects[path.Clean(key)]
+	if !ok {
+		return nil, argoerrs.Errorf(argoerrs.CodeNotFound, "no results for key: %s", key)
+	}
+	return &artifactscommon.ArtifactStream{ReadCloser: ioutil.NopCloser(bytes.NewReader(data[offset:])), Size: int64(len(data))}, nil
+}
+
+func (d *objectsDriver) Load(art *wfv1.Artifact, dst string) error {
+	key, _ := art.GetKey()
+	if data, ok := d.objects[path.Clean(key)]; ok {
+		return ioutil.WriteFile(dst, data, 0o600)
+	}
+	objects, _ := d.ListObjects(art)
+	if len(objects) == 0 {
+		return argoerrs.Errorf(argoerrs.CodeNotFound, "no results for key: %s", key)
+	}
+	for _, o := range objects {
+		p := filepath.Join(dst, strings.TrimPrefix(o, key+"/"))
+		if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(p, d.objects[o], 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *objectsDriver) ListObjects(art *wfv1.Artifact) ([]string, error) {
+	key, _ := art.GetKey()
+	var objects []string
+	for o := range d.objects {
+		if strings.HasPrefix(o, key+"/") {
+			objects = append(objects, o)
+		}
+	}
+	return objects, nil
+}
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0o700}))
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o600, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func zstdTarball(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	require.NoError(t, archive.Recompress(bytes.NewReader(tarball(t, files)), &wfv1.TarStrategy{Compression: wfv1.TarCompressionZstd}, buf))
+	return buf.Bytes()
+}
+
+func zipball(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func getArtifactFile(s *ArtifactServer, path string, header ...string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	for i := 0; i < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	s.GetOutputArtifactFile(w, r)
+	return w
+}
+
+func listArtifactFiles(t *testing.T, s *ArtifactServer, path string) []string {
+	w := getArtifactFile(s, path)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	listing := ArtifactFiles{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listing))
+	return listing.Files
+}
+
+func TestArtifactServer_GetOutputArtifactFile(t *testing.T) {
+	files := map[string]string{"dir/a.txt": "foo", "dir/b/c.html": "bar"}
+
+	t.Run("Tar", func(t *testing.T) {
+		s := newServerWithDriver(&objectsDriver{objects: map[string][]byte{"my-wf/my-node/my-s3-artifact.tgz": tarball(t, files)}})
+		assert.Equal(t, []string{"dir/a.txt", "dir/b/c.html"}, listArtifactFiles(t, s, "/artifact-files/my-ns/my-wf/my-node/my-s3-artifact/"))
+		assert.Equal(t, []string{"dir/b/c.html"}, listArtifactFiles(t, s, "/artifact-files/my-ns/my-wf/my-node/my-s3-artifact/dir/b/"))
+		w := getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-s3-artifact/dir/b/c.html")
+		if assert.Equal(t, http.StatusOK, w.Code) {
+			assert.Equal(t, "bar", w.Body.String())
+			assert.Equal(t, "3", w.Header().Get("Content-Length"))
+			assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.Equal(t, `filename="c.html"`, w.Header().Get("Content-Disposition"))
+		}
+		assert.Equal(t, http.StatusNotFound, getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-s3-artifact/dir/missing").Code)
+	})
+
+	t.Run("TarZstd", func(t *testing.T) {
+		s := newServerWithDriver(&objectsDriver{objects: map[string][]byte{"my-wf/my-node/my-s3-artifact.tgz": zstdTarball(t, files)}})
+		assert.Equal(t, []string{"dir/a.txt", "dir/b/c.html"}, listArtifactFiles(t, s, "/artifact-files/my-ns/my-wf/my-node/my-s3-artifact/"))
+		w := getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-s3-artifact/dir/a.txt")
+		if assert.Equal(t, http.StatusOK, w.Code) {
+			assert.Equal(t, "foo", w.Body.String())
+		}
+	})
+
+	t.Run("Zip", func(t *testing.T) {
+		for _, noStream := range []bool{false, true} {
+			s := newServerWithDriver(&objectsDriver{objects: map[string][]byte{"my-wf/my-node/my-oss-artifact.zip": zipball(t, files)}, noStream: noStream})
+			assert.Equal(t, []string{"dir/a.txt", "dir/b/c.html"}, listArtifactFiles(t, s, "/artifact-files/my-ns/my-wf/my-node/my-oss-artifact/"))
+			w := getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-oss-artifact/dir/a.txt")
+			if assert.Equal(t, http.StatusOK, w.Code) {
+				assert.Equal(t, "foo", w.Body.String())
+			}
+		}
+	})
+
+	t.Run("Directory", func(t *testing.T) {
+		for _, noStream := range []bool{false, true} {
+			s := newServerWithDriver(&objectsDriver{objects: map[string][]byte{
+				"my-wf/my-node/my-gcs-artifact/a.txt":   []byte("foo"),
+				"my-wf/my-node/my-gcs-artifact/b/c.txt": []byte("bar"),
+				"my-wf/my-node/my-gcs-artifact-other":   []byte("baz"),
+			}, noStream: noStream})
+			assert.Equal(t, []string{"a.txt", "b/c.txt"}, listArtifactFiles(t, s, "/artifact-files/my-ns/my-wf/my-node/my-gcs-artifact"))
+			w := getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-gcs-artifact/b/c.txt", "Range", "bytes=1-")
+			if assert.Equal(t, http.StatusPartialContent, w.Code) {
+				assert.Equal(t, "ar", w.Body.String())
+			}
+			assert.Equal(t, http.StatusNotFound, getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-gcs-artifact/missing").Code)
+			// a path cannot escape the directory to the keys of other artifacts
+			assert.Equal(t, http.StatusNotFound, getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-gcs-artifact/../my-gcs-artifact-other").Code)
+		}
+	})
+
+	t.Run("File", func(t *testing.T) {
+		s := newServerWithDriver(&objectsDriver{objects: map[string][]byte{"my-wf/my-node/my-gcs-artifact": []byte("foo")}})
+		assert.Equal(t, []string{"my-gcs-artifact"}, listArtifactFiles(t, s, "/artifact-files/my-ns/my-wf/my-node/my-gcs-artifact/"))
+		w := getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-gcs-artifact/my-gcs-artifact")
+		if assert.Equal(t, http.StatusOK, w.Code) {
+			assert.Equal(t, "foo", w.Body.String())
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		s := newServerWithDriver(&objectsDriver{objects: map[string][]byte{}})
+		assert.Equal(t, http.StatusNotFound, getArtifactFile(s, "/artifact-files/my-ns/my-wf/my-node/my-gcs-artifact/").Code)
+	})
+}
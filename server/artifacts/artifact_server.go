@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"os"
 	"path"
@@ -26,6 +27,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 )
 
@@ -161,6 +163,16 @@ func (a *ArtifactServer) serverInternalError(err error, w http.ResponseWriter) {
 }
 
 func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, wf *wfv1.Workflow, nodeId, artifactName string, isInput bool) error {
+	art, driver, err := a.getArtifactAndDriver(ctx, wf, nodeId, artifactName, isInput)
+	if err != nil {
+		return err
+	}
+	return a.serveArtifact(w, r, driver, art)
+}
+
+// getArtifactAndDriver returns an artifact of a node, relocated to the artifact repository of the workflow, and its
+// driver
+func (a *ArtifactServer) getArtifactAndDriver(ctx context.Context, wf *wfv1.Workflow, nodeId, artifactName string, isInput bool) (*wfv1.Artifact, artifactscommon.ArtifactDriver, error) {
 	kubeClient := auth.GetKubeClient(ctx)
 
 	var art *wfv1.Artifact
@@ -170,23 +182,68 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 		art = wf.Status.Nodes[nodeId].Outputs.GetArtifactByName(artifactName)
 	}
 	if art == nil {
-		return fmt.Errorf("artifact not found")
+		return nil, nil, fmt.Errorf("artifact not found")
 	}
 
 	ar, err := a.artifactRepositories.Get(ctx, wf.Status.ArtifactRepositoryRef)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	l := ar.ToArtifactLocation()
 	err = art.Relocate(l)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	driver, err := a.artDriverFactory(ctx, art, resources{kubeClient, wf.Namespace})
+	if err != nil {
+		return nil, nil, err
+	}
+	return art, driver, nil
+}
+
+// serveArtifact streams an artifact, or loads it to a temporary file if the driver cannot stream it
+func (a *ArtifactServer) serveArtifact(w http.ResponseWriter, r *http.Request, driver artifactscommon.ArtifactDriver, art *wfv1.Artifact) error {
+	name := artifactFileName(art)
+	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, name))
+	if art.Checksum != "" {
+		// ServeContent uses the ETag for conditional and range requests
+		w.Header().Set("ETag", fmt.Sprintf(`"%s"`, art.Checksum))
+	}
+
+	stream, err := artifact.OpenStream(driver, art)
+	if errors.Is(err, artifactscommon.ErrOpenStreamNotSupported) {
+		return a.serveLoadedArtifact(w, r, driver, art)
+	}
 	if err != nil {
 		return err
 	}
+	defer func() { _ = stream.Close() }()
+
+	log.WithFields(log.Fields{"size": stream.Size}).Debug("Artifact stream size")
+
+	// set the content type, so that it is not sniffed from the content, which would need it to be read twice
+	w.Header().Set("Content-Type", contentType(stream.ContentType, name))
+
+	// ServeContent serves the requested range, and sets the Content-Length
+	http.ServeContent(w, r, "", time.Time{}, stream)
+
+	return nil
+}
+
+// contentType returns the content type of a file, from the storage if it knows it, else from the extension of the name
+func contentType(storageContentType, name string) string {
+	if storageContentType != "" {
+		return storageContentType
+	}
+	if t := mime.TypeByExtension(path.Ext(name)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// serveLoadedArtifact serves an artifact whose driver cannot stream it by loading it to a temporary file
+func (a *ArtifactServer) serveLoadedArtifact(w http.ResponseWriter, r *http.Request, driver artifactscommon.ArtifactDriver, art *wfv1.Artifact) error {
 	tmp, err := ioutil.TempFile("/tmp", "artifact")
 	if err != nil {
 		return err
@@ -218,10 +275,6 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 	contentLength := strconv.FormatInt(stats.Size(), 10)
 	log.WithFields(log.Fields{"size": contentLength}).Debug("Artifact file size")
 
-	key, _ := art.GetKey()
-	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(key)))
-	w.WriteHeader(200)
-
 	http.ServeContent(w, r, "", time.Time{}, file)
 
 	return nil
@@ -1,10 +1,12 @@
 package artifacts
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -37,7 +39,10 @@ func mustParse(text string) *url.URL {
 
 type fakeArtifactDriver struct {
 	artifactscommon.ArtifactDriver
-	data []byte
+	data     []byte
+	noStream bool
+	// offsets are the offsets the artifact was streamed from
+	offsets []int64
 }
 
 func (a *fakeArtifactDriver) Load(_ *wfv1.Artifact, path string) error {
@@ -48,7 +53,19 @@ func (a *fakeArtifactDriver) Save(_ string, _ *wfv1.Artifact) error {
 	return fmt.Errorf("not implemented")
 }
 
+func (a *fakeArtifactDriver) OpenStream(_ *wfv1.Artifact, offset int64) (*artifactscommon.ArtifactStream, error) {
+	if a.noStream {
+		return nil, artifactscommon.ErrOpenStreamNotSupported
+	}
+	a.offsets = append(a.offsets, offset)
+	return &artifactscommon.ArtifactStream{ReadCloser: ioutil.NopCloser(bytes.NewReader(a.data[offset:])), Size: int64(len(a.data))}, nil
+}
+
 func newServer() *ArtifactServer {
+	return newServerWithDriver(&fakeArtifactDriver{data: []byte("my-data")})
+}
+
+func newServerWithDriver(driver artifactscommon.ArtifactDriver) *ArtifactServer {
 	gatekeeper := &authmocks.Gatekeeper{}
 	kube := kubefake.NewSimpleClientset()
 	instanceId := "my-instanceid"
@@ -105,6 +122,7 @@ func newServer() *ArtifactServer {
 										Key: "my-wf/my-node/my-oss-artifact.zip",
 									},
 								},
+								Checksum: "sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385",
 							},
 						},
 					},
@@ -123,7 +141,7 @@ func newServer() *ArtifactServer {
 	a.On("GetWorkflow", "my-uuid").Return(wf, nil)
 
 	fakeArtifactDriverFactory := func(_ context.Context, _ *wfv1.Artifact, _ resource.Interface) (artifactscommon.ArtifactDriver, error) {
-		return &fakeArtifactDriver{data: []byte("my-data")}, nil
+		return driver, nil
 	}
 
 	artifactRepositories := armocks.DummyArtifactRepositories(&wfv1.ArtifactRepository{
@@ -173,6 +191,49 @@ func TestArtifactServer_GetOutputArtifact(t *testing.T) {
 	}
 }
 
+func TestArtifactServer_GetOutputArtifactStream(t *testing.T) {
+	t.Run("Range", func(t *testing.T) {
+		driver := &fakeArtifactDriver{data: []byte("my-data")}
+		s := newServerWithDriver(driver)
+		r := httptest.NewRequest(http.MethodGet, "/artifacts/my-ns/my-wf/my-node/my-oss-artifact", nil)
+		r.Header.Set("Range", "bytes=3-")
+		w := httptest.NewRecorder()
+		s.GetOutputArtifact(w, r)
+		if assert.Equal(t, http.StatusPartialContent, w.Code) {
+			assert.Equal(t, "data", w.Body.String())
+			assert.Equal(t, "bytes 3-6/7", w.Header().Get("Content-Range"))
+			assert.Equal(t, "4", w.Header().Get("Content-Length"))
+			assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+			assert.Equal(t, []int64{0, 3}, driver.offsets, "only the range is streamed")
+		}
+	})
+	t.Run("ETag", func(t *testing.T) {
+		s := newServer()
+		r := httptest.NewRequest(http.MethodGet, "/artifacts/my-ns/my-wf/my-node/my-oss-artifact", nil)
+		w := httptest.NewRecorder()
+		s.GetOutputArtifact(w, r)
+		etag := `"sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385"`
+		if assert.Equal(t, http.StatusOK, w.Code) {
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+		}
+		r = httptest.NewRequest(http.MethodGet, "/artifacts/my-ns/my-wf/my-node/my-oss-artifact", nil)
+		r.Header.Set("If-None-Match", etag)
+		w = httptest.NewRecorder()
+		s.GetOutputArtifact(w, r)
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+	t.Run("Fallback", func(t *testing.T) {
+		s := newServerWithDriver(&fakeArtifactDriver{data: []byte("my-data"), noStream: true})
+		r := httptest.NewRequest(http.MethodGet, "/artifacts/my-ns/my-wf/my-node/my-gcs-artifact", nil)
+		r.Header.Set("Range", "bytes=0-1")
+		w := httptest.NewRecorder()
+		s.GetOutputArtifact(w, r)
+		if assert.Equal(t, http.StatusPartialContent, w.Code) {
+			assert.Equal(t, "my", w.Body.String())
+		}
+	})
+}
+
 func TestArtifactServer_GetInputArtifact(t *testing.T) {
 	s := newServer()
 
@@ -0,0 +1,237 @@
+package memoizationcache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	argotime "github.com/argoproj/pkg/time"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+var cacheTypes = []wfv1.CacheType{wfv1.CacheTypeConfigMap, wfv1.CacheTypeSQL, wfv1.CacheTypeArtifact}
+
+type cacheServer struct {
+	// namespace is the namespace the caches are stored in, i.e. the controller's namespace
+	namespace    string
+	cacheFactory controllercache.Factory
+}
+
+// NewCacheServer returns a new cacheServer, the entries of the caches belong to the namespaces of the workflows that
+// created them, and are only visible to users who can list the workflows of that namespace
+func NewCacheServer(namespace string, cacheFactory controllercache.Factory) cachepkg.CacheServiceServer {
+	return &cacheServer{namespace: namespace, cacheFactory: cacheFactory}
+}
+
+func (s *cacheServer) ListCacheEntries(ctx context.Context, req *cachepkg.ListCacheEntriesRequest) (*cachepkg.CacheEntryList, error) {
+	if err := canI(ctx, "list", req.Namespace); err != nil {
+		return nil, err
+	}
+	items, err := s.listEntries(ctx, req.Namespace, req.CacheType, req.CacheName, req.KeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return &cachepkg.CacheEntryList{Items: items}, nil
+}
+
+func (s *cacheServer) GetCacheEntry(ctx context.Context, req *cachepkg.GetCacheEntryRequest) (*cachepkg.CacheEntry, error) {
+	if err := canI(ctx, "get", req.Namespace); err != nil {
+		return nil, err
+	}
+	return s.getEntry(ctx, req.Namespace, req.CacheType, req.CacheName, req.Key)
+}
+
+func (s *cacheServer) DeleteCacheEntry(ctx context.Context, req *cachepkg.DeleteCacheEntryRequest) (*cachepkg.CacheEntryDeletedResponse, error) {
+	if err := canI(ctx, "delete", req.Namespace); err != nil {
+		return nil, err
+	}
+	entry, err := s.getEntry(ctx, req.Namespace, req.CacheType, req.CacheName, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.deleteEntries(ctx, []*cachepkg.CacheEntry{entry}); err != nil {
+		return nil, err
+	}
+	return &cachepkg.CacheEntryDeletedResponse{}, nil
+}
+
+func (s *cacheServer) PruneCacheEntries(ctx context.Context, req *cachepkg.PruneCacheEntriesRequest) (*cachepkg.CacheEntryList, error) {
+	if req.KeyPrefix == "" && req.OlderThan == "" {
+		return nil, status.Error(codes.InvalidArgument, "keyPrefix or olderThan must be specified")
+	}
+	if err := canI(ctx, "delete", req.Namespace); err != nil {
+		return nil, err
+	}
+	items, err := s.listEntries(ctx, req.Namespace, req.CacheType, req.CacheName, req.KeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	if req.OlderThan != "" {
+		createdBefore, err := argotime.ParseSince(req.OlderThan)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid olderThan: %v", err))
+		}
+		var older []*cachepkg.CacheEntry
+		for _, item := range items {
+			if item.CreationTimestamp.Time.Before(*createdBefore) {
+				older = append(older, item)
+			}
+		}
+		items = older
+	}
+	if !req.DryRun {
+		if err := s.deleteEntries(ctx, items); err != nil {
+			return nil, err
+		}
+	}
+	return &cachepkg.CacheEntryList{Items: items}, nil
+}
+
+func canI(ctx context.Context, verb, namespace string) error {
+	allowed, err := auth.CanI(ctx, verb, workflow.WorkflowPlural, namespace, "")
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to %s workflows in namespace \"%s\".", verb, namespace))
+	}
+	return nil
+}
+
+// caches returns the caches of the type and name, all types and all names if they are empty
+func (s *cacheServer) caches(ctx context.Context, cacheType, cacheName string) (map[wfv1.CacheType][]string, error) {
+	types := cacheTypes
+	if cacheType != "" {
+		ct, err := parseCacheType(cacheType)
+		if err != nil {
+			return nil, err
+		}
+		types = []wfv1.CacheType{ct}
+	}
+	caches := map[wfv1.CacheType][]string{}
+	for _, ct := range types {
+		if cacheName != "" {
+			caches[ct] = []string{cacheName}
+			continue
+		}
+		names, err := s.cacheFactory.ListCaches(ctx, ct)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list %s caches: %v", ct, err))
+		}
+		caches[ct] = names
+	}
+	return caches, nil
+}
+
+func (s *cacheServer) listEntries(ctx context.Context, namespace, cacheType, cacheName, keyPrefix string) ([]*cachepkg.CacheEntry, error) {
+	caches, err := s.caches(ctx, cacheType, cacheName)
+	if err != nil {
+		return nil, err
+	}
+	var items []*cachepkg.CacheEntry
+	for _, ct := range cacheTypes {
+		for _, name := range caches[ct] {
+			entries, err := s.cacheFactory.GetCache(ct, name, 0).List(ctx, keyPrefix)
+			if err != nil {
+				// a cache that cannot be read must not hide the others that were found
+				if cacheName == "" {
+					log.WithFields(log.Fields{"cacheType": ct, "cacheName": name}).WithError(err).Warn("Failed to list memoization cache entries")
+					continue
+				}
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			for key, entry := range entries {
+				if s.entryNamespace(entry) == namespace {
+					items = append(items, newCacheEntry(ct, name, key, entry))
+				}
+			}
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		x, y := items[i], items[j]
+		return strings.Join([]string{x.CacheType, x.CacheName, x.Key}, "/") < strings.Join([]string{y.CacheType, y.CacheName, y.Key}, "/")
+	})
+	return items, nil
+}
+
+func (s *cacheServer) getEntry(ctx context.Context, namespace, cacheType, cacheName, key string) (*cachepkg.CacheEntry, error) {
+	ct, err := parseCacheType(cacheType)
+	if err != nil {
+		return nil, err
+	}
+	if cacheName == "" || key == "" {
+		return nil, status.Error(codes.InvalidArgument, "cacheName and key must be specified")
+	}
+	entries, err := s.cacheFactory.GetCache(ct, cacheName, 0).List(ctx, key)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	entry, ok := entries[key]
+	// entries of other namespaces are reported as not found, so their existence is not disclosed
+	if !ok || s.entryNamespace(entry) != namespace {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("cache entry %s not found in %s cache %s", key, ct, cacheName))
+	}
+	return newCacheEntry(ct, cacheName, key, entry), nil
+}
+
+func (s *cacheServer) deleteEntries(ctx context.Context, items []*cachepkg.CacheEntry) error {
+	keys := map[wfv1.CacheType]map[string][]string{}
+	for _, item := range items {
+		ct := wfv1.CacheType(item.CacheType)
+		if keys[ct] == nil {
+			keys[ct] = map[string][]string{}
+		}
+		keys[ct][item.CacheName] = append(keys[ct][item.CacheName], item.Key)
+	}
+	for ct, caches := range keys {
+		for name, cacheKeys := range caches {
+			if err := s.cacheFactory.GetCache(ct, name, 0).Delete(ctx, cacheKeys...); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// entryNamespace returns the namespace of the workflow that created the entry, entries created before that was
+// recorded belong to the namespace of the caches
+func (s *cacheServer) entryNamespace(entry controllercache.Entry) string {
+	if entry.WorkflowNamespace != "" {
+		return entry.WorkflowNamespace
+	}
+	return s.namespace
+}
+
+func parseCacheType(cacheType string) (wfv1.CacheType, error) {
+	for _, ct := range cacheTypes {
+		if strings.EqualFold(string(ct), cacheType) {
+			return ct, nil
+		}
+	}
+	return "", status.Error(codes.InvalidArgument, fmt.Sprintf("cacheType must be one of %v", cacheTypes))
+}
+
+func newCacheEntry(ct wfv1.CacheType, name, key string, entry controllercache.Entry) *cachepkg.CacheEntry {
+	creationTimestamp, lastHitTimestamp := entry.CreationTimestamp, entry.LastHitTimestamp
+	return &cachepkg.CacheEntry{
+		CacheType:         string(ct),
+		CacheName:         name,
+		Key:               key,
+		NodeID:            entry.NodeID,
+		WorkflowName:      entry.WorkflowName,
+		WorkflowNamespace: entry.WorkflowNamespace,
+		HitCount:          entry.HitCount,
+		CreationTimestamp: &creationTimestamp,
+		LastHitTimestamp:  &lastHitTimestamp,
+		Outputs:           entry.Outputs,
+	}
+}
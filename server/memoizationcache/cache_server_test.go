@@ -0,0 +1,140 @@
+package memoizationcache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	cachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cache"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+func Test_cacheServer(t *testing.T) {
+	factory := controllercache.NewCacheFactory(kubefake.NewSimpleClientset(), "argo", sqldb.NullMemoizationCacheRepo, nil, nil)
+	s := NewCacheServer("argo", factory)
+	kubeClient := &kubefake.Clientset{}
+	allowed := true
+	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	ctx := context.WithValue(context.TODO(), auth.KubeKey, kubeClient)
+
+	save := func(key, namespace string) {
+		var wf metav1.Object
+		if namespace != "" {
+			wf = &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: namespace}}
+		}
+		c := factory.GetCache(wfv1.CacheTypeConfigMap, "my-cache", 0)
+		err := c.Save(ctx, key, "my-node", &wfv1.Outputs{}, wf)
+		assert.NoError(t, err)
+	}
+	save("a-1", "my-ns")
+	save("a-2", "other-ns")
+	save("b-1", "my-ns")
+	// entries created before the workflow was recorded belong to the namespace of the caches
+	save("legacy", "")
+
+	keys := func(list *cachepkg.CacheEntryList) []string {
+		var keys []string
+		for _, item := range list.Items {
+			keys = append(keys, item.Key)
+		}
+		return keys
+	}
+
+	t.Run("ListCacheEntries", func(t *testing.T) {
+		allowed = false
+		_, err := s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		list, err := s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a-1", "b-1"}, keys(list))
+			assert.Equal(t, "ConfigMap", list.Items[0].CacheType)
+			assert.Equal(t, "my-cache", list.Items[0].CacheName)
+			assert.Equal(t, "my-wf", list.Items[0].WorkflowName)
+		}
+		list, err = s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", KeyPrefix: "b-"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"b-1"}, keys(list))
+		}
+		list, err = s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "argo"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"legacy"}, keys(list))
+		}
+		_, err = s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", CacheType: "Redis"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("GetCacheEntry", func(t *testing.T) {
+		allowed = false
+		_, err := s.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", CacheType: "ConfigMap", CacheName: "my-cache", Key: "a-1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		entry, err := s.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", CacheType: "ConfigMap", CacheName: "my-cache", Key: "a-1"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-node", entry.NodeID)
+		}
+		_, err = s.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", CacheType: "ConfigMap", CacheName: "my-cache", Key: "a-2"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("DeleteCacheEntry", func(t *testing.T) {
+		allowed = false
+		_, err := s.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", CacheType: "ConfigMap", CacheName: "my-cache", Key: "a-1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		// the entry of another namespace cannot be deleted
+		_, err = s.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", CacheType: "ConfigMap", CacheName: "my-cache", Key: "a-2"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = s.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", CacheType: "ConfigMap", CacheName: "my-cache", Key: "a-1"})
+		assert.NoError(t, err)
+		list, err := s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"b-1"}, keys(list))
+		}
+	})
+	t.Run("PruneCacheEntries", func(t *testing.T) {
+		_, err := s.PruneCacheEntries(ctx, &cachepkg.PruneCacheEntriesRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.PruneCacheEntries(ctx, &cachepkg.PruneCacheEntriesRequest{Namespace: "my-ns", OlderThan: "foo"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		allowed = false
+		_, err = s.PruneCacheEntries(ctx, &cachepkg.PruneCacheEntriesRequest{Namespace: "my-ns", KeyPrefix: "b-"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		// no entry is older than a day
+		list, err := s.PruneCacheEntries(ctx, &cachepkg.PruneCacheEntriesRequest{Namespace: "my-ns", OlderThan: "1d"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, list.Items)
+		}
+		list, err = s.PruneCacheEntries(ctx, &cachepkg.PruneCacheEntriesRequest{Namespace: "other-ns", KeyPrefix: "a-", DryRun: true})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a-2"}, keys(list))
+		}
+		list, err = s.PruneCacheEntries(ctx, &cachepkg.PruneCacheEntriesRequest{Namespace: "other-ns", KeyPrefix: "a-"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a-2"}, keys(list))
+		}
+		list, err = s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "other-ns"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, list.Items)
+		}
+		// the entries of other namespaces are not pruned
+		list, err = s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"b-1"}, keys(list))
+		}
+	})
+}
@@ -8,8 +8,10 @@ import (
 	"strings"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -19,15 +21,20 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type archivedWorkflowServer struct {
-	wfArchive sqldb.WorkflowArchive
+	wfArchive         sqldb.WorkflowArchive
+	instanceIDService instanceid.Service
+	hydrator          hydrator.Interface
 }
 
 // NewWorkflowArchiveServer returns a new archivedWorkflowServer
-func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive) workflowarchivepkg.ArchivedWorkflowServiceServer {
-	return &archivedWorkflowServer{wfArchive: wfArchive}
+func NewWorkflowArchiveServer(wfArchive sqldb.WorkflowArchive, instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) workflowarchivepkg.ArchivedWorkflowServiceServer {
+	return &archivedWorkflowServer{wfArchive: wfArchive, instanceIDService: instanceIDService, hydrator: hydrator.New(offloadNodeStatusRepo)}
 }
 
 func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req *workflowarchivepkg.ListArchivedWorkflowsRequest) (*wfv1.WorkflowList, error) {
@@ -188,3 +195,91 @@ func (w *archivedWorkflowServer) ListArchivedWorkflowLabelValues(ctx context.Con
 	}
 	return labels, err
 }
+
+// getArchivedWorkflowForRerun returns the archived workflow after checking that it matches the request and is managed by this server
+func (w *archivedWorkflowServer) getArchivedWorkflowForRerun(ctx context.Context, uid, namespace, name string) (*wfv1.Workflow, error) {
+	wf, err := w.GetArchivedWorkflow(ctx, &workflowarchivepkg.GetArchivedWorkflowRequest{Uid: uid})
+	if err != nil {
+		return nil, err
+	}
+	if (namespace != "" && namespace != wf.Namespace) || (name != "" && name != wf.Name) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if err := w.instanceIDService.Validate(wf); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return wf, nil
+}
+
+func (w *archivedWorkflowServer) RetryArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.RetryArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+	kubeClient := auth.GetKubeClient(ctx)
+
+	wf, err := w.getArchivedWorkflowForRerun(ctx, req.Uid, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := auth.CanI(ctx, "create", workflow.WorkflowPlural, wf.Namespace, wf.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	// a retried workflow keeps its name, so it cannot be restored while the original is still in the cluster
+	_, err = wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace).Get(ctx, wf.Name, metav1.GetOptions{})
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "workflow %s already exists in namespace %s, retry it instead", wf.Name, wf.Namespace)
+	} else if !apierr.IsNotFound(err) {
+		return nil, err
+	}
+	switch wf.Status.Phase {
+	case wfv1.WorkflowFailed, wfv1.WorkflowError:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "workflow must be Failed/Error to retry")
+	}
+
+	newWF, podsToDelete, err := util.FormulateRetryWorkflow(wf, req.RestartSuccessful, req.NodeFieldSelector, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	for _, podName := range podsToDelete {
+		log.WithFields(log.Fields{"podName": podName}).Info("Deleting pod")
+		err := kubeClient.CoreV1().Pods(wf.Namespace).Delete(ctx, podName, metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	newWF.ObjectMeta.ResourceVersion = ""
+	newWF.ObjectMeta.UID = ""
+	newWF.ObjectMeta.CreationTimestamp = metav1.Time{}
+	newWF.ObjectMeta.ManagedFields = nil
+	err = w.hydrator.Dehydrate(newWF)
+	if err != nil {
+		return nil, err
+	}
+	return wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace).Create(ctx, newWF, metav1.CreateOptions{})
+}
+
+func (w *archivedWorkflowServer) ResubmitArchivedWorkflow(ctx context.Context, req *workflowarchivepkg.ResubmitArchivedWorkflowRequest) (*wfv1.Workflow, error) {
+	wfClient := auth.GetWfClient(ctx)
+
+	wf, err := w.getArchivedWorkflowForRerun(ctx, req.Uid, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := auth.CanI(ctx, "create", workflow.WorkflowPlural, wf.Namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	newWF, err := util.FormulateResubmitWorkflow(wf, req.Memoized, req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	return util.SubmitWorkflow(ctx, wfClient.ArgoprojV1alpha1().Workflows(wf.Namespace), wfClient, wf.Namespace, newWF, &wfv1.SubmitOpts{})
+}
@@ -10,24 +10,29 @@ import (
 	"google.golang.org/grpc/status"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	workflowarchivepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflowarchive"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func Test_archivedWorkflowServer(t *testing.T) {
 	repo := &mocks.WorkflowArchive{}
 	kubeClient := &kubefake.Clientset{}
 	wfClient := &argofake.Clientset{}
-	w := NewWorkflowArchiveServer(repo)
+	w := NewWorkflowArchiveServer(repo, instanceid.NewService(""), sqldb.ExplosiveOffloadNodeStatusRepo)
 	allowed := true
 	kubeClient.AddReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &authorizationv1.SelfSubjectAccessReview{
@@ -60,10 +65,32 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		Spec: wfv1.WorkflowSpec{
 			Entrypoint: "my-entrypoint",
 			Templates: []wfv1.Template{
-				{Name: "my-entrypoint", Container: &apiv1.Container{}},
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "my-image"}},
 			},
 		},
 	}, nil)
+	repo.On("GetWorkflow", "failed-uid").Return(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "failed-name", Namespace: "my-ns"},
+		Spec: wfv1.WorkflowSpec{
+			Entrypoint: "my-entrypoint",
+			Templates: []wfv1.Template{
+				{Name: "my-entrypoint", Container: &apiv1.Container{Image: "my-image"}},
+			},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase: wfv1.WorkflowFailed,
+			Nodes: wfv1.Nodes{
+				"failed-name": {ID: "failed-name", Name: "failed-name", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed},
+			},
+		},
+	}, nil)
+	repo.On("GetWorkflow", "unmanaged-uid").Return(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "unmanaged-name", Labels: map[string]string{common.LabelKeyControllerInstanceID: "other"}},
+		Status:     wfv1.WorkflowStatus{Phase: wfv1.WorkflowFailed},
+	}, nil)
+	wfClient.AddReactor("get", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, nil, apierr.NewNotFound(wfv1.Resource(workflow.WorkflowPlural), action.(k8stesting.GetAction).GetName())
+	})
 	wfClient.AddReactor("create", "workflows", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, &wfv1.Workflow{
 			ObjectMeta: metav1.ObjectMeta{Name: "my-name-resubmitted"},
@@ -133,6 +160,34 @@ func Test_archivedWorkflowServer(t *testing.T) {
 		_, err = w.DeleteArchivedWorkflow(ctx, &workflowarchivepkg.DeleteArchivedWorkflowRequest{Uid: "my-uid"})
 		assert.NoError(t, err)
 	})
+	t.Run("RetryArchivedWorkflow", func(t *testing.T) {
+		_, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "my-uid"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid", Namespace: "other-ns"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "unmanaged-uid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		allowed = false
+		_, err = w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		wf, err := w.RetryArchivedWorkflow(ctx, &workflowarchivepkg.RetryArchivedWorkflowRequest{Uid: "failed-uid", Namespace: "my-ns", Name: "failed-name"})
+		assert.NoError(t, err)
+		assert.NotNil(t, wf)
+	})
+	t.Run("ResubmitArchivedWorkflow", func(t *testing.T) {
+		allowed = false
+		_, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		wf, err := w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid", Parameters: []string{"bad"}})
+		assert.Error(t, err)
+		assert.Nil(t, wf)
+		wf, err = w.ResubmitArchivedWorkflow(ctx, &workflowarchivepkg.ResubmitArchivedWorkflowRequest{Uid: "my-uid"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-name-resubmitted", wf.Name)
+		}
+	})
 	t.Run("ListArchivedWorkflowLabelKeys", func(t *testing.T) {
 		resp, err := w.ListArchivedWorkflowLabelKeys(ctx, &workflowarchivepkg.ListArchivedWorkflowLabelKeysRequest{})
 		assert.NoError(t, err)
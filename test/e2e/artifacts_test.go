@@ -4,16 +4,27 @@
 package e2e
 
 import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/test/e2e/fixtures"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/azure"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/oci"
 )
 
+// azuriteAccountKey is the well-known key of the account of the Azurite emulator, which the quick-start manifests run
+const azuriteAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
 type ArtifactsSuite struct {
 	fixtures.E2ESuite
 }
@@ -146,6 +157,84 @@ func (s *ArtifactsSuite) TestMainLog() {
 		})
 }
 
+func (s *ArtifactsSuite) TestAzureArtifacts() {
+	ctx := context.Background()
+	// Azurite is port-forwarded, and its endpoints are path-style, so it is signed for in the same way from here
+	endpoint := "http://localhost:10000/devstoreaccount1"
+	cred, err := container.NewSharedKeyCredential("devstoreaccount1", azuriteAccountKey)
+	s.Require().NoError(err)
+	c, err := container.NewClientWithSharedKeyCredential(endpoint+"/my-container", cred, nil)
+	s.Require().NoError(err)
+	if _, err := c.Create(ctx, nil); !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		s.Require().NoError(err)
+	}
+	s.Given().
+		Workflow("@testdata/azure-artifacts-workflow.yaml").
+		When().
+		SubmitWorkflow().
+		WaitForWorkflow(fixtures.ToBeSucceeded).
+		Then().
+		ExpectWorkflow(func(t *testing.T, m *metav1.ObjectMeta, status *wfv1.WorkflowStatus) {
+			n := status.Nodes.FindByDisplayName("generate")
+			if !assert.NotNil(t, n) || !assert.Len(t, n.Outputs.Artifacts, 1) {
+				return
+			}
+			art := n.Outputs.Artifacts[0].DeepCopy()
+			assert.Equal(t, m.Name+"/message", art.Azure.Blob)
+			art.Azure.Endpoint = endpoint
+			driver := &azure.ArtifactDriver{AccountKey: azuriteAccountKey}
+			path := filepath.Join(t.TempDir(), "message")
+			if assert.NoError(t, driver.Load(art, path)) {
+				data, err := ioutil.ReadFile(path)
+				assert.NoError(t, err)
+				assert.Equal(t, "hello", string(data))
+			}
+			digest, err := driver.Digest(art)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, digest)
+			assert.NoError(t, driver.Delete(art))
+			keys, err := driver.ListObjects(art)
+			assert.NoError(t, err)
+			assert.Empty(t, keys)
+		})
+}
+
+func (s *ArtifactsSuite) TestOCIArtifacts() {
+	s.Given().
+		Workflow("@testdata/oci-artifacts-workflow.yaml").
+		When().
+		SubmitWorkflow().
+		WaitForWorkflow(fixtures.ToBeSucceeded).
+		Then().
+		ExpectWorkflow(func(t *testing.T, m *metav1.ObjectMeta, status *wfv1.WorkflowStatus) {
+			n := status.Nodes.FindByDisplayName("generate")
+			if !assert.NotNil(t, n) || !assert.Len(t, n.Outputs.Artifacts, 1) {
+				return
+			}
+			art := n.Outputs.Artifacts[0].DeepCopy()
+			assert.Equal(t, m.Name, art.OCI.Tag)
+			assert.NotEmpty(t, art.OCI.Digest)
+			// the registry is port-forwarded
+			art.OCI.Repository = "localhost:5000/argo/message"
+			driver := &oci.ArtifactDriver{}
+			path := filepath.Join(t.TempDir(), "message")
+			if assert.NoError(t, driver.Load(art, path)) {
+				data, err := ioutil.ReadFile(path)
+				assert.NoError(t, err)
+				assert.Equal(t, "hello", string(data))
+			}
+			digest, err := driver.Digest(art)
+			assert.NoError(t, err)
+			assert.Equal(t, art.OCI.Digest, digest)
+			keys, err := driver.ListObjects(art)
+			assert.NoError(t, err)
+			assert.Len(t, keys, 1)
+			assert.NoError(t, driver.Delete(art))
+			err = driver.Load(art, path)
+			assert.True(t, errors.IsCode(errors.CodeNotFound, err), "%v", err)
+		})
+}
+
 func TestArtifactsSuite(t *testing.T) {
 	suite.Run(t, new(ArtifactsSuite))
 }
@@ -10,6 +10,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
 )
 
@@ -19,6 +20,19 @@ type flusher interface {
 
 // TarGzToWriter tar.gz's the source path to the supplied writer
 func TarGzToWriter(sourcePath string, level int, w io.Writer) error {
+	return tarToWriter(sourcePath, w, func(w io.Writer) (io.WriteCloser, error) {
+		return gzip.NewWriterLevel(w, level)
+	})
+}
+
+// TarToWriter tars the source path to the supplied writer, compressed as the strategy specifies
+func TarToWriter(sourcePath string, strategy *wfv1.TarStrategy, w io.Writer) error {
+	return tarToWriter(sourcePath, w, func(w io.Writer) (io.WriteCloser, error) {
+		return NewCompressor(w, strategy)
+	})
+}
+
+func tarToWriter(sourcePath string, w io.Writer, newCompressor func(io.Writer) (io.WriteCloser, error)) error {
 	sourcePath, err := filepath.Abs(sourcePath)
 	if err != nil {
 		return errors.InternalErrorf("getting absolute path: %v", err)
@@ -37,12 +51,12 @@ func TarGzToWriter(sourcePath string, level int, w io.Writer) error {
 	if flush, ok := w.(flusher); ok {
 		defer func() { _ = flush.Flush() }()
 	}
-	gzw, err := gzip.NewWriterLevel(w, level)
+	cw, err := newCompressor(w)
 	if err != nil {
 		return errors.InternalWrapError(err)
 	}
-	defer util.Close(gzw)
-	tw := tar.NewWriter(gzw)
+	defer util.Close(cw)
+	tw := tar.NewWriter(cw)
 	defer util.Close(tw)
 
 	if sourceFi.IsDir() {
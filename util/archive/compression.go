@@ -0,0 +1,205 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"runtime"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// Format is the format of a file that may be an archive
+type Format int
+
+const (
+	// FormatNone is a file that is not an archive
+	FormatNone Format = iota
+	FormatTarGzip
+	FormatTarZstd
+	FormatTar
+	FormatZip
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	zipMagic  = []byte("PK\x03\x04")
+	// tarMagic is at tarMagicOffset of a POSIX or GNU tarball
+	tarMagic = []byte("ustar")
+)
+
+const (
+	tarMagicOffset      = 257
+	defaultZstdLevel    = 3
+	pgzipBlockSize      = 1 << 20
+	maxMagicBytesLength = tarMagicOffset + 5
+)
+
+// Extension returns the file extension of a tarball compressed as the strategy specifies
+func Extension(strategy *wfv1.TarStrategy) string {
+	switch strategy.GetCompression() {
+	case wfv1.TarCompressionZstd:
+		return ".tar.zst"
+	case wfv1.TarCompressionNone:
+		return ".tar"
+	default:
+		return ".tgz"
+	}
+}
+
+// GzipLevel returns the gzip compression level of the tar.gz that a path is copied from a container as, before it is
+// archived with the strategy: the level of the tarball of a gzip tar strategy, the default strategy, else no
+// compression, because the tarball is recompressed or unarchived
+func GzipLevel(strategy *wfv1.ArchiveStrategy) int {
+	if strategy == nil {
+		return gzip.DefaultCompression
+	}
+	if strategy.Tar == nil || strategy.Tar.GetCompression() != wfv1.TarCompressionGzip {
+		return gzip.NoCompression
+	}
+	if l := strategy.Tar.CompressionLevel; l != nil {
+		return int(*l)
+	}
+	return gzip.DefaultCompression
+}
+
+// NewCompressor returns a writer that compresses to the supplied writer as the strategy specifies. Closing it does not
+// close the supplied writer.
+func NewCompressor(w io.Writer, strategy *wfv1.TarStrategy) (io.WriteCloser, error) {
+	concurrency := runtime.GOMAXPROCS(0)
+	if strategy != nil && strategy.Concurrency != nil && *strategy.Concurrency > 0 {
+		concurrency = int(*strategy.Concurrency)
+	}
+	var level *int
+	if strategy != nil && strategy.CompressionLevel != nil {
+		l := int(*strategy.CompressionLevel)
+		level = &l
+	}
+	switch compression := strategy.GetCompression(); compression {
+	case wfv1.TarCompressionZstd:
+		l := defaultZstdLevel
+		if level != nil {
+			l = *level
+		}
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(l)), zstd.WithEncoderConcurrency(concurrency))
+	case wfv1.TarCompressionNone:
+		return nopWriteCloser{w}, nil
+	case wfv1.TarCompressionGzip:
+		l := gzip.DefaultCompression
+		if level != nil {
+			l = *level
+		}
+		gzw, err := pgzip.NewWriterLevel(w, l)
+		if err != nil {
+			return nil, err
+		}
+		if err := gzw.SetConcurrency(pgzipBlockSize, concurrency); err != nil {
+			return nil, err
+		}
+		return gzw, nil
+	default:
+		return nil, fmt.Errorf("unknown tar compression %q", compression)
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// DetectFormat detects the format of a file from its magic bytes. A gzip or zstd compressed file is only a tarball if
+// its content is, and a zip file is detected by its magic bytes alone.
+func DetectFormat(r io.ReaderAt) (Format, error) {
+	magic := make([]byte, maxMagicBytesLength)
+	n, err := r.ReadAt(magic, 0)
+	if err != nil && err != io.EOF {
+		return FormatNone, err
+	}
+	magic = magic[:n]
+	var format Format
+	switch {
+	case bytes.HasPrefix(magic, zipMagic):
+		return FormatZip, nil
+	case bytes.HasPrefix(magic, gzipMagic):
+		format = FormatTarGzip
+	case bytes.HasPrefix(magic, zstdMagic):
+		format = FormatTarZstd
+	case len(magic) == maxMagicBytesLength && bytes.Equal(magic[tarMagicOffset:], tarMagic):
+		return FormatTar, nil
+	default:
+		return FormatNone, nil
+	}
+	// decompress without reading ahead, so only the first header is read
+	tr, closeTar, err := newTarReader(io.NewSectionReader(r, 0, 1<<63-1), format, false)
+	if err != nil {
+		return FormatNone, nil
+	}
+	defer closeTar()
+	if _, err := tr.Next(); err != nil {
+		return FormatNone, nil
+	}
+	return format, nil
+}
+
+// NewTarReader returns a reader of a tarball of a format, and a function that closes its decompressor
+func NewTarReader(r io.Reader, format Format) (*tar.Reader, func(), error) {
+	return newTarReader(r, format, true)
+}
+
+// newTarReader returns a reader of a tarball, whose decompressor decompresses ahead of the reads concurrently if
+// readAhead is true
+func newTarReader(r io.Reader, format Format, readAhead bool) (*tar.Reader, func(), error) {
+	switch format {
+	case FormatTarGzip:
+		var gzr io.ReadCloser
+		var err error
+		if readAhead {
+			gzr, err = pgzip.NewReader(r)
+		} else {
+			gzr, err = gzip.NewReader(r)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return tar.NewReader(gzr), func() { _ = gzr.Close() }, nil
+	case FormatTarZstd:
+		concurrency := 1
+		if readAhead {
+			concurrency = runtime.GOMAXPROCS(0)
+		}
+		zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(concurrency))
+		if err != nil {
+			return nil, nil, err
+		}
+		return tar.NewReader(zr), zr.Close, nil
+	case FormatTar:
+		return tar.NewReader(r), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("not a tarball")
+	}
+}
+
+// Recompress decompresses a tar.gz and compresses it to the supplied writer as the strategy specifies
+func Recompress(r io.Reader, strategy *wfv1.TarStrategy, w io.Writer) error {
+	gzr, err := pgzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = gzr.Close() }()
+	cw, err := NewCompressor(w, strategy)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(cw, gzr); err != nil {
+		_ = cw.Close()
+		return err
+	}
+	return cw.Close()
+}
@@ -0,0 +1,134 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func tarDirToBytes(t *testing.T, strategy *wfv1.TarStrategy) []byte {
+	dir, err := ioutil.TempDir("", "archive")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("foo"), 0o600))
+	buf := &bytes.Buffer{}
+	assert.NoError(t, TarToWriter(dir, strategy, buf))
+	return buf.Bytes()
+}
+
+func readTar(t *testing.T, data []byte, format Format) map[string]string {
+	tr, closeTar, err := NewTarReader(bytes.NewReader(data), format)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	defer closeTar()
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			return files
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			data, err := ioutil.ReadAll(tr)
+			assert.NoError(t, err)
+			files[filepath.Base(hdr.Name)] = string(data)
+		}
+	}
+}
+
+func TestTarToWriter(t *testing.T) {
+	level := int32(9)
+	concurrency := int32(2)
+	tests := []struct {
+		name     string
+		strategy *wfv1.TarStrategy
+		format   Format
+	}{
+		{"Default", nil, FormatTarGzip},
+		{"Gzip", &wfv1.TarStrategy{Compression: wfv1.TarCompressionGzip, CompressionLevel: &level, Concurrency: &concurrency}, FormatTarGzip},
+		{"Zstd", &wfv1.TarStrategy{Compression: wfv1.TarCompressionZstd}, FormatTarZstd},
+		{"ZstdLevel", &wfv1.TarStrategy{Compression: wfv1.TarCompressionZstd, CompressionLevel: &level, Concurrency: &concurrency}, FormatTarZstd},
+		{"None", &wfv1.TarStrategy{Compression: wfv1.TarCompressionNone}, FormatTar},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tarDirToBytes(t, tt.strategy)
+			format, err := DetectFormat(bytes.NewReader(data))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.format, format)
+			assert.Equal(t, map[string]string{"a.txt": "foo"}, readTar(t, data, format))
+		})
+	}
+	t.Run("Unknown", func(t *testing.T) {
+		err := TarToWriter("../../test/e2e", &wfv1.TarStrategy{Compression: "bzip2"}, &bytes.Buffer{})
+		assert.Error(t, err)
+	})
+}
+
+func TestDetectFormat(t *testing.T) {
+	gzipped := &bytes.Buffer{}
+	gzw := gzip.NewWriter(gzipped)
+	_, _ = gzw.Write([]byte("foo"))
+	assert.NoError(t, gzw.Close())
+	zstded := &bytes.Buffer{}
+	zstdw, err := zstd.NewWriter(zstded)
+	assert.NoError(t, err)
+	_, _ = zstdw.Write([]byte("foo"))
+	assert.NoError(t, zstdw.Close())
+	zipped := &bytes.Buffer{}
+	zw := zip.NewWriter(zipped)
+	_, err = zw.Create("a.txt")
+	assert.NoError(t, err)
+	assert.NoError(t, zw.Close())
+
+	tests := []struct {
+		name   string
+		data   []byte
+		format Format
+	}{
+		{"Empty", nil, FormatNone},
+		{"Text", []byte("foo"), FormatNone},
+		{"Gzip", gzipped.Bytes(), FormatNone},
+		{"Zstd", zstded.Bytes(), FormatNone},
+		{"Zip", zipped.Bytes(), FormatZip},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := DetectFormat(bytes.NewReader(tt.data))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.format, format)
+		})
+	}
+}
+
+func TestRecompress(t *testing.T) {
+	tgz := tarDirToBytes(t, nil)
+	buf := &bytes.Buffer{}
+	assert.NoError(t, Recompress(bytes.NewReader(tgz), &wfv1.TarStrategy{Compression: wfv1.TarCompressionZstd}, buf))
+	assert.Equal(t, map[string]string{"a.txt": "foo"}, readTar(t, buf.Bytes(), FormatTarZstd))
+}
+
+func TestGzipLevel(t *testing.T) {
+	level := int32(1)
+	assert.Equal(t, gzip.DefaultCompression, GzipLevel(nil))
+	assert.Equal(t, gzip.DefaultCompression, GzipLevel(&wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{}}))
+	assert.Equal(t, 1, GzipLevel(&wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{CompressionLevel: &level}}))
+	assert.Equal(t, gzip.NoCompression, GzipLevel(&wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{Compression: wfv1.TarCompressionZstd}}))
+	assert.Equal(t, gzip.NoCompression, GzipLevel(&wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}}))
+}
+
+func TestExtension(t *testing.T) {
+	assert.Equal(t, ".tgz", Extension(nil))
+	assert.Equal(t, ".tar.zst", Extension(&wfv1.TarStrategy{Compression: wfv1.TarCompressionZstd}))
+	assert.Equal(t, ".tar", Extension(&wfv1.TarStrategy{Compression: wfv1.TarCompressionNone}))
+}
@@ -0,0 +1,59 @@
+package k8s
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// SplitManifest splits a manifest of one or more YAML or JSON documents into its documents, skipping empty ones.
+func SplitManifest(manifest []byte) ([]interface{}, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	var docs []interface{}
+	for {
+		var doc interface{}
+		if err := decoder.Decode(&doc); err == io.EOF {
+			return docs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+}
+
+// ParseObjects parses a manifest of one or more YAML or JSON documents into Kubernetes objects.
+func ParseObjects(manifest []byte) ([]*unstructured.Unstructured, error) {
+	docs, err := SplitManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]*unstructured.Unstructured, len(docs))
+	for i, doc := range docs {
+		m, ok := doc.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("document %d is not a Kubernetes object", i)
+		}
+		objs[i] = &unstructured.Unstructured{Object: m}
+	}
+	return objs, nil
+}
+
+// FormatObjects formats Kubernetes objects as a multi-document YAML manifest.
+func FormatObjects(objs []*unstructured.Unstructured) (string, error) {
+	docs := make([]string, len(objs))
+	for i, obj := range objs {
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return "", err
+		}
+		docs[i] = string(data)
+	}
+	return strings.Join(docs, "---\n"), nil
+}
@@ -0,0 +1,57 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitManifest(t *testing.T) {
+	t.Run("YAML", func(t *testing.T) {
+		docs, err := SplitManifest([]byte(`
+a: 1
+---
+---
+- op: add
+`))
+		if assert.NoError(t, err) {
+			assert.Len(t, docs, 2)
+		}
+	})
+	t.Run("JSON", func(t *testing.T) {
+		docs, err := SplitManifest([]byte(`{"a": 1} {"b": 2}`))
+		if assert.NoError(t, err) {
+			assert.Len(t, docs, 2)
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := SplitManifest([]byte(`a: [`))
+		assert.Error(t, err)
+	})
+}
+
+func TestParseObjects(t *testing.T) {
+	objs, err := ParseObjects([]byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: b
+`))
+	if assert.NoError(t, err) && assert.Len(t, objs, 2) {
+		assert.Equal(t, "a", objs[0].GetName())
+		assert.Equal(t, "b", objs[1].GetName())
+		manifest, err := FormatObjects(objs)
+		if assert.NoError(t, err) {
+			again, err := ParseObjects([]byte(manifest))
+			assert.NoError(t, err)
+			assert.Equal(t, objs, again)
+		}
+	}
+	_, err = ParseObjects([]byte(`- op: add`))
+	assert.EqualError(t, err, "document 0 is not a Kubernetes object")
+}
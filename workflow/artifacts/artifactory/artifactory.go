@@ -80,3 +80,31 @@ func (a *ArtifactDriver) Save(path string, artifact *wfv1.Artifact) error {
 func (a *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
 	return nil, fmt.Errorf("ListObjects is currently not supported for this artifact type, but it will be in a future version")
 }
+
+// Delete deletes the artifact at the artifactory URL
+func (a *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	req, err := http.NewRequest(http.MethodDelete, artifact.Artifactory.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(a.Username, a.Password)
+	res, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.InternalErrorf("deleting %s from artifactory failed with reason:%s", artifact.Artifactory.URL, res.Status)
+	}
+	return nil
+}
+
+// OpenStream is unsupported, artifactory artifacts are loaded to a file instead
+func (a *ArtifactDriver) OpenStream(*wfv1.Artifact, int64) (*common.ArtifactStream, error) {
+	return nil, common.ErrOpenStreamNotSupported
+}
@@ -6,12 +6,16 @@ import (
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/artifactory"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/azure"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/encryption"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/gcs"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/git"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/hdfs"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/http"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/oci"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/oss"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/plugin"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/raw"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/s3"
@@ -21,8 +25,41 @@ var ErrUnsupportedDriver = fmt.Errorf("unsupported artifact driver")
 
 type NewDriverFunc func(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error)
 
-// NewDriver initializes an instance of an artifact driver
+// NewDriver initializes an instance of an artifact driver. The driver of an encrypted artifact encrypts and decrypts it,
+// and the key ID of the artifact is set to the ID of the key of the secret.
 func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
+	driver, err := newDriver(ctx, art, ri)
+	if err != nil || art.Encryption == nil {
+		return driver, err
+	}
+	if art.Encryption.KeySecret == nil {
+		return nil, fmt.Errorf("the encryption of artifact %s has no key secret", art.Name)
+	}
+	secret, err := ri.GetSecret(ctx, art.Encryption.KeySecret.Name, art.Encryption.KeySecret.Key)
+	if err != nil {
+		return nil, err
+	}
+	key, keyID, err := encryption.ParseKey([]byte(secret))
+	if err != nil {
+		return nil, err
+	}
+	previousKeys := encryption.Keys{}
+	for _, keySecret := range art.Encryption.PreviousKeySecrets {
+		secret, err := ri.GetSecret(ctx, keySecret.Name, keySecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		previousKey, previousKeyID, err := encryption.ParseKey([]byte(secret))
+		if err != nil {
+			return nil, fmt.Errorf("the previous encryption key in secret %s is invalid: %w", keySecret.Name, err)
+		}
+		previousKeys[previousKeyID] = previousKey
+	}
+	art.Encryption.KeyID = keyID
+	return &encryption.ArtifactDriver{Driver: driver, Key: key, KeyID: keyID, PreviousKeys: previousKeys}, nil
+}
+
+func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (common.ArtifactDriver, error) {
 	if art.S3 != nil {
 		var accessKey string
 		var secretKey string
@@ -79,7 +116,43 @@ func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		return &driver, nil
 	}
 	if art.HTTP != nil {
-		return &http.ArtifactDriver{}, nil
+		driver := http.ArtifactDriver{}
+		for _, header := range art.HTTP.Headers {
+			if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
+				value, err := ri.GetSecret(ctx, header.ValueFrom.SecretKeyRef.Name, header.ValueFrom.SecretKeyRef.Key)
+				if err != nil {
+					return nil, err
+				}
+				if driver.SecretHeaders == nil {
+					driver.SecretHeaders = map[string]string{}
+				}
+				driver.SecretHeaders[header.Name] = value
+			}
+		}
+		if auth := art.HTTP.Auth; auth != nil {
+			if auth.BasicAuth != nil && auth.BasicAuth.UsernameSecret != nil {
+				username, err := ri.GetSecret(ctx, auth.BasicAuth.UsernameSecret.Name, auth.BasicAuth.UsernameSecret.Key)
+				if err != nil {
+					return nil, err
+				}
+				driver.Username = username
+			}
+			if auth.BasicAuth != nil && auth.BasicAuth.PasswordSecret != nil {
+				password, err := ri.GetSecret(ctx, auth.BasicAuth.PasswordSecret.Name, auth.BasicAuth.PasswordSecret.Key)
+				if err != nil {
+					return nil, err
+				}
+				driver.Password = password
+			}
+			if auth.BearerTokenSecret != nil {
+				token, err := ri.GetSecret(ctx, auth.BearerTokenSecret.Name, auth.BearerTokenSecret.Key)
+				if err != nil {
+					return nil, err
+				}
+				driver.BearerToken = token
+			}
+		}
+		return &driver, nil
 	}
 	if art.Git != nil {
 		gitDriver := git.ArtifactDriver{
@@ -173,5 +246,44 @@ func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		return &driver, nil
 	}
 
+	if art.Azure != nil {
+		driver := azure.ArtifactDriver{UseWorkloadIdentity: art.Azure.UseWorkloadIdentity}
+		if art.Azure.AccountKeySecret != nil && art.Azure.AccountKeySecret.Name != "" {
+			accountKey, err := ri.GetSecret(ctx, art.Azure.AccountKeySecret.Name, art.Azure.AccountKeySecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.AccountKey = accountKey
+		}
+		if art.Azure.SASTokenSecret != nil && art.Azure.SASTokenSecret.Name != "" {
+			sasToken, err := ri.GetSecret(ctx, art.Azure.SASTokenSecret.Name, art.Azure.SASTokenSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.SASToken = sasToken
+		}
+		return &driver, nil
+	}
+
+	if art.OCI != nil {
+		driver := oci.ArtifactDriver{}
+		if art.OCI.DockerConfigSecret != nil && art.OCI.DockerConfigSecret.Name != "" {
+			dockerConfig, err := ri.GetSecret(ctx, art.OCI.DockerConfigSecret.Name, art.OCI.DockerConfigSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.DockerConfig = dockerConfig
+		}
+		return &driver, nil
+	}
+
+	if art.Plugin != nil {
+		driver, err := plugin.CreateDriver(ctx, ri, art.Plugin)
+		if err != nil {
+			return nil, err
+		}
+		return driver, nil
+	}
+
 	return nil, ErrUnsupportedDriver
 }
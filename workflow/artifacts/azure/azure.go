@@ -0,0 +1,299 @@
+package azure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/argoproj/pkg/file"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// ArtifactDriver is a driver for Azure Blob Storage
+type ArtifactDriver struct {
+	AccountKey          string
+	SASToken            string
+	UseWorkloadIdentity bool
+
+	// credential is the workload identity credential, which is shared by the clients so that it caches its token
+	credential azcore.TokenCredential
+}
+
+var (
+	_            common.ArtifactDriver   = &ArtifactDriver{}
+	_            common.ArtifactDigester = &ArtifactDriver{}
+	defaultRetry                         = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
+)
+
+func isTransientAzureErr(err error) bool {
+	var azureErr *azcore.ResponseError
+	if stderrors.As(err, &azureErr) {
+		return azureErr.StatusCode == http.StatusTooManyRequests || azureErr.StatusCode >= 500
+	}
+	var urlErr *url.Error
+	if stderrors.As(err, &urlErr) {
+		return urlErr.Timeout() || strings.Contains(urlErr.Error(), "connection refused") || strings.Contains(urlErr.Error(), "connection reset")
+	}
+	return stderrors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isNotFound(err error) bool {
+	var azureErr *azcore.ResponseError
+	return stderrors.As(err, &azureErr) && azureErr.StatusCode == http.StatusNotFound
+}
+
+func (d *ArtifactDriver) newClient(art *wfv1.AzureArtifact) (*client, error) {
+	if d.AccountKey == "" && d.SASToken == "" && d.UseWorkloadIdentity && d.credential == nil {
+		credential, err := newWorkloadIdentityCredential()
+		if err != nil {
+			return nil, err
+		}
+		d.credential = credential
+	}
+	return newClient(art.Endpoint, art.Container, d.AccountKey, d.SASToken, d.credential)
+}
+
+// Load downloads the blob, or all the blobs of the directory of the same name, from the container
+func (d *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("Azure Load path: %s, container: %s, blob: %s", path, inputArtifact.Azure.Container, inputArtifact.Azure.Blob)
+			c, err := d.newClient(inputArtifact.Azure)
+			if err != nil {
+				return true, err
+			}
+			err = downloadBlobs(context.Background(), c, inputArtifact.Azure.Blob, path)
+			if err != nil {
+				log.Warnf("Failed to download blobs from Azure: %v", err)
+				return !isTransientAzureErr(err), err
+			}
+			return true, nil
+		})
+}
+
+// download the blob of the name, or else the blobs of the directory of the name
+func downloadBlobs(ctx context.Context, c *client, name, path string) error {
+	err := downloadBlob(ctx, c, name, path)
+	if !isNotFound(err) {
+		return err
+	}
+	prefix := strings.TrimSuffix(name, "/") + "/"
+	blobs, err := c.listBlobs(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	if len(blobs) == 0 {
+		return errors.New(errors.CodeNotFound, fmt.Sprintf("no results for blob: %s", name))
+	}
+	for _, b := range blobs {
+		err := downloadBlob(ctx, c, b.Name, filepath.Join(path, filepath.FromSlash(strings.TrimPrefix(b.Name, prefix))))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// download a blob to a local file
+func downloadBlob(ctx context.Context, c *client, name, localPath string) error {
+	body, err := c.getBlob(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	if err := os.MkdirAll(filepath.Dir(localPath), 0o700); err != nil {
+		return fmt.Errorf("mkdir %s: %v", filepath.Dir(localPath), err)
+	}
+	out, err := os.Create(localPath)
+	if err != nil {
+		return fmt.Errorf("os create %s: %v", localPath, err)
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			log.Fatalf("Error closing file[%s]: %v", localPath, err)
+		}
+	}()
+	if _, err := io.Copy(out, body); err != nil {
+		return fmt.Errorf("io copy: %v", err)
+	}
+	return nil
+}
+
+// Save uploads a file as a blob, or the files of a directory as the blobs of the directory of the blob name
+func (d *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("Azure Save path: %s, container: %s, blob: %s", path, outputArtifact.Azure.Container, outputArtifact.Azure.Blob)
+			c, err := d.newClient(outputArtifact.Azure)
+			if err != nil {
+				return true, err
+			}
+			err = uploadBlobs(context.Background(), c, outputArtifact.Azure.Blob, path)
+			if err != nil {
+				log.Warnf("Failed to upload blobs to Azure: %v", err)
+				return !isTransientAzureErr(err), err
+			}
+			return true, nil
+		})
+}
+
+// upload a local file or the files of a local directory
+func uploadBlobs(ctx context.Context, c *client, name, path string) error {
+	isDir, err := file.IsDirectory(path)
+	if err != nil {
+		return fmt.Errorf("test if %s is a dir: %v", path, err)
+	}
+	if !isDir {
+		return c.putBlob(ctx, name, path)
+	}
+	prefix := strings.TrimSuffix(name, "/") + "/"
+	return filepath.Walk(path, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relPath, err := filepath.Rel(path, localPath)
+		if err != nil {
+			return err
+		}
+		if err := c.putBlob(ctx, prefix+filepath.ToSlash(relPath), localPath); err != nil {
+			return fmt.Errorf("upload %s: %w", localPath, err)
+		}
+		return nil
+	})
+}
+
+// ListObjects returns the names of the blobs prefixed with the blob name
+func (d *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var names []string
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("Azure List container: %s, blob: %s", artifact.Azure.Container, artifact.Azure.Blob)
+			c, err := d.newClient(artifact.Azure)
+			if err != nil {
+				return true, err
+			}
+			blobs, err := c.listBlobs(context.Background(), artifact.Azure.Blob)
+			if err != nil {
+				return !isTransientAzureErr(err), err
+			}
+			names = nil
+			for _, b := range blobs {
+				names = append(names, b.Name)
+			}
+			return true, nil
+		})
+	return names, err
+}
+
+// OpenStream opens a stream of the blob from the offset
+func (d *ArtifactDriver) OpenStream(artifact *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	var stream *common.ArtifactStream
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("Azure OpenStream container: %s, blob: %s, offset: %d", artifact.Azure.Container, artifact.Azure.Blob, offset)
+			c, err := d.newClient(artifact.Azure)
+			if err != nil {
+				return true, err
+			}
+			stream, err = c.openBlob(context.Background(), artifact.Azure.Blob, offset)
+			if isNotFound(err) {
+				return true, errors.New(errors.CodeNotFound, fmt.Sprintf("no results for blob: %s", artifact.Azure.Blob))
+			}
+			if err != nil {
+				return !isTransientAzureErr(err), err
+			}
+			return true, nil
+		})
+	return stream, err
+}
+
+// Digest returns the ETag of the blob, or a hash of the names and ETags of the blobs of the directory of the blob name
+func (d *ArtifactDriver) Digest(artifact *wfv1.Artifact) (string, error) {
+	var digest string
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			c, err := d.newClient(artifact.Azure)
+			if err != nil {
+				return true, err
+			}
+			digest, err = digestBlobs(context.Background(), c, artifact.Azure.Blob)
+			if err != nil {
+				return !isTransientAzureErr(err), err
+			}
+			return true, nil
+		})
+	return digest, err
+}
+
+func digestBlobs(ctx context.Context, c *client, name string) (string, error) {
+	etag, err := c.getBlobEtag(ctx, name)
+	if err == nil {
+		return "azure:" + strings.Trim(etag, `"`), nil
+	}
+	if !isNotFound(err) {
+		return "", err
+	}
+	prefix := strings.TrimSuffix(name, "/") + "/"
+	blobs, err := c.listBlobs(ctx, prefix)
+	if err != nil {
+		return "", err
+	}
+	if len(blobs) == 0 {
+		return "", errors.New(errors.CodeNotFound, fmt.Sprintf("no results for blob: %s", name))
+	}
+	h := sha256.New()
+	for _, b := range blobs {
+		_, _ = fmt.Fprintf(h, "%s:%s\n", strings.TrimPrefix(b.Name, prefix), b.Etag)
+	}
+	return "azure-dir:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Delete deletes the blob, and the blobs of the directory of the same name if there are any
+func (d *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("Azure Delete container: %s, blob: %s", artifact.Azure.Container, artifact.Azure.Blob)
+			c, err := d.newClient(artifact.Azure)
+			if err != nil {
+				return true, err
+			}
+			err = deleteBlobs(context.Background(), c, artifact.Azure.Blob)
+			if err != nil {
+				return !isTransientAzureErr(err), err
+			}
+			return true, nil
+		})
+}
+
+func deleteBlobs(ctx context.Context, c *client, name string) error {
+	blobs, err := c.listBlobs(ctx, strings.TrimSuffix(name, "/")+"/")
+	if err != nil {
+		return err
+	}
+	names := []string{name}
+	for _, b := range blobs {
+		names = append(names, b.Name)
+	}
+	for _, n := range names {
+		if err := c.deleteBlob(ctx, n); err != nil && !isNotFound(err) {
+			return fmt.Errorf("delete %s: %w", n, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,333 @@
+package azure
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoErrors "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// the well-known account and key of the Azurite emulator
+	testAccount    = "devstoreaccount1"
+	testAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	testContainer  = "my-container"
+)
+
+// fakeBlobService is a Blob service that keeps blobs in memory. It does not verify the signatures of requests, which are
+// tested against Azurite by the e2e tests.
+type fakeBlobService struct {
+	t        *testing.T
+	sasToken bool
+	mu       sync.Mutex
+	blobs    map[string][]byte
+	blocks   map[string][]byte
+	etags    map[string]int
+}
+
+func newFakeBlobService(t *testing.T, sasToken bool) (*fakeBlobService, *httptest.Server) {
+	s := &fakeBlobService{t: t, sasToken: sasToken, blobs: map[string][]byte{}, blocks: map[string][]byte{}, etags: map[string]int{}}
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	return s, server
+}
+
+func (s *fakeBlobService) writeError(w http.ResponseWriter, statusCode int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(statusCode)
+	_, _ = fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>%s
+RequestId:1</Message></Error>`, code, code)
+}
+
+func (s *fakeBlobService) authorized(r *http.Request) bool {
+	if s.sasToken {
+		return r.URL.Query().Get("sig") == "secret" && r.Header.Get("Authorization") == ""
+	}
+	return strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey "+testAccount+":")
+}
+
+func (s *fakeBlobService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.Header.Get("x-ms-version") == "" || !s.authorized(r) {
+		s.writeError(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != testAccount || parts[1] != testContainer {
+		s.writeError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+	query := r.URL.Query()
+	if len(parts) == 2 {
+		if r.Method != http.MethodGet || query.Get("restype") != "container" || query.Get("comp") != "list" {
+			s.writeError(w, http.StatusBadRequest, "InvalidQueryParameterValue")
+			return
+		}
+		s.list(w, query.Get("prefix"), query.Get("marker"))
+		return
+	}
+	name := parts[2]
+	switch r.Method {
+	case http.MethodPut:
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(s.t, err)
+		switch query.Get("comp") {
+		case "block":
+			s.blocks[name+"/"+query.Get("blockid")] = data
+		case "blocklist":
+			var blockList struct {
+				Latest []string `xml:"Latest"`
+			}
+			require.NoError(s.t, xml.Unmarshal(data, &blockList))
+			var content []byte
+			for _, id := range blockList.Latest {
+				content = append(content, s.blocks[name+"/"+id]...)
+			}
+			s.put(name, content)
+		default:
+			assert.Equal(s.t, "BlockBlob", r.Header.Get("x-ms-blob-type"))
+			s.put(name, data)
+		}
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodGet, http.MethodHead:
+		data, ok := s.blobs[name]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.Header().Set("ETag", fmt.Sprintf(`"0x%d"`, s.etags[name]))
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if blobRange := r.Header.Get("x-ms-range"); blobRange != "" {
+			var start int
+			_, err := fmt.Sscanf(blobRange, "bytes=%d-", &start)
+			require.NoError(s.t, err)
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(data)-1, len(data)))
+			w.WriteHeader(http.StatusPartialContent)
+			data = data[start:]
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		if _, ok := s.blobs[name]; !ok {
+			s.writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(s.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func (s *fakeBlobService) put(name string, data []byte) {
+	s.blobs[name] = data
+	s.etags[name]++
+}
+
+// list lists the blobs a page of two at a time, so that paging is tested
+func (s *fakeBlobService) list(w http.ResponseWriter, prefix, marker string) {
+	var names []string
+	for name := range s.blobs {
+		if strings.HasPrefix(name, prefix) && name >= marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	nextMarker := ""
+	if len(names) > 2 {
+		nextMarker = names[2]
+		names = names[:2]
+	}
+	_, _ = fmt.Fprint(w, `<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+	for _, name := range names {
+		_, _ = fmt.Fprintf(w, `<Blob><Name>%s</Name><Properties><Etag>0x%d</Etag><Content-Length>%d</Content-Length></Properties></Blob>`, name, s.etags[name], len(s.blobs[name]))
+	}
+	_, _ = fmt.Fprintf(w, `</Blobs><NextMarker>%s</NextMarker></EnumerationResults>`, nextMarker)
+}
+
+func newArtifact(endpoint, blob string) *wfv1.Artifact {
+	return &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Azure: &wfv1.AzureArtifact{
+		AzureBlobContainer: wfv1.AzureBlobContainer{Endpoint: endpoint, Container: testContainer},
+		Blob:               blob,
+	}}}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0o600))
+}
+
+func readFile(t *testing.T, path string) string {
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestArtifactDriver(t *testing.T) {
+	s, server := newFakeBlobService(t, false)
+	endpoint := server.URL + "/" + testAccount
+	driver := &ArtifactDriver{AccountKey: testAccountKey}
+	tmp := t.TempDir()
+
+	t.Run("File", func(t *testing.T) {
+		writeFile(t, filepath.Join(tmp, "file.txt"), "hello")
+		art := newArtifact(endpoint, "my-wf/file.txt")
+		require.NoError(t, driver.Save(filepath.Join(tmp, "file.txt"), art))
+		assert.Equal(t, "hello", string(s.blobs["my-wf/file.txt"]))
+
+		require.NoError(t, driver.Load(art, filepath.Join(tmp, "loaded.txt")))
+		assert.Equal(t, "hello", readFile(t, filepath.Join(tmp, "loaded.txt")))
+
+		digest, err := driver.Digest(art)
+		require.NoError(t, err)
+		assert.Equal(t, "azure:0x1", digest)
+	})
+
+	t.Run("OpenStream", func(t *testing.T) {
+		art := newArtifact(endpoint, "my-wf/file.txt")
+		for offset, content := range map[int64]string{0: "hello", 2: "llo"} {
+			stream, err := driver.OpenStream(art, offset)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(stream)
+			require.NoError(t, err)
+			assert.NoError(t, stream.Close())
+			assert.Equal(t, content, string(data))
+			assert.Equal(t, int64(5), stream.Size)
+			assert.Equal(t, "application/octet-stream", stream.ContentType)
+		}
+		_, err := driver.OpenStream(newArtifact(endpoint, "my-wf/missing"), 0)
+		assert.True(t, argoErrors.IsCode(argoErrors.CodeNotFound, err))
+	})
+
+	t.Run("EmptyFile", func(t *testing.T) {
+		writeFile(t, filepath.Join(tmp, "empty.txt"), "")
+		art := newArtifact(endpoint, "my-wf/empty.txt")
+		require.NoError(t, driver.Save(filepath.Join(tmp, "empty.txt"), art))
+		require.NoError(t, driver.Load(art, filepath.Join(tmp, "empty-loaded.txt")))
+		assert.Equal(t, "", readFile(t, filepath.Join(tmp, "empty-loaded.txt")))
+	})
+
+	t.Run("Dir", func(t *testing.T) {
+		for _, name := range []string{"a.txt", "b/c.txt", "b/d.txt"} {
+			writeFile(t, filepath.Join(tmp, "dir", name), name)
+		}
+		art := newArtifact(endpoint, "my-wf/dir")
+		require.NoError(t, driver.Save(filepath.Join(tmp, "dir"), art))
+
+		names, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-wf/dir/a.txt", "my-wf/dir/b/c.txt", "my-wf/dir/b/d.txt"}, names)
+
+		require.NoError(t, driver.Load(art, filepath.Join(tmp, "dir-loaded")))
+		for _, name := range []string{"a.txt", "b/c.txt", "b/d.txt"} {
+			assert.Equal(t, name, readFile(t, filepath.Join(tmp, "dir-loaded", name)))
+		}
+
+		digest, err := driver.Digest(art)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(digest, "azure-dir:"))
+		writeFile(t, filepath.Join(tmp, "dir", "a.txt"), "changed")
+		require.NoError(t, driver.Save(filepath.Join(tmp, "dir"), art))
+		changed, err := driver.Digest(art)
+		require.NoError(t, err)
+		assert.NotEqual(t, digest, changed)
+
+		require.NoError(t, driver.Delete(art))
+		names, err = driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.Empty(t, names)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		art := newArtifact(endpoint, "my-wf/missing")
+		err := driver.Load(art, filepath.Join(tmp, "missing"))
+		assert.True(t, argoErrors.IsCode(argoErrors.CodeNotFound, err))
+		_, err = driver.Digest(art)
+		assert.True(t, argoErrors.IsCode(argoErrors.CodeNotFound, err))
+		assert.NoError(t, driver.Delete(art))
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		err := (&ArtifactDriver{}).Load(newArtifact(endpoint, "my-wf/file.txt"), filepath.Join(tmp, "anonymous"))
+		var azureErr *azcore.ResponseError
+		if assert.ErrorAs(t, err, &azureErr) {
+			assert.Equal(t, http.StatusForbidden, azureErr.StatusCode)
+			assert.Equal(t, "AuthenticationFailed", azureErr.ErrorCode)
+		}
+	})
+}
+
+func TestArtifactDriver_SASToken(t *testing.T) {
+	s, server := newFakeBlobService(t, true)
+	endpoint := server.URL + "/" + testAccount
+	driver := &ArtifactDriver{SASToken: "?sv=2019-12-12&sig=secret"}
+	tmp := t.TempDir()
+	writeFile(t, filepath.Join(tmp, "file.txt"), "hello")
+	art := newArtifact(endpoint, "my-wf/file.txt")
+	require.NoError(t, driver.Save(filepath.Join(tmp, "file.txt"), art))
+	assert.Equal(t, "hello", string(s.blobs["my-wf/file.txt"]))
+	names, err := driver.ListObjects(art)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-wf/file.txt"}, names)
+}
+
+func TestAccountName(t *testing.T) {
+	for endpoint, account := range map[string]string{
+		"https://myaccount.blob.core.windows.net":    "myaccount",
+		"https://myaccount.blob.core.windows.net/":   "myaccount",
+		"http://127.0.0.1:10000/devstoreaccount1":    "devstoreaccount1",
+		"http://azurite:10000/devstoreaccount1/":     "devstoreaccount1",
+		"https://myaccount.privatelink.example.com/": "myaccount",
+	} {
+		u, err := url.Parse(endpoint)
+		require.NoError(t, err)
+		assert.Equal(t, account, accountName(u), endpoint)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := newClient("https://myaccount.blob.core.windows.net/", testContainer, testAccountKey, "", nil)
+	assert.NoError(t, err)
+	_, err = newClient("myaccount.blob.core.windows.net", testContainer, "", "", nil)
+	assert.Error(t, err)
+	_, err = newClient("https://myaccount.blob.core.windows.net", "", "", "", nil)
+	assert.Error(t, err)
+	_, err = newClient("https://myaccount.blob.core.windows.net", testContainer, "not base64", "", nil)
+	assert.EqualError(t, err, "invalid account key: decode account key: illegal base64 data at input byte 3")
+}
+
+func TestIsTransientAzureErr(t *testing.T) {
+	for _, test := range []struct {
+		err         error
+		shouldretry bool
+	}{
+		{&azcore.ResponseError{StatusCode: http.StatusNotFound}, false},
+		{&azcore.ResponseError{StatusCode: http.StatusForbidden}, false},
+		{argoErrors.New(argoErrors.CodeNotFound, "no results for blob: foo/bar"), false},
+		{&azcore.ResponseError{StatusCode: http.StatusTooManyRequests}, true},
+		{&azcore.ResponseError{StatusCode: http.StatusServiceUnavailable}, true},
+		{&url.Error{Op: "blah", URL: "blah", Err: fmt.Errorf("connection refused")}, true},
+		{io.ErrUnexpectedEOF, true},
+		{fmt.Errorf("Test unwrapping of a temporary error: %w", &azcore.ResponseError{StatusCode: 500}), true},
+		{fmt.Errorf("Test unwrapping of a non-retriable error: %w", &azcore.ResponseError{StatusCode: 400}), false},
+	} {
+		got := isTransientAzureErr(test.err)
+		if got != test.shouldretry {
+			t.Errorf("%+v: got %v, want %v", test, got, test.shouldretry)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// httpClient times out connections, and requests whose response the service does not start to send in time. Blobs may
+// take long to transfer, so requests have no overall timeout.
+var httpClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		ForceAttemptHTTP2:     true,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 5 * time.Minute,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	},
+}
+
+// clientOptions are the options of the clients of the SDK. Failed requests are retried by the driver, with its backoff,
+// rather than by the SDK.
+func clientOptions() azcore.ClientOptions {
+	return azcore.ClientOptions{Transport: httpClient, Retry: policy.RetryOptions{MaxRetries: -1}}
+}
+
+// blobItem is an item of a list of blobs
+type blobItem struct {
+	Name string
+	Etag string
+}
+
+// client is a client of the Blob service for a container
+type client struct {
+	container *container.Client
+}
+
+// newClient returns a client of the container, authorized with the account key, the SAS token or the token credential,
+// in that order of precedence, or anonymous if none are set
+func newClient(endpoint, containerName, accountKey, sasToken string, credential azcore.TokenCredential) (*client, error) {
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: must be a URL such as https://<account>.blob.core.windows.net", endpoint)
+	}
+	if containerName == "" {
+		return nil, fmt.Errorf("container is required")
+	}
+	account := accountName(u)
+	u.Path = path.Join(u.Path, containerName)
+	u.RawPath = ""
+	options := &container.ClientOptions{ClientOptions: clientOptions()}
+	var c *container.Client
+	switch {
+	case accountKey != "":
+		var cred *container.SharedKeyCredential
+		cred, err = container.NewSharedKeyCredential(account, accountKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account key: %w", err)
+		}
+		c, err = container.NewClientWithSharedKeyCredential(u.String(), cred, options)
+	case sasToken != "":
+		u.RawQuery = strings.TrimPrefix(sasToken, "?")
+		c, err = container.NewClientWithNoCredential(u.String(), options)
+	case credential != nil:
+		c, err = container.NewClient(u.String(), credential, options)
+	default:
+		c, err = container.NewClientWithNoCredential(u.String(), options)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &client{container: c}, nil
+}
+
+// accountName returns the name of the storage account of an endpoint: the first element of the path for path-style
+// endpoints such as Azurite's, else the first label of the host
+func accountName(u *url.URL) string {
+	if p := strings.Trim(u.Path, "/"); p != "" {
+		return strings.SplitN(p, "/", 2)[0]
+	}
+	return strings.SplitN(u.Hostname(), ".", 2)[0]
+}
+
+// newWorkloadIdentityCredential returns a credential that exchanges the federated token of the pod's service account
+// for an Azure AD token, see https://azure.github.io/azure-workload-identity/docs/
+func newWorkloadIdentityCredential() (azcore.TokenCredential, error) {
+	clientID, tenantID, tokenFile := os.Getenv("AZURE_CLIENT_ID"), os.Getenv("AZURE_TENANT_ID"), os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+	if clientID == "" || tenantID == "" || tokenFile == "" {
+		return nil, fmt.Errorf("workload identity requires AZURE_CLIENT_ID, AZURE_TENANT_ID and AZURE_FEDERATED_TOKEN_FILE to be set")
+	}
+	// the token file is read for each exchange, as it is rotated
+	getAssertion := func(context.Context) (string, error) {
+		assertion, err := ioutil.ReadFile(tokenFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read federated token: %w", err)
+		}
+		return strings.TrimSpace(string(assertion)), nil
+	}
+	return azidentity.NewClientAssertionCredential(tenantID, clientID, getAssertion, &azidentity.ClientAssertionCredentialOptions{ClientOptions: clientOptions()})
+}
+
+// getBlob returns the content of a blob
+func (c *client) getBlob(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := c.container.NewBlobClient(name).DownloadStream(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// openBlob returns a stream of the content of a blob from the offset
+func (c *client) openBlob(ctx context.Context, name string, offset int64) (*common.ArtifactStream, error) {
+	resp, err := c.container.NewBlobClient(name).DownloadStream(ctx, &blob.DownloadStreamOptions{Range: blob.HTTPRange{Offset: offset}})
+	if err != nil {
+		return nil, err
+	}
+	stream := &common.ArtifactStream{ReadCloser: resp.Body, Size: -1}
+	if resp.ContentLength != nil {
+		stream.Size = *resp.ContentLength
+	}
+	if resp.ContentType != nil {
+		stream.ContentType = *resp.ContentType
+	}
+	if resp.ContentRange != nil {
+		// bytes <start>-<end>/<size>
+		contentRange := *resp.ContentRange
+		stream.Size, err = strconv.ParseInt(contentRange[strings.LastIndex(contentRange, "/")+1:], 10, 64)
+		if err != nil {
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("invalid content range %q: %w", contentRange, err)
+		}
+	}
+	return stream, nil
+}
+
+// getBlobEtag returns the ETag of a blob
+func (c *client) getBlobEtag(ctx context.Context, name string) (string, error) {
+	resp, err := c.container.NewBlobClient(name).GetProperties(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	if resp.ETag == nil {
+		return "", nil
+	}
+	return string(*resp.ETag), nil
+}
+
+// putBlob uploads a file as a block blob, which the SDK uploads in blocks if it is too large for a single request
+func (c *client) putBlob(ctx context.Context, name string, localPath string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = c.container.NewBlockBlobClient(name).UploadFile(ctx, f, nil)
+	return err
+}
+
+// listBlobs returns all the blobs whose names start with the prefix, in lexical order
+func (c *client) listBlobs(ctx context.Context, prefix string) ([]blobItem, error) {
+	var blobs []blobItem
+	pager := c.container.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Segment.BlobItems {
+			b := blobItem{Name: *item.Name}
+			if item.Properties != nil && item.Properties.ETag != nil {
+				b.Etag = string(*item.Properties.ETag)
+			}
+			blobs = append(blobs, b)
+		}
+	}
+	return blobs, nil
+}
+
+// deleteBlob deletes a blob and its snapshots
+func (c *client) deleteBlob(ctx context.Context, name string) error {
+	include := blob.DeleteSnapshotsOptionTypeInclude
+	_, err := c.container.NewBlobClient(name).Delete(ctx, &blob.DeleteOptions{DeleteSnapshots: &include})
+	return err
+}
@@ -0,0 +1,121 @@
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// Checksum returns the checksum and size of the content of an artifact at a path. The checksum of a file is the
+// SHA-256 of the file, so it can be checked with sha256sum, and the checksum of a directory is the SHA-256 of the
+// relative paths and contents of its files.
+func Checksum(path string) (string, int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", 0, err
+	}
+	if info.IsDir() {
+		digest, err := hashPath(path)
+		if err != nil {
+			return "", 0, err
+		}
+		size, err := dirSize(path)
+		if err != nil {
+			return "", 0, err
+		}
+		return "sha256:" + digest, size, nil
+	}
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), size, nil
+}
+
+// VerifyChecksum verifies the content of an artifact at a path against the checksum of the artifact. An artifact
+// without a checksum is only an error if it must be verified.
+func VerifyChecksum(art *wfv1.Artifact, path string) error {
+	if art.Checksum == "" {
+		if art.VerifyChecksum {
+			return errors.Errorf(errors.CodeBadRequest, "artifact %s has no checksum to verify", art.Name)
+		}
+		return nil
+	}
+	checksum, size, err := Checksum(path)
+	if err != nil {
+		return err
+	}
+	if checksum != art.Checksum {
+		return errors.Errorf(errors.CodeBadRequest, "artifact %s checksum mismatch: expected %s, got %s", art.Name, art.Checksum, checksum)
+	}
+	if art.SizeBytes != 0 && size != art.SizeBytes {
+		return errors.Errorf(errors.CodeBadRequest, "artifact %s size mismatch: expected %d bytes, got %d bytes", art.Name, art.SizeBytes, size)
+	}
+	return nil
+}
+
+// dirSize returns the total size of the files of a directory
+func dirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the size of %s: %w", path, err)
+	}
+	return size, nil
+}
+
+// hashPath returns the SHA-256 of a file, or of the relative paths and contents of the files of a directory, ignoring
+// the metadata of git repositories, which differs between clones of the same commit
+func hashPath(path string) (string, error) {
+	h := sha256.New()
+	err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(h, "%s:%d\n", filepath.ToSlash(rel), info.Size())
+		f, err := os.Open(filepath.Clean(p))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
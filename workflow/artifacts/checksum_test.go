@@ -0,0 +1,66 @@
+package executor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestChecksum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checksum")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	file := filepath.Join(dir, "a")
+	assert.NoError(t, ioutil.WriteFile(file, []byte("foo"), 0o600))
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "b"), 0o700))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b", "c"), []byte("bar!"), 0o600))
+
+	t.Run("File", func(t *testing.T) {
+		checksum, size, err := Checksum(file)
+		assert.NoError(t, err)
+		assert.Equal(t, "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", checksum, "the same as sha256sum")
+		assert.Equal(t, int64(3), size)
+	})
+	t.Run("Directory", func(t *testing.T) {
+		checksum, size, err := Checksum(dir)
+		assert.NoError(t, err)
+		assert.Regexp(t, "^sha256:[0-9a-f]{64}$", checksum)
+		assert.Equal(t, int64(7), size)
+	})
+	t.Run("NotExist", func(t *testing.T) {
+		_, _, err := Checksum(filepath.Join(dir, "d"))
+		assert.Error(t, err)
+	})
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checksum")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	file := filepath.Join(dir, "a")
+	assert.NoError(t, ioutil.WriteFile(file, []byte("foo"), 0o600))
+	checksum := "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+	t.Run("NoChecksum", func(t *testing.T) {
+		assert.NoError(t, VerifyChecksum(&wfv1.Artifact{Name: "my-art"}, file))
+	})
+	t.Run("NoChecksumVerified", func(t *testing.T) {
+		assert.EqualError(t, VerifyChecksum(&wfv1.Artifact{Name: "my-art", VerifyChecksum: true}, file), "artifact my-art has no checksum to verify")
+	})
+	t.Run("Match", func(t *testing.T) {
+		assert.NoError(t, VerifyChecksum(&wfv1.Artifact{Name: "my-art", Checksum: checksum, SizeBytes: 3}, file))
+	})
+	t.Run("Mismatch", func(t *testing.T) {
+		err := VerifyChecksum(&wfv1.Artifact{Name: "my-art", Checksum: "sha256:0000"}, file)
+		assert.EqualError(t, err, "artifact my-art checksum mismatch: expected sha256:0000, got "+checksum)
+	})
+	t.Run("SizeMismatch", func(t *testing.T) {
+		err := VerifyChecksum(&wfv1.Artifact{Name: "my-art", Checksum: checksum, SizeBytes: 4}, file)
+		assert.EqualError(t, err, "artifact my-art size mismatch: expected 4 bytes, got 3 bytes")
+	})
+}
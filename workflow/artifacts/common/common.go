@@ -1,6 +1,28 @@
 package common
 
-import "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+import (
+	"errors"
+	"io"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// ErrDeleteNotSupported is returned by the drivers of the artifacts that cannot be deleted, e.g. because they are not
+// stored by Argo
+var ErrDeleteNotSupported = errors.New("delete is not supported for this artifact type")
+
+// ErrOpenStreamNotSupported is returned by the drivers that cannot stream the content of an artifact, whose artifacts
+// must be loaded to a local file instead
+var ErrOpenStreamNotSupported = errors.New("streaming is not supported for this artifact type")
+
+// ArtifactStream is a stream of the content of an artifact from an offset
+type ArtifactStream struct {
+	io.ReadCloser
+	// Size is the size of the whole content of the artifact, not only of the part after the offset
+	Size int64
+	// ContentType is the media type of the content, or empty if the storage does not know it
+	ContentType string
+}
 
 // ArtifactDriver is the interface for loading and saving of artifacts
 type ArtifactDriver interface {
@@ -11,4 +33,27 @@ type ArtifactDriver interface {
 	Save(path string, outputArtifact *v1alpha1.Artifact) error
 
 	ListObjects(artifact *v1alpha1.Artifact) ([]string, error)
+
+	// Delete deletes the artifact, or all the objects of it if it is a directory, from the artifact destination. It is
+	// not an error if the artifact does not exist.
+	Delete(artifact *v1alpha1.Artifact) error
+
+	// OpenStream opens a stream of the content of the artifact, from the offset to its end, without downloading it to
+	// a file. The artifact must be a single file.
+	OpenStream(artifact *v1alpha1.Artifact, offset int64) (*ArtifactStream, error)
+}
+
+// ArtifactDigester is implemented by the artifact drivers that can read a digest of the content of an artifact from the
+// metadata of the storage, without downloading it
+type ArtifactDigester interface {
+	// Digest returns a string that changes when the content of the artifact changes, or an empty string if the storage
+	// has no such metadata for the artifact
+	Digest(artifact *v1alpha1.Artifact) (string, error)
+}
+
+// ArtifactLoadReporter is implemented by the artifact drivers that report a message about the artifact they last
+// loaded, such as the statistics of a clone, which is added to the message of the node
+type ArtifactLoadReporter interface {
+	// LoadMessage returns the message about the artifact last loaded, or an empty string if there is none
+	LoadMessage() string
 }
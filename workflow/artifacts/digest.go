@@ -0,0 +1,25 @@
+package executor
+
+import (
+	"errors"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// ErrNoDigest is returned for the artifacts whose storage has no digest of their content in its metadata
+var ErrNoDigest = errors.New("the storage of the artifact has no digest of its content")
+
+// Digest returns a digest of the content of the artifact, read from the metadata of the storage. The artifact is never
+// downloaded, as digests are taken by the controller, so ErrNoDigest is returned if the storage has no digest.
+func Digest(driver common.ArtifactDriver, art *wfv1.Artifact) (string, error) {
+	digester, ok := driver.(common.ArtifactDigester)
+	if !ok {
+		return "", ErrNoDigest
+	}
+	digest, err := digester.Digest(art)
+	if err == nil && digest == "" {
+		return "", ErrNoDigest
+	}
+	return digest, err
+}
@@ -0,0 +1,59 @@
+package executor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/git"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/raw"
+)
+
+// dirDriver loads a directory of files, and cannot read digests from the storage
+type dirDriver struct {
+	files map[string]string
+}
+
+func (d *dirDriver) Load(_ *wfv1.Artifact, path string) error {
+	for name, content := range d.files {
+		p := filepath.Join(path, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *dirDriver) Save(string, *wfv1.Artifact) error { return nil }
+
+func (d *dirDriver) ListObjects(*wfv1.Artifact) ([]string, error) { return nil, nil }
+
+func (d *dirDriver) Delete(*wfv1.Artifact) error { return nil }
+
+func (d *dirDriver) OpenStream(*wfv1.Artifact, int64) (*common.ArtifactStream, error) {
+	return nil, common.ErrOpenStreamNotSupported
+}
+
+func TestDigest(t *testing.T) {
+	t.Run("Storage", func(t *testing.T) {
+		digest, err := Digest(&raw.ArtifactDriver{}, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "foo"}}})
+		assert.NoError(t, err)
+		assert.Equal(t, "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", digest)
+	})
+	t.Run("NoDigest", func(t *testing.T) {
+		_, err := Digest(&dirDriver{map[string]string{"a": "foo"}}, &wfv1.Artifact{})
+		assert.Equal(t, ErrNoDigest, err)
+	})
+	t.Run("EmptyDigest", func(t *testing.T) {
+		_, err := Digest(&git.ArtifactDriver{}, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{Repo: "https://github.com/argoproj/argo-workflows.git", Fetch: []string{"refs/meta/*"}}}})
+		assert.Equal(t, ErrNoDigest, err)
+	})
+}
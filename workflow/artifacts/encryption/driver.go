@@ -0,0 +1,117 @@
+package encryption
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// ArtifactDriver encrypts the artifacts that the driver of their storage saves, and decrypts the artifacts it loads
+type ArtifactDriver struct {
+	Driver common.ArtifactDriver
+	// Key encrypts the artifacts that are saved, and decrypts those that were encrypted with it
+	Key   []byte
+	KeyID string
+	// PreviousKeys decrypt the artifacts that were encrypted before the key was rotated
+	PreviousKeys Keys
+}
+
+var _ common.ArtifactDriver = &ArtifactDriver{}
+
+func (d *ArtifactDriver) encrypt(r io.Reader, w io.Writer) error {
+	return Encrypt(r, w, d.Key, d.KeyID)
+}
+
+// keys returns the keys that decrypt artifacts, which are the key and the previous keys
+func (d *ArtifactDriver) keys() Keys {
+	keys := Keys{d.KeyID: d.Key}
+	for keyID, key := range d.PreviousKeys {
+		if _, ok := keys[keyID]; !ok {
+			keys[keyID] = key
+		}
+	}
+	return keys
+}
+
+func (d *ArtifactDriver) decrypt(r io.Reader, w io.Writer) error {
+	return Decrypt(r, w, d.keys())
+}
+
+// Load loads the encrypted artifact to a temporary path, and decrypts it, or each of its files, to the path
+func (d *ArtifactDriver) Load(art *wfv1.Artifact, path string) error {
+	tmp, err := ioutil.TempDir("", "encrypted")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	encryptedPath := filepath.Join(tmp, "artifact")
+	if err := d.Driver.Load(art, encryptedPath); err != nil {
+		return err
+	}
+	return transformPath(encryptedPath, path, d.decrypt)
+}
+
+// Save encrypts the path, or each of its files, to a temporary path, and saves it
+func (d *ArtifactDriver) Save(path string, art *wfv1.Artifact) error {
+	tmp, err := ioutil.TempDir("", "encrypted")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	// keep the name, which some drivers use, e.g. for the content type
+	encryptedPath := filepath.Join(tmp, filepath.Base(path))
+	if err := transformPath(path, encryptedPath, d.encrypt); err != nil {
+		return err
+	}
+	return d.Driver.Save(encryptedPath, art)
+}
+
+func (d *ArtifactDriver) ListObjects(art *wfv1.Artifact) ([]string, error) {
+	return d.Driver.ListObjects(art)
+}
+
+func (d *ArtifactDriver) Delete(art *wfv1.Artifact) error {
+	return d.Driver.Delete(art)
+}
+
+// OpenStream opens a stream of the encrypted artifact from the chunk of the offset, and decrypts it
+func (d *ArtifactDriver) OpenStream(art *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	stream, err := d.Driver.OpenStream(art, 0)
+	if err != nil {
+		return nil, err
+	}
+	h, err := readHeader(stream, d.keys())
+	if err != nil {
+		_ = stream.Close()
+		return nil, err
+	}
+	size := plainSize(stream.Size, h.size)
+	index := offset / chunkSize
+	if index > 0 {
+		_ = stream.Close()
+		stream, err = d.Driver.OpenStream(art, h.size+index*sealedSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+	decrypter, err := newDecrypter(stream, h.dataKey, uint64(index))
+	if err != nil {
+		_ = stream.Close()
+		return nil, err
+	}
+	if _, err := io.CopyN(ioutil.Discard, decrypter, offset%chunkSize); err != nil {
+		_ = stream.Close()
+		return nil, err
+	}
+	return &common.ArtifactStream{
+		ReadCloser: struct {
+			io.Reader
+			io.Closer
+		}{decrypter, stream},
+		Size: size,
+	}, nil
+}
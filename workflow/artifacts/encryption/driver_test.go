@@ -0,0 +1,150 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// fileDriver saves artifacts to the path of their raw location under a directory
+type fileDriver struct {
+	dir string
+}
+
+func (d *fileDriver) path(art *wfv1.Artifact) string {
+	return filepath.Join(d.dir, art.Raw.Data)
+}
+
+func (d *fileDriver) Load(art *wfv1.Artifact, path string) error {
+	return transformPath(d.path(art), path, copyData)
+}
+
+func (d *fileDriver) Save(path string, art *wfv1.Artifact) error {
+	if err := os.MkdirAll(d.dir, 0o700); err != nil {
+		return err
+	}
+	return transformPath(path, d.path(art), copyData)
+}
+
+func (d *fileDriver) ListObjects(*wfv1.Artifact) ([]string, error) {
+	return nil, nil
+}
+
+func (d *fileDriver) Delete(art *wfv1.Artifact) error {
+	return os.RemoveAll(d.path(art))
+}
+
+func (d *fileDriver) OpenStream(art *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	f, err := os.Open(d.path(art))
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return &common.ArtifactStream{ReadCloser: f, Size: info.Size()}, nil
+}
+
+func copyData(r io.Reader, w io.Writer) error {
+	_, err := io.Copy(w, r)
+	return err
+}
+
+func newTestDriver(t *testing.T) (*ArtifactDriver, string) {
+	dir, err := ioutil.TempDir("", "encryption")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	key, keyID := testKey(t)
+	return &ArtifactDriver{Driver: &fileDriver{dir: filepath.Join(dir, "storage")}, Key: key, KeyID: keyID}, dir
+}
+
+func TestArtifactDriver(t *testing.T) {
+	driver, dir := newTestDriver(t)
+	art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "art"}}}
+	plain := make([]byte, 2*chunkSize+10)
+	_, err := rand.Read(plain)
+	require.NoError(t, err)
+
+	t.Run("File", func(t *testing.T) {
+		src := filepath.Join(dir, "file.txt")
+		require.NoError(t, ioutil.WriteFile(src, plain, 0o600))
+		require.NoError(t, driver.Save(src, art))
+		stored, err := ioutil.ReadFile(filepath.Join(dir, "storage", "art"))
+		require.NoError(t, err)
+		assert.False(t, bytes.Contains(stored, plain[:chunkSize]), "the stored artifact is encrypted")
+		dst := filepath.Join(dir, "loaded.txt")
+		require.NoError(t, driver.Load(art, dst))
+		loaded, err := ioutil.ReadFile(dst)
+		require.NoError(t, err)
+		assert.Equal(t, plain, loaded)
+	})
+	t.Run("OpenStream", func(t *testing.T) {
+		for _, offset := range []int64{0, 5, chunkSize, chunkSize + 3, int64(len(plain)) - 1, int64(len(plain))} {
+			stream, err := driver.OpenStream(art, offset)
+			require.NoError(t, err, "offset %d", offset)
+			assert.Equal(t, int64(len(plain)), stream.Size)
+			data, err := ioutil.ReadAll(stream)
+			assert.NoError(t, err, "offset %d", offset)
+			assert.Equal(t, plain[offset:], data, "offset %d", offset)
+			assert.NoError(t, stream.Close())
+		}
+	})
+	t.Run("Directory", func(t *testing.T) {
+		src := filepath.Join(dir, "in")
+		require.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o700))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("foo"), 0o600))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(src, "sub", "b.txt"), plain, 0o600))
+		dirArt := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "dir"}}}
+		require.NoError(t, driver.Save(src, dirArt))
+		dst := filepath.Join(dir, "out")
+		require.NoError(t, driver.Load(dirArt, dst))
+		a, err := ioutil.ReadFile(filepath.Join(dst, "a.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "foo", string(a))
+		b, err := ioutil.ReadFile(filepath.Join(dst, "sub", "b.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, plain, b)
+	})
+	t.Run("OtherKey", func(t *testing.T) {
+		other, _ := newTestDriver(t)
+		other.Driver = driver.Driver
+		err := other.Load(art, filepath.Join(dir, "other.txt"))
+		assert.Contains(t, err.Error(), "the artifact was encrypted with key "+driver.KeyID)
+	})
+	t.Run("RotatedKey", func(t *testing.T) {
+		rotated, _ := newTestDriver(t)
+		rotated.Driver = driver.Driver
+		rotated.PreviousKeys = Keys{driver.KeyID: driver.Key}
+		dst := filepath.Join(dir, "rotated.txt")
+		require.NoError(t, rotated.Load(art, dst))
+		loaded, err := ioutil.ReadFile(dst)
+		require.NoError(t, err)
+		assert.Equal(t, plain, loaded)
+		stream, err := rotated.OpenStream(art, chunkSize)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, plain[chunkSize:], data)
+		assert.NoError(t, stream.Close())
+
+		// artifacts saved after the rotation are encrypted with the new key
+		art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "rotated"}}}
+		require.NoError(t, rotated.Save(dst, art))
+		err = driver.Load(art, filepath.Join(dir, "old.txt"))
+		assert.Contains(t, err.Error(), "the artifact was encrypted with key "+rotated.KeyID)
+	})
+}
@@ -0,0 +1,262 @@
+// Package encryption encrypts artifacts in the executor, before they are saved by the driver of their storage.
+//
+// An encrypted artifact is a header followed by the chunks of its content. The header is:
+//
+//	"argoenc1" | the length of the key ID (1 byte) | the key ID | nonce (12 bytes) | the wrapped data key (48 bytes)
+//
+// The data key is a random AES-256 key, wrapped with AES-256-GCM by the key from the secret, with the magic and key ID
+// as additional data. Each chunk is up to 64 KiB of the content, sealed with AES-256-GCM by the data key. The nonce of
+// a chunk is its index as 11 big endian bytes, followed by 1 for the last chunk or 0 for the others, so chunks cannot
+// be reordered or truncated. The last chunk is shorter than a full chunk, and may be empty.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	magic      = "argoenc1"
+	keySize    = 32
+	nonceSize  = 12
+	tagSize    = 16
+	chunkSize  = 64 * 1024
+	sealedSize = chunkSize + tagSize
+	// wrappedKeySize is the size of a data key sealed by the key
+	wrappedKeySize = keySize + tagSize
+)
+
+// ParseKey parses the key from a secret, which is 32 bytes either raw or base64 encoded, and returns it with its ID
+func ParseKey(secret []byte) ([]byte, string, error) {
+	key := secret
+	if len(key) != keySize {
+		decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(secret)))
+		if err != nil || len(decoded) != keySize {
+			return nil, "", fmt.Errorf("the encryption key must be %d bytes, raw or base64 encoded", keySize)
+		}
+		key = decoded
+	}
+	sum := sha256.Sum256(key)
+	return key, "sha256:" + hex.EncodeToString(sum[:8]), nil
+}
+
+// Keys are the keys that can decrypt artifacts, by their ID
+type Keys map[string][]byte
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce of the chunk of an index
+func chunkNonce(index uint64, last bool) []byte {
+	nonce := make([]byte, nonceSize)
+	binary.BigEndian.PutUint64(nonce[3:11], index)
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+// header is the header of an encrypted artifact
+type header struct {
+	keyID   string
+	dataKey []byte
+	// size is the size of the header
+	size int64
+}
+
+func headerAdditionalData(keyID string) []byte {
+	return append([]byte(magic), keyID...)
+}
+
+// writeHeader writes the header of an artifact that is encrypted by a new data key, and returns the data key
+func writeHeader(w io.Writer, key []byte, keyID string) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, keySize)
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	buf.WriteString(magic)
+	buf.WriteByte(byte(len(keyID)))
+	buf.WriteString(keyID)
+	buf.Write(nonce)
+	buf.Write(gcm.Seal(nil, nonce, dataKey, headerAdditionalData(keyID)))
+	_, err = w.Write(buf.Bytes())
+	return dataKey, err
+}
+
+// readHeader reads the header of an encrypted artifact, and unwraps its data key with the key of the ID in the header
+func readHeader(r io.Reader, keys Keys) (*header, error) {
+	prefix := make([]byte, len(magic)+1)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, fmt.Errorf("failed to read the encryption header: %w", err)
+	}
+	if string(prefix[:len(magic)]) != magic {
+		return nil, errors.New("the artifact is not encrypted")
+	}
+	rest := make([]byte, int(prefix[len(magic)])+nonceSize+wrappedKeySize)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return nil, fmt.Errorf("failed to read the encryption header: %w", err)
+	}
+	h := &header{keyID: string(rest[:len(rest)-nonceSize-wrappedKeySize]), size: int64(len(prefix) + len(rest))}
+	key, ok := keys[h.keyID]
+	if !ok {
+		return nil, fmt.Errorf("the artifact was encrypted with key %s, which is neither the key of the secret nor a previous key", h.keyID)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := rest[len(h.keyID) : len(h.keyID)+nonceSize]
+	h.dataKey, err = gcm.Open(nil, nonce, rest[len(h.keyID)+nonceSize:], headerAdditionalData(h.keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap the data key of the artifact: %w", err)
+	}
+	return h, nil
+}
+
+// Encrypt encrypts the content of the reader to the writer
+func Encrypt(r io.Reader, w io.Writer, key []byte, keyID string) error {
+	dataKey, err := writeHeader(w, key, keyID)
+	if err != nil {
+		return err
+	}
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, chunkSize)
+	sealed := make([]byte, 0, sealedSize)
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(r, buf)
+		last := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !last {
+			return err
+		}
+		if _, err := w.Write(gcm.Seal(sealed[:0], chunkNonce(index, last), buf[:n], nil)); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// decrypter decrypts the chunks of an encrypted artifact from a chunk index
+type decrypter struct {
+	r     io.Reader
+	gcm   cipher.AEAD
+	index uint64
+	// sealed is a buffer of a sealed chunk, and plain is the unread part of the decrypted chunk
+	sealed []byte
+	plain  []byte
+	done   bool
+}
+
+func newDecrypter(r io.Reader, dataKey []byte, index uint64) (*decrypter, error) {
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &decrypter{r: r, gcm: gcm, index: index, sealed: make([]byte, sealedSize)}, nil
+}
+
+func (d *decrypter) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(d.r, d.sealed)
+		last := err == io.ErrUnexpectedEOF
+		if err == io.EOF {
+			return 0, errors.New("the encrypted artifact is truncated")
+		}
+		if err != nil && !last {
+			return 0, err
+		}
+		d.plain, err = d.gcm.Open(d.sealed[:0], chunkNonce(d.index, last), d.sealed[:n], nil)
+		if err != nil {
+			return 0, fmt.Errorf("failed to decrypt chunk %d of the artifact: %w", d.index, err)
+		}
+		d.index++
+		d.done = last
+	}
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+// Decrypt decrypts the content of the reader to the writer, with the key that it was encrypted with
+func Decrypt(r io.Reader, w io.Writer, keys Keys) error {
+	h, err := readHeader(r, keys)
+	if err != nil {
+		return err
+	}
+	d, err := newDecrypter(r, h.dataKey, 0)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, d)
+	return err
+}
+
+// plainSize returns the size of the content of an encrypted artifact of a size with a header of a size
+func plainSize(size, headerSize int64) int64 {
+	body := size - headerSize
+	chunks := (body + sealedSize - 1) / sealedSize
+	return body - chunks*tagSize
+}
+
+// transformPath encrypts or decrypts a file, or each of the files of a directory, to a path
+func transformPath(src, dst string, transform func(io.Reader, io.Writer) error) error {
+	return filepath.Walk(src, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+		in, err := os.Open(filepath.Clean(p))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = in.Close() }()
+		out, err := os.OpenFile(filepath.Clean(target), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+		if err != nil {
+			return err
+		}
+		if err := transform(in, out); err != nil {
+			_ = out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
@@ -0,0 +1,100 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(t *testing.T) ([]byte, string) {
+	secret := make([]byte, keySize)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+	key, keyID, err := ParseKey(secret)
+	require.NoError(t, err)
+	return key, keyID
+}
+
+func TestParseKey(t *testing.T) {
+	raw := bytes.Repeat([]byte{1}, keySize)
+	key, keyID, err := ParseKey(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, raw, key)
+	assert.Regexp(t, "^sha256:[0-9a-f]{16}$", keyID)
+
+	key, encodedKeyID, err := ParseKey([]byte(base64.StdEncoding.EncodeToString(raw) + "\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, raw, key)
+	assert.Equal(t, keyID, encodedKeyID, "the ID is of the key, not of its encoding")
+
+	_, _, err = ParseKey([]byte("too-short"))
+	assert.EqualError(t, err, "the encryption key must be 32 bytes, raw or base64 encoded")
+}
+
+func encrypt(t *testing.T, plain, key []byte, keyID string) []byte {
+	encrypted := &bytes.Buffer{}
+	require.NoError(t, Encrypt(bytes.NewReader(plain), encrypted, key, keyID))
+	return encrypted.Bytes()
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, keyID := testKey(t)
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 5} {
+		plain := make([]byte, size)
+		_, err := rand.Read(plain)
+		require.NoError(t, err)
+		encrypted := encrypt(t, plain, key, keyID)
+		if size > 1 {
+			assert.NotContains(t, string(encrypted), string(plain[:size/2]))
+		}
+		h, err := readHeader(bytes.NewReader(encrypted), Keys{keyID: key})
+		require.NoError(t, err)
+		assert.Equal(t, int64(size), plainSize(int64(len(encrypted)), h.size), "size %d", size)
+		decrypted := &bytes.Buffer{}
+		if assert.NoError(t, Decrypt(bytes.NewReader(encrypted), decrypted, Keys{keyID: key}), "size %d", size) {
+			assert.Equal(t, plain, decrypted.Bytes(), "size %d", size)
+		}
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	key, keyID := testKey(t)
+	plain := bytes.Repeat([]byte("foo"), chunkSize)
+	encrypted := encrypt(t, plain, key, keyID)
+	decrypt := func(encrypted, key []byte, keyID string) error {
+		return Decrypt(bytes.NewReader(encrypted), &bytes.Buffer{}, Keys{keyID: key})
+	}
+
+	t.Run("NotEncrypted", func(t *testing.T) {
+		assert.EqualError(t, decrypt(plain, key, keyID), "the artifact is not encrypted")
+	})
+	t.Run("OtherKey", func(t *testing.T) {
+		otherKey, otherKeyID := testKey(t)
+		assert.EqualError(t, decrypt(encrypted, otherKey, otherKeyID), "the artifact was encrypted with key "+keyID+", which is neither the key of the secret nor a previous key")
+	})
+	t.Run("PreviousKey", func(t *testing.T) {
+		otherKey, otherKeyID := testKey(t)
+		decrypted := &bytes.Buffer{}
+		require.NoError(t, Decrypt(bytes.NewReader(encrypted), decrypted, Keys{otherKeyID: otherKey, keyID: key}))
+		assert.Equal(t, plain, decrypted.Bytes())
+	})
+	t.Run("WrongKey", func(t *testing.T) {
+		otherKey, _ := testKey(t)
+		assert.Error(t, decrypt(encrypted, otherKey, keyID))
+	})
+	t.Run("Tampered", func(t *testing.T) {
+		tampered := append([]byte{}, encrypted...)
+		tampered[len(tampered)-1] ^= 1
+		assert.Error(t, decrypt(tampered, key, keyID))
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		h, err := readHeader(bytes.NewReader(encrypted), Keys{keyID: key})
+		require.NoError(t, err)
+		assert.EqualError(t, decrypt(encrypted[:h.size+3*sealedSize], key, keyID), "the encrypted artifact is truncated")
+		assert.Error(t, decrypt(encrypted[:h.size+sealedSize+100], key, keyID))
+	})
+}
@@ -2,6 +2,8 @@ package gcs
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -32,8 +34,9 @@ type ArtifactDriver struct {
 }
 
 var (
-	_            common.ArtifactDriver = &ArtifactDriver{}
-	defaultRetry                       = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
+	_            common.ArtifactDriver   = &ArtifactDriver{}
+	_            common.ArtifactDigester = &ArtifactDriver{}
+	defaultRetry                         = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
 )
 
 // from https://github.com/googleapis/google-cloud-go/blob/master/storage/go110.go
@@ -320,3 +323,130 @@ func (g *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error)
 		})
 	return files, err
 }
+
+// OpenStream opens a stream of the object of the key from the offset
+func (g *ArtifactDriver) OpenStream(artifact *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	var stream *common.ArtifactStream
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("GCS OpenStream bucket: %s, key: %s, offset: %d", artifact.GCS.Bucket, artifact.GCS.Key, offset)
+			client, err := g.newGCSClient()
+			if err != nil {
+				log.Warnf("Failed to create new GCS client: %v", err)
+				return !isTransientGCSErr(err), err
+			}
+			stream, err = openObjectStream(client, artifact.GCS.Bucket, artifact.GCS.Key, offset)
+			if err != nil {
+				_ = client.Close()
+				return !isTransientGCSErr(err), err
+			}
+			return true, nil
+		})
+	return stream, err
+}
+
+// objectStream closes the client of the object when the stream is closed
+type objectStream struct {
+	*storage.Reader
+	client *storage.Client
+}
+
+func (s *objectStream) Close() error {
+	defer s.client.Close()
+	return s.Reader.Close()
+}
+
+func openObjectStream(client *storage.Client, bucket, key string, offset int64) (*common.ArtifactStream, error) {
+	r, err := client.Bucket(bucket).Object(key).NewRangeReader(context.Background(), offset, -1)
+	if err != nil {
+		if err == storage.ErrObjectNotExist {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, fmt.Errorf("new bucket reader: %w", err)
+	}
+	return &common.ArtifactStream{ReadCloser: &objectStream{r, client}, Size: r.Attrs.Size, ContentType: r.Attrs.ContentType}, nil
+}
+
+// Digest returns a hash of the names, MD5 and CRC32C checksums of the objects of the key
+func (g *ArtifactDriver) Digest(artifact *wfv1.Artifact) (string, error) {
+	var digest string
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			client, err := g.newGCSClient()
+			if err != nil {
+				log.Warnf("Failed to create new GCS client: %v", err)
+				return !isTransientGCSErr(err), err
+			}
+			defer client.Close()
+			digest, err = digestObjects(client, artifact.GCS.Bucket, artifact.GCS.Key)
+			if err != nil {
+				return !isTransientGCSErr(err), err
+			}
+			return true, nil
+		})
+	return digest, err
+}
+
+// digestObjects hashes the attributes of all the objects of a key, in the order they are listed in, i.e. lexical
+func digestObjects(client *storage.Client, bucket, key string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	it := client.Bucket(bucket).Objects(ctx, &storage.Query{Prefix: key})
+	h := sha256.New()
+	found := false
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		found = true
+		_, _ = fmt.Fprintf(h, "%s:%x:%d\n", strings.TrimPrefix(attrs.Name, key), attrs.MD5, attrs.CRC32C)
+	}
+	if !found {
+		return "", errors.New(errors.CodeNotFound, fmt.Sprintf("no results for key: %s", key))
+	}
+	return "gcs:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Delete deletes the object of the key, and the objects of the directory of the same key if there are any
+func (g *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("GCS Delete bucket: %s, key: %s", artifact.GCS.Bucket, artifact.GCS.Key)
+			client, err := g.newGCSClient()
+			if err != nil {
+				log.Warnf("Failed to create new GCS client: %v", err)
+				return !isTransientGCSErr(err), err
+			}
+			defer client.Close()
+			err = deleteObjects(client, artifact.GCS.Bucket, artifact.GCS.Key)
+			if err != nil {
+				return !isTransientGCSErr(err), err
+			}
+			return true, nil
+		})
+}
+
+// delete the object of the key, or else the objects of the key as a directory, so that the objects of another
+// artifact whose key starts with the key of a file artifact are kept
+func deleteObjects(client *storage.Client, bucket, key string) error {
+	ctx := context.Background()
+	err := client.Bucket(bucket).Object(key).Delete(ctx)
+	if err != storage.ErrObjectNotExist {
+		return err
+	}
+	objNames, err := listByPrefix(client, bucket, strings.TrimSuffix(key, "/")+"/", "")
+	if err != nil {
+		return err
+	}
+	for _, objName := range objNames {
+		err := client.Bucket(bucket).Object(objName).Delete(ctx)
+		if err != nil && err != storage.ErrObjectNotExist {
+			return fmt.Errorf("delete %s: %v", objName, err)
+		}
+	}
+	return nil
+}
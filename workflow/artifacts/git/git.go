@@ -1,14 +1,19 @@
 package git
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
@@ -16,6 +21,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	ssh2 "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh"
 
@@ -30,11 +36,25 @@ type ArtifactDriver struct {
 	SSHPrivateKey         string
 	InsecureIgnoreHostKey bool
 	DisableSubmodules     bool
+	// message is the statistics of the last clone of an input artifact
+	message string
 }
 
-var _ common.ArtifactDriver = &ArtifactDriver{}
+var (
+	_ common.ArtifactDriver       = &ArtifactDriver{}
+	_ common.ArtifactDigester     = &ArtifactDriver{}
+	_ common.ArtifactLoadReporter = &ArtifactDriver{}
+)
+
+const (
+	defaultAuthorName  = "Argo Workflows"
+	defaultAuthorEmail = "argo-workflows@argoproj.io"
+)
 
-var sshURLRegex = regexp.MustCompile("^(ssh://)?([^/:]*?)@[^@]+$")
+var (
+	sshURLRegex = regexp.MustCompile("^(ssh://)?([^/:]*?)@[^@]+$")
+	commitRegex = regexp.MustCompile("^[0-9a-f]{40}$")
+)
 
 func GetUser(url string) string {
 	matches := sshURLRegex.FindStringSubmatch(url)
@@ -67,7 +87,7 @@ func (g *ArtifactDriver) auth(sshUser string) (func(), transport.AuthMethod, []s
 		if g.InsecureIgnoreHostKey {
 			args = append(args, "-o", "StrictHostKeyChecking=no", "-o", "UserKnownHostsFile=/dev/null")
 		} else {
-			args = append(args, "-o", "StrictHostKeyChecking=yes", "-o")
+			args = append(args, "-o", "StrictHostKeyChecking=yes")
 		}
 		env := []string{"GIT_SSH_COMMAND=" + strings.Join(args, " ")}
 		if g.InsecureIgnoreHostKey {
@@ -106,29 +126,276 @@ esac
 	return func() {}, nil, nil, nil
 }
 
-// Save is unsupported for git output artifacts
-func (g *ArtifactDriver) Save(string, *wfv1.Artifact) error {
-	return errors.New("git output artifacts unsupported")
+// Save commits the file or directory of the path to the directory of the artifact on its branch, and pushes it. If
+// the branch was updated after it was fetched, the commit is rebased onto it and pushed again. The revision of the
+// artifact is set to the pushed commit, so the artifact is loaded from it.
+func (g *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	a := outputArtifact.Git
+	if a.Branch == "" {
+		return errors.New("git output artifacts require a branch")
+	}
+	closer, _, env, err := g.auth(GetUser(a.Repo))
+	if err != nil {
+		return err
+	}
+	defer closer()
+	dir, err := ioutil.TempDir("", "git")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	target := filepath.Join(dir, a.Dir)
+	if rel, err := filepath.Rel(dir, target); err != nil || strings.HasPrefix(rel, "..") {
+		return fmt.Errorf("dir %q is not in the repository", a.Dir)
+	}
+	run := func(args ...string) (string, error) {
+		return g.run(dir, env, args...)
+	}
+	authorName, authorEmail := a.AuthorName, a.AuthorEmail
+	if authorName == "" {
+		authorName = defaultAuthorName
+	}
+	if authorEmail == "" {
+		authorEmail = defaultAuthorEmail
+	}
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"remote", "add", git.DefaultRemoteName, a.Repo},
+		{"config", "user.name", authorName},
+		{"config", "user.email", authorEmail},
+	} {
+		if _, err := run(args...); err != nil {
+			return err
+		}
+	}
+	created, orphan, err := g.checkoutBranch(run, a)
+	if err != nil {
+		return err
+	}
+	if err := replaceDir(path, target); err != nil {
+		return err
+	}
+	if _, err := run("add", "--all"); err != nil {
+		return err
+	}
+	status, err := run("status", "--porcelain")
+	if err != nil {
+		return err
+	}
+	if status != "" || orphan {
+		message := a.CommitMessage
+		if message == "" {
+			message = fmt.Sprintf("Save artifact %s", outputArtifact.Name)
+		}
+		log.Infof("Committing artifact %s to branch %s", outputArtifact.Name, a.Branch)
+		if _, err := run("commit", "-q", "--allow-empty", "-m", message); err != nil {
+			return err
+		}
+	} else {
+		log.Infof("Artifact %s is unchanged on branch %s", outputArtifact.Name, a.Branch)
+	}
+	if status != "" || created {
+		if err := g.push(run, a); err != nil {
+			return err
+		}
+	}
+	commit, err := run("rev-parse", "HEAD")
+	if err != nil {
+		return err
+	}
+	log.Infof("Saved artifact %s in commit %s", outputArtifact.Name, commit)
+	a.Revision = commit
+	return nil
+}
+
+// checkoutBranch checks out the branch of an output artifact, or creates it from the revision of the artifact if it
+// does not exist and createBranch is set. It returns whether the branch was created, and whether it was created in an
+// empty repository, so has no commits.
+func (g *ArtifactDriver) checkoutBranch(run func(...string) (string, error), a *wfv1.GitArtifact) (bool, bool, error) {
+	ref := plumbing.NewBranchReferenceName(a.Branch).String()
+	exists, err := run("ls-remote", git.DefaultRemoteName, ref)
+	if err != nil {
+		return false, false, err
+	}
+	if exists != "" {
+		if err := g.fetch(run, a, a.Branch); err != nil {
+			return false, false, err
+		}
+		_, err := run("checkout", "-q", "-B", a.Branch, "FETCH_HEAD")
+		return false, false, err
+	}
+	if !a.CreateBranch {
+		return false, false, fmt.Errorf("branch %s does not exist in %s, set createBranch to create it", a.Branch, a.Repo)
+	}
+	refs, err := run("ls-remote", git.DefaultRemoteName)
+	if err != nil {
+		return false, false, err
+	}
+	if refs == "" {
+		log.Infof("Creating branch %s in an empty repository", a.Branch)
+		_, err := run("checkout", "-q", "--orphan", a.Branch)
+		return true, true, err
+	}
+	revision := "HEAD"
+	if a.Revision != "" {
+		revision = a.Revision
+	}
+	log.Infof("Creating branch %s from %s", a.Branch, revision)
+	if err := g.fetch(run, a, revision); err != nil {
+		return false, false, err
+	}
+	_, err = run("checkout", "-q", "-b", a.Branch, "FETCH_HEAD")
+	return true, false, err
+}
+
+func (g *ArtifactDriver) fetch(run func(...string) (string, error), a *wfv1.GitArtifact, ref string) error {
+	args := []string{"fetch", "-q"}
+	if depth := a.GetDepth(); depth > 0 {
+		args = append(args, fmt.Sprintf("--depth=%d", depth))
+	}
+	_, err := run(append(args, git.DefaultRemoteName, ref)...)
+	return err
+}
+
+// push pushes the branch of an output artifact, and rebases it onto the branch of the remote if the push fails, up to
+// the push retries of the artifact
+func (g *ArtifactDriver) push(run func(...string) (string, error), a *wfv1.GitArtifact) error {
+	for i := 0; ; i++ {
+		_, err := run("push", "-q", git.DefaultRemoteName, "HEAD:"+plumbing.NewBranchReferenceName(a.Branch).String())
+		if err == nil || i >= a.GetPushRetries() {
+			return err
+		}
+		log.WithError(err).Warnf("Failed to push to branch %s, rebasing onto it", a.Branch)
+		if err := g.fetch(run, a, a.Branch); err != nil {
+			return err
+		}
+		if _, err := run("rebase", "-q", "FETCH_HEAD"); err != nil {
+			_, _ = run("rebase", "--abort")
+			return fmt.Errorf("failed to rebase onto branch %s: %w", a.Branch, err)
+		}
+	}
+}
+
+func (g *ArtifactDriver) run(dir string, env []string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", g.error(err, cmd)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// replaceDir replaces the content of a directory with the directory of the path, or adds the file of the path to it.
+// The .git directory of the repository is kept.
+func replaceDir(path, dir string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return copyFile(path, filepath.Join(dir, filepath.Base(path)), info.Mode())
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.Name() == git.GitDirName {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		return copyFile(p, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(filepath.Clean(src))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(filepath.Clean(dst), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
 }
 
+// Load clones the repository of the artifact and checks out its revision. Partial clones, sparse checkouts and LFS
+// objects are only supported by the git CLI, so artifacts that use them are cloned with it. The statistics of the
+// clone are reported in the message of the node.
 func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
-	sshUser := GetUser(inputArtifact.Git.Repo)
+	start := time.Now()
+	var err error
+	if usesCLI(inputArtifact.Git) {
+		err = g.cloneWithCLI(inputArtifact.Git, path)
+	} else {
+		err = g.clone(inputArtifact.Git, path)
+	}
+	if err != nil {
+		return err
+	}
+	stats, err := newCloneStats(path, time.Since(start))
+	if err != nil {
+		log.WithError(err).Warn("Failed to get the statistics of the clone")
+		return nil
+	}
+	g.message = stats.String()
+	log.Infof("Cloned %s: %s", inputArtifact.Git.Repo, g.message)
+	return nil
+}
+
+// LoadMessage returns the statistics of the last clone
+func (g *ArtifactDriver) LoadMessage() string {
+	return g.message
+}
+
+// usesCLI returns whether an input artifact uses features of git that only the git CLI supports
+func usesCLI(a *wfv1.GitArtifact) bool {
+	return a.Filter != "" || len(a.SparseCheckout) > 0 || a.LFS != nil
+}
+
+func (g *ArtifactDriver) clone(a *wfv1.GitArtifact, path string) error {
+	sshUser := GetUser(a.Repo)
 	closer, auth, env, err := g.auth(sshUser)
 	if err != nil {
 		return err
 	}
 	defer closer()
 
-	var recurseSubmodules = git.DefaultSubmoduleRecursionDepth
-	if inputArtifact.Git.DisableSubmodules {
+	recurseSubmodules := git.SubmoduleRescursivity(a.GetSubmoduleRecursionDepth())
+	if recurseSubmodules == git.NoRecurseSubmodules {
 		log.Info("Recursive cloning of submodules is disabled")
-		recurseSubmodules = git.NoRecurseSubmodules
 	}
 	repo, err := git.PlainClone(path, false, &git.CloneOptions{
-		URL:               inputArtifact.Git.Repo,
+		URL:               a.Repo,
 		RecurseSubmodules: recurseSubmodules,
 		Auth:              auth,
-		Depth:             inputArtifact.Git.GetDepth(),
+		Depth:             a.GetDepth(),
 	})
 	switch err {
 	case transport.ErrEmptyRemoteRepository:
@@ -137,10 +404,10 @@ func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 		if err != nil {
 			return err
 		}
-		if _, err := r.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{inputArtifact.Git.Repo}}); err != nil {
+		if _, err := r.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{a.Repo}}); err != nil {
 			return err
 		}
-		branchName := inputArtifact.Git.Revision
+		branchName := a.Revision
 		if branchName == "" {
 			branchName = "master"
 		}
@@ -153,15 +420,15 @@ func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	case nil:
 		// fallthrough ...
 	}
-	if inputArtifact.Git.Fetch != nil {
-		refSpecs := make([]config.RefSpec, len(inputArtifact.Git.Fetch))
-		for i, spec := range inputArtifact.Git.Fetch {
+	if a.Fetch != nil {
+		refSpecs := make([]config.RefSpec, len(a.Fetch))
+		for i, spec := range a.Fetch {
 			refSpecs[i] = config.RefSpec(spec)
 		}
 		fetchOptions := git.FetchOptions{
 			Auth:     auth,
 			RefSpecs: refSpecs,
-			Depth:    inputArtifact.Git.GetDepth(),
+			Depth:    a.GetDepth(),
 		}
 		err = fetchOptions.Validate()
 		if err != nil {
@@ -172,10 +439,10 @@ func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 			return err
 		}
 	}
-	if inputArtifact.Git.Revision != "" {
+	if a.Revision != "" {
 		// We still rely on forking git for checkout, since go-git does not have a reliable
 		// way of resolving revisions (e.g. mybranch, HEAD^, v1.2.3)
-		rev := getRevisionForCheckout(inputArtifact.Git.Revision)
+		rev := getRevisionForCheckout(a.Revision)
 		log.Info("Checking out revision ", rev)
 		cmd := exec.Command("git", "checkout", rev)
 		cmd.Dir = path
@@ -185,15 +452,103 @@ func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 			return g.error(err, cmd)
 		}
 		log.Infof("`%s` stdout:\n%s", cmd.Args, string(output))
-		if !inputArtifact.Git.DisableSubmodules {
-			submodulesCmd := exec.Command("git", "submodule", "update", "--init", "--recursive", "--force")
-			submodulesCmd.Dir = path
-			submodulesCmd.Env = env
-			submoduleOutput, err := submodulesCmd.Output()
-			if err != nil {
-				return g.error(err, cmd)
-			}
-			log.Infof("`%s` stdout:\n%s", cmd.Args, string(submoduleOutput))
+		return g.updateSubmodules(path, env, a.GetSubmoduleRecursionDepth())
+	}
+	return nil
+}
+
+// cloneWithCLI clones the repository without checking it out, sets the sparse checkout of the artifact, and then
+// checks out its revision, so only the objects of the checked out files are fetched by a partial clone. LFS objects are
+// not downloaded by the checkout, but pulled afterwards with the patterns of the artifact.
+func (g *ArtifactDriver) cloneWithCLI(a *wfv1.GitArtifact, path string) error {
+	closer, _, env, err := g.auth(GetUser(a.Repo))
+	if err != nil {
+		return err
+	}
+	defer closer()
+	env = append(env, "GIT_LFS_SKIP_SMUDGE=1")
+	args := []string{"clone", "-q", "--no-checkout"}
+	if a.Filter != "" {
+		args = append(args, "--filter="+a.Filter)
+	}
+	if depth := a.GetDepth(); depth > 0 {
+		args = append(args, fmt.Sprintf("--depth=%d", depth), "--no-single-branch")
+	}
+	log.Infof("Cloning %s with the git CLI", a.Repo)
+	if _, err := g.run("", env, append(args, "--", a.Repo, path)...); err != nil {
+		return err
+	}
+	run := func(args ...string) (string, error) {
+		return g.run(path, env, args...)
+	}
+	if _, err := run("rev-parse", "-q", "--verify", "HEAD^{commit}"); err != nil {
+		log.Info("Cloned an empty repository")
+		return nil
+	}
+	if len(a.SparseCheckout) > 0 {
+		log.Infof("Sparse checkout of %s", strings.Join(a.SparseCheckout, ", "))
+		if _, err := run(append([]string{"sparse-checkout", "set", "--cone", "--"}, a.SparseCheckout...)...); err != nil {
+			return err
+		}
+	}
+	if len(a.Fetch) > 0 {
+		args := []string{"fetch", "-q"}
+		if a.Filter != "" {
+			args = append(args, "--filter="+a.Filter)
+		}
+		if depth := a.GetDepth(); depth > 0 {
+			args = append(args, fmt.Sprintf("--depth=%d", depth))
+		}
+		if _, err := run(append(append(args, git.DefaultRemoteName), a.Fetch...)...); err != nil {
+			return err
+		}
+	}
+	revision := "HEAD"
+	if a.Revision != "" {
+		revision = getRevisionForCheckout(a.Revision)
+	}
+	log.Info("Checking out revision ", revision)
+	if _, err := run("checkout", "-q", revision); err != nil {
+		return err
+	}
+	if err := g.updateSubmodules(path, env, a.GetSubmoduleRecursionDepth()); err != nil {
+		return err
+	}
+	if a.LFS != nil {
+		args := []string{"lfs", "pull"}
+		if len(a.LFS.Include) > 0 {
+			args = append(args, "--include="+strings.Join(a.LFS.Include, ","))
+		}
+		if len(a.LFS.Exclude) > 0 {
+			args = append(args, "--exclude="+strings.Join(a.LFS.Exclude, ","))
+		}
+		log.Info("Pulling LFS objects")
+		if _, err := run(args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateSubmodules checks out the submodules of the repository of the directory, and their submodules, up to the levels
+// of nesting
+func (g *ArtifactDriver) updateSubmodules(dir string, env []string, levels int) error {
+	if levels <= 0 {
+		return nil
+	}
+	if _, err := g.run(dir, env, "submodule", "update", "--init", "--force"); err != nil {
+		return err
+	}
+	paths, err := g.run(dir, env, "submodule", "foreach", "--quiet", "echo $sm_path")
+	if err != nil {
+		return err
+	}
+	for _, p := range strings.Split(paths, "\n") {
+		if p == "" {
+			continue
+		}
+		if err := g.updateSubmodules(filepath.Join(dir, p), env, levels-1); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -220,3 +575,67 @@ func (g *ArtifactDriver) error(err error, cmd *exec.Cmd) error {
 func (g *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
 	return nil, fmt.Errorf("ListObjects is currently not supported for this artifact type, but it will be in a future version")
 }
+
+// Digest returns the commit the revision of the artifact resolves to. Only commit hashes and the names of the branches
+// and tags of the remote can be resolved without a clone, the digest of other revisions is empty.
+func (g *ArtifactDriver) Digest(artifact *wfv1.Artifact) (string, error) {
+	// the commits fetched in addition to the revision are part of the content too
+	if len(artifact.Git.Fetch) > 0 {
+		return "", nil
+	}
+	if commitRegex.MatchString(artifact.Git.Revision) {
+		return "git:" + artifact.Git.Revision + checkoutSuffix(artifact.Git), nil
+	}
+	closer, auth, _, err := g.auth(GetUser(artifact.Git.Repo))
+	if err != nil {
+		return "", err
+	}
+	defer closer()
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{artifact.Git.Repo}})
+	refs, err := remote.List(&git.ListOptions{Auth: auth})
+	if err == transport.ErrEmptyRemoteRepository {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	byName := map[plumbing.ReferenceName]*plumbing.Reference{}
+	for _, ref := range refs {
+		byName[ref.Name()] = ref
+	}
+	names := []plumbing.ReferenceName{plumbing.HEAD}
+	if rev := artifact.Git.Revision; rev != "" {
+		names = []plumbing.ReferenceName{plumbing.ReferenceName(rev), plumbing.NewBranchReferenceName(rev), plumbing.NewTagReferenceName(rev)}
+	}
+	for _, name := range names {
+		ref, ok := byName[name]
+		if ok && ref.Type() == plumbing.SymbolicReference {
+			ref, ok = byName[ref.Target()]
+		}
+		if ok {
+			return "git:" + ref.Hash().String() + checkoutSuffix(artifact.Git), nil
+		}
+	}
+	return "", nil
+}
+
+// checkoutSuffix returns a suffix of the digest of an artifact that tells apart the checkouts of the same commit with
+// other sparse checkout directories, LFS objects or levels of submodules
+func checkoutSuffix(a *wfv1.GitArtifact) string {
+	if len(a.SparseCheckout) == 0 && a.LFS == nil && a.SubmoduleRecursionDepth == nil {
+		return ""
+	}
+	data, _ := json.Marshal([]interface{}{a.SparseCheckout, a.LFS, a.GetSubmoduleRecursionDepth()})
+	sum := sha256.Sum256(data)
+	return ":" + hex.EncodeToString(sum[:8])
+}
+
+// Delete is unsupported, git artifacts are not stored by Argo
+func (g *ArtifactDriver) Delete(*wfv1.Artifact) error {
+	return common.ErrDeleteNotSupported
+}
+
+// OpenStream is unsupported, git artifacts are loaded to a file instead
+func (g *ArtifactDriver) OpenStream(*wfv1.Artifact, int64) (*common.ArtifactStream, error) {
+	return nil, common.ErrOpenStreamNotSupported
+}
@@ -1,21 +1,361 @@
 package git
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 var d = uint64(1)
 
+// runGit runs git in a directory, and returns its trimmed output
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+	return strings.TrimSpace(string(output))
+}
+
+// newRemote returns a bare repository, with a commit of a README on the main branch
+func newRemote(t *testing.T) string {
+	tmp, err := ioutil.TempDir("", "git")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(tmp) })
+	remote := filepath.Join(tmp, "remote.git")
+	runGit(t, tmp, "init", "-q", "--bare", "-b", "main", remote)
+	work := filepath.Join(tmp, "work")
+	runGit(t, tmp, "clone", "-q", remote, work)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(work, "README.md"), []byte("foo"), 0o600))
+	runGit(t, work, "add", ".")
+	runGit(t, work, "commit", "-q", "-m", "initial")
+	runGit(t, work, "push", "-q", "origin", "HEAD:main")
+	return remote
+}
+
+// newArtifactDir returns a directory with the files of an output artifact
+func newArtifactDir(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "artifact")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
 func TestGitArtifactDriver_Save(t *testing.T) {
 	driver := &ArtifactDriver{}
-	err := driver.Save("", nil)
-	assert.Error(t, err)
+	t.Run("Dir", func(t *testing.T) {
+		remote := newRemote(t)
+		art := &wfv1.Artifact{Name: "manifests", ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{
+			Repo:          remote,
+			Branch:        "main",
+			Dir:           "env/prod",
+			CommitMessage: "Render manifests",
+			AuthorName:    "ci",
+			AuthorEmail:   "ci@example.com",
+		}}}
+		require.NoError(t, driver.Save(newArtifactDir(t, map[string]string{"a.yaml": "a", "sub/b.yaml": "b"}), art))
+		assert.Equal(t, runGit(t, remote, "rev-parse", "main"), art.Git.Revision)
+		assert.Equal(t, "ci <ci@example.com> Render manifests", runGit(t, remote, "log", "-1", "--format=%an <%ae> %s", "main"))
+		assert.Equal(t, "README.md\nenv/prod/a.yaml\nenv/prod/sub/b.yaml", runGit(t, remote, "ls-tree", "-r", "--name-only", "main"))
+
+		// the content of the directory is replaced
+		require.NoError(t, driver.Save(newArtifactDir(t, map[string]string{"c.yaml": "c"}), art))
+		assert.Equal(t, "README.md\nenv/prod/c.yaml", runGit(t, remote, "ls-tree", "-r", "--name-only", "main"))
+
+		// nothing is committed if the content is unchanged
+		commit := art.Git.Revision
+		require.NoError(t, driver.Save(newArtifactDir(t, map[string]string{"c.yaml": "c"}), art))
+		assert.Equal(t, commit, art.Git.Revision)
+		assert.Equal(t, commit, runGit(t, remote, "rev-parse", "main"))
+	})
+	t.Run("File", func(t *testing.T) {
+		remote := newRemote(t)
+		art := &wfv1.Artifact{Name: "version", ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{Repo: remote, Branch: "main"}}}
+		path := filepath.Join(newArtifactDir(t, map[string]string{"version.txt": "v1"}), "version.txt")
+		require.NoError(t, driver.Save(path, art))
+		assert.Equal(t, "Save artifact version", runGit(t, remote, "log", "-1", "--format=%s", "main"))
+		assert.Equal(t, "README.md\nversion.txt", runGit(t, remote, "ls-tree", "-r", "--name-only", "main"))
+	})
+	t.Run("CreateBranch", func(t *testing.T) {
+		remote := newRemote(t)
+		art := &wfv1.Artifact{Name: "manifests", ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{Repo: remote, Branch: "staging"}}}
+		path := newArtifactDir(t, map[string]string{"a.yaml": "a"})
+		assert.EqualError(t, driver.Save(path, art), "branch staging does not exist in "+remote+", set createBranch to create it")
+		art.Git.CreateBranch = true
+		require.NoError(t, driver.Save(path, art))
+		assert.Equal(t, runGit(t, remote, "rev-parse", "main"), runGit(t, remote, "rev-parse", "staging^"))
+	})
+	t.Run("Rebase", func(t *testing.T) {
+		remote := newRemote(t)
+		a := &wfv1.GitArtifact{Repo: remote, Branch: "main", Dir: "manifests"}
+		work := filepath.Join(filepath.Dir(remote), "work")
+		dir, err := ioutil.TempDir("", "git")
+		require.NoError(t, err)
+		defer func() { _ = os.RemoveAll(dir) }()
+		run := func(args ...string) (string, error) {
+			return driver.run(dir, nil, args...)
+		}
+		runGit(t, dir, "clone", "-q", remote, ".")
+		runGit(t, dir, "config", "user.name", "test")
+		runGit(t, dir, "config", "user.email", "test@example.com")
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.yaml"), []byte("a"), 0o600))
+		runGit(t, dir, "add", ".")
+		runGit(t, dir, "commit", "-q", "-m", "ours")
+		// the branch is updated after it was fetched
+		require.NoError(t, ioutil.WriteFile(filepath.Join(work, "b.yaml"), []byte("b"), 0o600))
+		runGit(t, work, "add", ".")
+		runGit(t, work, "commit", "-q", "-m", "theirs")
+		runGit(t, work, "push", "-q", "origin", "HEAD:main")
+
+		zero := int32(0)
+		a.PushRetries = &zero
+		assert.Error(t, driver.push(run, a))
+		a.PushRetries = nil
+		require.NoError(t, driver.push(run, a))
+		assert.Equal(t, "ours\ntheirs\ninitial", runGit(t, remote, "log", "--format=%s", "main"))
+	})
+}
+
+// newSSHServer returns the address of an SSH server that accepts the public key of the client, and runs the git
+// commands of its sessions in a shell, as a git host does
+func newSSHServer(t *testing.T, clientKey ssh.PublicKey) string {
+	hostKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	require.NoError(t, err)
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if !bytes.Equal(key.Marshal(), clientKey.Marshal()) {
+				return nil, fmt.Errorf("unknown public key")
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(signer)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSSH(conn, config)
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func serveSSH(conn net.Conn, config *ssh.ServerConfig) {
+	_, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(requests)
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer func() { _ = channel.Close() }()
+			for req := range requests {
+				if req.Type != "exec" {
+					_ = req.Reply(false, nil)
+					continue
+				}
+				_ = req.Reply(true, nil)
+				var payload struct{ Command string }
+				_ = ssh.Unmarshal(req.Payload, &payload)
+				cmd := exec.Command("sh", "-c", payload.Command)
+				cmd.Stdin, cmd.Stdout, cmd.Stderr = channel, channel, channel.Stderr()
+				status := struct{ Status uint32 }{}
+				if err := cmd.Run(); err != nil {
+					status.Status = 1
+				}
+				_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(&status))
+				return
+			}
+		}()
+	}
+}
+
+func TestGitArtifactDriver_SaveSSH(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	publicKey, err := ssh.NewPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	addr := newSSHServer(t, publicKey)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+
+	t.Run("InsecureIgnoreHostKey", func(t *testing.T) {
+		remote := newRemote(t)
+		driver := &ArtifactDriver{SSHPrivateKey: privateKey, InsecureIgnoreHostKey: true}
+		art := &wfv1.Artifact{Name: "manifests", ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{
+			Repo:                  "ssh://git@" + addr + remote,
+			Branch:                "main",
+			InsecureIgnoreHostKey: true,
+		}}}
+		require.NoError(t, driver.Save(newArtifactDir(t, map[string]string{"a.yaml": "a"}), art))
+		assert.Equal(t, runGit(t, remote, "rev-parse", "main"), art.Git.Revision)
+		assert.Equal(t, "a.yaml", runGit(t, remote, "ls-tree", "-r", "--name-only", "main"))
+	})
+	t.Run("StrictHostKeyChecking", func(t *testing.T) {
+		remote := newRemote(t)
+		driver := &ArtifactDriver{SSHPrivateKey: privateKey}
+		art := &wfv1.Artifact{Name: "manifests", ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{
+			Repo:   "ssh://git@" + addr + remote,
+			Branch: "main",
+		}}}
+		// the host key of the server is not known
+		err := driver.Save(newArtifactDir(t, map[string]string{"a.yaml": "a"}), art)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "No ECDSA host key is known")
+	})
+}
+
+// commitFiles commits files to the main branch of a bare repository
+func commitFiles(t *testing.T, remote string, files map[string]string) {
+	work, err := ioutil.TempDir("", "work")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(work) }()
+	runGit(t, work, "clone", "-q", remote, ".")
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(work, name)), 0o755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(work, name), []byte(content), 0o600))
+	}
+	runGit(t, work, "add", ".")
+	runGit(t, work, "commit", "-q", "-m", "files")
+	runGit(t, work, "push", "-q", "origin", "HEAD:main")
+}
+
+// addSubmodule adds a repository as a submodule of the main branch of a bare repository
+func addSubmodule(t *testing.T, remote, submodule, path string) {
+	work, err := ioutil.TempDir("", "work")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(work) }()
+	runGit(t, work, "clone", "-q", remote, ".")
+	runGit(t, work, "submodule", "add", "-q", submodule, path)
+	runGit(t, work, "commit", "-q", "-m", "submodule")
+	runGit(t, work, "push", "-q", "origin", "HEAD:main")
+}
+
+// checkedOutFiles returns the files of a clone, without its git metadata
+func checkedOutFiles(t *testing.T, dir string) []string {
+	var files []string
+	require.NoError(t, filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || info.Name() == ".git" || strings.Contains(p, "/.git/") {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		files = append(files, rel)
+		return err
+	}))
+	return files
+}
+
+func TestGitArtifactDriver_LoadCLI(t *testing.T) {
+	// allow submodules of local repositories
+	t.Setenv("GIT_CONFIG_COUNT", "1")
+	t.Setenv("GIT_CONFIG_KEY_0", "protocol.file.allow")
+	t.Setenv("GIT_CONFIG_VALUE_0", "always")
+	driver := &ArtifactDriver{}
+	t.Run("SparseCheckout", func(t *testing.T) {
+		remote := newRemote(t)
+		commitFiles(t, remote, map[string]string{"services/api/main.go": "api", "services/web/index.js": "web", "docs/index.md": "docs"})
+		runGit(t, remote, "config", "uploadpack.allowFilter", "true")
+		path := filepath.Join(t.TempDir(), "repo")
+		art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{
+			Repo:           "file://" + remote,
+			Filter:         "blob:none",
+			SparseCheckout: []string{"services/api"},
+		}}}
+		require.NoError(t, driver.Load(art, path))
+		assert.ElementsMatch(t, []string{"README.md", "services/api/main.go"}, checkedOutFiles(t, path))
+		assert.Regexp(t, `^cloned in .*, 2 files of 6 B checked out, .* of git objects$`, driver.LoadMessage())
+		// the blobs of the other files were not fetched
+		assert.Contains(t, runGit(t, path, "rev-list", "--objects", "--missing=print", "HEAD"), "?")
+	})
+	t.Run("Revision", func(t *testing.T) {
+		remote := newRemote(t)
+		first := runGit(t, remote, "rev-parse", "main")
+		commitFiles(t, remote, map[string]string{"a.txt": "a"})
+		path := filepath.Join(t.TempDir(), "repo")
+		art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{Repo: remote, Filter: "blob:none", Revision: first}}}
+		require.NoError(t, driver.Load(art, path))
+		assert.Equal(t, []string{"README.md"}, checkedOutFiles(t, path))
+	})
+	t.Run("SubmoduleRecursionDepth", func(t *testing.T) {
+		inner := newRemote(t)
+		middle := newRemote(t)
+		addSubmodule(t, middle, inner, "inner")
+		remote := newRemote(t)
+		addSubmodule(t, remote, middle, "middle")
+		for depth, files := range map[int32][]string{
+			0: {".gitmodules", "README.md"},
+			1: {".gitmodules", "README.md", "middle/.gitmodules", "middle/README.md"},
+			2: {".gitmodules", "README.md", "middle/.gitmodules", "middle/README.md", "middle/inner/README.md"},
+		} {
+			depth := depth
+			path := filepath.Join(t.TempDir(), "repo")
+			art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Git: &wfv1.GitArtifact{Repo: remote, Filter: "blob:none", SubmoduleRecursionDepth: &depth}}}
+			require.NoError(t, driver.Load(art, path))
+			assert.ElementsMatch(t, files, checkedOutFiles(t, path), "depth %d", depth)
+		}
+	})
+}
+
+func TestCheckoutSuffix(t *testing.T) {
+	assert.Empty(t, checkoutSuffix(&wfv1.GitArtifact{Filter: "blob:none"}))
+	api := checkoutSuffix(&wfv1.GitArtifact{SparseCheckout: []string{"services/api"}})
+	web := checkoutSuffix(&wfv1.GitArtifact{SparseCheckout: []string{"services/web"}})
+	lfs := checkoutSuffix(&wfv1.GitArtifact{SparseCheckout: []string{"services/api"}, LFS: &wfv1.GitLFS{Include: []string{"*.bin"}}})
+	assert.Regexp(t, "^:[0-9a-f]{16}$", api)
+	assert.NotEqual(t, api, web)
+	assert.NotEqual(t, api, lfs)
+}
+
+func TestCloneStats(t *testing.T) {
+	dir := newArtifactDir(t, map[string]string{
+		"a.txt":                     "foo",
+		"sub/b.txt":                 "bar",
+		".git/objects/pack/p.pack":  strings.Repeat("x", 2048),
+		".git/lfs/objects/ab/cd/ef": strings.Repeat("y", 3*1024*1024),
+		"submodule/.git":            "gitdir: ../.git/modules/submodule",
+	})
+	stats, err := newCloneStats(dir, 1500*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, "cloned in 1.5s, 2 files of 6 B checked out, 2.0 KiB of git objects, 1 LFS objects of 3.0 MiB", stats.String())
 }
 
 func TestGitArtifactDriverLoad_HTTPS(t *testing.T) {
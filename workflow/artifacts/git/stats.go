@@ -0,0 +1,77 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// cloneStats are the statistics of the clone of an input artifact
+type cloneStats struct {
+	duration time.Duration
+	// files is the number of the files checked out, and fileBytes their size
+	files     int
+	fileBytes int64
+	// gitBytes is the size of the git directory, without LFS objects
+	gitBytes int64
+	// lfsObjects is the number of the LFS objects pulled, and lfsBytes their size
+	lfsObjects int
+	lfsBytes   int64
+}
+
+func newCloneStats(path string, duration time.Duration) (*cloneStats, error) {
+	s := &cloneStats{duration: duration}
+	gitDir := filepath.Join(path, git.GitDirName)
+	lfsDir := filepath.Join(gitDir, "lfs", "objects")
+	err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !info.Mode().IsRegular() {
+			return nil
+		}
+		switch {
+		case strings.HasPrefix(p, lfsDir+string(filepath.Separator)):
+			s.lfsObjects++
+			s.lfsBytes += info.Size()
+		case strings.HasPrefix(p, gitDir+string(filepath.Separator)):
+			s.gitBytes += info.Size()
+		case info.Name() == git.GitDirName:
+			// the .git file of a submodule
+		default:
+			s.files++
+			s.fileBytes += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *cloneStats) String() string {
+	message := fmt.Sprintf("cloned in %v, %d files of %s checked out, %s of git objects", s.duration.Round(time.Millisecond), s.files, formatBytes(s.fileBytes), formatBytes(s.gitBytes))
+	if s.lfsObjects > 0 {
+		message += fmt.Sprintf(", %d LFS objects of %s", s.lfsObjects, formatBytes(s.lfsBytes))
+	}
+	return message
+}
+
+// formatBytes formats a number of bytes with a binary unit, e.g. "1.5 MiB"
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -231,3 +231,23 @@ func (driver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) e
 func (driver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
 	return nil, fmt.Errorf("ListObjects is currently not supported for this artifact type, but it will be in a future version")
 }
+
+// Delete deletes the file or directory of the artifact
+func (driver *ArtifactDriver) Delete(*wfv1.Artifact) error {
+	hdfscli, err := createHDFSClient(driver.Addresses, driver.HDFSUser, driver.KrbOptions)
+	if err != nil {
+		return err
+	}
+	defer util.Close(hdfscli)
+
+	err = hdfscli.Remove(driver.Path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// OpenStream is unsupported, hdfs artifacts are loaded to a file instead
+func (driver *ArtifactDriver) OpenStream(*wfv1.Artifact, int64) (*common.ArtifactStream, error) {
+	return nil, common.ErrOpenStreamNotSupported
+}
@@ -1,53 +1,553 @@
 package http
 
 import (
+	"encoding/base64"
+	"encoding/xml"
+	stderrors "errors"
 	"fmt"
-	"os/exec"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 )
 
 // ArtifactDriver is the artifact driver for a HTTP URL
-type ArtifactDriver struct{}
+type ArtifactDriver struct {
+	// SecretHeaders are the values of the headers of the artifact from secrets, by the name of the header
+	SecretHeaders map[string]string
+	Username      string
+	Password      string
+	BearerToken   string
+}
+
+var (
+	_ common.ArtifactDriver   = &ArtifactDriver{}
+	_ common.ArtifactDigester = &ArtifactDriver{}
+)
+
+var defaultRetry = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
+
+// transport times out connections, and requests whose response the server does not start to send in time. Downloads,
+// uploads and streams of artifacts may take long to transfer, so their requests have no overall timeout.
+var transport = &http.Transport{
+	Proxy:                 http.ProxyFromEnvironment,
+	DialContext:           (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+	ForceAttemptHTTP2:     true,
+	TLSHandshakeTimeout:   10 * time.Second,
+	ResponseHeaderTimeout: 5 * time.Minute,
+	IdleConnTimeout:       90 * time.Second,
+	ExpectContinueTimeout: time.Second,
+}
 
-var _ common.ArtifactDriver = &ArtifactDriver{}
+var (
+	// webDAVTimeout is the timeout of the PROPFIND and MKCOL requests of WebDAV collections
+	webDAVTimeout = time.Minute
+	// digestTimeout is the timeout of the requests for digests, which the controller makes while it operates on a
+	// workflow, so they must not take long
+	digestTimeout = 30 * time.Second
+)
 
-// Load download artifacts from an HTTP URL
+// Error is the error of a request that the server responded to with a status code that is not successful
+type Error struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.Method, e.URL, e.Status)
+}
+
+func isTransientErr(err error) bool {
+	var httpErr *Error
+	if stderrors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= 500
+	}
+	var urlErr *url.Error
+	if stderrors.As(err, &urlErr) {
+		return urlErr.Timeout() || strings.Contains(urlErr.Error(), "connection refused") || strings.Contains(urlErr.Error(), "connection reset")
+	}
+	return stderrors.Is(err, io.ErrUnexpectedEOF)
+}
+
+type header struct {
+	name   string
+	value  string
+	secret bool
+}
+
+// headers returns the headers of requests for the artifact, including the Authorization header of its auth
+func (h *ArtifactDriver) headers(art *wfv1.HTTPArtifact) []header {
+	var headers []header
+	for _, v := range art.Headers {
+		if v.ValueFrom != nil {
+			headers = append(headers, header{name: v.Name, value: h.SecretHeaders[v.Name], secret: true})
+		} else {
+			headers = append(headers, header{name: v.Name, value: v.Value})
+		}
+	}
+	switch {
+	case h.BearerToken != "":
+		headers = append(headers, header{name: "Authorization", value: "Bearer " + h.BearerToken, secret: true})
+	case h.Username != "" || h.Password != "":
+		credentials := base64.StdEncoding.EncodeToString([]byte(h.Username + ":" + h.Password))
+		headers = append(headers, header{name: "Authorization", value: "Basic " + credentials, secret: true})
+	}
+	return headers
+}
+
+// client returns a client of the requests for the artifact with the timeout, or no overall timeout if it is 0. When a
+// request is redirected to another host, the secret headers of the artifact are not sent to it, such as to the host of
+// a presigned URL that an API redirects to.
+func (h *ArtifactDriver) client(art *wfv1.HTTPArtifact, timeout time.Duration) *http.Client {
+	var secrets []string
+	for _, v := range h.headers(art) {
+		if v.secret {
+			secrets = append(secrets, v.name)
+		}
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return stderrors.New("stopped after 10 redirects")
+			}
+			if req.URL.Host != via[0].URL.Host {
+				for _, name := range secrets {
+					req.Header.Del(name)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func (h *ArtifactDriver) newRequest(art *wfv1.HTTPArtifact, method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range h.headers(art) {
+		req.Header.Add(v.name, v.value)
+	}
+	return req, nil
+}
+
+// Load download artifacts from an HTTP URL. If the URL ends with a slash, or is not found, it is loaded as a WebDAV
+// collection, whose files are downloaded into the directory of the path.
 func (h *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
-	// Download the file to a local file path
-	args := []string{"-fsS", "-L", "--create-dirs", "--proto-default", "https", "-o", path, inputArtifact.HTTP.URL}
-	headers := inputArtifact.HTTP.Headers
-	for _, v := range headers {
-		// Build curl -H string for each key-value header parameter
-		args = append(args, "-H", fmt.Sprintf("%s: %s", v.Name, v.Value))
+	u, err := url.Parse(inputArtifact.HTTP.URL)
+	if err != nil {
+		return err
+	}
+	err = errors.Errorf(errors.CodeNotFound, "%s not found", inputArtifact.HTTP.URL)
+	if !strings.HasSuffix(u.Path, "/") {
+		err = h.download(inputArtifact.HTTP, inputArtifact.HTTP.URL, path)
+		if !errors.IsCode(errors.CodeNotFound, err) {
+			return err
+		}
+	}
+	// not retried, as servers that are not WebDAV servers may respond to PROPFIND requests with any error
+	files, listErr := h.listFiles(inputArtifact.HTTP, u)
+	if listErr != nil || len(files) == 0 {
+		if listErr != nil {
+			log.WithError(listErr).Debug("The URL is not a WebDAV collection")
+		}
+		return err
+	}
+	prefix := strings.TrimSuffix(u.Path, "/") + "/"
+	for _, file := range files {
+		rel, err := relativePath(prefix, file)
+		if err != nil {
+			return err
+		}
+		fileURL := *u
+		fileURL.Path = file
+		fileURL.RawPath = ""
+		if err := h.download(inputArtifact.HTTP, fileURL.String(), filepath.Join(path, rel)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// relativePath returns the path of a file of a WebDAV collection relative to the collection. The hrefs of the files
+// are from the server, so a file whose path is outside the collection once cleaned, such as one with ".." elements, is
+// an error.
+func relativePath(collection, file string) (string, error) {
+	rel := path.Clean(strings.TrimPrefix(file, collection))
+	if path.IsAbs(rel) || rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", fmt.Errorf("file %s is not in the collection %s", file, collection)
+	}
+	return filepath.FromSlash(rel), nil
+}
+
+// download a file, without logging the values of secret headers. URLs without a scheme are downloaded with HTTPS. A 404
+// response is a not found error, so that optional artifacts are skipped only if they do not exist.
+func (h *ArtifactDriver) download(art *wfv1.HTTPArtifact, url, path string) error {
+	if !strings.Contains(url, "://") {
+		url = "https://" + url
+	}
+	req, err := h.newRequest(art, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	var logHeaders []string
+	for _, v := range h.headers(art) {
+		if v.secret {
+			logHeaders = append(logHeaders, fmt.Sprintf("%s: ******", v.name))
+		} else {
+			logHeaders = append(logHeaders, fmt.Sprintf("%s: %s", v.name, v.value))
+		}
+	}
+	log.WithField("headers", strings.Join(logHeaders, ", ")).Infof("Downloading %s to %s", req.URL.Redacted(), path)
+	res, err := h.client(art, 0).Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return errors.Errorf(errors.CodeNotFound, "GET %s: %s", req.URL.Redacted(), res.Status)
+	case res.StatusCode >= 300:
+		return &Error{Method: req.Method, URL: req.URL.Redacted(), StatusCode: res.StatusCode, Status: res.Status}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, res.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Save uploads a file with a request of the method of the artifact to its URL, or the files of a directory to the URLs
+// of their paths under its URL. Only PUT requests are retried, as a POST or PATCH request that failed may have been
+// applied, and applying it again may upload the artifact twice.
+func (h *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	method := outputArtifact.HTTP.GetMethod()
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("HTTP Save path: %s, method: %s", path, method)
+			err := h.upload(outputArtifact.HTTP, path)
+			if err != nil {
+				log.Warnf("Failed to upload the HTTP artifact: %v", err)
+				return method != http.MethodPut || !isTransientErr(err), err
+			}
+			return true, nil
+		})
+}
+
+func (h *ArtifactDriver) upload(art *wfv1.HTTPArtifact, localPath string) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
 	}
-	log.Info(strings.Join(append([]string{"curl"}, args...), " "))
-	cmd := exec.Command("curl", args...)
-	output, err := cmd.CombinedOutput()
-	log.Info(string(output))
+	if !info.IsDir() {
+		return h.uploadFile(art, art.URL, localPath)
+	}
+	u, err := url.Parse(art.URL)
 	if err != nil {
-		log.WithError(err).Error()
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			// https://ec.haxx.se/usingcurl/usingcurl-returns
-			// 22 - HTTP page not retrieved.
-			if exitErr.ExitCode() == 22 {
-				return errors.New(errors.CodeNotFound, exitErr.Error())
+		return err
+	}
+	return filepath.Walk(localPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(localPath, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		fileURL := *u
+		fileURL.Path = strings.TrimSuffix(u.Path, "/") + "/" + rel
+		fileURL.RawPath = ""
+		err = h.uploadFile(art, fileURL.String(), filePath)
+		var httpErr *Error
+		if stderrors.As(err, &httpErr) && httpErr.StatusCode == http.StatusConflict {
+			// WebDAV servers require the collection of a file to exist before it is put
+			if err := h.makeCollection(art, u, path.Dir(fileURL.Path)); err != nil {
+				return err
 			}
+			err = h.uploadFile(art, fileURL.String(), filePath)
 		}
 		return err
+	})
+}
+
+func (h *ArtifactDriver) uploadFile(art *wfv1.HTTPArtifact, url, path string) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	var req *http.Request
+	if art.MultipartField != "" {
+		body, contentType := multipartBody(art.MultipartField, filepath.Base(path), f)
+		req, err = h.newRequest(art, art.GetMethod(), url, body)
+		if err != nil {
+			_ = body.Close()
+			return err
+		}
+		req.Header.Set("Content-Type", contentType)
+	} else {
+		var body io.Reader = f
+		if info.Size() == 0 {
+			body = http.NoBody
+		}
+		req, err = h.newRequest(art, art.GetMethod(), url, body)
+		if err != nil {
+			return err
+		}
+		req.ContentLength = info.Size()
+		if req.Header.Get("Content-Type") == "" {
+			req.Header.Set("Content-Type", "application/octet-stream")
+		}
+	}
+	return h.do(art, req, 0)
+}
+
+// multipartBody streams a multipart/form-data body, with the content of the reader as a file in the field
+func multipartBody(field, filename string, r io.Reader) (io.ReadCloser, string) {
+	pr, pw := io.Pipe()
+	w := multipart.NewWriter(pw)
+	go func() {
+		part, err := w.CreateFormFile(field, filename)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = w.Close()
+		}
+		_ = pw.CloseWithError(err)
+	}()
+	return pr, w.FormDataContentType()
+}
+
+// makeCollection makes the WebDAV collection of the path, and the collections of its parents that do not exist
+func (h *ArtifactDriver) makeCollection(art *wfv1.HTTPArtifact, u *url.URL, collection string) error {
+	collectionURL := *u
+	collectionURL.Path = strings.TrimSuffix(collection, "/") + "/"
+	collectionURL.RawPath = ""
+	mkcol := func() error {
+		req, err := h.newRequest(art, "MKCOL", collectionURL.String(), nil)
+		if err != nil {
+			return err
+		}
+		return h.do(art, req, webDAVTimeout)
+	}
+	err := mkcol()
+	var httpErr *Error
+	if stderrors.As(err, &httpErr) {
+		switch {
+		case httpErr.StatusCode == http.StatusMethodNotAllowed:
+			// the response to a collection that exists
+			return nil
+		case httpErr.StatusCode == http.StatusConflict && path.Dir(collection) != collection:
+			if err := h.makeCollection(art, u, path.Dir(collection)); err != nil {
+				return err
+			}
+			return mkcol()
+		}
+	}
+	return err
+}
+
+// do a request with the timeout, and return an error if the status of the response is not successful
+func (h *ArtifactDriver) do(art *wfv1.HTTPArtifact, req *http.Request, timeout time.Duration) error {
+	res, err := h.client(art, timeout).Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+	if res.StatusCode >= 300 {
+		return &Error{Method: req.Method, URL: req.URL.Redacted(), StatusCode: res.StatusCode, Status: res.Status}
 	}
 	return nil
 }
 
-func (h *ArtifactDriver) Save(string, *wfv1.Artifact) error {
-	return errors.Errorf(errors.CodeBadRequest, "HTTP output artifacts unsupported")
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?><propfind xmlns="DAV:"><prop><resourcetype/></prop></propfind>`
+
+// multistatus is the response to a PROPFIND request of a WebDAV server
+type multistatus struct {
+	Responses []struct {
+		Href        string     `xml:"href"`
+		Collections []struct{} `xml:"propstat>prop>resourcetype>collection"`
+	} `xml:"response"`
 }
 
+// ListObjects lists the paths of the files of the WebDAV collection of the URL, and of its sub-collections
 func (h *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
-	return nil, fmt.Errorf("ListObjects is currently not supported for this artifact type, but it will be in a future version")
+	u, err := url.Parse(artifact.HTTP.URL)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	err = waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			files, err = h.listFiles(artifact.HTTP, u)
+			if err != nil {
+				return !isTransientErr(err), err
+			}
+			return true, nil
+		})
+	return files, err
+}
+
+func (h *ArtifactDriver) listFiles(art *wfv1.HTTPArtifact, u *url.URL) ([]string, error) {
+	var files []string
+	collections := []*url.URL{u}
+	for len(collections) > 0 {
+		collection := collections[0]
+		collections = collections[1:]
+		status, err := h.propfind(art, collection)
+		if err != nil {
+			return nil, err
+		}
+		prefix := strings.TrimSuffix(collection.Path, "/") + "/"
+		for _, r := range status.Responses {
+			member, err := collection.Parse(strings.TrimSpace(r.Href))
+			if err != nil {
+				return nil, err
+			}
+			// the response of the collection itself, or of a resource outside it
+			if !strings.HasPrefix(member.Path, prefix) || member.Path == prefix {
+				continue
+			}
+			if len(r.Collections) > 0 {
+				collections = append(collections, member)
+			} else {
+				files = append(files, member.Path)
+			}
+		}
+	}
+	return files, nil
+}
+
+func (h *ArtifactDriver) propfind(art *wfv1.HTTPArtifact, u *url.URL) (*multistatus, error) {
+	req, err := h.newRequest(art, "PROPFIND", u.String(), strings.NewReader(propfindBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Depth", "1")
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+	res, err := h.client(art, webDAVTimeout).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	switch res.StatusCode {
+	case http.StatusMultiStatus:
+	case http.StatusNotFound:
+		return nil, errors.Errorf(errors.CodeNotFound, "%s not found", u.Redacted())
+	default:
+		return nil, &Error{Method: req.Method, URL: u.Redacted(), StatusCode: res.StatusCode, Status: res.Status}
+	}
+	status := &multistatus{}
+	if err := xml.NewDecoder(res.Body).Decode(status); err != nil {
+		return nil, fmt.Errorf("failed to decode the PROPFIND response of %s: %w", u.Redacted(), err)
+	}
+	return status, nil
+}
+
+// Digest returns the ETag of the URL, or its Last-Modified header if the server does not send an ETag
+func (h *ArtifactDriver) Digest(artifact *wfv1.Artifact) (string, error) {
+	req, err := h.newRequest(artifact.HTTP, http.MethodHead, artifact.HTTP.URL, nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := h.client(artifact.HTTP, digestTimeout).Do(req)
+	if err != nil {
+		return "", err
+	}
+	_ = res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return "", errors.Errorf(errors.CodeNotFound, "%s not found", artifact.HTTP.URL)
+	case res.StatusCode >= 300:
+		// e.g. servers that do not allow HEAD requests
+		return "", nil
+	case res.Header.Get("ETag") != "":
+		return "etag:" + res.Header.Get("ETag"), nil
+	case res.Header.Get("Last-Modified") != "":
+		return "last-modified:" + res.Header.Get("Last-Modified"), nil
+	}
+	return "", nil
+}
+
+// OpenStream opens a stream of the content of the URL from the offset, with a range request if the offset is not 0
+func (h *ArtifactDriver) OpenStream(artifact *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	req, err := h.newRequest(artifact.HTTP, http.MethodGet, artifact.HTTP.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	res, err := h.client(artifact.HTTP, 0).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	stream := &common.ArtifactStream{ReadCloser: res.Body, Size: res.ContentLength, ContentType: res.Header.Get("Content-Type")}
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		_ = res.Body.Close()
+		return nil, errors.Errorf(errors.CodeNotFound, "%s not found", artifact.HTTP.URL)
+	case res.StatusCode == http.StatusPartialContent:
+		// bytes <start>-<end>/<size>
+		contentRange := res.Header.Get("Content-Range")
+		stream.Size, err = strconv.ParseInt(contentRange[strings.LastIndex(contentRange, "/")+1:], 10, 64)
+		if err != nil {
+			_ = res.Body.Close()
+			return nil, fmt.Errorf("invalid content range %q: %w", contentRange, err)
+		}
+	case res.StatusCode >= 300:
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("failed to get %s: %s", artifact.HTTP.URL, res.Status)
+	case offset > 0:
+		// the server ignored the range, so the content is read from the start
+		if _, err := io.CopyN(ioutil.Discard, res.Body, offset); err != nil {
+			_ = res.Body.Close()
+			return nil, err
+		}
+	}
+	if stream.Size < 0 {
+		// the server did not send the length of the content
+		_ = res.Body.Close()
+		return nil, common.ErrOpenStreamNotSupported
+	}
+	return stream, nil
+}
+
+// Delete is unsupported, HTTP artifacts are not stored by Argo
+func (h *ArtifactDriver) Delete(*wfv1.Artifact) error {
+	return common.ErrDeleteNotSupported
 }
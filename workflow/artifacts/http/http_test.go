@@ -2,12 +2,26 @@ package http
 
 import (
 	"bytes"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
-	"regexp"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -48,8 +62,8 @@ func TestHTTPArtifactDriver_Load(t *testing.T) {
 				assert.NoError(t, err)
 			}
 		})
-		curl := "curl -fsS -L --create-dirs --proto-default https -o /tmp/found-with-request-headers https://github.com/argoproj/argo-workflows -H Accept: application/json -H Authorization: Bearer foo-bar"
-		assert.Regexp(t, regexp.MustCompile(curl), output)
+		assert.Contains(t, output, "Downloading https://github.com/argoproj/argo-workflows to /tmp/found-with-request-headers")
+		assert.Contains(t, output, "Accept: application/json, Authorization: Bearer foo-bar")
 	})
 	t.Run("NotFound", func(t *testing.T) {
 		err := driver.Load(&wfv1.Artifact{
@@ -66,7 +80,395 @@ func TestHTTPArtifactDriver_Load(t *testing.T) {
 	})
 }
 
+// fakeWebDAV is a WebDAV server of files in memory, which requires the collection of a file to exist to put it
+type fakeWebDAV struct {
+	mu          sync.Mutex
+	files       map[string]string
+	collections map[string]bool
+	auth        string
+}
+
+func newFakeWebDAV(auth string) *httptest.Server {
+	dav := &fakeWebDAV{files: map[string]string{}, collections: map[string]bool{"/": true}, auth: auth}
+	return httptest.NewServer(dav)
+}
+
+// parentCollection returns the path of the collection of a file or collection, with a trailing slash
+func parentCollection(name string) string {
+	return strings.TrimSuffix(path.Dir(strings.TrimSuffix(name, "/")), "/") + "/"
+}
+
+func (d *fakeWebDAV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if r.Header.Get("Authorization") != d.auth {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	name := r.URL.Path
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := d.files[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(data))
+	case http.MethodPut:
+		if !d.collections[parentCollection(name)] {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		data, _ := ioutil.ReadAll(r.Body)
+		d.files[name] = string(data)
+		w.WriteHeader(http.StatusCreated)
+	case "MKCOL":
+		if d.collections[name] {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if !d.collections[parentCollection(name)] {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		d.collections[name] = true
+		w.WriteHeader(http.StatusCreated)
+	case "PROPFIND":
+		collection := strings.TrimSuffix(name, "/") + "/"
+		if !d.collections[collection] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Depth") != "1" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body := `<?xml version="1.0" encoding="utf-8"?><D:multistatus xmlns:D="DAV:">`
+		response := func(href string, isCollection bool) {
+			resourceType := ""
+			if isCollection {
+				resourceType = "<D:collection/>"
+			}
+			body += fmt.Sprintf(`<D:response><D:href>%s</D:href><D:propstat><D:prop><D:resourcetype>%s</D:resourcetype></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>`, (&url.URL{Path: href}).EscapedPath(), resourceType)
+		}
+		response(collection, true)
+		for c := range d.collections {
+			if c != collection && parentCollection(c) == collection {
+				response(c, true)
+			}
+		}
+		for f := range d.files {
+			if parentCollection(f) == collection {
+				response(f, false)
+			}
+		}
+		w.WriteHeader(http.StatusMultiStatus)
+		_, _ = w.Write([]byte(body + "</D:multistatus>"))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
 func TestHTTPArtifactDriver_Save(t *testing.T) {
+	server := newFakeWebDAV("Basic " + base64.StdEncoding.EncodeToString([]byte("admin:password")))
+	defer server.Close()
+	driver := &ArtifactDriver{Username: "admin", Password: "password"}
+	dir := t.TempDir()
+	t.Run("File", func(t *testing.T) {
+		src := filepath.Join(dir, "file.txt")
+		require.NoError(t, ioutil.WriteFile(src, []byte("hello"), 0o600))
+		art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/file.txt"}}}
+		require.NoError(t, driver.Save(src, art))
+		dst := filepath.Join(dir, "loaded.txt")
+		require.NoError(t, driver.Load(art, dst))
+		data, err := ioutil.ReadFile(dst)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+	t.Run("Directory", func(t *testing.T) {
+		src := filepath.Join(dir, "in")
+		require.NoError(t, os.MkdirAll(filepath.Join(src, "sub dir"), 0o700))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("foo"), 0o600))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(src, "sub dir", "b.txt"), []byte("bar"), 0o600))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(src, "empty.txt"), nil, 0o600))
+		art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/out/dir"}}}
+		require.NoError(t, driver.Save(src, art))
+		files, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"/out/dir/a.txt", "/out/dir/empty.txt", "/out/dir/sub dir/b.txt"}, files)
+		for _, u := range []string{server.URL + "/out/dir", server.URL + "/out/dir/"} {
+			dst := filepath.Join(t.TempDir(), "out")
+			require.NoError(t, driver.Load(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: u}}}, dst))
+			a, err := ioutil.ReadFile(filepath.Join(dst, "a.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "foo", string(a))
+			b, err := ioutil.ReadFile(filepath.Join(dst, "sub dir", "b.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "bar", string(b))
+		}
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		err := driver.Load(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/missing"}}}, filepath.Join(dir, "missing"))
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		src := filepath.Join(dir, "file.txt")
+		art := &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/file.txt"}}}
+		err := (&ArtifactDriver{}).Save(src, art)
+		var httpErr *Error
+		if assert.True(t, stderrors.As(err, &httpErr)) {
+			assert.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+		}
+		// an unauthorized load is not a not found error, so an optional artifact is not skipped
+		err = (&ArtifactDriver{}).Load(art, filepath.Join(dir, "unauthorized.txt"))
+		assert.False(t, errors.IsCode(errors.CodeNotFound, err), "%v", err)
+		if assert.True(t, stderrors.As(err, &httpErr)) {
+			assert.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+		}
+	})
+}
+
+func TestHTTPArtifactDriver_LoadOutsideCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PROPFIND" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusMultiStatus)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?><D:multistatus xmlns:D="DAV:">` +
+			`<D:response><D:href>/dav/art/</D:href><D:propstat><D:prop><D:resourcetype><D:collection/></D:resourcetype></D:prop></D:propstat></D:response>` +
+			`<D:response><D:href>/dav/art/%2e%2e/%2e%2e/etc/passwd</D:href><D:propstat><D:prop><D:resourcetype/></D:prop></D:propstat></D:response>` +
+			`</D:multistatus>`))
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+	err := (&ArtifactDriver{}).Load(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + "/dav/art/"}}}, filepath.Join(dir, "inputs", "art"))
+	assert.EqualError(t, err, "file /dav/art/../../etc/passwd is not in the collection /dav/art/")
+	_, err = os.Stat(filepath.Join(dir, "etc", "passwd"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRelativePath(t *testing.T) {
+	for file, expected := range map[string]string{
+		"/dav/art/a.txt":         "a.txt",
+		"/dav/art/sub/b.txt":     "sub/b.txt",
+		"/dav/art/sub/../c.txt":  "c.txt",
+		"/dav/art/sub dir/e.txt": "sub dir/e.txt",
+	} {
+		rel, err := relativePath("/dav/art/", file)
+		assert.NoError(t, err, file)
+		assert.Equal(t, filepath.FromSlash(expected), rel, file)
+	}
+	for _, file := range []string{"/dav/art/../x", "/dav/art/..", "/dav/art/a/../../x", "/dav/art/", "/dav/art//etc/passwd"} {
+		_, err := relativePath("/dav/art/", file)
+		assert.Error(t, err, file)
+	}
+}
+
+func TestHTTPArtifactDriver_SaveMultipart(t *testing.T) {
+	var uploaded string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "my-key", r.Header.Get("X-Api-Key"))
+		f, header, err := r.FormFile("asset")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "report.txt", header.Filename)
+			data, _ := ioutil.ReadAll(f)
+			uploaded = string(data)
+		}
+	}))
+	defer server.Close()
+	src := filepath.Join(t.TempDir(), "report.txt")
+	require.NoError(t, ioutil.WriteFile(src, []byte("hello"), 0o600))
+	driver := &ArtifactDriver{BearerToken: "my-token", SecretHeaders: map[string]string{"X-Api-Key": "my-key"}}
+	art := &wfv1.HTTPArtifact{
+		URL:            server.URL + "/upload",
+		Method:         http.MethodPost,
+		MultipartField: "asset",
+		Headers:        []wfv1.Header{{Name: "X-Api-Key", ValueFrom: &wfv1.HTTPHeaderSource{SecretKeyRef: &apiv1.SecretKeySelector{}}}},
+	}
+	require.NoError(t, driver.Save(src, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: art}}))
+	assert.Equal(t, "hello", uploaded)
+}
+
+func TestHTTPArtifactDriver_SaveRetry(t *testing.T) {
+	defer func(backoff wait.Backoff) { defaultRetry = backoff }(defaultRetry)
+	defaultRetry = wait.Backoff{Duration: time.Millisecond, Steps: 3}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/unavailable":
+			if requests < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		case "/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		case "/post":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	src := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, ioutil.WriteFile(src, []byte("hello"), 0o600))
+	save := func(path, method string) error {
+		return (&ArtifactDriver{}).Save(src, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + path, Method: method}}})
+	}
+	assert.NoError(t, save("/unavailable", ""))
+	assert.Equal(t, 3, requests)
+	requests = 0
+	assert.Error(t, save("/forbidden", ""))
+	assert.Equal(t, 1, requests)
+	// a POST request that failed may have been applied, so it is not retried
+	requests = 0
+	assert.Error(t, save("/post", http.MethodPost))
+	assert.Equal(t, 1, requests)
+}
+
+func TestHTTPArtifactDriver_LoadSecretHeaders(t *testing.T) {
+	server := newFakeWebDAV("my-secret")
+	defer server.Close()
+	driver := &ArtifactDriver{SecretHeaders: map[string]string{"Authorization": "my-secret"}}
+	art := &wfv1.HTTPArtifact{
+		URL:     server.URL + "/file.txt",
+		Headers: []wfv1.Header{{Name: "Authorization", ValueFrom: &wfv1.HTTPHeaderSource{SecretKeyRef: &apiv1.SecretKeySelector{}}}},
+	}
+	src := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, ioutil.WriteFile(src, []byte("hello"), 0o600))
+	require.NoError(t, driver.Save(src, &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: art}}))
+	output := captureOutput(func() {
+		require.NoError(t, driver.Load(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: art}}, filepath.Join(t.TempDir(), "file.txt")))
+	})
+	assert.Contains(t, output, "Authorization: ******")
+	assert.NotContains(t, output, "my-secret")
+}
+
+func TestHTTPArtifactDriver_LoadRedirect(t *testing.T) {
+	var headers http.Header
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		_, _ = w.Write([]byte("hello"))
+	}))
+	defer other.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/other-host":
+			http.Redirect(w, r, other.URL+"/file.txt", http.StatusFound)
+		case "/same-host":
+			http.Redirect(w, r, "/file.txt", http.StatusFound)
+		default:
+			headers = r.Header
+			_, _ = w.Write([]byte("hello"))
+		}
+	}))
+	defer server.Close()
+	driver := &ArtifactDriver{SecretHeaders: map[string]string{"X-Api-Key": "my-key"}}
+	load := func(path string) {
+		art := &wfv1.HTTPArtifact{
+			URL: server.URL + path,
+			Headers: []wfv1.Header{
+				{Name: "Accept", Value: "text/plain"},
+				{Name: "X-Api-Key", ValueFrom: &wfv1.HTTPHeaderSource{SecretKeyRef: &apiv1.SecretKeySelector{}}},
+			},
+		}
+		dst := filepath.Join(t.TempDir(), "file.txt")
+		require.NoError(t, driver.Load(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: art}}, dst))
+		data, err := ioutil.ReadFile(dst)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	}
+	t.Run("SameHost", func(t *testing.T) {
+		load("/same-host")
+		assert.Equal(t, "text/plain", headers.Get("Accept"))
+		assert.Equal(t, "my-key", headers.Get("X-Api-Key"))
+	})
+	t.Run("OtherHost", func(t *testing.T) {
+		load("/other-host")
+		assert.Equal(t, "text/plain", headers.Get("Accept"))
+		assert.Empty(t, headers.Get("X-Api-Key"))
+	})
+}
+
+func TestHTTPArtifactDriver_Digest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		switch r.URL.Path {
+		case "/etag":
+			assert.Equal(t, "Bearer foo-bar", r.Header.Get("Authorization"))
+			w.Header().Set("ETag", `"my-etag"`)
+		case "/last-modified":
+			w.Header().Set("Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT")
+		case "/no-head":
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case "/not-found":
+			w.WriteHeader(http.StatusNotFound)
+		case "/slow":
+			<-r.Context().Done()
+		}
+	}))
+	defer server.Close()
+	defer func(timeout time.Duration) { digestTimeout = timeout }(digestTimeout)
+	digestTimeout = 100 * time.Millisecond
+	driver := &ArtifactDriver{}
+	digest := func(path string, headers ...wfv1.Header) (string, error) {
+		return driver.Digest(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + path, Headers: headers}}})
+	}
+	d, err := digest("/etag", wfv1.Header{Name: "Authorization", Value: "Bearer foo-bar"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `etag:"my-etag"`, d)
+	}
+	d, err = digest("/last-modified")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "last-modified:Wed, 21 Oct 2015 07:28:00 GMT", d)
+	}
+	d, err = digest("/no-head")
+	if assert.NoError(t, err) {
+		assert.Empty(t, d)
+	}
+	_, err = digest("/not-found")
+	assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	_, err = digest("/slow")
+	assert.Error(t, err)
+}
+
+func TestHTTPArtifactDriver_OpenStream(t *testing.T) {
+	content := strings.NewReader("hello world")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/range":
+			assert.Equal(t, "Bearer foo-bar", r.Header.Get("Authorization"))
+			http.ServeContent(w, r, "hello.txt", time.Time{}, content)
+		case "/no-range":
+			_, _ = w.Write([]byte("hello world"))
+		case "/not-found":
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
 	driver := &ArtifactDriver{}
-	assert.Error(t, driver.Save("", nil))
+	read := func(path string, offset int64, headers ...wfv1.Header) (string, int64, error) {
+		stream, err := driver.OpenStream(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{HTTP: &wfv1.HTTPArtifact{URL: server.URL + path, Headers: headers}}}, offset)
+		if err != nil {
+			return "", 0, err
+		}
+		defer func() { _ = stream.Close() }()
+		data, err := ioutil.ReadAll(stream)
+		return string(data), stream.Size, err
+	}
+	auth := wfv1.Header{Name: "Authorization", Value: "Bearer foo-bar"}
+	for _, path := range []string{"/range", "/no-range"} {
+		data, size, err := read(path, 0, auth)
+		if assert.NoError(t, err, path) {
+			assert.Equal(t, "hello world", data, path)
+			assert.Equal(t, int64(11), size, path)
+		}
+		data, size, err = read(path, 6, auth)
+		if assert.NoError(t, err, path) {
+			assert.Equal(t, "world", data, path)
+			assert.Equal(t, int64(11), size, path)
+		}
+	}
+	_, _, err := read("/not-found", 0)
+	assert.True(t, errors.IsCode(errors.CodeNotFound, err))
 }
@@ -0,0 +1,304 @@
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	mediaTypeManifest = "application/vnd.oci.image.manifest.v1+json"
+	// mediaTypeEmpty is the media type of the empty config of a manifest of an artifact that is not an image
+	mediaTypeEmpty = "application/vnd.oci.empty.v1+json"
+	// mediaTypeLayer is the default media type of the files of an artifact, as for the ORAS CLI
+	mediaTypeLayer = "application/vnd.oci.image.layer.v1.tar"
+	// annotationTitle is the annotation of the path of the file of a layer in the artifact
+	annotationTitle = "org.opencontainers.image.title"
+	// annotationCreated is the annotation of the time a manifest was created
+	annotationCreated = "org.opencontainers.image.created"
+	// annotationDirectory is the annotation of the manifest of an artifact that is a directory, rather than a file
+	annotationDirectory = "workflows.argoproj.io/directory"
+
+	dockerHubRegistry = "docker.io"
+	dockerHubEndpoint = "registry-1.docker.io"
+	dockerHubAuthKey  = "index.docker.io"
+)
+
+// emptyConfig is the content of the empty config of a manifest
+var emptyConfig = []byte("{}")
+
+// descriptor describes the content of a manifest, config or layer
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+func (d descriptor) title() string {
+	return d.Annotations[annotationTitle]
+}
+
+type manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	Config        descriptor        `json:"config"`
+	Layers        []descriptor      `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// isDir returns whether the artifact of the manifest is a directory, which it is unless it was pushed as a directory
+// or has a single layer
+func (m *manifest) isDir() bool {
+	return m.Annotations[annotationDirectory] == "true" || len(m.Layers) != 1
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// dockerConfig is a Docker config, or the auths of a legacy .dockercfg
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Auth     string `json:"auth"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// registryHost returns the host of a key of the auths of a Docker config, which may be a URL
+func registryHost(key string) string {
+	if u, err := url.Parse(key); err == nil && u.Host != "" {
+		key = u.Host
+	}
+	key = strings.SplitN(key, "/", 2)[0]
+	if key == dockerHubRegistry || key == dockerHubEndpoint {
+		return dockerHubAuthKey
+	}
+	return key
+}
+
+// parseDockerConfig returns the username and password of a registry in a Docker config
+func parseDockerConfig(data []byte, registry string) (string, string, error) {
+	config := dockerConfig{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", "", fmt.Errorf("invalid Docker config: %w", err)
+	}
+	if config.Auths == nil {
+		if err := json.Unmarshal(data, &config.Auths); err != nil {
+			return "", "", fmt.Errorf("invalid Docker config: %w", err)
+		}
+	}
+	for key, auth := range config.Auths {
+		if registryHost(key) != registryHost(registry) {
+			continue
+		}
+		if auth.Auth == "" {
+			return auth.Username, auth.Password, nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid auth of registry %s: %w", key, err)
+		}
+		parts := strings.SplitN(string(decoded), ":", 2)
+		if len(parts) != 2 {
+			return "", "", fmt.Errorf("invalid auth of registry %s", key)
+		}
+		return parts[0], parts[1], nil
+	}
+	return "", "", nil
+}
+
+// httpTransport times out connections, and requests whose response the registry does not start to send in time. Blobs may
+// take long to transfer, so requests have no overall timeout.
+var httpTransport = &http.Transport{
+	Proxy:                 http.ProxyFromEnvironment,
+	DialContext:           (&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+	ForceAttemptHTTP2:     true,
+	TLSHandshakeTimeout:   10 * time.Second,
+	ResponseHeaderTimeout: 5 * time.Minute,
+	IdleConnTimeout:       90 * time.Second,
+	ExpectContinueTimeout: time.Second,
+}
+
+// client is a client of the OCI distribution API for a repository, which authorizes its requests with a token from
+// the token service of the registry, or with basic authentication, as the registry challenges
+type client struct {
+	repo    name.Repository
+	options []remote.Option
+}
+
+// newClient returns a client of the repository of the artifact, with the credentials of its registry in the Docker
+// config, or anonymous if it has none
+func newClient(ctx context.Context, art *wfv1.OCIArtifact, dockerConfig string) (*client, error) {
+	var opts []name.Option
+	if art.PlainHTTP {
+		opts = append(opts, name.Insecure)
+	}
+	repo, err := name.NewRepository(art.Repository, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository %q: %w", art.Repository, err)
+	}
+	auth := authn.Anonymous
+	if dockerConfig != "" {
+		username, password, err := parseDockerConfig([]byte(dockerConfig), repo.RegistryStr())
+		if err != nil {
+			return nil, err
+		}
+		if username != "" || password != "" {
+			auth = &authn.Basic{Username: username, Password: password}
+		}
+	}
+	return &client{repo: repo, options: []remote.Option{remote.WithContext(ctx), remote.WithAuth(auth), remote.WithTransport(httpTransport)}}, nil
+}
+
+// reference returns the reference of a tag or digest in the repository
+func (c *client) reference(ref string) name.Reference {
+	if strings.HasPrefix(ref, "sha256:") {
+		return c.repo.Digest(ref)
+	}
+	return c.repo.Tag(ref)
+}
+
+// getManifest returns the manifest of a tag or digest, and its digest
+func (c *client) getManifest(ref string) (*manifest, string, error) {
+	desc, err := remote.Get(c.reference(ref), c.options...)
+	if err != nil {
+		return nil, "", err
+	}
+	m := &manifest{}
+	if err := json.Unmarshal(desc.Manifest, m); err != nil {
+		return nil, "", fmt.Errorf("invalid manifest of %s:%s: %w", c.repo, ref, err)
+	}
+	return m, desc.Digest.String(), nil
+}
+
+// resolve returns the digest of the manifest of a tag or digest
+func (c *client) resolve(ref string) (string, error) {
+	if strings.HasPrefix(ref, "sha256:") {
+		return ref, nil
+	}
+	desc, err := remote.Head(c.reference(ref), c.options...)
+	if err != nil {
+		return "", err
+	}
+	return desc.Digest.String(), nil
+}
+
+// openBlob returns a stream of the content of a blob from the offset, whose digest is verified once it is read to the
+// end
+func (c *client) openBlob(digest string, offset int64) (io.ReadCloser, error) {
+	layer, err := remote.Layer(c.repo.Digest(digest), c.options...)
+	if err != nil {
+		return nil, err
+	}
+	body, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		if _, err := io.CopyN(ioutil.Discard, body, offset); err != nil {
+			_ = body.Close()
+			return nil, err
+		}
+	}
+	return body, nil
+}
+
+// push uploads the config and the layers of a manifest, unless the repository already has them, and the manifest to
+// the tag
+func (c *client) push(tag string, m manifest, files map[string]string) (string, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	img, err := partial.CompressedToImage(&artifactImage{manifest: data, layers: m.Layers, files: files})
+	if err != nil {
+		return "", err
+	}
+	if err := remote.Write(c.repo.Tag(tag), img, c.options...); err != nil {
+		return "", err
+	}
+	return digestOf(data), nil
+}
+
+// deleteManifest deletes the manifest of a digest
+func (c *client) deleteManifest(digest string) error {
+	return remote.Delete(c.repo.Digest(digest), c.options...)
+}
+
+// artifactImage is an artifact with the empty config, whose layers are local files, by the titles of the layers
+type artifactImage struct {
+	manifest []byte
+	layers   []descriptor
+	files    map[string]string
+}
+
+var _ partial.CompressedImageCore = &artifactImage{}
+
+func (i *artifactImage) RawConfigFile() ([]byte, error) {
+	return emptyConfig, nil
+}
+
+func (i *artifactImage) MediaType() (types.MediaType, error) {
+	return mediaTypeManifest, nil
+}
+
+func (i *artifactImage) RawManifest() ([]byte, error) {
+	return i.manifest, nil
+}
+
+func (i *artifactImage) LayerByDigest(h v1.Hash) (partial.CompressedLayer, error) {
+	for _, layer := range i.layers {
+		if layer.Digest == h.String() {
+			return &fileLayer{desc: layer, path: i.files[layer.title()]}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown layer %s", h)
+}
+
+// fileLayer is a layer whose blob is the content of a local file
+type fileLayer struct {
+	desc descriptor
+	path string
+}
+
+func (l *fileLayer) Digest() (v1.Hash, error) {
+	return v1.NewHash(l.desc.Digest)
+}
+
+func (l *fileLayer) Compressed() (io.ReadCloser, error) {
+	return os.Open(filepath.Clean(l.path))
+}
+
+func (l *fileLayer) Size() (int64, error) {
+	return l.desc.Size, nil
+}
+
+func (l *fileLayer) MediaType() (types.MediaType, error) {
+	return types.MediaType(l.desc.MediaType), nil
+}
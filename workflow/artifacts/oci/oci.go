@@ -0,0 +1,360 @@
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// ArtifactDriver is a driver for OCI registries
+type ArtifactDriver struct {
+	// DockerConfig is a Docker config with the credentials of the registry
+	DockerConfig string
+}
+
+var (
+	_            common.ArtifactDriver   = &ArtifactDriver{}
+	_            common.ArtifactDigester = &ArtifactDriver{}
+	defaultRetry                         = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
+)
+
+func isTransientOCIErr(err error) bool {
+	var ociErr *transport.Error
+	if stderrors.As(err, &ociErr) {
+		return ociErr.StatusCode == http.StatusTooManyRequests || ociErr.StatusCode >= 500
+	}
+	var urlErr *url.Error
+	if stderrors.As(err, &urlErr) {
+		return urlErr.Timeout() || strings.Contains(urlErr.Error(), "connection refused") || strings.Contains(urlErr.Error(), "connection reset")
+	}
+	return stderrors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isNotFound(err error) bool {
+	var ociErr *transport.Error
+	return stderrors.As(err, &ociErr) && ociErr.StatusCode == http.StatusNotFound
+}
+
+func notFound(art *wfv1.OCIArtifact) error {
+	return errors.New(errors.CodeNotFound, fmt.Sprintf("no results for OCI artifact: %s:%s", art.Repository, art.GetReference()))
+}
+
+// Load pulls the layer of a file artifact to the path, or the layers of a directory artifact to the paths of their
+// titles in the directory of the path
+func (d *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			art := inputArtifact.OCI
+			log.Infof("OCI Load path: %s, repository: %s, reference: %s", path, art.Repository, art.GetReference())
+			err := d.load(context.Background(), art, path)
+			if isNotFound(err) {
+				return true, notFound(art)
+			}
+			if err != nil {
+				log.Warnf("Failed to pull OCI artifact: %v", err)
+				return !isTransientOCIErr(err), err
+			}
+			return true, nil
+		})
+}
+
+func (d *ArtifactDriver) load(ctx context.Context, art *wfv1.OCIArtifact, path string) error {
+	c, err := newClient(ctx, art, d.DockerConfig)
+	if err != nil {
+		return err
+	}
+	m, _, err := c.getManifest(art.GetReference())
+	if err != nil {
+		return err
+	}
+	if !m.isDir() {
+		return pullBlob(c, m.Layers[0], path)
+	}
+	for _, layer := range m.Layers {
+		localPath, err := layerPath(path, layer)
+		if err != nil {
+			return err
+		}
+		if localPath == "" {
+			log.Infof("Skipping layer %s without a title", layer.Digest)
+			continue
+		}
+		if err := pullBlob(c, layer, localPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// layerPath returns the path of the file of a layer in the directory of the path, or an empty path if the layer has
+// no title
+func layerPath(dir string, layer descriptor) (string, error) {
+	title := layer.title()
+	if title == "" {
+		return "", nil
+	}
+	clean := path.Clean("/" + title)
+	if clean == "/" || clean != "/"+strings.TrimPrefix(title, "./") {
+		return "", fmt.Errorf("invalid title %q of layer %s", title, layer.Digest)
+	}
+	return filepath.Join(dir, filepath.FromSlash(clean)), nil
+}
+
+// pullBlob downloads the blob of a layer to a local file, and verifies its digest
+func pullBlob(c *client, layer descriptor, localPath string) error {
+	body, err := c.openBlob(layer.Digest, 0)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	if err := os.MkdirAll(filepath.Dir(localPath), 0o700); err != nil {
+		return fmt.Errorf("mkdir %s: %v", filepath.Dir(localPath), err)
+	}
+	out, err := os.Create(localPath)
+	if err != nil {
+		return fmt.Errorf("os create %s: %v", localPath, err)
+	}
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(out, h), body)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("io copy: %w", err)
+	}
+	if digest := "sha256:" + hex.EncodeToString(h.Sum(nil)); strings.HasPrefix(layer.Digest, "sha256:") && digest != layer.Digest {
+		return fmt.Errorf("the digest of layer %s is %s", layer.Digest, digest)
+	}
+	return nil
+}
+
+// Save pushes a file, or the files of a directory, as the layers of a manifest, and sets the digest of the artifact
+// to the digest of the manifest
+func (d *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			art := outputArtifact.OCI
+			log.Infof("OCI Save path: %s, repository: %s, tag: %s", path, art.Repository, art.GetTag())
+			digest, err := d.save(context.Background(), art, path)
+			if err != nil {
+				log.Warnf("Failed to push OCI artifact: %v", err)
+				return !isTransientOCIErr(err), err
+			}
+			log.Infof("Pushed OCI artifact %s@%s", art.Repository, digest)
+			art.Digest = digest
+			return true, nil
+		})
+}
+
+func (d *ArtifactDriver) save(ctx context.Context, art *wfv1.OCIArtifact, path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	files := map[string]string{}
+	if info.IsDir() {
+		err = filepath.Walk(path, func(localPath string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(path, localPath)
+			files[filepath.ToSlash(rel)] = localPath
+			return err
+		})
+		if err != nil {
+			return "", err
+		}
+	} else {
+		files[filepath.Base(path)] = path
+	}
+	c, err := newClient(ctx, art, d.DockerConfig)
+	if err != nil {
+		return "", err
+	}
+	m := manifest{
+		SchemaVersion: 2,
+		MediaType:     mediaTypeManifest,
+		Config:        descriptor{MediaType: mediaTypeEmpty, Digest: digestOf(emptyConfig), Size: int64(len(emptyConfig))},
+		Layers:        []descriptor{},
+		Annotations:   map[string]string{annotationCreated: time.Now().UTC().Format(time.RFC3339)},
+	}
+	for k, v := range art.Annotations {
+		m.Annotations[k] = v
+	}
+	if info.IsDir() {
+		m.Annotations[annotationDirectory] = "true"
+	}
+	for _, title := range sortedKeys(files) {
+		mediaType := art.MediaTypes[title]
+		if mediaType == "" {
+			mediaType = mediaTypeLayer
+		}
+		layer, err := fileDescriptor(files[title], descriptor{MediaType: mediaType, Annotations: map[string]string{annotationTitle: title}})
+		if err != nil {
+			return "", err
+		}
+		m.Layers = append(m.Layers, layer)
+	}
+	return c.push(art.GetTag(), m, files)
+}
+
+// fileDescriptor returns the descriptor of a layer with the digest and size of a local file
+func fileDescriptor(localPath string, layer descriptor) (descriptor, error) {
+	f, err := os.Open(filepath.Clean(localPath))
+	if err != nil {
+		return layer, err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	layer.Size, err = io.Copy(h, f)
+	if err != nil {
+		return layer, err
+	}
+	layer.Digest = "sha256:" + hex.EncodeToString(h.Sum(nil))
+	return layer, nil
+}
+
+// ListObjects returns the titles of the layers of the artifact
+func (d *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var titles []string
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			art := artifact.OCI
+			log.Infof("OCI List repository: %s, reference: %s", art.Repository, art.GetReference())
+			c, err := newClient(context.Background(), art, d.DockerConfig)
+			if err != nil {
+				return true, err
+			}
+			m, _, err := c.getManifest(art.GetReference())
+			if isNotFound(err) {
+				return true, notFound(art)
+			}
+			if err != nil {
+				return !isTransientOCIErr(err), err
+			}
+			titles = nil
+			for _, layer := range m.Layers {
+				if title := layer.title(); title != "" {
+					titles = append(titles, title)
+				}
+			}
+			return true, nil
+		})
+	return titles, err
+}
+
+// OpenStream opens a stream of the layer of a file artifact from the offset. A directory artifact cannot be streamed.
+func (d *ArtifactDriver) OpenStream(artifact *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	var stream *common.ArtifactStream
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			art := artifact.OCI
+			log.Infof("OCI OpenStream repository: %s, reference: %s, offset: %d", art.Repository, art.GetReference(), offset)
+			c, err := newClient(context.Background(), art, d.DockerConfig)
+			if err != nil {
+				return true, err
+			}
+			m, _, err := c.getManifest(art.GetReference())
+			if isNotFound(err) {
+				return true, notFound(art)
+			}
+			if err != nil {
+				return !isTransientOCIErr(err), err
+			}
+			if m.isDir() {
+				return true, common.ErrOpenStreamNotSupported
+			}
+			layer := m.Layers[0]
+			body, err := c.openBlob(layer.Digest, offset)
+			if err != nil {
+				return !isTransientOCIErr(err), err
+			}
+			stream = &common.ArtifactStream{ReadCloser: body, Size: layer.Size}
+			if layer.MediaType != mediaTypeLayer {
+				stream.ContentType = layer.MediaType
+			}
+			return true, nil
+		})
+	return stream, err
+}
+
+// Digest returns the digest of the manifest of the artifact
+func (d *ArtifactDriver) Digest(artifact *wfv1.Artifact) (string, error) {
+	art := artifact.OCI
+	if art.Digest != "" {
+		return "oci:" + art.Digest, nil
+	}
+	var digest string
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			c, err := newClient(context.Background(), art, d.DockerConfig)
+			if err != nil {
+				return true, err
+			}
+			digest, err = c.resolve(art.GetTag())
+			if isNotFound(err) {
+				return true, notFound(art)
+			}
+			if err != nil {
+				return !isTransientOCIErr(err), err
+			}
+			return true, nil
+		})
+	if err != nil {
+		return "", err
+	}
+	return "oci:" + digest, nil
+}
+
+// Delete deletes the manifest of the artifact. Its blobs are deleted by the garbage collection of the registry.
+func (d *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			art := artifact.OCI
+			log.Infof("OCI Delete repository: %s, reference: %s", art.Repository, art.GetReference())
+			c, err := newClient(context.Background(), art, d.DockerConfig)
+			if err != nil {
+				return true, err
+			}
+			digest, err := c.resolve(art.GetReference())
+			if err == nil {
+				err = c.deleteManifest(digest)
+			}
+			if isNotFound(err) {
+				return true, nil
+			}
+			if err != nil {
+				return !isTransientOCIErr(err), err
+			}
+			return true, nil
+		})
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
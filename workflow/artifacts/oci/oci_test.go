@@ -0,0 +1,340 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoErrors "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// fakeRegistry is a registry with token authentication, that keeps blobs and manifests in memory, for the errors that
+// a registry:2 cannot be made to return; the e2e tests run against a registry:2
+type fakeRegistry struct {
+	mu        sync.Mutex
+	url       string
+	blobs     map[string][]byte
+	manifests map[string][]byte
+	// uploads are the data of the upload sessions, by their location
+	uploads map[string][]byte
+}
+
+func newFakeRegistry(t *testing.T) (*fakeRegistry, *httptest.Server) {
+	r := &fakeRegistry{blobs: map[string][]byte{}, manifests: map[string][]byte{}, uploads: map[string][]byte{}}
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+	r.url = server.URL
+	return r, server
+}
+
+func (r *fakeRegistry) writeError(w http.ResponseWriter, statusCode int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = fmt.Fprintf(w, `{"errors":[{"code":%q,"message":%q}]}`, code, strings.ToLower(code))
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if req.URL.Path == "/token" {
+		username, password, ok := req.BasicAuth()
+		if !ok || username != "user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"token":%q}`, req.URL.Query().Get("scope"))
+		return
+	}
+	// the token is its scope
+	scope := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	actions := scope[strings.LastIndex(scope, ":")+1:]
+	write := req.Method != http.MethodGet && req.Method != http.MethodHead
+	if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") || (write && !strings.Contains(actions, "push") && !strings.Contains(actions, "delete")) {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry.test"`, r.url))
+		r.writeError(w, http.StatusUnauthorized, "UNAUTHORIZED")
+		return
+	}
+	p := req.URL.Path
+	switch {
+	case p == "/v2/":
+		return
+	case strings.Contains(p, "/blobs/uploads/"):
+		if req.Method == http.MethodPost {
+			location := fmt.Sprintf("%s%d", p, len(r.uploads)+1)
+			r.uploads[location] = []byte{}
+			w.Header().Set("Location", location)
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		data, ok := r.uploads[p]
+		if !ok {
+			r.writeError(w, http.StatusNotFound, "BLOB_UPLOAD_UNKNOWN")
+			return
+		}
+		chunk, _ := ioutil.ReadAll(req.Body)
+		data = append(data, chunk...)
+		r.uploads[p] = data
+		if req.Method == http.MethodPatch {
+			w.Header().Set("Location", p)
+			w.Header().Set("Range", fmt.Sprintf("0-%d", len(data)-1))
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		digest := req.URL.Query().Get("digest")
+		if digestOf(data) != digest {
+			r.writeError(w, http.StatusBadRequest, "DIGEST_INVALID")
+			return
+		}
+		r.blobs[digest] = data
+		w.WriteHeader(http.StatusCreated)
+	case strings.Contains(p, "/blobs/"):
+		data, ok := r.blobs[p[strings.LastIndex(p, "/")+1:]]
+		if !ok {
+			r.writeError(w, http.StatusNotFound, "BLOB_UNKNOWN")
+			return
+		}
+		http.ServeContent(w, req, "", time.Time{}, bytes.NewReader(data))
+	case strings.Contains(p, "/manifests/"):
+		name := p[len("/v2/"):strings.Index(p, "/manifests/")]
+		ref := p[strings.LastIndex(p, "/")+1:]
+		switch req.Method {
+		case http.MethodPut:
+			data, _ := ioutil.ReadAll(req.Body)
+			m := &manifest{}
+			if err := json.Unmarshal(data, m); err != nil {
+				r.writeError(w, http.StatusBadRequest, "MANIFEST_INVALID")
+				return
+			}
+			for _, layer := range append(m.Layers, m.Config) {
+				if _, ok := r.blobs[layer.Digest]; !ok {
+					r.writeError(w, http.StatusBadRequest, "MANIFEST_BLOB_UNKNOWN")
+					return
+				}
+			}
+			r.manifests[name+":"+ref] = data
+			r.manifests[name+"@"+digestOf(data)] = data
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			data, ok := r.manifests[name+"@"+ref]
+			if !ok {
+				r.writeError(w, http.StatusNotFound, "MANIFEST_UNKNOWN")
+				return
+			}
+			for key, d := range r.manifests {
+				if bytes.Equal(d, data) {
+					delete(r.manifests, key)
+				}
+			}
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			data, ok := r.manifests[name+":"+ref]
+			if !ok {
+				data, ok = r.manifests[name+"@"+ref]
+			}
+			if !ok {
+				r.writeError(w, http.StatusNotFound, "MANIFEST_UNKNOWN")
+				return
+			}
+			w.Header().Set("Content-Type", mediaTypeManifest)
+			w.Header().Set("Docker-Content-Digest", digestOf(data))
+			w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+			if req.Method == http.MethodGet {
+				_, _ = w.Write(data)
+			}
+		}
+	default:
+		r.writeError(w, http.StatusNotFound, "NAME_UNKNOWN")
+	}
+}
+
+func newArtifact(server *httptest.Server, tag string) *wfv1.Artifact {
+	return &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{OCI: &wfv1.OCIArtifact{
+		Repository: strings.TrimPrefix(server.URL, "http://") + "/models/bert",
+		Tag:        tag,
+		PlainHTTP:  true,
+	}}}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0o600))
+}
+
+func readFile(t *testing.T, path string) string {
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func testDockerConfig(server *httptest.Server) string {
+	auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+	return fmt.Sprintf(`{"auths":{%q:{"auth":%q}}}`, strings.TrimPrefix(server.URL, "http://"), auth)
+}
+
+func TestArtifactDriver(t *testing.T) {
+	r, server := newFakeRegistry(t)
+	driver := &ArtifactDriver{DockerConfig: testDockerConfig(server)}
+	tmp := t.TempDir()
+
+	t.Run("File", func(t *testing.T) {
+		writeFile(t, filepath.Join(tmp, "model.bin"), "hello")
+		art := newArtifact(server, "v1")
+		art.OCI.MediaTypes = map[string]string{"model.bin": "application/x-model"}
+		art.OCI.Annotations = map[string]string{"org.opencontainers.image.source": "https://example.com"}
+		require.NoError(t, driver.Save(filepath.Join(tmp, "model.bin"), art))
+		assert.Equal(t, digestOf(r.manifests["models/bert:v1"]), art.OCI.Digest)
+		m := &manifest{}
+		require.NoError(t, json.Unmarshal(r.manifests["models/bert:v1"], m))
+		assert.Equal(t, "https://example.com", m.Annotations["org.opencontainers.image.source"])
+		assert.NotEmpty(t, m.Annotations[annotationCreated])
+		require.Len(t, m.Layers, 1)
+		assert.Equal(t, "application/x-model", m.Layers[0].MediaType)
+		assert.Equal(t, "model.bin", m.Layers[0].title())
+
+		// by tag and by digest
+		require.NoError(t, driver.Load(newArtifact(server, "v1"), filepath.Join(tmp, "tag.bin")))
+		assert.Equal(t, "hello", readFile(t, filepath.Join(tmp, "tag.bin")))
+		require.NoError(t, driver.Load(art, filepath.Join(tmp, "digest.bin")))
+		assert.Equal(t, "hello", readFile(t, filepath.Join(tmp, "digest.bin")))
+
+		digest, err := driver.Digest(newArtifact(server, "v1"))
+		require.NoError(t, err)
+		assert.Equal(t, "oci:"+art.OCI.Digest, digest)
+	})
+
+	t.Run("OpenStream", func(t *testing.T) {
+		art := newArtifact(server, "v1")
+		for offset, content := range map[int64]string{0: "hello", 2: "llo"} {
+			stream, err := driver.OpenStream(art, offset)
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(stream)
+			require.NoError(t, err)
+			assert.NoError(t, stream.Close())
+			assert.Equal(t, content, string(data))
+			assert.Equal(t, int64(5), stream.Size)
+			assert.Equal(t, "application/x-model", stream.ContentType)
+		}
+		_, err := driver.OpenStream(newArtifact(server, "missing"), 0)
+		assert.True(t, argoErrors.IsCode(argoErrors.CodeNotFound, err))
+	})
+
+	t.Run("Directory", func(t *testing.T) {
+		writeFile(t, filepath.Join(tmp, "dir", "config.json"), "{}")
+		writeFile(t, filepath.Join(tmp, "dir", "weights", "a.bin"), "a")
+		art := newArtifact(server, "dir")
+		require.NoError(t, driver.Save(filepath.Join(tmp, "dir"), art))
+
+		require.NoError(t, driver.Load(art, filepath.Join(tmp, "loaded")))
+		assert.Equal(t, "{}", readFile(t, filepath.Join(tmp, "loaded", "config.json")))
+		assert.Equal(t, "a", readFile(t, filepath.Join(tmp, "loaded", "weights", "a.bin")))
+
+		objects, err := driver.ListObjects(art)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"config.json", "weights/a.bin"}, objects)
+
+		_, err = driver.OpenStream(art, 0)
+		assert.ErrorIs(t, err, common.ErrOpenStreamNotSupported)
+	})
+
+	t.Run("EmptyFile", func(t *testing.T) {
+		writeFile(t, filepath.Join(tmp, "empty.txt"), "")
+		art := newArtifact(server, "empty")
+		require.NoError(t, driver.Save(filepath.Join(tmp, "empty.txt"), art))
+		require.NoError(t, driver.Load(art, filepath.Join(tmp, "empty-loaded.txt")))
+		assert.Equal(t, "", readFile(t, filepath.Join(tmp, "empty-loaded.txt")))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		art := newArtifact(server, "empty")
+		require.NoError(t, driver.Delete(art))
+		err := driver.Load(art, filepath.Join(tmp, "deleted.txt"))
+		assert.True(t, argoErrors.IsCode(argoErrors.CodeNotFound, err))
+		// it is not an error if the artifact does not exist
+		assert.NoError(t, driver.Delete(art))
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		err := (&ArtifactDriver{}).Load(newArtifact(server, "v1"), filepath.Join(tmp, "unauthorized.txt"))
+		var ociErr *transport.Error
+		if assert.True(t, stderrors.As(err, &ociErr), "%v", err) {
+			assert.Equal(t, http.StatusUnauthorized, ociErr.StatusCode)
+		}
+	})
+}
+
+func TestLayerPath(t *testing.T) {
+	for title, expected := range map[string]string{
+		"":          "",
+		"a.txt":     "/tmp/a.txt",
+		"./a.txt":   "/tmp/a.txt",
+		"dir/a.txt": "/tmp/dir/a.txt",
+	} {
+		p, err := layerPath("/tmp", descriptor{Annotations: map[string]string{annotationTitle: title}})
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.FromSlash(expected), p)
+	}
+	for _, title := range []string{"../a.txt", "/a.txt", "dir/../../a.txt", "."} {
+		_, err := layerPath("/tmp", descriptor{Annotations: map[string]string{annotationTitle: title}})
+		assert.Error(t, err, title)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	for repository, expected := range map[string]string{
+		"alpine":                           "index.docker.io/library/alpine",
+		"argoproj/argoexec":                "index.docker.io/argoproj/argoexec",
+		"docker.io/argoproj/argoexec":      "index.docker.io/argoproj/argoexec",
+		"registry.example.com:5000/bert":   "registry.example.com:5000/bert",
+		"registry.example.com/models/bert": "registry.example.com/models/bert",
+	} {
+		c, err := newClient(context.Background(), &wfv1.OCIArtifact{Repository: repository}, "")
+		if assert.NoError(t, err, repository) {
+			assert.Equal(t, expected, c.repo.Name(), repository)
+			assert.Equal(t, "https", c.repo.Scheme(), repository)
+		}
+	}
+	c, err := newClient(context.Background(), &wfv1.OCIArtifact{Repository: "registry.example.com/models/bert", PlainHTTP: true}, "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "http", c.repo.Scheme())
+	}
+	_, err = newClient(context.Background(), &wfv1.OCIArtifact{Repository: "alpine@sha256:abc"}, "")
+	assert.Error(t, err)
+}
+
+func TestParseDockerConfig(t *testing.T) {
+	auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+	config := fmt.Sprintf(`{"auths":{"https://index.docker.io/v1/":{"auth":%q},"registry.example.com":{"username":"u","password":"p"}}}`, auth)
+	username, password, err := parseDockerConfig([]byte(config), "docker.io")
+	assert.NoError(t, err)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+	username, password, err = parseDockerConfig([]byte(config), "registry.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "u", username)
+	assert.Equal(t, "p", password)
+	username, _, err = parseDockerConfig([]byte(config), "other.example.com")
+	assert.NoError(t, err)
+	assert.Empty(t, username)
+	// a legacy .dockercfg
+	username, _, err = parseDockerConfig([]byte(fmt.Sprintf(`{"registry.example.com":{"auth":%q}}`, auth)), "registry.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "user", username)
+}
@@ -2,8 +2,10 @@ package oss
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -179,6 +181,52 @@ func (ossDriver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string,
 	return files, err
 }
 
+// OpenStream opens a stream of the object of the artifact from the offset
+func (ossDriver *ArtifactDriver) OpenStream(artifact *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	var stream *common.ArtifactStream
+	err := waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("OSS OpenStream key: %s, offset: %d", artifact.OSS.Key, offset)
+			osscli, err := ossDriver.newOSSClient()
+			if err != nil {
+				return !isTransientOSSErr(err), err
+			}
+			bucket, err := osscli.Bucket(artifact.OSS.Bucket)
+			if err != nil {
+				return !isTransientOSSErr(err), err
+			}
+			stream, err = openOssStream(bucket, artifact.OSS.Key, offset)
+			if err != nil {
+				return !isTransientOSSErr(err), err
+			}
+			return true, nil
+		})
+	return stream, err
+}
+
+func openOssStream(bucket *oss.Bucket, objectName string, offset int64) (*common.ArtifactStream, error) {
+	meta, err := bucket.GetObjectDetailedMeta(objectName)
+	if err != nil {
+		if serr, ok := err.(oss.ServiceError); ok && serr.StatusCode == http.StatusNotFound {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	size, err := strconv.ParseInt(meta.Get(oss.HTTPHeaderContentLength), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content length of %s: %w", objectName, err)
+	}
+	var options []oss.Option
+	if offset > 0 {
+		options = append(options, oss.NormalizedRange(fmt.Sprintf("%d-", offset)))
+	}
+	body, err := bucket.GetObject(objectName, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &common.ArtifactStream{ReadCloser: body, Size: size, ContentType: meta.Get(oss.HTTPHeaderContentType)}, nil
+}
+
 func setBucketLogging(client *oss.Client, bucketName string) error {
 	if os.Getenv(wfcommon.EnvVarArgoTrace) == "1" {
 		err := client.SetBucketLogging(bucketName, bucketName, bucketLogFilePrefix, true)
@@ -378,3 +426,35 @@ func ListOssDirectory(bucket *oss.Bucket, objectKey string) (files []string, err
 	}
 	return files, nil
 }
+
+// Delete deletes the object of the artifact, and the objects of the directory of the same key if there are any
+func (ossDriver *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return waitutil.Backoff(defaultRetry,
+		func() (bool, error) {
+			log.Infof("OSS Delete key: %s", artifact.OSS.Key)
+			osscli, err := ossDriver.newOSSClient()
+			if err != nil {
+				return !isTransientOSSErr(err), err
+			}
+			bucket, err := osscli.Bucket(artifact.OSS.Bucket)
+			if err != nil {
+				return !isTransientOSSErr(err), err
+			}
+			objectNames := []string{artifact.OSS.Key}
+			// an empty key would list the whole bucket
+			if artifact.OSS.Key != "" {
+				files, err := ListOssDirectory(bucket, artifact.OSS.Key)
+				if err != nil {
+					return !isTransientOSSErr(err), err
+				}
+				objectNames = append(objectNames, files...)
+			}
+			for _, objectName := range objectNames {
+				// deleting an object that does not exist is not an error
+				if err := bucket.DeleteObject(objectName); err != nil {
+					return !isTransientOSSErr(err), err
+				}
+			}
+			return true, nil
+		})
+}
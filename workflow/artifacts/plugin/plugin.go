@@ -0,0 +1,410 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+	plugins "github.com/argoproj/argo-workflows/v3/workflow/util/plugins"
+)
+
+// ArtifactDriver is the artifact driver of an artifact plugin. It runs the binary of the plugin if it has one, or else
+// calls the service of the plugin.
+type ArtifactDriver struct {
+	// Name is the name of the plugin
+	Name string
+	// Binary is the path of the binary of the plugin
+	Binary string
+	// Address is the base URL of the service of the plugin
+	Address string
+	// Token is the bearer token sent to the service
+	Token string
+	// Timeout is the maximum duration until the service responds to a request
+	Timeout time.Duration
+
+	client *http.Client
+}
+
+var _ common.ArtifactDriver = &ArtifactDriver{}
+
+var defaultRetry = wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1, Cap: time.Minute * 10}
+
+// binaryDir is the directory of the binaries that were copied into the pod
+var binaryDir = wfcommon.ArtifactPluginsPath
+
+// exitCodeNotFound is the exit code of a binary when the artifact does not exist
+const exitCodeNotFound = 3
+
+// Request is the request of an operation, which is the standard input of the binary, or the body of the call to the
+// service
+type Request struct {
+	// Artifact is the artifact, whose plugin location has the configuration and the key
+	Artifact *wfv1.Artifact `json:"artifact"`
+	// Path is the local path that the binary loads the artifact to, or saves it from
+	Path string `json:"path,omitempty"`
+	// Offset is the offset of the content that the service responds to a load with
+	Offset int64 `json:"offset,omitempty"`
+}
+
+// ListResponse is the response to a list of the keys of an artifact
+type ListResponse struct {
+	// Keys are the keys of the files of the artifact
+	Keys []string `json:"keys"`
+}
+
+// CreateDriver creates the driver of the plugin of an artifact. The binary of the plugin is used if it was copied into
+// the pod, or else the service in the config map of the plugin in the namespace.
+func CreateDriver(ctx context.Context, ri resource.Interface, art *wfv1.PluginArtifact) (*ArtifactDriver, error) {
+	binary := filepath.Join(binaryDir, art.Name)
+	if _, err := os.Stat(binary); err == nil {
+		return &ArtifactDriver{Name: art.Name, Binary: binary}, nil
+	}
+	data, err := ri.GetConfigMapKey(ctx, plugins.ArtifactPluginConfigMapName(art.Name), "service")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the service of artifact plugin %q: %w", art.Name, err)
+	}
+	if data == "" {
+		return nil, fmt.Errorf("artifact plugin %q has no service, and its binary is not in the pod", art.Name)
+	}
+	service := spec.Service{}
+	if err := yaml.UnmarshalStrict([]byte(data), &service); err != nil {
+		return nil, fmt.Errorf("the service of artifact plugin %q is invalid: %w", art.Name, err)
+	}
+	if err := service.Validate(); err != nil {
+		return nil, fmt.Errorf("the service of artifact plugin %q is invalid: %w", art.Name, err)
+	}
+	driver := &ArtifactDriver{
+		Name:    art.Name,
+		Address: strings.TrimSuffix(service.Address, "/"),
+		Timeout: service.GetTimeout(),
+	}
+	if service.Token != nil {
+		token, err := ri.GetSecret(ctx, service.Token.Name, service.Token.Key)
+		if err != nil {
+			return nil, err
+		}
+		driver.Token = token
+	}
+	return driver, nil
+}
+
+// Error is the error of a call that the service responded to with a status code that is not successful
+type Error struct {
+	Plugin     string
+	Method     string
+	StatusCode int
+	Status     string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("artifact plugin %s %s: %s: %s", e.Plugin, e.Method, e.Status, e.Message)
+}
+
+func isTransientErr(err error) bool {
+	var callErr *Error
+	if stderrors.As(err, &callErr) {
+		return callErr.StatusCode == http.StatusTooManyRequests || callErr.StatusCode == http.StatusServiceUnavailable
+	}
+	var urlErr *url.Error
+	if stderrors.As(err, &urlErr) {
+		return urlErr.Timeout() || strings.Contains(urlErr.Error(), "connection refused") || strings.Contains(urlErr.Error(), "connection reset")
+	}
+	return false
+}
+
+// Load loads an artifact to the path. The service is called for each file of a directory, with its key under the key
+// of the artifact.
+func (d *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	if d.Binary != "" {
+		_, err := d.run("load", Request{Artifact: inputArtifact, Path: path})
+		return err
+	}
+	return waitutil.Backoff(defaultRetry, func() (bool, error) {
+		err := d.load(inputArtifact, path)
+		return !isTransientErr(err), err
+	})
+}
+
+func (d *ArtifactDriver) load(art *wfv1.Artifact, localPath string) error {
+	err := d.loadFile(art, localPath)
+	if !errors.IsCode(errors.CodeNotFound, err) {
+		return err
+	}
+	keys, listErr := d.list(art)
+	if listErr != nil {
+		return listErr
+	}
+	prefix := strings.TrimSuffix(art.Plugin.Key, "/") + "/"
+	loaded := false
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		file := art.DeepCopy()
+		file.Plugin.Key = key
+		if err := d.loadFile(file, filepath.Join(localPath, strings.TrimPrefix(key, prefix))); err != nil {
+			return err
+		}
+		loaded = true
+	}
+	if !loaded {
+		return err
+	}
+	return nil
+}
+
+func (d *ArtifactDriver) loadFile(art *wfv1.Artifact, localPath string) error {
+	resp, err := d.call("artifact.load", Request{Artifact: art})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+		return err
+	}
+	file, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// Save saves the file or directory of the path to the artifact. The service is called for each file of a directory,
+// with its key under the key of the artifact.
+func (d *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	if d.Binary != "" {
+		_, err := d.run("save", Request{Artifact: outputArtifact, Path: path})
+		return err
+	}
+	return waitutil.Backoff(defaultRetry, func() (bool, error) {
+		err := d.save(path, outputArtifact)
+		return !isTransientErr(err), err
+	})
+}
+
+func (d *ArtifactDriver) save(localPath string, art *wfv1.Artifact) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return d.saveFile(localPath, art)
+	}
+	return filepath.Walk(localPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(localPath, filePath)
+		if err != nil {
+			return err
+		}
+		file := art.DeepCopy()
+		file.Plugin.Key = path.Join(art.Plugin.Key, filepath.ToSlash(rel))
+		return d.saveFile(filePath, file)
+	})
+}
+
+// saveFile calls the service with a multipart body of the request, followed by the content of the file
+func (d *ArtifactDriver) saveFile(localPath string, art *wfv1.Artifact) error {
+	request, err := json.Marshal(Request{Artifact: art})
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(filepath.Clean(localPath))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+	body, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	go func() {
+		err := form.WriteField("request", string(request))
+		if err == nil {
+			var part io.Writer
+			part, err = form.CreateFormFile("content", filepath.Base(localPath))
+			if err == nil {
+				_, err = io.Copy(part, file)
+			}
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		_ = writer.CloseWithError(err)
+	}()
+	resp, err := d.do("artifact.save", form.FormDataContentType(), body)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// ListObjects lists the keys of the files of the artifact
+func (d *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	if d.Binary != "" {
+		output, err := d.run("list", Request{Artifact: artifact})
+		if err != nil {
+			return nil, err
+		}
+		return parseKeys(output)
+	}
+	var keys []string
+	err := waitutil.Backoff(defaultRetry, func() (bool, error) {
+		var err error
+		keys, err = d.list(artifact)
+		return !isTransientErr(err), err
+	})
+	return keys, err
+}
+
+func (d *ArtifactDriver) list(art *wfv1.Artifact) ([]string, error) {
+	resp, err := d.call("artifact.list", Request{Artifact: art})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	output, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parseKeys(output)
+}
+
+func parseKeys(output []byte) ([]string, error) {
+	response := ListResponse{}
+	if err := json.Unmarshal(output, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse the keys: %w", err)
+	}
+	return response.Keys, nil
+}
+
+// Delete deletes the artifact, the plugin deletes the files of a directory
+func (d *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	var err error
+	if d.Binary != "" {
+		_, err = d.run("delete", Request{Artifact: artifact})
+	} else {
+		err = waitutil.Backoff(defaultRetry, func() (bool, error) {
+			resp, err := d.call("artifact.delete", Request{Artifact: artifact})
+			if err != nil {
+				return !isTransientErr(err), err
+			}
+			return true, resp.Body.Close()
+		})
+	}
+	if errors.IsCode(errors.CodeNotFound, err) {
+		return nil
+	}
+	return err
+}
+
+// OpenStream opens a stream of the response of the service to a load from the offset. The response must have a
+// Content-Length.
+func (d *ArtifactDriver) OpenStream(artifact *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	if d.Binary != "" {
+		return nil, common.ErrOpenStreamNotSupported
+	}
+	resp, err := d.call("artifact.load", Request{Artifact: artifact, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+	if resp.ContentLength < 0 {
+		_ = resp.Body.Close()
+		return nil, common.ErrOpenStreamNotSupported
+	}
+	return &common.ArtifactStream{ReadCloser: resp.Body, Size: offset + resp.ContentLength, ContentType: resp.Header.Get("Content-Type")}, nil
+}
+
+// run runs the binary with the operation as its argument and the JSON of the request as its standard input, and
+// returns its standard output
+func (d *ArtifactDriver) run(operation string, request Request) ([]byte, error) {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{"plugin": d.Name, "operation": operation}).Info("Running artifact plugin")
+	cmd := exec.Command(d.Binary, operation)
+	cmd.Stdin = bytes.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if stderr.Len() > 0 {
+		log.WithField("plugin", d.Name).Info(stderr.String())
+	}
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		var exitErr *exec.ExitError
+		if stderrors.As(err, &exitErr) && exitErr.ExitCode() == exitCodeNotFound {
+			return nil, errors.Errorf(errors.CodeNotFound, "artifact plugin %s %s: %s", d.Name, operation, message)
+		}
+		return nil, fmt.Errorf("artifact plugin %s %s failed: %w: %s", d.Name, operation, err, message)
+	}
+	return stdout.Bytes(), nil
+}
+
+// call calls a method of the service with the JSON of the request
+func (d *ArtifactDriver) call(method string, request Request) (*http.Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	return d.do(method, "application/json", bytes.NewReader(body))
+}
+
+// do posts the body to the method of the service, and returns the response if it is successful. A 404 is a
+// not-found error.
+func (d *ArtifactDriver) do(method, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/%s", d.Address, method), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if d.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+d.Token)
+	}
+	if d.client == nil {
+		d.client = &http.Client{Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, ResponseHeaderTimeout: d.Timeout}}
+	}
+	log.WithFields(log.Fields{"plugin": d.Name, "method": method}).Debug("Calling artifact plugin")
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.Errorf(errors.CodeNotFound, "artifact plugin %s %s: %s", d.Name, method, strings.TrimSpace(string(message)))
+	}
+	return nil, &Error{Plugin: d.Name, Method: method, StatusCode: resp.StatusCode, Status: resp.Status, Message: strings.TrimSpace(string(message))}
+}
@@ -0,0 +1,266 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// fakeService is a service of an artifact plugin that stores the content of the keys in memory
+type fakeService struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	token   string
+	// configurations are the configurations of the requests
+	configurations []string
+}
+
+func (s *fakeService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.Header.Get("Authorization") != "Bearer "+s.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	request := Request{}
+	var content []byte
+	if r.URL.Path == "/api/v1/artifact.save" {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		file, _, err := r.FormFile("content")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		content, _ = io.ReadAll(file)
+		if err := json.Unmarshal([]byte(r.FormValue("request")), &request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	key := request.Artifact.Plugin.Key
+	s.configurations = append(s.configurations, request.Artifact.Plugin.Configuration)
+	switch r.URL.Path {
+	case "/api/v1/artifact.load":
+		data, ok := s.objects[key]
+		if !ok {
+			http.Error(w, key+" not found", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data[request.Offset:])
+	case "/api/v1/artifact.save":
+		s.objects[key] = content
+	case "/api/v1/artifact.list":
+		response := ListResponse{Keys: []string{}}
+		for k := range s.objects {
+			if k == key || strings.HasPrefix(k, key+"/") {
+				response.Keys = append(response.Keys, k)
+			}
+		}
+		sort.Strings(response.Keys)
+		_ = json.NewEncoder(w).Encode(response)
+	case "/api/v1/artifact.delete":
+		for k := range s.objects {
+			if k == key || strings.HasPrefix(k, key+"/") {
+				delete(s.objects, k)
+			}
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newArtifact(key string) *wfv1.Artifact {
+	return &wfv1.Artifact{
+		Name: "my-art",
+		ArtifactLocation: wfv1.ArtifactLocation{
+			Plugin: &wfv1.PluginArtifact{Name: "my-plugin", Configuration: "bucket: my-bucket", Key: key},
+		},
+	}
+}
+
+func TestArtifactDriver_Service(t *testing.T) {
+	service := &fakeService{objects: map[string][]byte{}, token: "my-token"}
+	server := httptest.NewServer(service)
+	defer server.Close()
+	driver := &ArtifactDriver{Name: "my-plugin", Address: server.URL, Token: "my-token", Timeout: time.Second}
+	tmp := t.TempDir()
+
+	t.Run("File", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "file"), []byte("my-content"), 0o600))
+		require.NoError(t, driver.Save(filepath.Join(tmp, "file"), newArtifact("my-file")))
+		assert.Equal(t, []byte("my-content"), service.objects["my-file"])
+
+		require.NoError(t, driver.Load(newArtifact("my-file"), filepath.Join(tmp, "loaded-file")))
+		data, err := ioutil.ReadFile(filepath.Join(tmp, "loaded-file"))
+		require.NoError(t, err)
+		assert.Equal(t, "my-content", string(data))
+	})
+	t.Run("Directory", func(t *testing.T) {
+		require.NoError(t, os.MkdirAll(filepath.Join(tmp, "dir", "sub"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "dir", "a"), []byte("a"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, "dir", "sub", "b"), []byte("b"), 0o600))
+		require.NoError(t, driver.Save(filepath.Join(tmp, "dir"), newArtifact("my-dir")))
+
+		keys, err := driver.ListObjects(newArtifact("my-dir"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-dir/a", "my-dir/sub/b"}, keys)
+
+		require.NoError(t, driver.Load(newArtifact("my-dir"), filepath.Join(tmp, "loaded-dir")))
+		data, err := ioutil.ReadFile(filepath.Join(tmp, "loaded-dir", "sub", "b"))
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(data))
+
+		require.NoError(t, driver.Delete(newArtifact("my-dir")))
+		assert.NotContains(t, service.objects, "my-dir/a")
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		err := driver.Load(newArtifact("missing"), filepath.Join(tmp, "missing"))
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err), "%v", err)
+		assert.NoError(t, driver.Delete(newArtifact("missing")))
+	})
+	t.Run("OpenStream", func(t *testing.T) {
+		stream, err := driver.OpenStream(newArtifact("my-file"), 3)
+		require.NoError(t, err)
+		defer func() { _ = stream.Close() }()
+		assert.Equal(t, int64(len("my-content")), stream.Size)
+		data, err := io.ReadAll(stream)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(data))
+	})
+	t.Run("Unauthorized", func(t *testing.T) {
+		driver := &ArtifactDriver{Name: "my-plugin", Address: server.URL}
+		_, err := driver.ListObjects(newArtifact("my-file"))
+		assert.EqualError(t, err, "artifact plugin my-plugin artifact.list: 401 Unauthorized: ")
+	})
+	assert.Contains(t, service.configurations, "bucket: my-bucket")
+}
+
+func TestArtifactDriver_ServiceRetry(t *testing.T) {
+	defer func(backoff wait.Backoff) { defaultRetry = backoff }(defaultRetry)
+	defaultRetry = wait.Backoff{Duration: time.Millisecond, Steps: 3}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"keys": ["my-key"]}`))
+	}))
+	defer server.Close()
+	keys, err := (&ArtifactDriver{Name: "my-plugin", Address: server.URL}).ListObjects(newArtifact("my-key"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-key"}, keys)
+	assert.Equal(t, 3, requests)
+}
+
+func TestArtifactDriver_Binary(t *testing.T) {
+	tmp := t.TempDir()
+	binary := filepath.Join(tmp, "my-plugin")
+	// the plugin records its request, and fails the load of a missing key
+	require.NoError(t, os.WriteFile(binary, []byte(`#!/bin/sh
+cat > "$(dirname "$0")/$1.json"
+case "$1" in
+load) echo "missing not found" >&2; exit 3 ;;
+list) echo '{"keys": ["my-key/a", "my-key/b"]}' ;;
+delete) echo "permission denied" >&2; exit 1 ;;
+esac
+`), 0o700))
+	driver := &ArtifactDriver{Name: "my-plugin", Binary: binary}
+
+	t.Run("Save", func(t *testing.T) {
+		require.NoError(t, driver.Save("/tmp/my-path", newArtifact("my-key")))
+		request := Request{}
+		data, err := ioutil.ReadFile(filepath.Join(tmp, "save.json"))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &request))
+		assert.Equal(t, Request{Artifact: newArtifact("my-key"), Path: "/tmp/my-path"}, request)
+	})
+	t.Run("List", func(t *testing.T) {
+		keys, err := driver.ListObjects(newArtifact("my-key"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-key/a", "my-key/b"}, keys)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		err := driver.Load(newArtifact("missing"), "/tmp/my-path")
+		assert.True(t, errors.IsCode(errors.CodeNotFound, err), "%v", err)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		assert.EqualError(t, driver.Delete(newArtifact("my-key")), "artifact plugin my-plugin delete failed: exit status 1: permission denied")
+	})
+	t.Run("OpenStream", func(t *testing.T) {
+		_, err := driver.OpenStream(newArtifact("my-key"), 0)
+		assert.Equal(t, common.ErrOpenStreamNotSupported, err)
+	})
+}
+
+func TestCreateDriver(t *testing.T) {
+	ctx := context.Background()
+	ri := resource.New(fake.NewSimpleClientset(
+		&apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-plugin-artifact-plugin", Namespace: "my-ns"},
+			Data: map[string]string{
+				"binary":  "{image: my-image, path: /bin/my-plugin}",
+				"service": "{address: 'http://my-plugin:4355/', token: {name: my-secret, key: token}}",
+			},
+		},
+		&apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "binary-only-artifact-plugin", Namespace: "my-ns"},
+			Data:       map[string]string{"binary": "{image: my-image, path: /bin/my-plugin}"},
+		},
+		&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "my-ns"},
+			Data:       map[string][]byte{"token": []byte("my-token")},
+		},
+	), "my-ns")
+	defer func(dir string) { binaryDir = dir }(binaryDir)
+	binaryDir = t.TempDir()
+
+	t.Run("Service", func(t *testing.T) {
+		driver, err := CreateDriver(ctx, ri, &wfv1.PluginArtifact{Name: "my-plugin"})
+		require.NoError(t, err)
+		assert.Equal(t, &ArtifactDriver{Name: "my-plugin", Address: "http://my-plugin:4355", Token: "my-token", Timeout: 30 * time.Second}, driver)
+	})
+	t.Run("NoService", func(t *testing.T) {
+		_, err := CreateDriver(ctx, ri, &wfv1.PluginArtifact{Name: "binary-only"})
+		assert.EqualError(t, err, `artifact plugin "binary-only" has no service, and its binary is not in the pod`)
+	})
+	t.Run("Binary", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(binaryDir, "binary-only"), nil, 0o700))
+		driver, err := CreateDriver(ctx, ri, &wfv1.PluginArtifact{Name: "binary-only"})
+		require.NoError(t, err)
+		assert.Equal(t, &ArtifactDriver{Name: "binary-only", Binary: filepath.Join(binaryDir, "binary-only")}, driver)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := CreateDriver(ctx, ri, &wfv1.PluginArtifact{Name: "missing"})
+		assert.EqualError(t, err, `failed to get the service of artifact plugin "missing": configmaps "missing-artifact-plugin" not found`)
+	})
+}
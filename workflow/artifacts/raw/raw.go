@@ -1,6 +1,8 @@
 package raw
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 
@@ -11,7 +13,10 @@ import (
 
 type ArtifactDriver struct{}
 
-var _ common.ArtifactDriver = &ArtifactDriver{}
+var (
+	_ common.ArtifactDriver   = &ArtifactDriver{}
+	_ common.ArtifactDigester = &ArtifactDriver{}
+)
 
 // Store raw content as artifact
 func (a *ArtifactDriver) Load(artifact *wfv1.Artifact, path string) error {
@@ -35,3 +40,19 @@ func (g *ArtifactDriver) Save(string, *wfv1.Artifact) error {
 func (a *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
 	return nil, fmt.Errorf("ListObjects is currently not supported for this artifact type, but it will be in a future version")
 }
+
+// Digest returns the SHA-256 of the raw content
+func (a *ArtifactDriver) Digest(artifact *wfv1.Artifact) (string, error) {
+	sum := sha256.Sum256([]byte(artifact.Raw.Data))
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// Delete is unsupported, raw artifacts are part of the workflow
+func (a *ArtifactDriver) Delete(*wfv1.Artifact) error {
+	return common.ErrDeleteNotSupported
+}
+
+// OpenStream is unsupported, raw artifacts are loaded to a file instead
+func (a *ArtifactDriver) OpenStream(*wfv1.Artifact, int64) (*common.ArtifactStream, error) {
+	return nil, common.ErrOpenStreamNotSupported
+}
@@ -35,3 +35,10 @@ func TestLoad(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, content, string(dat))
 }
+
+func TestDigest(t *testing.T) {
+	driver := &raw.ArtifactDriver{}
+	digest, err := driver.Digest(&wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "foo"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", digest)
+}
@@ -2,12 +2,16 @@ package s3
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/argoproj/pkg/file"
 	argos3 "github.com/argoproj/pkg/s3"
 	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
@@ -34,16 +38,24 @@ type ArtifactDriver struct {
 	ServerSideCustomerKey string
 }
 
-var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
+var (
+	_ artifactscommon.ArtifactDriver   = &ArtifactDriver{}
+	_ artifactscommon.ArtifactDigester = &ArtifactDriver{}
+)
 
 // newMinioClient instantiates a new minio client object.
 func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Client, error) {
-	opts := argos3.S3ClientOpts{
+	return argos3.NewS3Client(ctx, s3Driver.clientOpts())
+}
+
+// clientOpts returns the options of the clients of the driver, both argos3.S3Client and the minio client
+func (s3Driver *ArtifactDriver) clientOpts() argos3.S3ClientOpts {
+	return argos3.S3ClientOpts{
 		Endpoint:    s3Driver.Endpoint,
 		Region:      s3Driver.Region,
 		Secure:      s3Driver.Secure,
-		AccessKey:   s3Driver.AccessKey,
-		SecretKey:   s3Driver.SecretKey,
+		AccessKey:   strings.TrimSpace(s3Driver.AccessKey),
+		SecretKey:   strings.TrimSpace(s3Driver.SecretKey),
 		RoleARN:     s3Driver.RoleARN,
 		Trace:       os.Getenv(common.EnvVarArgoTrace) == "1",
 		UseSDKCreds: s3Driver.UseSDKCreds,
@@ -54,8 +66,6 @@ func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Clien
 			ServerSideCustomerKey: s3Driver.ServerSideCustomerKey,
 		},
 	}
-
-	return argos3.NewS3Client(ctx, opts)
 }
 
 // Load downloads artifacts from S3 compliant storage
@@ -173,3 +183,156 @@ func (s3Driver *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string,
 
 	return files, err
 }
+
+// Digest returns the ETag of the object of the artifact, or a hash of the ETags of the objects if it is a directory
+func (s3Driver *ArtifactDriver) Digest(artifact *wfv1.Artifact) (string, error) {
+	if s3Driver.ServerSideCustomerKey != "" {
+		// the metadata of objects encrypted with a customer key can only be read with the key
+		return "", nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var digest string
+	err := waitutil.Backoff(executorretry.ExecutorRetry,
+		func() (bool, error) {
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				return !isTransientS3Err(err), fmt.Errorf("failed to create new S3 client: %v", err)
+			}
+			digest, err = digestS3Artifact(ctx, minioClient, artifact.S3.Bucket, artifact.S3.Key)
+			if err != nil {
+				return !isTransientS3Err(err), fmt.Errorf("failed to get the digest of %s: %v", artifact.S3.Key, err)
+			}
+			return true, nil
+		})
+	return digest, err
+}
+
+// OpenStream opens a stream of the object of the artifact from the offset
+func (s3Driver *ArtifactDriver) OpenStream(artifact *wfv1.Artifact, offset int64) (*artifactscommon.ArtifactStream, error) {
+	var stream *artifactscommon.ArtifactStream
+	err := waitutil.Backoff(executorretry.ExecutorRetry,
+		func() (bool, error) {
+			log.Infof("S3 OpenStream key: %s, offset: %d", artifact.S3.Key, offset)
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				return !isTransientS3Err(err), fmt.Errorf("failed to create new S3 client: %v", err)
+			}
+			stream, err = openS3Stream(context.Background(), minioClient, artifact.S3.Bucket, artifact.S3.Key, offset, s3Driver.ServerSideCustomerKey)
+			if err != nil {
+				return !isTransientS3Err(err), err
+			}
+			return true, nil
+		})
+	return stream, err
+}
+
+func openS3Stream(ctx context.Context, minioClient *minio.Client, bucket, key string, offset int64, serverSideCustomerKey string) (*artifactscommon.ArtifactStream, error) {
+	statOpts := minio.StatObjectOptions{}
+	getOpts := minio.GetObjectOptions{}
+	if serverSideCustomerKey != "" {
+		// the same key derivation as argos3.S3Client uses to put objects
+		encryption := encrypt.DefaultPBKDF([]byte(serverSideCustomerKey), []byte(bucket+key))
+		statOpts.ServerSideEncryption = encryption
+		getOpts.ServerSideEncryption = encryption
+	}
+	info, err := minioClient.StatObject(ctx, bucket, key, statOpts)
+	if err != nil {
+		if argos3.IsS3ErrCode(err, "NoSuchKey") {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, fmt.Errorf("failed to stat %s: %w", key, err)
+	}
+	if offset > 0 {
+		if err := getOpts.SetRange(offset, 0); err != nil {
+			return nil, err
+		}
+	}
+	object, err := minioClient.GetObject(ctx, bucket, key, getOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	return &artifactscommon.ArtifactStream{ReadCloser: object, Size: info.Size, ContentType: info.ContentType}, nil
+}
+
+// newMinioClient instantiates a minio client, for the operations that argos3.S3Client does not provide, from the same
+// options as argos3.NewS3Client, which does not expose its own
+func (s3Driver *ArtifactDriver) newMinioClient() (*minio.Client, error) {
+	opts := s3Driver.clientOpts()
+	credentials, err := argos3.GetCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
+	minioClient, err := minio.New(opts.Endpoint, &minio.Options{Creds: credentials, Secure: opts.Secure, Region: opts.Region})
+	if err != nil {
+		return nil, err
+	}
+	if opts.Trace {
+		minioClient.TraceOn(log.StandardLogger().Out)
+	}
+	return minioClient, nil
+}
+
+func digestS3Artifact(ctx context.Context, minioClient *minio.Client, bucket, key string) (string, error) {
+	info, origErr := minioClient.StatObject(ctx, bucket, key, minio.StatObjectOptions{})
+	if origErr == nil {
+		return "s3:" + info.ETag, nil
+	}
+	if !argos3.IsS3ErrCode(origErr, "NoSuchKey") {
+		return "", origErr
+	}
+	// the key might be a "directory", whose objects are listed in lexical order
+	prefix := strings.TrimSuffix(key, "/") + "/"
+	h := sha256.New()
+	found := false
+	for object := range minioClient.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return "", object.Err
+		}
+		found = true
+		_, _ = fmt.Fprintf(h, "%s:%s\n", strings.TrimPrefix(object.Key, prefix), object.ETag)
+	}
+	if !found {
+		return "", errors.New(errors.CodeNotFound, origErr.Error())
+	}
+	return "s3-dir:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Delete deletes the object of the artifact, or the objects of the directory of the key if there is no such object
+func (s3Driver *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	return waitutil.Backoff(executorretry.ExecutorRetry,
+		func() (bool, error) {
+			log.Infof("S3 Delete key: %s", artifact.S3.Key)
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				return !isTransientS3Err(err), fmt.Errorf("failed to create new S3 client: %v", err)
+			}
+			if err := deleteS3Artifact(ctx, minioClient, artifact.S3.Bucket, artifact.S3.Key); err != nil {
+				return !isTransientS3Err(err), fmt.Errorf("failed to delete %s: %v", artifact.S3.Key, err)
+			}
+			return true, nil
+		})
+}
+
+// deleteS3Artifact deletes the object of the key, or else the objects of the key as a directory, so that the objects
+// of another artifact whose key starts with the key of a file artifact are kept
+func deleteS3Artifact(ctx context.Context, minioClient *minio.Client, bucket, key string) error {
+	_, err := minioClient.StatObject(ctx, bucket, key, minio.StatObjectOptions{})
+	if err == nil {
+		return minioClient.RemoveObject(ctx, bucket, key, minio.RemoveObjectOptions{})
+	}
+	if !argos3.IsS3ErrCode(err, "NoSuchKey") {
+		return err
+	}
+	objects := minioClient.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: strings.TrimSuffix(key, "/") + "/", Recursive: true})
+	for result := range minioClient.RemoveObjects(ctx, bucket, objects, minio.RemoveObjectsOptions{}) {
+		if result.Err != nil {
+			return result.Err
+		}
+	}
+	return nil
+}
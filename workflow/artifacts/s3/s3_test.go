@@ -402,3 +402,12 @@ func TestSaveS3Artifact(t *testing.T) {
 		_ = os.Unsetenv(transientEnvVarKey)
 	}
 }
+
+func TestNewMinioClient(t *testing.T) {
+	driver := &ArtifactDriver{Endpoint: "my-endpoint:9000", Secure: true, Region: "my-region", AccessKey: " my-access-key\n", SecretKey: "my-secret-key"}
+	assert.Equal(t, "my-access-key", driver.clientOpts().AccessKey)
+	minioClient, err := driver.newMinioClient()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "https://my-endpoint:9000", minioClient.EndpointURL().String())
+	}
+}
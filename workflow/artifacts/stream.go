@@ -0,0 +1,102 @@
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// Stream reads the content of an artifact from the stream of its driver. Seeking reopens the stream at the new offset
+// when it is next read, so only the parts of the content that are read are downloaded.
+type Stream struct {
+	driver common.ArtifactDriver
+	art    *wfv1.Artifact
+	stream *common.ArtifactStream
+	// streamOffset is the offset the stream is at
+	streamOffset int64
+	offset       int64
+	// Size is the size of the content of the artifact
+	Size int64
+	// ContentType is the media type of the content, or empty if the storage does not know it
+	ContentType string
+}
+
+var (
+	_ io.ReadSeekCloser = &Stream{}
+	_ io.ReaderAt       = &Stream{}
+)
+
+// OpenStream opens a stream of the content of an artifact, it returns common.ErrOpenStreamNotSupported if the driver
+// cannot stream it
+func OpenStream(driver common.ArtifactDriver, art *wfv1.Artifact) (*Stream, error) {
+	stream, err := driver.OpenStream(art, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &Stream{driver: driver, art: art, stream: stream, Size: stream.Size, ContentType: stream.ContentType}, nil
+}
+
+func (s *Stream) Read(p []byte) (int, error) {
+	if s.offset >= s.Size {
+		return 0, io.EOF
+	}
+	if s.stream != nil && s.streamOffset != s.offset {
+		if err := s.Close(); err != nil {
+			return 0, err
+		}
+	}
+	if s.stream == nil {
+		stream, err := s.driver.OpenStream(s.art, s.offset)
+		if err != nil {
+			return 0, err
+		}
+		s.stream = stream
+		s.streamOffset = s.offset
+	}
+	n, err := s.stream.Read(p)
+	s.offset += int64(n)
+	s.streamOffset = s.offset
+	return n, err
+}
+
+func (s *Stream) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.offset
+	case io.SeekEnd:
+		offset += s.Size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.New("negative offset")
+	}
+	s.offset = offset
+	return offset, nil
+}
+
+// ReadAt reads from an offset without changing the offset of the stream. The stream is reused by consecutive reads, so
+// reading a part of the content sequentially is a single request. It must not be called concurrently.
+func (s *Stream) ReadAt(p []byte, off int64) (int, error) {
+	offset := s.offset
+	defer func() { s.offset = offset }()
+	s.offset = off
+	n, err := io.ReadFull(s, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (s *Stream) Close() error {
+	if s.stream == nil {
+		return nil
+	}
+	err := s.stream.Close()
+	s.stream = nil
+	return err
+}
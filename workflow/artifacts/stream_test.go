@@ -0,0 +1,87 @@
+package executor
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// streamDriver streams its data, and records the offsets it is streamed from
+type streamDriver struct {
+	dirDriver
+	data    []byte
+	offsets []int64
+}
+
+func (d *streamDriver) OpenStream(_ *wfv1.Artifact, offset int64) (*common.ArtifactStream, error) {
+	d.offsets = append(d.offsets, offset)
+	return &common.ArtifactStream{ReadCloser: ioutil.NopCloser(bytes.NewReader(d.data[offset:])), Size: int64(len(d.data)), ContentType: "text/plain"}, nil
+}
+
+func TestOpenStream(t *testing.T) {
+	t.Run("NotSupported", func(t *testing.T) {
+		_, err := OpenStream(&dirDriver{}, &wfv1.Artifact{})
+		assert.Equal(t, common.ErrOpenStreamNotSupported, err)
+	})
+	t.Run("Read", func(t *testing.T) {
+		driver := &streamDriver{data: []byte("hello world")}
+		s, err := OpenStream(driver, &wfv1.Artifact{})
+		require.NoError(t, err)
+		defer func() { _ = s.Close() }()
+		assert.Equal(t, int64(11), s.Size)
+		assert.Equal(t, "text/plain", s.ContentType)
+		size, err := s.Seek(0, io.SeekEnd)
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), size)
+		_, err = s.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(s)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(data))
+		assert.Equal(t, []int64{0}, driver.offsets, "the stream is reused when the offset did not change")
+	})
+	t.Run("Seek", func(t *testing.T) {
+		driver := &streamDriver{data: []byte("hello world")}
+		s, err := OpenStream(driver, &wfv1.Artifact{})
+		require.NoError(t, err)
+		defer func() { _ = s.Close() }()
+		_, err = s.Seek(6, io.SeekStart)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(io.LimitReader(s, 3))
+		require.NoError(t, err)
+		assert.Equal(t, "wor", string(data))
+		_, err = s.Seek(-1, io.SeekCurrent)
+		require.NoError(t, err)
+		data, err = ioutil.ReadAll(s)
+		require.NoError(t, err)
+		assert.Equal(t, "rld", string(data))
+		assert.Equal(t, []int64{0, 6, 8}, driver.offsets)
+		_, err = s.Seek(-1, io.SeekStart)
+		assert.Error(t, err)
+	})
+}
+
+func TestStream_ReadAt(t *testing.T) {
+	driver := &streamDriver{data: []byte("hello world")}
+	s, err := OpenStream(driver, &wfv1.Artifact{})
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+	p := make([]byte, 3)
+	n, err := s.ReadAt(p, 6)
+	require.NoError(t, err)
+	assert.Equal(t, "wor", string(p[:n]))
+	n, err = s.ReadAt(p, 9)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, "ld", string(p[:n]))
+	assert.Equal(t, []int64{0, 6}, driver.offsets, "consecutive reads reuse the stream")
+	data, err := ioutil.ReadAll(s)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data), "the offset of the stream is not changed")
+}
@@ -33,6 +33,12 @@ const (
 
 	// AnnotationKeyOutputs is the pod metadata annotation key containing the container outputs
 	AnnotationKeyOutputs = workflow.WorkflowFullName + "/outputs"
+	// AnnotationKeyContainerOutputs is the pod metadata annotation key containing the outputs of the containers of a
+	// container set, keyed by container name
+	AnnotationKeyContainerOutputs = workflow.WorkflowFullName + "/container-outputs"
+	// AnnotationKeyCreatedResources is the pod annotation used to record the resources created by a resource template
+	// with a cleanup policy
+	AnnotationKeyCreatedResources = workflow.WorkflowFullName + "/created-resources"
 	// AnnotationKeyCronWfScheduledTime is the workflow metadata annotation key containing the time when the workflow
 	// was scheduled to run by CronWorkflow.
 	AnnotationKeyCronWfScheduledTime = workflow.WorkflowFullName + "/scheduled-time"
@@ -48,6 +54,10 @@ const (
 	// AnnotationKeyProgress is N/M progress for the node
 	AnnotationKeyProgress = workflow.WorkflowFullName + "/progress"
 
+	// AnnotationKeyMessage is the message of the node reported by the executor, such as the statistics of the clones of
+	// its git input artifacts
+	AnnotationKeyMessage = workflow.WorkflowFullName + "/message"
+
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
 	LabelKeyControllerInstanceID = workflow.WorkflowFullName + "/controller-instanceid"
@@ -81,6 +91,16 @@ const (
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
 	LabelKeyOnExit = workflow.WorkflowFullName + "/on-exit"
+	// LabelKeyArtifactGCStrategy is a label applied to the pods that delete the output artifacts of a workflow, whose
+	// value is the artifact GC strategy of the artifacts they delete
+	LabelKeyArtifactGCStrategy = workflow.WorkflowFullName + "/artifact-gc-strategy"
+
+	// FinalizerArtifactGC is the finalizer on workflows whose output artifacts are deleted by artifact GC, so that
+	// they are not deleted before their artifacts are
+	FinalizerArtifactGC = workflow.WorkflowFullName + "/artifact-gc"
+	// FinalizerResourceCleanup is the finalizer on workflows whose created resources are deleted by the controller, so
+	// that they are not deleted before their resources are
+	FinalizerResourceCleanup = workflow.WorkflowFullName + "/resource-cleanup"
 
 	// ExecutorArtifactBaseDir is the base directory in the init container in which artifacts will be copied to.
 	// Each artifact will be named according to its input name (e.g: /argo/inputs/artifacts/CODE)
@@ -107,6 +127,8 @@ const (
 	EnvVarWorkflowName = "ARGO_WORKFLOW_NAME"
 	// EnvVarPluginAddresses is a list of plugin addresses
 	EnvVarPluginAddresses = "ARGO_PLUGIN_ADDRESSES"
+	// EnvVarPluginServices is a list of shared plugin services
+	EnvVarPluginServices = "ARGO_PLUGIN_SERVICES"
 	// EnvVarContainerName container the container's name for the current pod
 	EnvVarContainerName = "ARGO_CONTAINER_NAME"
 	// EnvVarDeadline is the deadline for the pod
@@ -192,6 +214,8 @@ const (
 	LabelValueTypeConfigMapParameter = "Parameter"
 	// LabelValueTypeConfigMapExecutorPlugin is a key for configmaps that contains an executor plugin.
 	LabelValueTypeConfigMapExecutorPlugin = "ExecutorPlugin"
+	// LabelValueTypeConfigMapArtifactPlugin is a key for configmaps that contains an artifact plugin.
+	LabelValueTypeConfigMapArtifactPlugin = "ArtifactPlugin"
 
 	// LocalVarPodName is a step level variable that references the name of the pod
 	LocalVarPodName = "pod.name"
@@ -212,6 +236,8 @@ const (
 	LocalVarRetriesLastStatus = "lastRetry.status"
 	// LocalVarRetriesLastDuration is a variable that references information about the last retry's duration, in seconds
 	LocalVarRetriesLastDuration = "lastRetry.duration"
+	// LocalVarRetriesLastReason is a variable that references information about the last retry's failure reason, e.g. OOMKilled
+	LocalVarRetriesLastReason = "lastRetry.reason"
 
 	KubeConfigDefaultMountPath    = "/kube/config"
 	KubeConfigDefaultVolumeName   = "kubeconfig"
@@ -221,8 +247,17 @@ const (
 
 	// ArgoProgressPath defines the path to a file used for self reporting progress
 	ArgoProgressPath = "/var/run/argo/progress"
+	// ArtifactPluginsPath is the directory that the binaries of artifact plugins are copied to
+	ArtifactPluginsPath = "/var/run/argo/artifact-plugins"
+	// ArtifactGCArtifactsPath is the file of the JSON of the output artifacts to delete by an artifact GC pod, by node
+	// ID, which is mounted from a config map of the same name as the pod
+	ArtifactGCArtifactsPath = "/argo/artifact-gc/artifacts.json"
 )
 
+// ContainerSkippedPath is the path of the file the emissary creates when a container was not run because its depends
+// logic evaluated to false
+var ContainerSkippedPath = func(containerName string) string { return "/var/run/argo/ctr/" + containerName + "/skipped" }
+
 // AnnotationKeyKillCmd specifies the command to use to kill to container, useful for injected sidecars
 var AnnotationKeyKillCmd = func(containerName string) string { return workflow.WorkflowFullName + "/kill-cmd-" + containerName }
 
@@ -0,0 +1,124 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// ContainerOmittedMessage is the termination message of a container that was not run because its depends logic
+// evaluated to false
+const ContainerOmittedMessage = "omitted: depends condition not met"
+
+// ContainerResults are the results of a container in a container set that may be used in a depends expression.
+// Containers that were not run because their depends expression was false are considered skipped.
+type ContainerResults struct {
+	Succeeded bool `json:"Succeeded"`
+	Failed    bool `json:"Failed"`
+	Errored   bool `json:"Errored"`
+	Skipped   bool `json:"Skipped"`
+	// Daemoned is always false, but plain dependencies are expanded to logic that refers to it
+	Daemoned bool `json:"Daemoned"`
+}
+
+// containerSetContext allows the dependencies of containers to be resolved in the same way as DAG tasks
+type containerSetContext struct {
+	tmpl *wfv1.ContainerSetTemplate
+}
+
+func (c *containerSetContext) GetTask(name string) *wfv1.DAGTask {
+	for _, n := range c.tmpl.GetGraph() {
+		if n.Name == name {
+			return &wfv1.DAGTask{Name: n.Name, Depends: n.Depends, Dependencies: n.Dependencies}
+		}
+	}
+	// undefined containers are reported by validation, so we return an empty task rather than nil which cannot be expanded
+	return &wfv1.DAGTask{Name: name}
+}
+
+func (c *containerSetContext) GetTaskDependencies(name string) []string {
+	dependencies, _ := c.getDependencies(name)
+	return dependencies
+}
+
+func (c *containerSetContext) GetTaskFinishedAtTime(string) time.Time {
+	return time.Time{}
+}
+
+func (c *containerSetContext) getDependencies(name string) ([]string, string) {
+	deps, logic := GetTaskDependencies(c.GetTask(name), c)
+	var names []string
+	for dep := range deps {
+		names = append(names, dep)
+	}
+	sort.Strings(names)
+	return names, logic
+}
+
+// GetContainerDependencies returns the names of the containers that the named container in the container set depends
+// on, and its depends logic. Plain dependencies are expanded to the equivalent depends logic.
+func GetContainerDependencies(tmpl *wfv1.ContainerSetTemplate, containerName string) ([]string, string) {
+	return (&containerSetContext{tmpl: tmpl}).getDependencies(containerName)
+}
+
+// ContainerDependsEnv returns the environment a container's depends logic is evaluated in.
+// Container names are not valid identifiers, so depends logic must be evaluated with "-" replaced by "_".
+func ContainerDependsEnv(results map[string]ContainerResults) map[string]interface{} {
+	env := make(map[string]interface{}, len(results))
+	for name, result := range results {
+		env[strings.ReplaceAll(name, "-", "_")] = result
+	}
+	return env
+}
+
+// ValidateContainerSetDepends validates the depends logic of the containers in a container set. Plain dependencies
+// are validated by the template itself.
+func ValidateContainerSetDepends(tmpl *wfv1.ContainerSetTemplate) error {
+	ctx := &containerSetContext{tmpl: tmpl}
+	for _, ctr := range tmpl.GetGraph() {
+		if ctr.Depends == "" {
+			continue
+		}
+		for _, matchGroup := range taskResultRegex.FindAllStringSubmatch(ctr.Depends, -1) {
+			split := strings.Split(matchGroup[1], ".")
+			switch TaskResult(split[1]) {
+			case TaskResultSucceeded, TaskResultFailed, TaskResultErrored, TaskResultSkipped:
+			default:
+				return fmt.Errorf("containers.%s depends result '%s' for container '%s' is invalid", ctr.Name, split[1], split[0])
+			}
+		}
+		for _, dep := range ctx.GetTaskDependencies(ctr.Name) {
+			if !tmpl.HasContainerNamed(dep) {
+				return fmt.Errorf("containers.%s dependency '%s' not defined", ctr.Name, dep)
+			}
+		}
+	}
+	visited := make(map[string]bool)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		for _, p := range path {
+			if p == name {
+				return fmt.Errorf("containers dependency cycle detected: %s->%s", strings.Join(path, "->"), name)
+			}
+		}
+		if visited[name] {
+			return nil
+		}
+		for _, dep := range ctx.GetTaskDependencies(name) {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		return nil
+	}
+	for _, ctr := range tmpl.GetGraph() {
+		if err := visit(ctr.Name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+)
+
+func containerSet(nodes ...wfv1.ContainerNode) *wfv1.ContainerSetTemplate {
+	return &wfv1.ContainerSetTemplate{Containers: nodes}
+}
+
+func containerNode(name, depends string, dependencies ...string) wfv1.ContainerNode {
+	return wfv1.ContainerNode{Container: corev1.Container{Name: name}, Depends: depends, Dependencies: dependencies}
+}
+
+func TestGetContainerDependencies(t *testing.T) {
+	tmpl := containerSet(
+		containerNode("build", ""),
+		containerNode("test", "", "build"),
+		containerNode("clean-up", "build.Failed || test.Failed"),
+	)
+	deps, logic := GetContainerDependencies(tmpl, "build")
+	assert.Empty(t, deps)
+	assert.Empty(t, logic)
+
+	deps, logic = GetContainerDependencies(tmpl, "test")
+	assert.Equal(t, []string{"build"}, deps)
+	assert.Equal(t, "(build.Succeeded || build.Skipped || build.Daemoned)", logic)
+
+	deps, logic = GetContainerDependencies(tmpl, "clean-up")
+	assert.Equal(t, []string{"build", "test"}, deps)
+	assert.Equal(t, "build.Failed || test.Failed", logic)
+
+	execute, err := argoexpr.EvalBool(logic, ContainerDependsEnv(map[string]ContainerResults{"build": {Succeeded: true}, "test": {Failed: true}}))
+	assert.NoError(t, err)
+	assert.True(t, execute)
+}
+
+func TestValidateContainerSetDepends(t *testing.T) {
+	assert.NoError(t, ValidateContainerSetDepends(containerSet(containerNode("a", ""), containerNode("b", "a.Failed"))))
+	assert.EqualError(t, ValidateContainerSetDepends(containerSet(containerNode("a", ""), containerNode("b", "c"))), "containers.b dependency 'c' not defined")
+	assert.EqualError(t, ValidateContainerSetDepends(containerSet(containerNode("a", ""), containerNode("b", "a.AnySucceeded"))), "containers.b depends result 'AnySucceeded' for container 'a' is invalid")
+	assert.EqualError(t, ValidateContainerSetDepends(containerSet(containerNode("a", "b"), containerNode("b", "a.Failed"))), "containers dependency cycle detected: a->b->a")
+}
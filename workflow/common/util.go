@@ -188,6 +188,7 @@ func ProcessArgs(tmpl *wfv1.Template, args wfv1.ArgumentsProvider, globalParams,
 			artifacts[i].Path = inArt.Path
 			artifacts[i].Mode = inArt.Mode
 			artifacts[i].RecurseMode = inArt.RecurseMode
+			artifacts[i].VerifyChecksum = argArt.VerifyChecksum || inArt.VerifyChecksum
 		}
 	}
 
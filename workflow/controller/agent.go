@@ -14,6 +14,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
 	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
@@ -85,11 +86,24 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 		}
 	}
 
-	pluginSidecars := woc.getExecutorPlugins()
+	pluginSidecars, pluginServices := woc.getExecutorPlugins()
+	// the tokens of the services are mounted, so that the service account does not need to be able to get secrets
+	tokenVolumes := make(map[string]apiv1.Volume)
+	tokenKeys := make(map[string]bool)
+	for _, s := range pluginServices {
+		createSecretVal(tokenVolumes, s.Token, tokenKeys)
+	}
+	var volumes []apiv1.Volume
+	var volumeMounts []apiv1.VolumeMount
+	for name, vol := range tokenVolumes {
+		volumes = append(volumes, vol)
+		volumeMounts = append(volumeMounts, apiv1.VolumeMount{Name: name, MountPath: common.SecretVolMountPath + "/" + vol.Name, ReadOnly: true})
+	}
 	envVars := []apiv1.EnvVar{
 		{Name: common.EnvVarWorkflowName, Value: woc.wf.Name},
 		{Name: common.EnvAgentPatchRate, Value: env.LookupEnvStringOr(common.EnvAgentPatchRate, GetRequeueTime().String())},
 		{Name: common.EnvVarPluginAddresses, Value: wfv1.MustMarshallJSON(addresses(pluginSidecars))},
+		{Name: common.EnvVarPluginServices, Value: wfv1.MustMarshallJSON(pluginServices)},
 	}
 
 	// If the default number of task workers is overridden, then pass it to the agent pod.
@@ -115,6 +129,7 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 		Spec: apiv1.PodSpec{
 			RestartPolicy:    apiv1.RestartPolicyOnFailure,
 			ImagePullSecrets: woc.execWf.Spec.ImagePullSecrets,
+			Volumes:          volumes,
 			Containers: append(
 				pluginSidecars,
 				apiv1.Container{
@@ -124,6 +139,7 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 					Image:           woc.controller.executorImage(),
 					ImagePullPolicy: woc.controller.executorImagePullPolicy(),
 					Env:             envVars,
+					VolumeMounts:    volumeMounts,
 				},
 			),
 		},
@@ -150,17 +166,23 @@ func (woc *wfOperationCtx) createAgentPod(ctx context.Context) (*apiv1.Pod, erro
 	return created, nil
 }
 
-func (woc *wfOperationCtx) getExecutorPlugins() []apiv1.Container {
+// getExecutorPlugins returns the sidecars to run in the agent pod, and the shared services the agent should call
+func (woc *wfOperationCtx) getExecutorPlugins() ([]apiv1.Container, []spec.Service) {
 	var sidecars []apiv1.Container
+	var services []spec.Service
 	namespaces := map[string]bool{} // de-dupes executorPlugins when their namespaces are the same
 	namespaces[woc.controller.namespace] = true
 	namespaces[woc.wf.Namespace] = true
 	for namespace := range namespaces {
 		for _, plug := range woc.controller.executorPlugins[namespace] {
-			sidecars = append(sidecars, plug.Spec.Sidecar.Container)
+			if plug.Spec.Service != nil {
+				services = append(services, *plug.Spec.Service)
+			} else {
+				sidecars = append(sidecars, plug.Spec.Sidecar.Container)
+			}
 		}
 	}
-	return sidecars
+	return sidecars, services
 }
 
 func addresses(containers []apiv1.Container) []string {
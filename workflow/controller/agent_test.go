@@ -6,10 +6,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
@@ -137,6 +139,32 @@ status:
 	})
 }
 
+func TestCreateAgentPodWithPluginService(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+  namespace: my-ns
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.executorPlugins = map[string]map[string]*spec.Plugin{
+		"my-ns": {"hello": {Spec: spec.PluginSpec{Service: &spec.Service{
+			Address: "http://hello-executor-plugin:4355",
+			Token:   &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "hello-executor-plugin"}, Key: "token"},
+		}}}},
+	}
+	woc := newWorkflowOperationCtx(wf, controller)
+	pod, err := woc.createAgentPod(context.Background())
+	require.NoError(t, err)
+	// the token is mounted, rather than got by the service account
+	if assert.Len(t, pod.Spec.Volumes, 1) {
+		assert.Equal(t, "hello-executor-plugin", pod.Spec.Volumes[0].Secret.SecretName)
+		assert.Equal(t, []apiv1.KeyToPath{{Key: "token", Path: "token"}}, pod.Spec.Volumes[0].Secret.Items)
+	}
+	main := pod.Spec.Containers[len(pod.Spec.Containers)-1]
+	assert.Equal(t, []apiv1.VolumeMount{{Name: "hello-executor-plugin", MountPath: "/argo/secret/hello-executor-plugin", ReadOnly: true}}, main.VolumeMounts)
+}
+
 func TestAssessAgentPodStatus(t *testing.T) {
 	t.Run("Failed", func(t *testing.T) {
 		pod1 := &apiv1.Pod{
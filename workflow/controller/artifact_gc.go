@@ -0,0 +1,472 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// artifactGCStrategies are the strategies whose artifacts are deleted by artifact GC, in the order they become due
+var artifactGCStrategies = []wfv1.ArtifactGCStrategy{wfv1.ArtifactGCOnWorkflowCompletion, wfv1.ArtifactGCOnWorkflowDeletion}
+
+const (
+	// artifactGCMaxPods is the number of artifact GC pods of a strategy that may fail before its artifacts are given up
+	artifactGCMaxPods = 3
+	// artifactGCVolumeName is the name of the volume of the config map of the artifacts that an artifact GC pod deletes
+	artifactGCVolumeName = "artifact-gc"
+)
+
+// artifactGCBackoff is the backoff from the failure of an artifact GC pod to the creation of the next one
+var artifactGCBackoff = wfv1.Backoff{Duration: "10s", Factor: intstrutil.ParsePtr("2")}
+
+// getArtifactGCPodName returns the name of the current artifact GC pod of the strategy, which is also the name of the
+// config map of its artifacts
+func (woc *wfOperationCtx) getArtifactGCPodName(strategy wfv1.ArtifactGCStrategy) string {
+	name := "artgc-" + strings.ToLower(string(strategy))
+	if failed := woc.wf.Status.ArtifactGCStatus.GetPodsFailed(strategy); failed > 0 {
+		name += fmt.Sprintf("-%d", failed)
+	}
+	return woc.wf.NodeID(name) + "-artgc"
+}
+
+func (woc *wfOperationCtx) isArtifactGCPod(pod *apiv1.Pod) bool {
+	_, ok := pod.Labels[common.LabelKeyArtifactGCStrategy]
+	return ok
+}
+
+func (woc *wfOperationCtx) hasArtifactGCFinalizer() bool {
+	return slice.ContainsString(woc.wf.Finalizers, common.FinalizerArtifactGC)
+}
+
+// addArtifactGCFinalizer adds the artifact GC finalizer to a workflow that may have artifacts to delete, so that it
+// cannot be deleted before they are
+func (woc *wfOperationCtx) addArtifactGCFinalizer() {
+	if woc.wf.DeletionTimestamp != nil || woc.hasArtifactGCFinalizer() || !woc.usesArtifactGC() {
+		return
+	}
+	woc.log.Info("Adding artifact GC finalizer")
+	woc.wf.Finalizers = append(woc.wf.Finalizers, common.FinalizerArtifactGC)
+	woc.updated = true
+}
+
+// usesArtifactGC returns whether any output artifact of the workflow may be deleted by artifact GC
+func (woc *wfOperationCtx) usesArtifactGC() bool {
+	uses := func(s wfv1.ArtifactGCStrategy) bool {
+		return s != wfv1.ArtifactGCStrategyUndefined && s != wfv1.ArtifactGCNever
+	}
+	if uses(woc.execWf.Spec.ArtifactGC.GetStrategy()) {
+		return true
+	}
+	templates := woc.execWf.Spec.Templates
+	for _, tmpl := range woc.wf.Status.StoredTemplates {
+		templates = append(templates, tmpl)
+	}
+	for _, tmpl := range templates {
+		if tmpl.ArchiveLocation != nil && uses(tmpl.ArchiveLocation.ArtifactGC.GetStrategy()) {
+			return true
+		}
+		for _, art := range tmpl.Outputs.Artifacts {
+			if uses(art.ArtifactGC.GetStrategy()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// artifactGCStrategy returns the strategy of an output artifact of a node: that of the artifact, then that of the
+// archive location of its template, then that of the workflow
+func (woc *wfOperationCtx) artifactGCStrategy(tmpl *wfv1.Template, art *wfv1.Artifact) wfv1.ArtifactGCStrategy {
+	strategy := art.ArtifactGC.GetStrategy()
+	if strategy == wfv1.ArtifactGCStrategyUndefined && tmpl != nil {
+		if a := tmpl.Outputs.GetArtifactByName(art.Name); a != nil {
+			strategy = a.ArtifactGC.GetStrategy()
+		}
+		if strategy == wfv1.ArtifactGCStrategyUndefined && tmpl.ArchiveLocation != nil {
+			strategy = tmpl.ArchiveLocation.ArtifactGC.GetStrategy()
+		}
+	}
+	if strategy == wfv1.ArtifactGCStrategyUndefined {
+		strategy = woc.execWf.Spec.ArtifactGC.GetStrategy()
+	}
+	if strategy == wfv1.ArtifactGCStrategyUndefined {
+		return wfv1.ArtifactGCNever
+	}
+	return strategy
+}
+
+// isArtifactGCSupported returns whether the storage of an artifact supports deleting it
+func isArtifactGCSupported(art *wfv1.Artifact) bool {
+	return art.Raw == nil && art.HTTP == nil && art.Git == nil
+}
+
+// nodeTemplate returns the template of a node without resolving template references, as they cannot be resolved
+// once the workflow has completed
+func (woc *wfOperationCtx) nodeTemplate(node wfv1.NodeStatus) *wfv1.Template {
+	if node.TemplateRef != nil {
+		scope, name := node.GetTemplateScope()
+		return woc.wf.GetStoredTemplate(scope, name, &node)
+	}
+	return woc.wf.GetTemplateByName(node.TemplateName)
+}
+
+// artifactsToGarbageCollect returns the output artifacts that are left to delete with a strategy, by node ID, at their
+// full location. Artifacts of memoized nodes are kept, as they are also the outputs of their cache entries.
+func (woc *wfOperationCtx) artifactsToGarbageCollect(strategy wfv1.ArtifactGCStrategy) map[string][]wfv1.Artifact {
+	artifacts := make(map[string][]wfv1.Artifact)
+	for nodeID, node := range woc.wf.Status.Nodes {
+		if node.Outputs == nil || node.MemoizationStatus != nil {
+			continue
+		}
+		tmpl := woc.nodeTemplate(node)
+		for _, a := range node.Outputs.Artifacts {
+			art := a.DeepCopy()
+			if art.Deleted || !art.HasLocationOrKey() || woc.artifactGCStrategy(tmpl, art) != strategy {
+				continue
+			}
+			if err := woc.relocateArtifact(tmpl, art); err != nil {
+				woc.log.WithError(err).WithFields(log.Fields{"nodeID": nodeID, "artifact": art.Name}).Warn("Cannot garbage collect artifact")
+				continue
+			}
+			if !isArtifactGCSupported(art) {
+				continue
+			}
+			artifacts[nodeID] = append(artifacts[nodeID], *art)
+		}
+	}
+	return artifacts
+}
+
+// garbageCollectArtifacts records the results of the artifact GC pods, creates those for the strategies that are due,
+// and removes the artifact GC finalizer once there are no artifacts left to delete, or the pods of their strategy have
+// failed too many times
+func (woc *wfOperationCtx) garbageCollectArtifacts(ctx context.Context) {
+	if !woc.hasArtifactGCFinalizer() {
+		return
+	}
+	if woc.wf.Status.ArtifactGCStatus == nil {
+		woc.wf.Status.ArtifactGCStatus = &wfv1.ArtGCStatus{}
+	}
+	status := woc.wf.Status.ArtifactGCStatus
+	for _, strategy := range artifactGCStrategies {
+		if status.IsStrategyProcessed(strategy) && !status.IsPodRecouped(woc.getArtifactGCPodName(strategy)) {
+			if err := woc.recoupArtifactGCPod(ctx, strategy); err != nil {
+				woc.log.WithError(err).WithField("strategy", strategy).Warn("Failed to recoup artifact GC pod")
+			}
+		}
+	}
+	pending := false
+	for _, strategy := range artifactGCStrategies {
+		artifacts := woc.artifactsToGarbageCollect(strategy)
+		if len(artifacts) == 0 || status.GetPodsFailed(strategy) >= artifactGCMaxPods {
+			continue
+		}
+		pending = true
+		if status.IsStrategyProcessed(strategy) || !woc.isArtifactGCDue(strategy) {
+			continue
+		}
+		if err := woc.createArtifactGCPod(ctx, strategy, artifacts); err != nil {
+			woc.log.WithError(err).WithField("strategy", strategy).Error("Failed to create artifact GC pod")
+			woc.markArtifactGCError(fmt.Sprintf("failed to create artifact GC pod: %v", err))
+			continue
+		}
+		status.SetStrategyProcessed(strategy)
+		woc.updated = true
+	}
+	if !pending {
+		woc.log.Info("Removing artifact GC finalizer")
+		woc.wf.Finalizers = slice.RemoveString(woc.wf.Finalizers, common.FinalizerArtifactGC)
+		woc.updated = true
+	}
+}
+
+func (woc *wfOperationCtx) isArtifactGCDue(strategy wfv1.ArtifactGCStrategy) bool {
+	switch strategy {
+	case wfv1.ArtifactGCOnWorkflowCompletion:
+		return woc.wf.Status.Fulfilled()
+	case wfv1.ArtifactGCOnWorkflowDeletion:
+		return woc.wf.Status.Fulfilled() && woc.wf.DeletionTimestamp != nil
+	}
+	return false
+}
+
+func (woc *wfOperationCtx) markArtifactGCError(message string) {
+	woc.wf.Status.Conditions.UpsertCondition(wfv1.Condition{Status: metav1.ConditionTrue, Type: wfv1.ConditionTypeArtifactGCError, Message: message})
+	woc.eventRecorder.Event(woc.wf, apiv1.EventTypeWarning, "ArtifactGCFailed", message)
+	woc.updated = true
+}
+
+// recoupArtifactGCPod records the result of a completed artifact GC pod, and deletes it. A failed pod is kept until the
+// backoff to the next pod has passed.
+func (woc *wfOperationCtx) recoupArtifactGCPod(ctx context.Context, strategy wfv1.ArtifactGCStrategy) error {
+	podName := woc.getArtifactGCPodName(strategy)
+	pod, err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.Namespace).Get(ctx, podName, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		woc.failArtifactGCPod(ctx, strategy, fmt.Sprintf("artifact GC pod %s was deleted before it completed", podName))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch pod.Status.Phase {
+	case apiv1.PodSucceeded:
+		artifacts, err := woc.artifactGCPodArtifacts(ctx, pod)
+		if apierr.IsNotFound(err) {
+			woc.failArtifactGCPod(ctx, strategy, fmt.Sprintf("the artifacts of artifact GC pod %s are unknown: %v", podName, err))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		woc.markArtifactsDeleted(artifacts)
+		woc.log.WithField("podName", podName).Info("Artifact GC pod succeeded")
+	case apiv1.PodFailed:
+		remaining, err := woc.artifactGCBackoffRemaining(strategy, pod)
+		if err != nil {
+			return err
+		}
+		if remaining > 0 {
+			woc.requeueAfter(remaining)
+			return nil
+		}
+		message := pod.Status.Message
+		for _, s := range pod.Status.ContainerStatuses {
+			if t := s.State.Terminated; t != nil && t.Message != "" {
+				message = t.Message
+			}
+		}
+		woc.failArtifactGCPod(ctx, strategy, fmt.Sprintf("artifact GC pod %s failed: %s", podName, message))
+		return nil
+	default:
+		return nil
+	}
+	woc.wf.Status.ArtifactGCStatus.SetPodRecouped(podName)
+	woc.updated = true
+	woc.deleteArtifactGCPod(ctx, podName)
+	return nil
+}
+
+// failArtifactGCPod records the error of the failed artifact GC pod of a strategy, and deletes it, so that the next pod
+// is created, unless too many have failed
+func (woc *wfOperationCtx) failArtifactGCPod(ctx context.Context, strategy wfv1.ArtifactGCStrategy, message string) {
+	podName := woc.getArtifactGCPodName(strategy)
+	status := woc.wf.Status.ArtifactGCStatus
+	status.SetPodRecouped(podName)
+	status.AddPodFailed(strategy)
+	if status.GetPodsFailed(strategy) >= artifactGCMaxPods {
+		message = fmt.Sprintf("%s, giving up after %d attempts", message, artifactGCMaxPods)
+	}
+	woc.markArtifactGCError(message)
+	woc.deleteArtifactGCPod(ctx, podName)
+}
+
+// artifactGCBackoffRemaining returns how long until the next pod of a strategy is created after the failed pod, which is
+// none if it is the last pod
+func (woc *wfOperationCtx) artifactGCBackoffRemaining(strategy wfv1.ArtifactGCStrategy, pod *apiv1.Pod) (time.Duration, error) {
+	failed := woc.wf.Status.ArtifactGCStatus.GetPodsFailed(strategy)
+	if failed+1 >= artifactGCMaxPods {
+		return 0, nil
+	}
+	backoff, err := backoffDuration("artifact GC", artifactGCBackoff, int(failed)+1, woc.wf.Name)
+	if err != nil {
+		return 0, err
+	}
+	finishedAt := pod.CreationTimestamp.Time
+	for _, s := range pod.Status.ContainerStatuses {
+		if t := s.State.Terminated; t != nil && t.FinishedAt.After(finishedAt) {
+			finishedAt = t.FinishedAt.Time
+		}
+	}
+	return time.Until(finishedAt.Add(backoff)), nil
+}
+
+// deleteArtifactGCPod deletes an artifact GC pod and the config map of its artifacts
+func (woc *wfOperationCtx) deleteArtifactGCPod(ctx context.Context, podName string) {
+	log := woc.log.WithField("podName", podName)
+	err := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.Namespace).Delete(ctx, podName, metav1.DeleteOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		log.WithError(err).Warn("Failed to delete artifact GC pod")
+	}
+	err = woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.Namespace).Delete(ctx, podName, metav1.DeleteOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		log.WithError(err).Warn("Failed to delete the artifacts config map of artifact GC pod")
+	}
+}
+
+// artifactGCPodArtifacts returns the artifacts that an artifact GC pod deletes, from the config map mounted in it
+func (woc *wfOperationCtx) artifactGCPodArtifacts(ctx context.Context, pod *apiv1.Pod) (map[string][]wfv1.Artifact, error) {
+	for _, v := range pod.Spec.Volumes {
+		if v.Name != artifactGCVolumeName || v.ConfigMap == nil {
+			continue
+		}
+		cm, err := woc.controller.kubeclientset.CoreV1().ConfigMaps(pod.Namespace).Get(ctx, v.ConfigMap.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		artifacts := make(map[string][]wfv1.Artifact)
+		if err := json.Unmarshal([]byte(cm.Data[filepath.Base(common.ArtifactGCArtifactsPath)]), &artifacts); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal artifacts of artifact GC pod %s: %w", pod.Name, err)
+		}
+		return artifacts, nil
+	}
+	return nil, fmt.Errorf("artifact GC pod %s has no artifacts", pod.Name)
+}
+
+func (woc *wfOperationCtx) markArtifactsDeleted(artifacts map[string][]wfv1.Artifact) {
+	for nodeID, arts := range artifacts {
+		node, ok := woc.wf.Status.Nodes[nodeID]
+		if !ok || node.Outputs == nil {
+			continue
+		}
+		for _, art := range arts {
+			for i, a := range node.Outputs.Artifacts {
+				if a.Name == art.Name {
+					node.Outputs.Artifacts[i].Deleted = true
+				}
+			}
+		}
+		woc.wf.Status.Nodes[nodeID] = node
+	}
+}
+
+// createArtifactGCPod creates the pod that deletes the artifacts of a strategy, with the service account and
+// credentials of the workflow, and the config map of the artifacts, which are too many for an environment variable
+func (woc *wfOperationCtx) createArtifactGCPod(ctx context.Context, strategy wfv1.ArtifactGCStrategy, artifacts map[string][]wfv1.Artifact) error {
+	podName := woc.getArtifactGCPodName(strategy)
+	log := woc.log.WithField("podName", podName)
+	ownerReferences := []metav1.OwnerReference{
+		*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+	}
+
+	tmpl := &wfv1.Template{}
+	for _, arts := range artifacts {
+		tmpl.Inputs.Artifacts = append(tmpl.Inputs.Artifacts, arts...)
+	}
+	artifactPlugins, err := woc.getArtifactPlugins(tmpl)
+	if err != nil {
+		return err
+	}
+	volumes, volumeMounts := createSecretVolumes(tmpl, artifactPlugins)
+	volumes = append(volumes, apiv1.Volume{
+		Name: artifactGCVolumeName,
+		VolumeSource: apiv1.VolumeSource{
+			ConfigMap: &apiv1.ConfigMapVolumeSource{LocalObjectReference: apiv1.LocalObjectReference{Name: podName}},
+		},
+	})
+	volumeMounts = append(volumeMounts, apiv1.VolumeMount{
+		Name:      artifactGCVolumeName,
+		MountPath: filepath.Dir(common.ArtifactGCArtifactsPath),
+		ReadOnly:  true,
+	})
+
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName,
+			Namespace: woc.wf.ObjectMeta.Namespace,
+			Labels: map[string]string{
+				common.LabelKeyWorkflow:           woc.wf.Name, // Allows filtering by pods related to specific workflow
+				common.LabelKeyCompleted:          "false",     // Allows filtering by incomplete workflow pods
+				common.LabelKeyArtifactGCStrategy: string(strategy),
+			},
+			OwnerReferences: ownerReferences,
+		},
+		Spec: apiv1.PodSpec{
+			RestartPolicy:      apiv1.RestartPolicyNever,
+			ServiceAccountName: woc.execWf.Spec.ServiceAccountName,
+			ImagePullSecrets:   woc.execWf.Spec.ImagePullSecrets,
+			Volumes:            volumes,
+			Containers: []apiv1.Container{
+				{
+					Name:            "main",
+					Command:         []string{"argoexec"},
+					Args:            []string{"artifact", "delete"},
+					Image:           woc.controller.executorImage(),
+					ImagePullPolicy: woc.controller.executorImagePullPolicy(),
+					Env: []apiv1.EnvVar{
+						{Name: common.EnvVarWorkflowName, Value: woc.wf.Name},
+					},
+					VolumeMounts: volumeMounts,
+				},
+			},
+		},
+	}
+
+	woc.addArtifactPluginBinaries(pod, artifactPlugins)
+	if len(pod.Spec.InitContainers) > 0 {
+		// the binaries of the artifact plugins are copied into the var-run-argo volume
+		pod.Spec.Volumes = append(pod.Spec.Volumes, volumeVarArgo)
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, volumeMountVarArgo)
+	}
+
+	if woc.controller.Config.InstanceID != "" {
+		pod.ObjectMeta.Labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
+	}
+
+	cm := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            podName,
+			Namespace:       woc.wf.ObjectMeta.Namespace,
+			Labels:          map[string]string{common.LabelKeyWorkflow: woc.wf.Name},
+			OwnerReferences: ownerReferences,
+		},
+		Data: map[string]string{filepath.Base(common.ArtifactGCArtifactsPath): wfv1.MustMarshallJSON(artifacts)},
+	}
+	_, err = woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.ObjectMeta.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+	if err != nil && !apierr.IsAlreadyExists(err) {
+		return errors.InternalWrapError(err)
+	}
+	_, err = woc.controller.kubeclientset.CoreV1().Pods(woc.wf.ObjectMeta.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		if apierr.IsAlreadyExists(err) {
+			return nil
+		}
+		// the config map is created again, with the artifacts left to delete, with the pod
+		woc.deleteArtifactGCPod(ctx, podName)
+		return errors.InternalWrapError(err)
+	}
+	log.WithField("strategy", strategy).Info("Created artifact GC pod")
+	return nil
+}
+
+// cleanupCompletedWorkflow deletes the created resources and garbage collects the artifacts of a completed workflow
+// that still has their finalizers, as completed workflows are otherwise not operated on
+func (wfc *WorkflowController) cleanupCompletedWorkflow(ctx context.Context, wf *wfv1.Workflow) {
+	woc := newWorkflowOperationCtx(wf, wfc)
+	if !woc.hasArtifactGCFinalizer() && !woc.hasResourceCleanupFinalizer() {
+		return
+	}
+	if err := wfc.hydrator.Hydrate(woc.wf); err != nil {
+		woc.log.WithError(err).Error("Failed to hydrate completed workflow")
+		return
+	}
+	defer woc.persistUpdates(ctx)
+	woc.cleanupCreatedResources(ctx)
+	if !woc.hasArtifactGCFinalizer() {
+		return
+	}
+	if woc.wf.Status.StoredWorkflowSpec != nil {
+		woc.execWf = &wfv1.Workflow{Spec: *woc.wf.Status.StoredWorkflowSpec.DeepCopy()}
+	}
+	repo, err := wfc.artifactRepositories.Get(ctx, woc.wf.Status.ArtifactRepositoryRef)
+	if err != nil {
+		woc.log.WithError(err).Error("Failed to get artifact repository for artifact GC")
+		return
+	}
+	woc.artifactRepository = repo
+	woc.garbageCollectArtifacts(ctx)
+}
@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var artifactGCWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: artifact-gc
+spec:
+  entrypoint: main
+  artifactGC:
+    strategy: OnWorkflowCompletion
+  templates:
+    - name: main
+      container:
+        image: argoproj/argosay:v2
+      outputs:
+        artifacts:
+          - name: kept
+            path: /tmp/kept
+            artifactGC:
+              strategy: Never
+          - name: deleted
+            path: /tmp/deleted
+`
+
+func runArtifactGCWf(t *testing.T, ctx context.Context) (*WorkflowController, *wfOperationCtx, context.CancelFunc) {
+	wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+	cancel, controller := newController(wf)
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Contains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+
+	outputs := wfv1.Outputs{Artifacts: wfv1.Artifacts{
+		{Name: "kept", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "artifact-gc/kept.tgz"}}},
+		{Name: "deleted", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "artifact-gc/deleted.tgz"}}},
+	}}
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withOutputs(wfv1.MustMarshallJSON(outputs)))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	assert.True(t, woc.wf.Status.ArtifactGCStatus.IsStrategyProcessed(wfv1.ArtifactGCOnWorkflowCompletion))
+	return controller, woc, cancel
+}
+
+func TestArtifactGC(t *testing.T) {
+	ctx := context.Background()
+	controller, woc, cancel := runArtifactGCWf(t, ctx)
+	defer cancel()
+
+	podName := woc.getArtifactGCPodName(wfv1.ArtifactGCOnWorkflowCompletion)
+	pods := controller.kubeclientset.CoreV1().Pods(woc.wf.Namespace)
+	pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"artifact", "delete"}, pod.Spec.Containers[0].Args)
+		assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, apiv1.VolumeMount{Name: "artifact-gc", MountPath: "/argo/artifact-gc", ReadOnly: true})
+		artifacts, err := woc.artifactGCPodArtifacts(ctx, pod)
+		if assert.NoError(t, err) && assert.Len(t, artifacts, 1) {
+			arts := artifacts[woc.wf.NodeID("artifact-gc")]
+			if assert.Len(t, arts, 1) {
+				assert.Equal(t, "deleted", arts[0].Name)
+				assert.Equal(t, "my-bucket", arts[0].S3.Bucket)
+				assert.Equal(t, "artifact-gc/deleted.tgz", arts[0].S3.Key)
+			}
+		}
+	}
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	controller.cleanupCompletedWorkflow(ctx, woc.wf)
+	wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace).Get(ctx, woc.wf.Name, metav1.GetOptions{})
+	if assert.NoError(t, err) {
+		assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		assert.True(t, wf.Status.ArtifactGCStatus.IsPodRecouped(podName))
+		outputs := wf.Status.Nodes[wf.NodeID("artifact-gc")].Outputs
+		assert.False(t, outputs.GetArtifactByName("kept").Deleted)
+		assert.True(t, outputs.GetArtifactByName("deleted").Deleted)
+	}
+	_, err = pods.Get(ctx, podName, metav1.GetOptions{})
+	assert.Error(t, err)
+	_, err = controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.Namespace).Get(ctx, podName, metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestArtifactGCFailed(t *testing.T) {
+	ctx := context.Background()
+	controller, woc, cancel := runArtifactGCWf(t, ctx)
+	defer cancel()
+	pods := controller.kubeclientset.CoreV1().Pods(woc.wf.Namespace)
+	getWf := func() *wfv1.Workflow {
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace).Get(ctx, woc.wf.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		return wf
+	}
+
+	t.Run("Backoff", func(t *testing.T) {
+		makePodsPhase(ctx, woc, apiv1.PodFailed, func(pod *apiv1.Pod) {
+			pod.Status.ContainerStatuses = []apiv1.ContainerStatus{{Name: "main", State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{FinishedAt: metav1.Now()}}}}
+		})
+		controller.cleanupCompletedWorkflow(ctx, woc.wf)
+		assert.Zero(t, woc.wf.Status.ArtifactGCStatus.GetPodsFailed(wfv1.ArtifactGCOnWorkflowCompletion))
+		pod, err := pods.Get(ctx, woc.getArtifactGCPodName(wfv1.ArtifactGCOnWorkflowCompletion), metav1.GetOptions{})
+		require.NoError(t, err)
+		// the pod failed long ago
+		pod.Status.ContainerStatuses = nil
+		_, err = pods.Update(ctx, pod, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	})
+	t.Run("Retried", func(t *testing.T) {
+		for i := 1; i < artifactGCMaxPods; i++ {
+			makePodsPhase(ctx, woc, apiv1.PodFailed)
+			controller.cleanupCompletedWorkflow(ctx, woc.wf)
+			wf := getWf()
+			woc = newWorkflowOperationCtx(wf, controller)
+			assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+			assert.Equal(t, int32(i), wf.Status.ArtifactGCStatus.GetPodsFailed(wfv1.ArtifactGCOnWorkflowCompletion))
+			condition := wf.Status.Conditions[len(wf.Status.Conditions)-1]
+			assert.Equal(t, wfv1.ConditionTypeArtifactGCError, condition.Type)
+			assert.Contains(t, condition.Message, "Pod failed")
+			// the next pod is created with the artifacts left to delete
+			podName := woc.getArtifactGCPodName(wfv1.ArtifactGCOnWorkflowCompletion)
+			assert.True(t, wf.Status.ArtifactGCStatus.IsStrategyProcessed(wfv1.ArtifactGCOnWorkflowCompletion))
+			_, err := pods.Get(ctx, podName, metav1.GetOptions{})
+			assert.NoError(t, err, podName)
+		}
+	})
+	t.Run("GivenUp", func(t *testing.T) {
+		makePodsPhase(ctx, woc, apiv1.PodFailed)
+		controller.cleanupCompletedWorkflow(ctx, woc.wf)
+		wf := getWf()
+		assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		condition := wf.Status.Conditions[len(wf.Status.Conditions)-1]
+		assert.Equal(t, wfv1.ConditionTypeArtifactGCError, condition.Type)
+		assert.Contains(t, condition.Message, "giving up after 3 attempts")
+		assert.False(t, wf.Status.Nodes[wf.NodeID("artifact-gc")].Outputs.GetArtifactByName("deleted").Deleted)
+		list, err := pods.List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeyArtifactGCStrategy})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+	})
+}
+
+func TestArtifactGCNever(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(artifactGCWf)
+	wf.Spec.ArtifactGC = nil
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	assert.NotContains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+}
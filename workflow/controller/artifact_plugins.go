@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"fmt"
+	"path/filepath"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	plugin "github.com/argoproj/argo-workflows/v3/workflow/util/plugins"
+)
+
+// getArtifactPlugins returns the artifact plugins of the artifacts and the archive location of a template, from their
+// config maps in the namespace of the workflow
+func (woc *wfOperationCtx) getArtifactPlugins(tmpl *wfv1.Template) ([]*spec.ArtifactPlugin, error) {
+	locations := []*wfv1.ArtifactLocation{tmpl.ArchiveLocation}
+	for i := range tmpl.Inputs.Artifacts {
+		locations = append(locations, &tmpl.Inputs.Artifacts[i].ArtifactLocation)
+	}
+	for i := range tmpl.Outputs.Artifacts {
+		locations = append(locations, &tmpl.Outputs.Artifacts[i].ArtifactLocation)
+	}
+	if tmpl.Data != nil {
+		if art, needed := tmpl.Data.Source.GetArtifactIfNeeded(); needed {
+			locations = append(locations, &art.ArtifactLocation)
+		}
+	}
+	var plugins []*spec.ArtifactPlugin
+	names := make(map[string]bool)
+	for _, l := range locations {
+		if l == nil || l.Plugin == nil || l.Plugin.Name == "" || names[l.Plugin.Name] {
+			continue
+		}
+		names[l.Plugin.Name] = true
+		p, err := woc.getArtifactPlugin(l.Plugin.Name)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, p)
+	}
+	return plugins, nil
+}
+
+func (woc *wfOperationCtx) getArtifactPlugin(name string) (*spec.ArtifactPlugin, error) {
+	cmName := plugin.ArtifactPluginConfigMapName(name)
+	obj, exists, err := woc.controller.configMapInformer.GetIndexer().GetByKey(woc.wf.Namespace + "/" + cmName)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := obj.(*apiv1.ConfigMap)
+	if !exists || !ok || cm.Labels[common.LabelKeyConfigMapType] != common.LabelValueTypeConfigMapArtifactPlugin {
+		return nil, fmt.Errorf("artifact plugin %q not found: ConfigMap '%s' with the label %s: %s does not exist",
+			name, cmName, common.LabelKeyConfigMapType, common.LabelValueTypeConfigMapArtifactPlugin)
+	}
+	p, err := plugin.ArtifactPluginFromConfigMap(cm)
+	if err != nil {
+		return nil, fmt.Errorf("artifact plugin %q is invalid: %w", name, err)
+	}
+	return p, nil
+}
+
+// addArtifactPluginBinaries adds an init container, before the others, for each artifact plugin with a binary, which
+// copies the binary into the var-run-argo volume for the executor to run
+func (woc *wfOperationCtx) addArtifactPluginBinaries(pod *apiv1.Pod, artifactPlugins []*spec.ArtifactPlugin) {
+	var ctrs []apiv1.Container
+	for _, p := range artifactPlugins {
+		if p.Spec.Binary == nil {
+			continue
+		}
+		ctr := apiv1.Container{
+			Name:    "artifact-plugin-" + p.Name,
+			Image:   p.Spec.Binary.Image,
+			Command: []string{"cp", p.Spec.Binary.Path, filepath.Join(common.ArtifactPluginsPath, p.Name)},
+			VolumeMounts: []apiv1.VolumeMount{{
+				Name:      volumeVarArgo.Name,
+				MountPath: common.ArtifactPluginsPath,
+				SubPath:   filepath.Base(common.ArtifactPluginsPath),
+			}},
+		}
+		if woc.controller.Config.Executor != nil && woc.controller.Config.Executor.SecurityContext != nil {
+			ctr.SecurityContext = woc.controller.Config.Executor.SecurityContext.DeepCopy()
+		}
+		if isResourcesSpecified(woc.controller.Config.Executor) {
+			ctr.Resources = *woc.controller.Config.Executor.Resources.DeepCopy()
+		} else if woc.controller.Config.ExecutorResources != nil {
+			ctr.Resources = *woc.controller.Config.ExecutorResources.DeepCopy()
+		}
+		ctrs = append(ctrs, ctr)
+	}
+	if len(ctrs) > 0 {
+		pod.Spec.InitContainers = append(ctrs, pod.Spec.InitContainers...)
+	}
+}
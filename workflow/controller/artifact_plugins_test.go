@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var artifactPluginTemplate = `
+name: main
+inputs:
+  artifacts:
+  - name: data
+    path: /tmp/data
+    plugin:
+      name: blob-store
+      configuration: "bucket: my-bucket"
+      key: data.tgz
+container:
+  image: docker/whalesay
+  command: [cat, /tmp/data]
+outputs:
+  artifacts:
+  - name: report
+    path: /tmp/report.txt
+    plugin:
+      name: remote-store
+      key: report.tgz
+`
+
+func addArtifactPlugin(t *testing.T, woc *wfOperationCtx, name string, data map[string]string) {
+	require.NoError(t, woc.controller.configMapInformer.GetIndexer().Add(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-artifact-plugin",
+			Namespace: woc.wf.Namespace,
+			Labels:    map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapArtifactPlugin},
+		},
+		Data: data,
+	}))
+}
+
+func TestArtifactPlugins(t *testing.T) {
+	ctx := context.Background()
+	tmpl := unmarshalTemplate(artifactPluginTemplate)
+
+	t.Run("NotFound", func(t *testing.T) {
+		woc := newWoc()
+		_, err := woc.createWorkflowPod(ctx, tmpl.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+		assert.EqualError(t, err, `artifact plugin "blob-store" not found: ConfigMap 'blob-store-artifact-plugin' with the label workflows.argoproj.io/configmap-type: ArtifactPlugin does not exist`)
+	})
+	t.Run("BinaryAndService", func(t *testing.T) {
+		woc := newWoc()
+		woc.wf.Namespace = "my-ns"
+		addArtifactPlugin(t, woc, "blob-store", map[string]string{
+			"binary":  "{image: blob-store-plugin, path: /bin/blob-store}",
+			"service": "{address: 'http://blob-store:4355', token: {name: blob-store-token, key: token}}",
+		})
+		addArtifactPlugin(t, woc, "remote-store", map[string]string{
+			"service": "{address: 'http://remote-store:4355', token: {name: remote-store-token, key: token}}",
+		})
+		pod, err := woc.createWorkflowPod(ctx, tmpl.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{})
+		require.NoError(t, err)
+
+		require.Len(t, pod.Spec.InitContainers, 2)
+		plugin := pod.Spec.InitContainers[0]
+		assert.Equal(t, "artifact-plugin-blob-store", plugin.Name)
+		assert.Equal(t, "blob-store-plugin", plugin.Image)
+		assert.Equal(t, []string{"cp", "/bin/blob-store", "/var/run/argo/artifact-plugins/blob-store"}, plugin.Command)
+		assert.Equal(t, []apiv1.VolumeMount{{Name: "var-run-argo", MountPath: "/var/run/argo/artifact-plugins", SubPath: "artifact-plugins"}}, plugin.VolumeMounts)
+		assert.Equal(t, common.InitContainerName, pod.Spec.InitContainers[1].Name)
+
+		// the token of the plugin that is called as a service is mounted for the executor
+		var secrets []string
+		for _, v := range pod.Spec.Volumes {
+			if v.Secret != nil {
+				secrets = append(secrets, v.Secret.SecretName)
+			}
+		}
+		assert.Equal(t, []string{"remote-store-token"}, secrets)
+	})
+}
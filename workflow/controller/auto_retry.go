@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v3/util/expr/env"
+	"github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// defaultAutoRetryLimit is the maximum number of times that a workflow is automatically retried, if its strategy has no
+// limit
+const defaultAutoRetryLimit = 3
+
+// autoRetry retries the workflow, which completed with the phase and message, if its auto-retry strategy allows it.
+// It returns true if the workflow is, or will be, retried, in which case it must not be marked as completed.
+func (woc *wfOperationCtx) autoRetry(ctx context.Context, phase wfv1.WorkflowPhase, message string) bool {
+	strategy := woc.execWf.Spec.AutoRetry
+	if strategy == nil {
+		return false
+	}
+	attempts := woc.wf.Status.AutoRetries
+	pending := len(attempts) > 0 && attempts[len(attempts)-1].RetriedAt.IsZero()
+	if !pending {
+		retry, err := woc.shouldAutoRetry(*strategy, phase, message)
+		if err != nil {
+			woc.log.WithError(err).Warn("unable to decide whether to automatically retry the workflow")
+			return false
+		}
+		if !retry {
+			return false
+		}
+		woc.wf.Status.AutoRetries = append(attempts, wfv1.AutoRetryAttempt{Phase: phase, Message: message, FailedAt: metav1.Time{Time: time.Now().UTC()}})
+		woc.updated = true
+	}
+	attempts = woc.wf.Status.AutoRetries
+	attempt := &attempts[len(attempts)-1]
+
+	if strategy.Backoff != nil {
+		timeToWait, err := autoRetryBackoff(woc.wf, *strategy.Backoff)
+		if err != nil {
+			woc.log.WithError(err).Warn("unable to compute the auto-retry backoff")
+			return false
+		}
+		waitingDeadline := attempt.FailedAt.Add(timeToWait)
+		if time.Now().UTC().Before(waitingDeadline) {
+			retryMessage := fmt.Sprintf("Retrying workflow in %s: %s", time.Until(waitingDeadline).Round(time.Second), message)
+			if woc.wf.Status.Message != retryMessage {
+				woc.wf.Status.Message = retryMessage
+				woc.updated = true
+			}
+			woc.requeueAfter(time.Until(waitingDeadline))
+			return true
+		}
+	}
+
+	newWF, podsToDelete, err := util.FormulateRetryWorkflow(woc.wf, strategy.RestartSuccessful, strategy.NodeFieldSelector, nil)
+	if err != nil {
+		woc.log.WithError(err).Warn("unable to automatically retry the workflow")
+		return false
+	}
+	for _, podName := range podsToDelete {
+		woc.controller.queuePodForCleanup(woc.wf.Namespace, podName, deletePod)
+	}
+	newWF.Status.AutoRetries[len(newWF.Status.AutoRetries)-1].RetriedAt = metav1.Time{Time: time.Now().UTC()}
+	woc.log.Infof("Automatically retrying workflow (attempt %d)", len(newWF.Status.AutoRetries))
+	woc.wf = newWF
+	woc.updated = true
+	woc.controller.metrics.WorkflowAutoRetried()
+	woc.eventRecorder.Event(woc.wf, apiv1.EventTypeNormal, "WorkflowAutoRetried", fmt.Sprintf("Workflow automatically retried after: %s", message))
+	woc.requeue()
+	return true
+}
+
+// shouldAutoRetry returns whether the workflow, which just completed with the phase and message, may be retried
+func (woc *wfOperationCtx) shouldAutoRetry(strategy wfv1.AutoRetryStrategy, phase wfv1.WorkflowPhase, message string) (bool, error) {
+	if woc.GetShutdownStrategy().Enabled() || (woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline)) {
+		return false, nil
+	}
+	attempts := woc.wf.Status.AutoRetries
+	limit, err := intstr.Int32(strategy.Limit)
+	if err != nil {
+		return false, err
+	}
+	if limit == nil {
+		limit = pointer.Int32Ptr(defaultAutoRetryLimit)
+	}
+	if int32(len(attempts)) >= *limit {
+		return false, nil
+	}
+	if strategy.Backoff != nil && strategy.Backoff.MaxDuration != "" && len(attempts) > 0 {
+		maxDuration, err := parseStringToDuration(strategy.Backoff.MaxDuration)
+		if err != nil {
+			return false, err
+		}
+		if time.Now().UTC().After(attempts[0].FailedAt.Add(maxDuration)) {
+			return false, nil
+		}
+	}
+	if strategy.Expression == "" {
+		return true, nil
+	}
+	var failedNodes []interface{}
+	for _, node := range woc.wf.Status.Nodes {
+		if node.FailedOrError() {
+			failedNodes = append(failedNodes, map[string]interface{}{
+				"displayName":  node.DisplayName,
+				"templateName": node.TemplateName,
+				"phase":        string(node.Phase),
+				"message":      node.Message,
+				"reason":       string(node.FailureReason),
+			})
+		}
+	}
+	return argoexpr.EvalBool(strategy.Expression, env.GetFuncMap(map[string]interface{}{
+		"workflow.status":  string(phase),
+		"workflow.message": message,
+		"workflow.retries": len(attempts),
+		"failedNodes":      failedNodes,
+	}))
+}
+
+// autoRetryBackoff returns how long to wait after the last attempt of the workflow failed before it is retried
+func autoRetryBackoff(wf *wfv1.Workflow, backoff wfv1.Backoff) (time.Duration, error) {
+	return backoffDuration("autoRetry", backoff, len(wf.Status.AutoRetries), string(wf.UID))
+}
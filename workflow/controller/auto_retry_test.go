@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var autoRetryWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: auto-retry
+  namespace: my-ns
+spec:
+  entrypoint: main
+  autoRetry:
+    limit: 1
+  templates:
+    - name: main
+      container:
+        image: my-image
+`
+
+func failAutoRetryWorkflow(ctx context.Context, wf *wfv1.Workflow, controller *WorkflowController) *wfOperationCtx {
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	return woc
+}
+
+func TestAutoRetry(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Retried", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(autoRetryWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := failAutoRetryWorkflow(ctx, wf, controller)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Empty(t, woc.wf.Status.Nodes)
+		if assert.Len(t, woc.wf.Status.AutoRetries, 1) {
+			attempt := woc.wf.Status.AutoRetries[0]
+			assert.Equal(t, wfv1.WorkflowFailed, attempt.Phase)
+			assert.False(t, attempt.FailedAt.IsZero())
+			assert.False(t, attempt.RetriedAt.IsZero())
+		}
+
+		// the limit is reached
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodFailed)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Len(t, woc.wf.Status.AutoRetries, 1)
+	})
+	t.Run("DefaultLimit", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(autoRetryWf)
+		wf.Spec.AutoRetry.Limit = nil
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.wf.Status.AutoRetries = make([]wfv1.AutoRetryAttempt, defaultAutoRetryLimit-1)
+		retry, err := woc.shouldAutoRetry(*wf.Spec.AutoRetry, wfv1.WorkflowFailed, "")
+		if assert.NoError(t, err) {
+			assert.True(t, retry)
+		}
+		woc.wf.Status.AutoRetries = make([]wfv1.AutoRetryAttempt, defaultAutoRetryLimit)
+		retry, err = woc.shouldAutoRetry(*wf.Spec.AutoRetry, wfv1.WorkflowFailed, "")
+		if assert.NoError(t, err) {
+			assert.False(t, retry)
+		}
+	})
+	t.Run("ExpressionFalse", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(autoRetryWf)
+		wf.Spec.AutoRetry.Expression = `any(failedNodes, {.reason == "OOMKilled"})`
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := failAutoRetryWorkflow(ctx, wf, controller)
+		assert.Equal(t, wfv1.WorkflowFailed, woc.wf.Status.Phase)
+		assert.Empty(t, woc.wf.Status.AutoRetries)
+	})
+	t.Run("ExpressionTrue", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(autoRetryWf)
+		wf.Spec.AutoRetry.Expression = `workflow.status == "Failed" && workflow.retries == 0 && len(failedNodes) > 0`
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := failAutoRetryWorkflow(ctx, wf, controller)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Len(t, woc.wf.Status.AutoRetries, 1)
+	})
+	t.Run("Backoff", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(autoRetryWf)
+		wf.Spec.AutoRetry.Backoff = &wfv1.Backoff{Duration: "1h"}
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := failAutoRetryWorkflow(ctx, wf, controller)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Contains(t, woc.wf.Status.Message, "Retrying workflow in")
+		assert.NotEmpty(t, woc.wf.Status.Nodes)
+		if assert.Len(t, woc.wf.Status.AutoRetries, 1) {
+			assert.True(t, woc.wf.Status.AutoRetries[0].RetriedAt.IsZero())
+		}
+
+		// the backoff has elapsed
+		woc.wf.Status.AutoRetries[0].FailedAt = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+		assert.Empty(t, woc.wf.Status.Nodes)
+		if assert.Len(t, woc.wf.Status.AutoRetries, 1) {
+			assert.False(t, woc.wf.Status.AutoRetries[0].RetriedAt.IsZero())
+		}
+	})
+}
+
+func TestAutoRetryBackoff(t *testing.T) {
+	wf := &wfv1.Workflow{Status: wfv1.WorkflowStatus{AutoRetries: make([]wfv1.AutoRetryAttempt, 3)}}
+	factor := intstr.FromInt(2)
+	timeToWait, err := autoRetryBackoff(wf, wfv1.Backoff{Duration: "10s", Factor: &factor})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 40*time.Second, timeToWait)
+	}
+	timeToWait, err = autoRetryBackoff(wf, wfv1.Backoff{Duration: "10s", Jitter: "0.5"})
+	if assert.NoError(t, err) {
+		assert.GreaterOrEqual(t, timeToWait, 10*time.Second)
+		assert.LessOrEqual(t, timeToWait, 15*time.Second)
+	}
+	_, err = autoRetryBackoff(wf, wfv1.Backoff{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,357 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// artifactCache stores each entry of a cache as a JSON object in the artifact repository, at
+// memoization-caches/<namespace>/<name>/<key>.json, so that entries are read and written on their own. Concurrent
+// writes of the same entry are not serialized: the last one wins.
+type artifactCache struct {
+	namespace          string
+	name               string
+	kubeClient         kubernetes.Interface
+	artifactRepository *wfv1.ArtifactRepository
+	newDriver          artifact.NewDriverFunc
+	maxEntries         int
+}
+
+func newArtifactCache(ns string, ki kubernetes.Interface, artifactRepository *wfv1.ArtifactRepository, newDriver artifact.NewDriverFunc, n string, maxEntries int) *artifactCache {
+	return &artifactCache{
+		namespace:          ns,
+		name:               n,
+		kubeClient:         ki,
+		artifactRepository: artifactRepository,
+		newDriver:          newDriver,
+		maxEntries:         maxEntries,
+	}
+}
+
+func (c *artifactCache) Load(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	entry, err := c.read(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact cache: %w", err)
+	}
+	if entry == nil {
+		log.WithFields(log.Fields{"name": c.name, "key": key}).Info("artifact cache miss: entry does not exist")
+		return nil, nil
+	}
+	entry.hit()
+	if err := c.write(ctx, key, entry); err != nil {
+		return nil, fmt.Errorf("error updating last hit timestamp on cache: %w", err)
+	}
+	return entry, nil
+}
+
+func (c *artifactCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, wf metav1.Object) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	entry := newEntry(nodeId, value, wf)
+	if err := c.write(ctx, key, &entry); err != nil {
+		return fmt.Errorf("error creating cache entry: %w", err)
+	}
+	if c.maxEntries <= 0 {
+		return nil
+	}
+	keys, err := c.keys(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list artifact cache: %w", err)
+	}
+	if len(keys) <= c.maxEntries {
+		return nil
+	}
+	// the entries are only read when the cache is full
+	entries, err := c.readAll(ctx, keys)
+	if err != nil {
+		return fmt.Errorf("could not load artifact cache: %w", err)
+	}
+	evicted := evict(entries, c.maxEntries, key)
+	if err := c.delete(ctx, evicted...); err != nil {
+		return fmt.Errorf("could not evict artifact cache entries: %w", err)
+	}
+	if len(evicted) > 0 {
+		log.WithFields(log.Fields{"name": c.name, "evicted": len(evicted)}).Info("Evicted artifact cache entries")
+	}
+	return nil
+}
+
+func (c *artifactCache) List(ctx context.Context, keyPrefix string) (map[string]Entry, error) {
+	keys, err := c.keys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list artifact cache: %w", err)
+	}
+	var matched []string
+	for _, key := range keys {
+		if strings.HasPrefix(key, keyPrefix) {
+			matched = append(matched, key)
+		}
+	}
+	entries, err := c.readAll(ctx, matched)
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact cache: %w", err)
+	}
+	return entries, nil
+}
+
+func (c *artifactCache) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		if !cacheKeyRegex.MatchString(key) {
+			return fmt.Errorf("invalid cache key: %s", key)
+		}
+	}
+	log.WithFields(log.Fields{"name": c.name, "keys": keys}).Info("Deleting artifact cache entries")
+	if err := c.delete(ctx, keys...); err != nil {
+		return fmt.Errorf("could not delete artifact cache entries: %w", err)
+	}
+	return nil
+}
+
+func (c *artifactCache) deleteNotHitSince(ctx context.Context, t time.Time) error {
+	keys, err := c.keys(ctx)
+	if err != nil {
+		return err
+	}
+	entries, err := c.readAll(ctx, keys)
+	if err != nil {
+		return err
+	}
+	for key, entry := range entries {
+		if entry.LastHitTimestamp.Time.Before(t) {
+			log.WithFields(log.Fields{"name": c.name, "key": key}).Info("Deleting entry in artifact cache since it's not been hit")
+			if err := c.delete(ctx, key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+const artifactCachesKey = "memoization-caches"
+
+func artifactCacheLocation(artifactRepository *wfv1.ArtifactRepository, key string) (*wfv1.Artifact, error) {
+	l := artifactRepository.ToArtifactLocation()
+	if l == nil || !l.HasLocation() {
+		return nil, fmt.Errorf("artifact caches require a default artifact repository to be configured")
+	}
+	art := &wfv1.Artifact{Name: path.Base(key), ArtifactLocation: *l}
+	if err := art.SetKey(key); err != nil {
+		return nil, err
+	}
+	return art, nil
+}
+
+// listArtifactCaches returns the names of the caches in the artifact repository, or none if there is no repository
+func listArtifactCaches(ctx context.Context, ns string, ki kubernetes.Interface, artifactRepository *wfv1.ArtifactRepository, newDriver artifact.NewDriverFunc) ([]string, error) {
+	if l := artifactRepository.ToArtifactLocation(); l == nil || !l.HasLocation() {
+		return nil, nil
+	}
+	dir := path.Join(artifactCachesKey, ns)
+	art, err := artifactCacheLocation(artifactRepository, dir)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := newDriver(ctx, art, resource.New(ki, ns))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := driver.ListObjects(art)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for _, key := range keys {
+		// the keys of the entries are <name>/<key>.json
+		parts := strings.Split(strings.TrimPrefix(key, dir+"/"), "/")
+		if len(parts) == 2 && strings.HasSuffix(parts[1], ".json") {
+			names[parts[0]] = true
+		}
+	}
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted, nil
+}
+
+// dir returns the location of the directory of the entries of the cache
+func (c *artifactCache) dir() (*wfv1.Artifact, error) {
+	return artifactCacheLocation(c.artifactRepository, path.Join(artifactCachesKey, c.namespace, c.name))
+}
+
+// artifact returns the location of the entry with the key
+func (c *artifactCache) artifact(key string) (*wfv1.Artifact, error) {
+	return artifactCacheLocation(c.artifactRepository, path.Join(artifactCachesKey, c.namespace, c.name, key+".json"))
+}
+
+func (c *artifactCache) driver(ctx context.Context, art *wfv1.Artifact) (artifactscommon.ArtifactDriver, error) {
+	return c.newDriver(ctx, art, resource.New(c.kubeClient, c.namespace))
+}
+
+// keys returns the keys of the entries of the cache
+func (c *artifactCache) keys(ctx context.Context) ([]string, error) {
+	art, err := c.dir()
+	if err != nil {
+		return nil, err
+	}
+	driver, err := c.driver(ctx, art)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := driver.ListObjects(art)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, object := range objects {
+		if key := strings.TrimSuffix(path.Base(object), ".json"); key != path.Base(object) && cacheKeyRegex.MatchString(key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// readAll returns the entries with the keys, keys whose entries have been deleted since they were listed are ignored
+func (c *artifactCache) readAll(ctx context.Context, keys []string) (map[string]Entry, error) {
+	entries := make(map[string]Entry)
+	for _, key := range keys {
+		entry, err := c.read(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			entries[key] = *entry
+		}
+	}
+	return entries, nil
+}
+
+// read returns the entry with the key, or nil if there is no such entry
+func (c *artifactCache) read(ctx context.Context, key string) (*Entry, error) {
+	art, err := c.artifact(key)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := c.driver(ctx, art)
+	if err != nil {
+		return nil, err
+	}
+	tmpDir, err := ioutil.TempDir("", "memoization-cache")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+	filename := filepath.Join(tmpDir, "entry.json")
+	err = driver.Load(art, filename)
+	if errors.IsCode(errors.CodeNotFound, err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	entry := &Entry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", key, err)
+	}
+	return entry, nil
+}
+
+func (c *artifactCache) write(ctx context.Context, key string, entry *Entry) error {
+	art, err := c.artifact(key)
+	if err != nil {
+		return err
+	}
+	driver, err := c.driver(ctx, art)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	tmpFile, err := ioutil.TempFile("", "memoization-cache")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return driver.Save(tmpFile.Name(), art)
+}
+
+// delete deletes the entries with the keys, keys that do not exist are ignored
+func (c *artifactCache) delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		art, err := c.artifact(key)
+		if err != nil {
+			return err
+		}
+		driver, err := c.driver(ctx, art)
+		if err != nil {
+			return err
+		}
+		if err := driver.Delete(art); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evict deletes the least recently hit entries beyond maxEntries, unless it is zero, and returns their keys.
+// The entry with the kept key, which has just been saved, is never evicted.
+func evict(entries map[string]Entry, maxEntries int, kept string) []string {
+	if maxEntries <= 0 || len(entries) <= maxEntries {
+		return nil
+	}
+	var keys []string
+	for key := range entries {
+		if key != kept {
+			keys = append(keys, key)
+		}
+	}
+	// timestamps are only stored to the second, so ties are common
+	sort.Slice(keys, func(i, j int) bool {
+		x, y := entries[keys[i]].LastHitTimestamp, entries[keys[j]].LastHitTimestamp
+		if x.Equal(&y) {
+			return keys[i] < keys[j]
+		}
+		return x.After(y.Time)
+	})
+	evicted := keys[maxEntries-1:]
+	for _, key := range evicted {
+		delete(entries, key)
+	}
+	return evicted
+}
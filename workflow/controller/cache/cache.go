@@ -2,27 +2,66 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"regexp"
+	"sync"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
-var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
+var (
+	cacheKeyRegex       = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
+	cacheKeyPrefixRegex = regexp.MustCompile("^[-a-zA-Z0-9]*$")
+)
 
 type MemoizationCache interface {
+	// Load returns the entry with the key and records that it has been hit, or nil if there is no such entry
 	Load(ctx context.Context, key string) (*Entry, error)
-	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error
+	// Save creates or replaces the entry with the key, wf is the workflow of the node and may be nil
+	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, wf metav1.Object) error
+	// List returns the entries whose keys have the prefix, without recording that they have been hit
+	List(ctx context.Context, keyPrefix string) (map[string]Entry, error)
+	// Delete deletes the entries with the keys, keys that do not exist are ignored
+	Delete(ctx context.Context, keys ...string) error
 }
 
 type Entry struct {
 	NodeID            string        `json:"nodeID"`
+	WorkflowName      string        `json:"workflowName,omitempty"`
+	WorkflowNamespace string        `json:"workflowNamespace,omitempty"`
 	Outputs           *wfv1.Outputs `json:"outputs"`
 	CreationTimestamp metav1.Time   `json:"creationTimestamp"`
 	LastHitTimestamp  metav1.Time   `json:"lastHitTimestamp"`
+	HitCount          int64         `json:"hitCount,omitempty"`
+}
+
+func newEntry(nodeId string, value *wfv1.Outputs, wf metav1.Object) Entry {
+	creationTime := metav1.Time{Time: time.Now()}
+	entry := Entry{
+		NodeID:            nodeId,
+		Outputs:           value,
+		CreationTimestamp: creationTime,
+		LastHitTimestamp:  creationTime,
+	}
+	if wf != nil {
+		entry.WorkflowName = wf.GetName()
+		entry.WorkflowNamespace = wf.GetNamespace()
+	}
+	return entry
+}
+
+// hit records that the entry has been hit now
+func (e *Entry) hit() {
+	e.LastHitTimestamp = metav1.Time{Time: time.Now()}
+	e.HitCount++
 }
 
 func (e *Entry) Hit() bool {
@@ -48,42 +87,115 @@ func (e *Entry) GetOutputsWithMaxAge(maxAge time.Duration) (*wfv1.Outputs, bool)
 }
 
 type cacheFactory struct {
-	caches     map[string]MemoizationCache
-	kubeclient kubernetes.Interface
-	namespace  string
+	caches               map[string]MemoizationCache
+	lock                 sync.Mutex
+	kubeclient           kubernetes.Interface
+	namespace            string
+	memoizationCacheRepo sqldb.MemoizationCacheRepo
+	artifactRepository   *wfv1.ArtifactRepository
+	newDriver            artifact.NewDriverFunc
 }
 
 type Factory interface {
-	GetCache(ct CacheType, name string) MemoizationCache
+	// GetCache returns the cache of the type with the name, maxEntries limits the size of the caches that support it
+	GetCache(ct wfv1.CacheType, name string, maxEntries int) MemoizationCache
+	// DeleteNotHitSince deletes the entries of the SQL and artifact caches that have not been hit since the time
+	DeleteNotHitSince(ctx context.Context, t time.Time) error
+	// ListCaches returns the names of the caches of the type, or none if the type is not configured
+	ListCaches(ctx context.Context, ct wfv1.CacheType) ([]string, error)
+	// SetConfig sets the repository of the SQL caches and the artifact repository of the artifact caches, when the
+	// configuration of the controller is reloaded
+	SetConfig(memoizationCacheRepo sqldb.MemoizationCacheRepo, artifactRepository *wfv1.ArtifactRepository)
 }
 
-func NewCacheFactory(ki kubernetes.Interface, ns string) Factory {
+func NewCacheFactory(ki kubernetes.Interface, ns string, memoizationCacheRepo sqldb.MemoizationCacheRepo, artifactRepository *wfv1.ArtifactRepository, newDriver artifact.NewDriverFunc) Factory {
 	return &cacheFactory{
-		make(map[string]MemoizationCache),
-		ki,
-		ns,
+		caches:               make(map[string]MemoizationCache),
+		kubeclient:           ki,
+		namespace:            ns,
+		memoizationCacheRepo: memoizationCacheRepo,
+		artifactRepository:   artifactRepository,
+		newDriver:            newDriver,
 	}
 }
 
-type CacheType string
+func (cf *cacheFactory) SetConfig(memoizationCacheRepo sqldb.MemoizationCacheRepo, artifactRepository *wfv1.ArtifactRepository) {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
+	cf.memoizationCacheRepo = memoizationCacheRepo
+	cf.artifactRepository = artifactRepository
+}
 
-const (
-	// Only config maps are currently supported for caching
-	ConfigMapCache CacheType = "ConfigMapCache"
-)
+// config returns the repository of the SQL caches and the artifact repository of the artifact caches
+func (cf *cacheFactory) config() (sqldb.MemoizationCacheRepo, *wfv1.ArtifactRepository) {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
+	return cf.memoizationCacheRepo, cf.artifactRepository
+}
 
 // Returns a cache if it exists and creates it otherwise
-func (cf *cacheFactory) GetCache(ct CacheType, name string) MemoizationCache {
-	idx := string(ct) + "." + name
-	if c := cf.caches[idx]; c != nil {
-		return c
-	}
+func (cf *cacheFactory) GetCache(ct wfv1.CacheType, name string, maxEntries int) MemoizationCache {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
 	switch ct {
-	case ConfigMapCache:
+	case wfv1.CacheTypeConfigMap, "":
+		if c := cf.caches[name]; c != nil {
+			return c
+		}
 		c := NewConfigMapCache(cf.namespace, cf.kubeclient, name)
-		cf.caches[idx] = c
+		cf.caches[name] = c
 		return c
+	case wfv1.CacheTypeSQL:
+		return NewSQLCache(cf.namespace, cf.memoizationCacheRepo, name, maxEntries)
+	case wfv1.CacheTypeArtifact:
+		return newArtifactCache(cf.namespace, cf.kubeclient, cf.artifactRepository, cf.newDriver, name, maxEntries)
 	default:
 		return nil
 	}
 }
+
+func (cf *cacheFactory) DeleteNotHitSince(ctx context.Context, t time.Time) error {
+	memoizationCacheRepo, artifactRepository := cf.config()
+	rowsAffected, err := memoizationCacheRepo.DeleteNotHitSince(t)
+	if err != nil {
+		return fmt.Errorf("failed to delete SQL cache entries: %w", err)
+	}
+	if rowsAffected > 0 {
+		log.WithField("rowsAffected", rowsAffected).Info("Deleted SQL cache entries since they have not been hit")
+	}
+	names, err := listArtifactCaches(ctx, cf.namespace, cf.kubeclient, artifactRepository, cf.newDriver)
+	if err != nil {
+		return fmt.Errorf("failed to list artifact caches: %w", err)
+	}
+	for _, name := range names {
+		c := newArtifactCache(cf.namespace, cf.kubeclient, artifactRepository, cf.newDriver, name, 0)
+		if err := c.deleteNotHitSince(ctx, t); err != nil {
+			return fmt.Errorf("failed to delete entries of artifact cache %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (cf *cacheFactory) ListCaches(ctx context.Context, ct wfv1.CacheType) ([]string, error) {
+	memoizationCacheRepo, artifactRepository := cf.config()
+	switch ct {
+	case wfv1.CacheTypeConfigMap, "":
+		configMaps, err := cf.kubeclient.CoreV1().ConfigMaps(cf.namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: common.LabelKeyConfigMapType + "=" + common.LabelValueTypeConfigMapCache,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, cm := range configMaps.Items {
+			names = append(names, cm.Name)
+		}
+		return names, nil
+	case wfv1.CacheTypeSQL:
+		return memoizationCacheRepo.ListCaches(cf.namespace)
+	case wfv1.CacheTypeArtifact:
+		return listArtifactCaches(ctx, cf.namespace, cf.kubeclient, artifactRepository, cf.newDriver)
+	default:
+		return nil, fmt.Errorf("unknown cache type %q", ct)
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
-	"time"
 
 	log "github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
@@ -61,7 +61,6 @@ func (c *configMapCache) Load(ctx context.Context, key string) (*Entry, error) {
 	}
 
 	c.logInfo(log.Fields{}, "config map cache loaded")
-	hitTime := time.Now()
 	rawEntry, ok := cm.Data[key]
 	if !ok || rawEntry == "" {
 		c.logInfo(log.Fields{}, "config map cache miss: entry does not exist")
@@ -74,7 +73,7 @@ func (c *configMapCache) Load(ctx context.Context, key string) (*Entry, error) {
 		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
 	}
 
-	entry.LastHitTimestamp = metav1.Time{Time: hitTime}
+	entry.hit()
 	entryJSON, err := json.Marshal(entry)
 	if err != nil {
 		c.logError(err, log.Fields{"key": key}, "Unable to marshal cache entry with last hit timestamp")
@@ -91,7 +90,7 @@ func (c *configMapCache) Load(ctx context.Context, key string) (*Entry, error) {
 	return &entry, nil
 }
 
-func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error {
+func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, wf metav1.Object) error {
 	if !cacheKeyRegex.MatchString(key) {
 		errString := fmt.Sprintf("invalid cache key: %s", key)
 		err := errors.New(errString)
@@ -117,17 +116,9 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 		}
 	}
 
-	creationTime := time.Now()
 	cache.SetLabels(map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapCache})
 
-	newEntry := Entry{
-		NodeID:            nodeId,
-		Outputs:           value,
-		CreationTimestamp: metav1.Time{Time: creationTime},
-		LastHitTimestamp:  metav1.Time{Time: creationTime},
-	}
-
-	entryJSON, err := json.Marshal(newEntry)
+	entryJSON, err := json.Marshal(newEntry(nodeId, value, wf))
 	if err != nil {
 		c.logError(err, log.Fields{"key": key, "nodeId": nodeId}, "Unable to marshal cache entry")
 		return fmt.Errorf("unable to marshal cache entry: %w", err)
@@ -145,3 +136,65 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 	}
 	return nil
 }
+
+func (c *configMapCache) List(ctx context.Context, keyPrefix string) (map[string]Entry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	entries := map[string]Entry{}
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load config map cache: %w", err)
+	}
+	for key, rawEntry := range cm.Data {
+		if !strings.HasPrefix(key, keyPrefix) {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal([]byte(rawEntry), &entry); err != nil {
+			return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", key, err)
+		}
+		entries[key] = entry
+	}
+	return entries, nil
+}
+
+func (c *configMapCache) Delete(ctx context.Context, keys ...string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not load config map cache: %w", err)
+	}
+	var modified bool
+	for _, key := range keys {
+		if _, ok := cm.Data[key]; ok {
+			delete(cm.Data, key)
+			modified = true
+		}
+	}
+	if !modified {
+		return nil
+	}
+	c.logInfo(log.Fields{"keys": keys}, "Deleting ConfigMap cache entries")
+	// like the garbage collection, delete the config map once it has no entries
+	if len(cm.Data) == 0 {
+		err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Delete(ctx, c.name, metav1.DeleteOptions{})
+		if apierr.IsNotFound(err) {
+			return nil
+		}
+	} else {
+		_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("could not delete config map cache entries: %w", err)
+	}
+	return nil
+}
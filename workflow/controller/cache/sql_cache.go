@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+type sqlCache struct {
+	namespace  string
+	name       string
+	repo       sqldb.MemoizationCacheRepo
+	maxEntries int
+}
+
+func NewSQLCache(ns string, repo sqldb.MemoizationCacheRepo, n string, maxEntries int) MemoizationCache {
+	return &sqlCache{
+		namespace:  ns,
+		name:       n,
+		repo:       repo,
+		maxEntries: maxEntries,
+	}
+}
+
+func (c *sqlCache) Load(_ context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	record, err := c.repo.Load(c.namespace, c.name, key)
+	if err != nil {
+		return nil, fmt.Errorf("could not load SQL cache: %w", err)
+	}
+	if record == nil {
+		log.WithFields(log.Fields{"name": c.name, "key": key}).Info("SQL cache miss: entry does not exist")
+		return nil, nil
+	}
+	entry, err := newSQLEntry(record)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func newSQLEntry(record *sqldb.MemoizationCacheRecord) (Entry, error) {
+	entry := Entry{
+		NodeID:            record.NodeID,
+		WorkflowName:      record.WorkflowName,
+		WorkflowNamespace: record.WorkflowNamespace,
+		CreationTimestamp: metav1.Time{Time: record.CreatedAt},
+		LastHitTimestamp:  metav1.Time{Time: record.LastHitAt},
+		HitCount:          record.HitCount,
+	}
+	if err := json.Unmarshal([]byte(record.Outputs), &entry.Outputs); err != nil {
+		return entry, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", record.Key, err)
+	}
+	return entry, nil
+}
+
+func (c *sqlCache) Save(_ context.Context, key string, nodeId string, value *wfv1.Outputs, wf metav1.Object) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	outputs, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	entry := newEntry(nodeId, value, wf)
+	err = c.repo.Save(&sqldb.MemoizationCacheRecord{
+		Namespace:         c.namespace,
+		Name:              c.name,
+		Key:               key,
+		NodeID:            nodeId,
+		WorkflowName:      entry.WorkflowName,
+		WorkflowNamespace: entry.WorkflowNamespace,
+		Outputs:           string(outputs),
+		CreatedAt:         entry.CreationTimestamp.UTC(),
+		LastHitAt:         entry.LastHitTimestamp.UTC(),
+	}, c.maxEntries)
+	if err != nil {
+		return fmt.Errorf("error creating SQL cache entry: %w", err)
+	}
+	return nil
+}
+
+func (c *sqlCache) List(_ context.Context, keyPrefix string) (map[string]Entry, error) {
+	entries := map[string]Entry{}
+	// no key can have a prefix that contains other characters, and they might be wildcards
+	if keyPrefix != "" && !cacheKeyPrefixRegex.MatchString(keyPrefix) {
+		return entries, nil
+	}
+	records, err := c.repo.List(c.namespace, c.name, keyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("could not load SQL cache: %w", err)
+	}
+	for i := range records {
+		entry, err := newSQLEntry(&records[i])
+		if err != nil {
+			return nil, err
+		}
+		entries[records[i].Key] = entry
+	}
+	return entries, nil
+}
+
+func (c *sqlCache) Delete(_ context.Context, keys ...string) error {
+	if err := c.repo.Delete(c.namespace, c.name, keys); err != nil {
+		return fmt.Errorf("could not delete SQL cache entries: %w", err)
+	}
+	return nil
+}
@@ -25,6 +25,14 @@ func init() {
 
 // syncAllCacheForGC syncs all cache for GC
 func (wfc *WorkflowController) syncAllCacheForGC(ctx context.Context) {
+	wfc.syncConfigMapCacheForGC(ctx)
+	if err := wfc.cacheFactory.DeleteNotHitSince(ctx, time.Now().Add(-gcAfterNotHitDuration)); err != nil {
+		log.WithError(err).Error("Unable to sync caches")
+	}
+}
+
+// syncConfigMapCacheForGC syncs the caches stored in ConfigMaps for GC
+func (wfc *WorkflowController) syncConfigMapCacheForGC(ctx context.Context) {
 	configMaps, err := wfc.configMapInformer.GetIndexer().ByIndex(indexes.ConfigMapLabelsIndex, common.LabelValueTypeConfigMapCache)
 	if err != nil {
 		log.WithError(err).Error("Failed to get configmaps from informer")
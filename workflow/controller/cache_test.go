@@ -2,13 +2,23 @@ package controller
 
 import (
 	"context"
+	"io/ioutil"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 )
 
@@ -88,7 +98,7 @@ func TestConfigMapCacheSave(t *testing.T) {
 	ctx := context.Background()
 	outputs := wfv1.Outputs{}
 	outputs.Parameters = append(outputs.Parameters, MockParam)
-	err := c.Save(ctx, "hi-there-world", "", &outputs)
+	err := c.Save(ctx, "hi-there-world", "", &outputs, nil)
 	assert.NoError(t, err)
 
 	cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "whalesay-cache", metav1.GetOptions{})
@@ -98,3 +108,211 @@ func TestConfigMapCacheSave(t *testing.T) {
 	wfv1.MustUnmarshal([]byte(cm.Data["hi-there-world"]), &entry)
 	assert.Equal(t, entry.LastHitTimestamp.Time, entry.CreationTimestamp.Time)
 }
+
+func TestConfigMapCacheListAndDelete(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+	factory := cache.NewCacheFactory(controller.kubeclientset, "default", sqldb.NullMemoizationCacheRepo, nil, nil)
+	c := factory.GetCache(wfv1.CacheTypeConfigMap, "whalesay-cache", 0)
+
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}}
+	for _, key := range []string{"hi-there-world", "hi-there-moon", "bye"} {
+		err := c.Save(ctx, key, "my-node", &wfv1.Outputs{}, wf)
+		assert.NoError(t, err)
+	}
+	_, err := c.Load(ctx, "hi-there-world")
+	assert.NoError(t, err)
+
+	names, err := factory.ListCaches(ctx, wfv1.CacheTypeConfigMap)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"whalesay-cache"}, names)
+	}
+	entries, err := c.List(ctx, "hi-")
+	if assert.NoError(t, err) && assert.Len(t, entries, 2) {
+		assert.Equal(t, int64(1), entries["hi-there-world"].HitCount)
+		assert.Equal(t, int64(0), entries["hi-there-moon"].HitCount)
+		assert.Equal(t, "my-wf", entries["hi-there-moon"].WorkflowName)
+		assert.Equal(t, "my-ns", entries["hi-there-moon"].WorkflowNamespace)
+	}
+
+	err = c.Delete(ctx, "hi-there-world", "hi-there-moon")
+	assert.NoError(t, err)
+	entries, err = c.List(ctx, "")
+	if assert.NoError(t, err) {
+		assert.Len(t, entries, 1)
+		assert.Contains(t, entries, "bye")
+	}
+
+	// the config map is deleted with its last entry
+	err = c.Delete(ctx, "bye")
+	assert.NoError(t, err)
+	_, err = controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "whalesay-cache", metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
+
+func TestSQLCache(t *testing.T) {
+	ctx := context.Background()
+	repo := &sqldbmocks.MemoizationCacheRepo{}
+	repo.On("Load", "default", "whalesay-cache", "miss").Return(nil, nil)
+	repo.On("Load", "default", "whalesay-cache", "hit").Return(&sqldb.MemoizationCacheRecord{
+		NodeID:    "my-node",
+		Outputs:   `{"parameters":[{"name":"hello","value":"foobar"}]}`,
+		CreatedAt: time.Now(),
+		LastHitAt: time.Now(),
+	}, nil)
+	repo.On("Save", mock.MatchedBy(func(r *sqldb.MemoizationCacheRecord) bool {
+		return r.Namespace == "default" && r.Name == "whalesay-cache" && r.Key == "hi-there-world" && r.NodeID == "my-node" && r.Outputs == `{"parameters":[{"name":"hello","value":"foobar"}]}`
+	}), 10).Return(nil)
+	repo.On("List", "default", "whalesay-cache", "hi").Return([]sqldb.MemoizationCacheRecord{
+		{Key: "hi-there-world", NodeID: "my-node", WorkflowName: "my-wf", Outputs: `{}`, HitCount: 2},
+	}, nil)
+	repo.On("Delete", "default", "whalesay-cache", []string{"hi-there-world"}).Return(nil)
+	c := cache.NewCacheFactory(nil, "default", repo, nil, nil).GetCache(wfv1.CacheTypeSQL, "whalesay-cache", 10)
+
+	entry, err := c.Load(ctx, "miss")
+	assert.NoError(t, err)
+	assert.False(t, entry.Hit())
+
+	entry, err = c.Load(ctx, "hit")
+	if assert.NoError(t, err) && assert.True(t, entry.Hit()) {
+		assert.Equal(t, "foobar", entry.GetOutputs().Parameters[0].Value.String())
+	}
+
+	err = c.Save(ctx, "hi-there-world", "my-node", &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("foobar")}}}, nil)
+	assert.NoError(t, err)
+
+	entries, err := c.List(ctx, "hi")
+	if assert.NoError(t, err) && assert.Contains(t, entries, "hi-there-world") {
+		assert.Equal(t, "my-wf", entries["hi-there-world"].WorkflowName)
+		assert.Equal(t, int64(2), entries["hi-there-world"].HitCount)
+	}
+	// a prefix with wildcards cannot match any key
+	entries, err = c.List(ctx, "%")
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	err = c.Delete(ctx, "hi-there-world")
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+
+	_, err = cache.NewCacheFactory(nil, "default", sqldb.NullMemoizationCacheRepo, nil, nil).GetCache(wfv1.CacheTypeSQL, "whalesay-cache", 0).Load(ctx, "hit")
+	assert.Error(t, err)
+}
+
+// memoryArtifactDriver stores the artifacts in memory
+type memoryArtifactDriver struct {
+	objects map[string][]byte
+}
+
+func (d *memoryArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	key, _ := inputArtifact.GetKey()
+	data, ok := d.objects[key]
+	if !ok {
+		return errors.New(errors.CodeNotFound, key)
+	}
+	return ioutil.WriteFile(path, data, 0o600)
+}
+
+func (d *memoryArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	key, _ := outputArtifact.GetKey()
+	data, err := ioutil.ReadFile(path)
+	d.objects[key] = data
+	return err
+}
+
+func (d *memoryArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	prefix, _ := artifact.GetKey()
+	var keys []string
+	for key := range d.objects {
+		if strings.HasPrefix(key, prefix+"/") {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (d *memoryArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	key, _ := artifact.GetKey()
+	delete(d.objects, key)
+	return nil
+}
+
+func (d *memoryArtifactDriver) OpenStream(*wfv1.Artifact, int64) (*artifactscommon.ArtifactStream, error) {
+	return nil, artifactscommon.ErrOpenStreamNotSupported
+}
+
+func TestArtifactCache(t *testing.T) {
+	ctx := context.Background()
+	driver := &memoryArtifactDriver{objects: map[string][]byte{}}
+	newDriver := func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		return driver, nil
+	}
+	repository := &wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket", Endpoint: "my-endpoint"}}}
+	factory := cache.NewCacheFactory(nil, "default", sqldb.NullMemoizationCacheRepo, repository, newDriver)
+	c := factory.GetCache(wfv1.CacheTypeArtifact, "whalesay-cache", 1)
+
+	entry, err := c.Load(ctx, "hi-there-world")
+	assert.NoError(t, err)
+	assert.False(t, entry.Hit())
+
+	for _, key := range []string{"one", "two"} {
+		err := c.Save(ctx, key, "node-"+key, &wfv1.Outputs{}, nil)
+		assert.NoError(t, err)
+	}
+	// each entry is an object of its own
+	assert.Contains(t, driver.objects, "memoization-caches/default/whalesay-cache/two.json")
+	assert.NotContains(t, driver.objects, "memoization-caches/default/whalesay-cache/one.json")
+
+	// the older entry was evicted to make room for the newer one
+	entry, err = c.Load(ctx, "one")
+	assert.NoError(t, err)
+	assert.False(t, entry.Hit())
+	entry, err = c.Load(ctx, "two")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "node-two", entry.NodeID)
+	}
+
+	// the caches are found in the artifact repository, including those that this factory has not used
+	err = cache.NewCacheFactory(nil, "default", sqldb.NullMemoizationCacheRepo, repository, newDriver).DeleteNotHitSince(ctx, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	entry, err = c.Load(ctx, "two")
+	assert.NoError(t, err)
+	assert.False(t, entry.Hit())
+	assert.Empty(t, driver.objects)
+
+	assert.NoError(t, c.Save(ctx, "two", "node-two", &wfv1.Outputs{}, nil))
+	names, err := factory.ListCaches(ctx, wfv1.CacheTypeArtifact)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"whalesay-cache"}, names)
+	}
+
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns"}}
+	for _, key := range []string{"three", "thirty", "four"} {
+		err := factory.GetCache(wfv1.CacheTypeArtifact, "whalesay-cache", 0).Save(ctx, key, "node-"+key, &wfv1.Outputs{}, wf)
+		assert.NoError(t, err)
+	}
+	entries, err := c.List(ctx, "th")
+	if assert.NoError(t, err) && assert.Len(t, entries, 2) {
+		assert.Equal(t, "my-wf", entries["three"].WorkflowName)
+		assert.Equal(t, "my-ns", entries["three"].WorkflowNamespace)
+	}
+	err = c.Delete(ctx, "three", "not-found")
+	assert.NoError(t, err)
+	entries, err = c.List(ctx, "")
+	if assert.NoError(t, err) {
+		assert.Len(t, entries, 3)
+		assert.NotContains(t, entries, "three")
+	}
+
+	// the artifact repository is replaced when the configuration is reloaded
+	factory.SetConfig(sqldb.NullMemoizationCacheRepo, nil)
+	_, err = factory.GetCache(wfv1.CacheTypeArtifact, "whalesay-cache", 0).Load(ctx, "two")
+	assert.Error(t, err)
+
+	_, err = cache.NewCacheFactory(nil, "default", sqldb.NullMemoizationCacheRepo, nil, newDriver).GetCache(wfv1.CacheTypeArtifact, "whalesay-cache", 0).Load(ctx, "three")
+	assert.EqualError(t, err, "could not load artifact cache: artifact caches require a default artifact repository to be configured")
+	names, err = cache.NewCacheFactory(nil, "default", sqldb.NullMemoizationCacheRepo, nil, newDriver).ListCaches(ctx, wfv1.CacheTypeArtifact)
+	assert.NoError(t, err)
+	assert.Empty(t, names)
+}
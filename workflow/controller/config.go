@@ -14,6 +14,8 @@ import (
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
 )
 
@@ -38,6 +40,7 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
 	wfc.offloadNodeStatusRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = sqldb.NullWorkflowArchive
+	memoizationCacheRepo := sqldb.NullMemoizationCacheRepo
 	wfc.archiveLabelSelector = labels.Everything()
 	persistence := wfc.Config.Persistence
 	if persistence != nil {
@@ -57,6 +60,7 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 		}
 
 		wfc.session = session
+		memoizationCacheRepo = sqldb.NewMemoizationCacheRepo(session, persistence.GetClusterName())
 		if persistence.NodeStatusOffload {
 			wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(session, persistence.GetClusterName(), tableName)
 			if err != nil {
@@ -82,6 +86,11 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 		log.Info("Persistence configuration disabled")
 	}
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
+	if wfc.cacheFactory == nil {
+		wfc.cacheFactory = controllercache.NewCacheFactory(wfc.kubeclientset, wfc.namespace, memoizationCacheRepo, &wfc.Config.ArtifactRepository, artifact.NewDriver)
+	} else {
+		wfc.cacheFactory.SetConfig(memoizationCacheRepo, &wfc.Config.ArtifactRepository)
+	}
 	wfc.updateEstimatorFactory()
 	wfc.rateLimiter = wfc.newRateLimiter()
 	return nil
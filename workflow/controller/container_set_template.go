@@ -2,7 +2,11 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+
+	apiv1 "k8s.io/api/core/v1"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -14,8 +18,8 @@ func (woc *wfOperationCtx) executeContainerSet(ctx context.Context, nodeName str
 		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypePod, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
 	}
 
-	if woc.getContainerRuntimeExecutor() != common.ContainerRuntimeExecutorEmissary && tmpl.HasSequencedContainers() {
-		woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("template has sequenced containers, so you must use the emissary executor rather than %q, learn more: https://argoproj.github.io/argo-workflows/workflow-executors/#emissary-emissary", woc.getContainerRuntimeExecutor()))
+	if woc.getContainerRuntimeExecutor() != common.ContainerRuntimeExecutorEmissary && tmpl.ContainerSet.RequiresEmissary() {
+		woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("template has sequenced or retried containers, or containers with outputs, so you must use the emissary executor rather than %q, learn more: https://argoproj.github.io/argo-workflows/workflow-executors/#emissary-emissary", woc.getContainerRuntimeExecutor()))
 		return woc.wf.GetNodeByName(nodeName), nil
 	}
 	includeScriptOutput, err := woc.includeScriptOutput(nodeName, opts.boundaryID)
@@ -43,13 +47,37 @@ func (woc *wfOperationCtx) executeContainerSet(ctx context.Context, nodeName str
 	}
 	for _, c := range tmpl.ContainerSet.GetGraph() {
 		ctrNodeName := fmt.Sprintf("%s.%s", nodeName, c.Name)
-		if len(c.Dependencies) == 0 {
+		dependencies, _ := common.GetContainerDependencies(tmpl.ContainerSet, c.Name)
+		if len(dependencies) == 0 {
 			woc.addChildNode(nodeName, ctrNodeName)
 		}
-		for _, v := range c.Dependencies {
+		for _, v := range dependencies {
 			woc.addChildNode(fmt.Sprintf("%s.%s", nodeName, v), ctrNodeName)
 		}
 	}
 
 	return node, nil
 }
+
+// updateContainerOutputs sets the outputs of the nodes of the containers of a container set from the pod's annotation
+func (woc *wfOperationCtx) updateContainerOutputs(node *wfv1.NodeStatus, pod *apiv1.Pod) {
+	outputStr, ok := pod.Annotations[common.AnnotationKeyContainerOutputs]
+	if !ok {
+		return
+	}
+	containerOutputs := make(map[string]*wfv1.Outputs)
+	if err := json.Unmarshal([]byte(outputStr), &containerOutputs); err != nil {
+		woc.log.WithError(err).WithField("pod", pod.Name).Error("failed to unmarshal container outputs")
+		return
+	}
+	for containerName, outputs := range containerOutputs {
+		ctrNode := woc.wf.GetNodeByName(fmt.Sprintf("%s.%s", node.Name, containerName))
+		if ctrNode == nil || reflect.DeepEqual(ctrNode.Outputs, outputs) {
+			continue
+		}
+		woc.log.Infof("Setting node %v outputs: %v", ctrNode.ID, outputs)
+		ctrNode.Outputs = outputs
+		woc.wf.Status.Nodes[ctrNode.ID] = *ctrNode
+		woc.updated = true
+	}
+}
@@ -211,3 +211,67 @@ spec:
 		}
 	}
 }
+
+func TestContainerSetTemplateWithDepends(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: pod
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      containerSet:
+        containers:
+          - name: build
+            image: argoproj/argosay:v2
+            retryStrategy:
+              retries: 2
+          - name: cleanup
+            image: argoproj/argosay:v2
+            depends: build.Failed
+          - name: report
+            image: argoproj/argosay:v2
+            depends: build
+            outputs:
+              parameters:
+                - name: message
+                  valueFrom:
+                    path: /tmp/message
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowRunning, woc.wf.Status.Phase)
+	assert.Len(t, woc.wf.Status.Nodes, 4)
+	build := woc.wf.GetNodeByName("pod.build")
+	if assert.NotNil(t, build) {
+		assert.ElementsMatch(t, []string{woc.wf.NodeID("pod.cleanup"), woc.wf.NodeID("pod.report")}, build.Children)
+	}
+
+	makePodsPhase(ctx, woc, corev1.PodSucceeded,
+		func(pod *corev1.Pod) {
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+				{Name: "build", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}},
+				{Name: "cleanup", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Message: common.ContainerOmittedMessage}}},
+				{Name: "report", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}},
+			}
+		},
+		withAnnotation(common.AnnotationKeyContainerOutputs, `{"report": {"parameters": [{"name": "message", "value": "hello"}]}}`),
+	)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("pod.build").Phase)
+	assert.Equal(t, wfv1.NodeOmitted, woc.wf.GetNodeByName("pod.cleanup").Phase)
+	report := woc.wf.GetNodeByName("pod.report")
+	if assert.NotNil(t, report) {
+		assert.Equal(t, wfv1.NodeSucceeded, report.Phase)
+		if assert.NotNil(t, report.Outputs) && assert.Len(t, report.Outputs.Parameters, 1) {
+			assert.Equal(t, "hello", report.Outputs.Parameters[0].Value.String())
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// memoizationKeyContent is what the automatic memoization key of a template is the hash of
+type memoizationKeyContent struct {
+	// Template is the resolved template, without its input artifacts, name, memoization and metrics
+	Template *wfv1.Template `json:"template"`
+	// Artifacts are the input artifacts, whose locations are replaced by the digests of their contents
+	Artifacts []memoizationKeyArtifact `json:"artifacts,omitempty"`
+}
+
+type memoizationKeyArtifact struct {
+	Name   string `json:"name"`
+	Path   string `json:"path,omitempty"`
+	Mode   *int32 `json:"mode,omitempty"`
+	Digest string `json:"digest"`
+}
+
+// memoizationKey returns the key of a template memoized with an automatic key: the SHA-256 of the template with its
+// parameters substituted and the digests of its input artifacts, so templates that run the same thing on the same
+// inputs share their cache entries
+func (woc *wfOperationCtx) memoizationKey(ctx context.Context, tmpl *wfv1.Template) (string, error) {
+	content := memoizationKeyContent{Template: tmpl.DeepCopy()}
+	content.Template.Name = ""
+	content.Template.Memoize = nil
+	content.Template.Metrics = nil
+	content.Template.Inputs.Artifacts = nil
+	for _, art := range tmpl.Inputs.Artifacts {
+		digest, err := woc.artifactDigest(ctx, tmpl, art.DeepCopy())
+		if err != nil {
+			return "", fmt.Errorf("failed to get the digest of input artifact %s: %w", art.Name, err)
+		}
+		content.Artifacts = append(content.Artifacts, memoizationKeyArtifact{Name: art.Name, Path: art.Path, Mode: art.Mode, Digest: digest})
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// artifactDigest returns the digest of the content of an input artifact, empty for an optional artifact that was not
+// supplied. The digest is read from the metadata of the storage, as the controller does not download artifacts.
+func (woc *wfOperationCtx) artifactDigest(ctx context.Context, tmpl *wfv1.Template, art *wfv1.Artifact) (string, error) {
+	if !art.HasLocationOrKey() {
+		return "", nil
+	}
+	if err := woc.relocateArtifact(tmpl, art); err != nil {
+		return "", err
+	}
+	driver, err := artifact.NewDriver(ctx, art, resource.New(woc.controller.kubeclientset, woc.wf.Namespace))
+	if err != nil {
+		return "", err
+	}
+	return artifact.Digest(driver, art)
+}
+
+// relocateArtifact gives an artifact that only has a key the full location it has for the executor: in the archive
+// location of its template, or else in the artifact repository of the workflow
+func (woc *wfOperationCtx) relocateArtifact(tmpl *wfv1.Template, art *wfv1.Artifact) error {
+	var location *wfv1.ArtifactLocation
+	if tmpl != nil {
+		location = tmpl.ArchiveLocation
+	}
+	if !location.HasLocation() {
+		location = woc.artifactRepository.ToArtifactLocation()
+	}
+	return art.Relocate(location)
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var workflowAutoMemoized = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: auto-memoized
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: message
+        value: hello
+    artifacts:
+      - name: data
+        raw:
+          data: foo
+  templates:
+    - name: main
+      inputs:
+        parameters:
+          - name: message
+        artifacts:
+          - name: data
+            path: /tmp/data
+      memoize:
+        auto: true
+        cache:
+          configMap:
+            name: auto-cache
+      container:
+        image: argoproj/argosay:v2
+        args: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestAutoMemoizationKey(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+
+	run := func(name string, mutate func(wf *wfv1.Workflow)) *wfv1.NodeStatus {
+		wf := wfv1.MustUnmarshalWorkflow(workflowAutoMemoized)
+		wf.Name = name
+		mutate(wf)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName(name)
+		if assert.NotNil(t, node) && assert.NotNil(t, node.MemoizationStatus) {
+			assert.Regexp(t, "^[0-9a-f]{64}$", node.MemoizationStatus.Key)
+		}
+		if node != nil && !node.Fulfilled() {
+			makePodsPhase(ctx, woc, apiv1.PodSucceeded, withExitCode(0), withOutputs(wfv1.MustMarshallJSON(wfv1.Outputs{ExitCode: pointer.StringPtr("0")})))
+			woc = newWorkflowOperationCtx(woc.wf, controller)
+			woc.operate(ctx)
+			assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+		}
+		return node
+	}
+
+	first := run("first", func(*wfv1.Workflow) {})
+	assert.False(t, first.MemoizationStatus.Hit)
+
+	t.Run("Unchanged", func(t *testing.T) {
+		node := run("unchanged", func(*wfv1.Workflow) {})
+		assert.True(t, node.MemoizationStatus.Hit)
+		assert.Equal(t, first.MemoizationStatus.Key, node.MemoizationStatus.Key)
+	})
+	t.Run("ChangedParameter", func(t *testing.T) {
+		node := run("changed-parameter", func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("bye")
+		})
+		assert.False(t, node.MemoizationStatus.Hit)
+		assert.NotEqual(t, first.MemoizationStatus.Key, node.MemoizationStatus.Key)
+	})
+	t.Run("ChangedArtifact", func(t *testing.T) {
+		node := run("changed-artifact", func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Artifacts[0].Raw.Data = "bar"
+		})
+		assert.False(t, node.MemoizationStatus.Hit)
+		assert.NotEqual(t, first.MemoizationStatus.Key, node.MemoizationStatus.Key)
+	})
+	t.Run("ChangedContainer", func(t *testing.T) {
+		node := run("changed-container", func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Container.Image = "argoproj/argosay:v1"
+		})
+		assert.False(t, node.MemoizationStatus.Hit)
+		assert.NotEqual(t, first.MemoizationStatus.Key, node.MemoizationStatus.Key)
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math"
 	"os"
 	"reflect"
 	"regexp"
@@ -25,14 +24,12 @@ import (
 	policyv1beta "k8s.io/api/policy/v1beta1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
-	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
@@ -44,6 +41,7 @@ import (
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/expr/env"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/util/k8s"
 	"github.com/argoproj/argo-workflows/v3/util/resource"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
 	argoruntime "github.com/argoproj/argo-workflows/v3/util/runtime"
@@ -51,7 +49,6 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
-	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/estimation"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
@@ -227,6 +224,10 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 	}
 	woc.artifactRepository = repo
 
+	woc.addArtifactGCFinalizer()
+	woc.addResourceCleanupFinalizer()
+	woc.terminateDeletedWorkflow()
+
 	// Workflow Level Synchronization lock
 	if woc.execWf.Spec.Synchronization != nil {
 		acquired, wfUpdate, msg, err := woc.controller.syncManager.TryAcquire(woc.wf, "", woc.execWf.Spec.Synchronization)
@@ -441,6 +442,10 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		workflowMessage = node.Message
 	}
 
+	if (workflowStatus == wfv1.WorkflowFailed || workflowStatus == wfv1.WorkflowError) && woc.autoRetry(ctx, workflowStatus, workflowMessage) {
+		return
+	}
+
 	// If we get here, the workflow completed, all PVCs were deleted successfully, and
 	// exit handlers were executed. We now need to infer the workflow phase from the
 	// node phase.
@@ -770,6 +775,18 @@ func (woc *wfOperationCtx) requeue() {
 	woc.controller.wfQueue.AddRateLimited(key)
 }
 
+// terminateDeletedWorkflow terminates a workflow with artifacts or created resources to delete that is deleted while it
+// runs, so that it completes and they are deleted before its finalizers are removed
+func (woc *wfOperationCtx) terminateDeletedWorkflow() {
+	if woc.wf.DeletionTimestamp == nil || !(woc.hasArtifactGCFinalizer() || woc.hasResourceCleanupFinalizer()) || woc.wf.Status.Fulfilled() || woc.GetShutdownStrategy().Enabled() {
+		return
+	}
+	woc.log.Info("Terminating deleted workflow so that its artifacts and created resources can be deleted")
+	woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+	woc.execWf.Spec.Shutdown = wfv1.ShutdownStrategyTerminate
+	woc.updated = true
+}
+
 // processNodeRetries updates the retry node state based on the child node state and the retry strategy and returns the node.
 func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrategy wfv1.RetryStrategy, opts *executeTemplateOpts) (*wfv1.NodeStatus, bool, error) {
 	if node.Fulfilled() {
@@ -822,6 +839,12 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, message), true, nil
 	}
 
+	rule, ruleIndex := retryStrategy.MatchRule(*lastChildNode)
+	if rule != nil && rule.GetAction() == wfv1.RetryRuleActionFail {
+		woc.log.Infof("Node not set to be retried by retryStrategy.rules[%d]", ruleIndex)
+		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+	}
+
 	if retryStrategy.Backoff != nil {
 		maxDurationDeadline := time.Time{}
 		// Process max duration limit
@@ -839,25 +862,10 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		}
 
 		// Max duration limit hasn't been exceeded, process back off
-		if retryStrategy.Backoff.Duration == "" {
-			return nil, false, fmt.Errorf("no base duration specified for retryStrategy")
-		}
-
-		baseDuration, err := parseStringToDuration(retryStrategy.Backoff.Duration)
+		timeToWait, err := retryBackoff(node, *retryStrategy.Backoff)
 		if err != nil {
 			return nil, false, err
 		}
-
-		timeToWait := baseDuration
-		retryStrategyBackoffFactor, err := intstr.Int32(retryStrategy.Backoff.Factor)
-		if err != nil {
-			return nil, false, err
-		}
-		if retryStrategyBackoffFactor != nil && *retryStrategyBackoffFactor > 0 {
-			// Formula: timeToWait = duration * factor^retry_number
-			// Note that timeToWait should equal to duration for the first retry attempt.
-			timeToWait = baseDuration * time.Duration(math.Pow(float64(*retryStrategyBackoffFactor), float64(len(node.Children)-1)))
-		}
 		waitingDeadline := lastChildNode.FinishedAt.Add(timeToWait)
 
 		// If the waiting deadline is after the max duration deadline, then it's futile to wait until then. Stop early
@@ -879,14 +887,14 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		node = woc.markNodePhase(node.Name, node.Phase, "")
 	}
 
-	var retryOnFailed bool
-	var retryOnError bool
+	// a matching rule retries the node regardless of the retry policy
+	retryOnFailed := rule != nil
+	retryOnError := rule != nil
 	switch retryStrategy.RetryPolicy {
 	case wfv1.RetryPolicyAlways:
 		retryOnFailed = true
 		retryOnError = true
 	case wfv1.RetryPolicyOnError:
-		retryOnFailed = false
 		retryOnError = true
 	case wfv1.RetryPolicyOnTransientError:
 		if (lastChildNode.Phase == wfv1.NodeFailed || lastChildNode.Phase == wfv1.NodeError) && errorsutil.IsTransientErr(errors.InternalError(lastChildNode.Message)) {
@@ -895,7 +903,6 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		}
 	case wfv1.RetryPolicyOnFailure, "":
 		retryOnFailed = true
-		retryOnError = false
 	default:
 		return nil, false, fmt.Errorf("%s is not a valid RetryPolicy", retryStrategy.RetryPolicy)
 	}
@@ -944,6 +951,9 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 			woc.updateAgentPodStatus(ctx, pod)
 			return
 		}
+		if woc.isArtifactGCPod(pod) {
+			return
+		}
 		nodeID := woc.nodeID(pod)
 		seenPodLock.Lock()
 		seenPods[nodeID] = pod
@@ -957,8 +967,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				woc.addOutputsToGlobalScope(node.Outputs)
 				if node.MemoizationStatus != nil {
 					if node.Succeeded() {
-						c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
-						err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
+						err := woc.saveMemoizedOutputs(ctx, &node)
 						if err != nil {
 							woc.log.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
 							node.Phase = wfv1.NodeError
@@ -970,6 +979,9 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				}
 				woc.updated = true
 			}
+			if woc.addCreatedResources(nodeID, pod) {
+				woc.updated = true
+			}
 			node := woc.wf.Status.Nodes[nodeID]
 			if node.Type == wfv1.NodeTypePod {
 				if node.HostNodeName != pod.Spec.NodeName {
@@ -1132,12 +1144,21 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 	var newPhase wfv1.NodePhase
 	var newDaemonStatus *bool
 	var message string
+	var failureReason wfv1.FailureReason
 	updated := false
 	switch pod.Status.Phase {
 	case apiv1.PodPending:
 		newPhase = wfv1.NodePending
 		newDaemonStatus = pointer.BoolPtr(false)
 		message = getPendingReason(pod)
+		if woc.retriesImagePullBackOff(pod, node) {
+			// the pod would never start, so it is deleted and the node is retried
+			newPhase = wfv1.NodeFailed
+			failureReason = wfv1.FailureReasonImagePullBackOff
+			woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
+				WithField("pod", pod.Name).Infof("Pod failed to pull an image, retrying it: %s", message)
+			woc.controller.queuePodForCleanup(pod.Namespace, pod.Name, deletePod)
+		}
 	case apiv1.PodSucceeded:
 		newPhase = wfv1.NodeSucceeded
 		newDaemonStatus = pointer.BoolPtr(false)
@@ -1147,8 +1168,9 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 			newPhase = wfv1.NodeSucceeded
 		} else {
 			newPhase, message = woc.inferFailedReason(pod)
+			failureReason = podFailureReason(pod)
 			woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
-				WithField("pod", pod.Name).Infof("Pod failed: %s", message)
+				WithField("pod", pod.Name).WithField("reason", failureReason).Infof("Pod failed: %s", message)
 		}
 		newDaemonStatus = pointer.BoolPtr(false)
 	case apiv1.PodRunning:
@@ -1175,6 +1197,10 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 		woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
 			WithField("pod", pod.Name).Error(message)
 	}
+	if message == "" {
+		// the message reported by the executor, e.g. the statistics of the clones of git input artifacts
+		message = pod.Annotations[common.AnnotationKeyMessage]
+	}
 
 	// if it's ContainerSetTemplate pod then the inner container names should match to some node names,
 	// in this case need to update nodes according to container status
@@ -1191,10 +1217,12 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 		case c.State.Terminated != nil:
 			exitCode := int(c.State.Terminated.ExitCode)
 			message := fmt.Sprintf("%s (exit code %d): %s", c.State.Terminated.Reason, exitCode, c.State.Terminated.Message)
-			switch exitCode {
-			case 0:
+			switch {
+			case exitCode == 0 && c.State.Terminated.Message == common.ContainerOmittedMessage:
+				woc.markNodePhase(ctrNodeName, wfv1.NodeOmitted, common.ContainerOmittedMessage)
+			case exitCode == 0:
 				woc.markNodePhase(ctrNodeName, wfv1.NodeSucceeded)
-			case 64:
+			case exitCode == 64:
 				// special emissary exit code indicating the emissary errors, rather than the sub-process failure,
 				// (unless the sub-process coincidentally exits with code 64 of course)
 				woc.markNodePhase(ctrNodeName, wfv1.NodeError, message)
@@ -1203,6 +1231,7 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 			}
 		}
 	}
+	woc.updateContainerOutputs(node, pod)
 
 	if !node.Completed() {
 		if newDaemonStatus != nil {
@@ -1253,10 +1282,19 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 			updated = true
 			node.Message = message
 		}
+		if failureReason != "" && node.FailureReason != failureReason {
+			woc.log.Infof("Updating node %s failure reason: %s", node.ID, failureReason)
+			updated = true
+			node.FailureReason = failureReason
+		}
 	}
 	if node.Fulfilled() && node.FinishedAt.IsZero() {
 		updated = true
 		node.FinishedAt = getLatestFinishedAt(pod)
+		if node.FinishedAt.IsZero() && pod.Status.Phase == apiv1.PodPending {
+			// none of the containers of the pod started, e.g. because an image could not be pulled
+			node.FinishedAt = metav1.Now()
+		}
 		node.ResourcesDuration = resource.DurationForPod(pod)
 	}
 	if updated {
@@ -1410,6 +1448,65 @@ func (woc *wfOperationCtx) inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, st
 	return wfv1.NodeSucceeded, ""
 }
 
+// podFailureReason returns why a Failed pod failed, if it is a reason that retry rules can match
+func podFailureReason(pod *apiv1.Pod) wfv1.FailureReason {
+	ctrs := append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...)
+	for _, ctr := range ctrs {
+		if t := ctr.State.Terminated; t != nil && t.Reason == "OOMKilled" {
+			return wfv1.FailureReasonOOMKilled
+		}
+	}
+	switch pod.Status.Reason {
+	case "Evicted":
+		return wfv1.FailureReasonEvicted
+	case "Shutdown", "NodeShutdown", "Terminated", "NodeLost":
+		return wfv1.FailureReasonNodeShutdown
+	}
+	if imagePullBackOff(pod) {
+		return wfv1.FailureReasonImagePullBackOff
+	}
+	if pod.Status.Reason == "DeadlineExceeded" {
+		return wfv1.FailureReasonDeadlineExceeded
+	}
+	return ""
+}
+
+// imagePullBackOff returns whether a container of the pod is waiting because its image could not be pulled
+func imagePullBackOff(pod *apiv1.Pod) bool {
+	for _, ctr := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		if w := ctr.State.Waiting; w != nil {
+			switch w.Reason {
+			case "ImagePullBackOff", "ErrImagePull", "InvalidImageName":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// retriesImagePullBackOff returns whether the pending pod of the node cannot pull an image, and the node is an attempt
+// of a retry node with a rule that retries it for that. Otherwise, the pod keeps pulling the image until it has
+// exceeded its deadline.
+func (woc *wfOperationCtx) retriesImagePullBackOff(pod *apiv1.Pod, node *wfv1.NodeStatus) bool {
+	if !imagePullBackOff(pod) {
+		return false
+	}
+	retryNode := findRetryParent(woc.wf.Status.Nodes, node.ID)
+	if retryNode == nil {
+		return false
+	}
+	retryStrategy, err := woc.retryNodeStrategy(retryNode)
+	if err != nil {
+		woc.log.WithError(err).Warn("unable to get the retry strategy of the node")
+		return false
+	}
+	if retryStrategy == nil {
+		return false
+	}
+	rule, _ := retryStrategy.MatchRule(wfv1.NodeStatus{Phase: wfv1.NodeFailed, FailureReason: wfv1.FailureReasonImagePullBackOff})
+	return rule != nil && rule.GetAction() == wfv1.RetryRuleActionRetry
+}
+
 func (woc *wfOperationCtx) createPVCs(ctx context.Context) error {
 	if !(woc.wf.Status.Phase == wfv1.WorkflowPending || woc.wf.Status.Phase == wfv1.WorkflowRunning) {
 		// Only attempt to create PVCs if workflow is in Pending or Running state
@@ -1594,6 +1691,7 @@ func buildRetryStrategyLocalScope(node *wfv1.NodeStatus, nodes wfv1.Nodes) map[s
 	localScope[common.LocalVarRetriesLastExitCode] = exitCode
 	localScope[common.LocalVarRetriesLastStatus] = string(lastChildNode.Phase)
 	localScope[common.LocalVarRetriesLastDuration] = fmt.Sprint(lastChildNode.GetDuration().Seconds())
+	localScope[common.LocalVarRetriesLastReason] = string(lastChildNode.FailureReason)
 
 	return localScope
 }
@@ -1649,14 +1747,23 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 
 	// If memoization is on, check if node output exists in cache
 	if node == nil && processedTmpl.Memoize != nil {
-		memoizationCache := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, processedTmpl.Memoize.Cache.ConfigMap.Name)
+		cache := processedTmpl.Memoize.Cache
+		memoizationCache := woc.controller.cacheFactory.GetCache(cache.GetType(), cache.GetName(), cache.GetMaxEntries())
 		if memoizationCache == nil {
 			err := fmt.Errorf("cache could not be found or created")
-			woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.ConfigMap.Name}).WithError(err)
+			woc.log.WithFields(log.Fields{"cacheName": cache.GetName()}).WithError(err)
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
 
-		entry, err := memoizationCache.Load(ctx, processedTmpl.Memoize.Key)
+		key := processedTmpl.Memoize.Key
+		if processedTmpl.Memoize.Auto {
+			key, err = woc.memoizationKey(ctx, processedTmpl)
+			if err != nil {
+				return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
+			}
+		}
+
+		entry, err := memoizationCache.Load(ctx, key)
 		if err != nil {
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
@@ -1681,8 +1788,9 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 
 		memoizationStatus := &wfv1.MemoizationStatus{
 			Hit:       hit,
-			Key:       processedTmpl.Memoize.Key,
-			CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+			Key:       key,
+			CacheName: cache.GetName(),
+			CacheType: cache.GetType(),
 		}
 		if hit {
 			node = woc.initializeCacheHitNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, outputs, memoizationStatus)
@@ -1770,6 +1878,8 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 	// the container. The status of this node should be "Success" if any
 	// of the retries succeed. Otherwise, it is "Failed".
 	retryNodeName := ""
+	// decision is recorded on the node of a new attempt
+	var decision *wfv1.RetryDecision
 
 	// Here it is needed to be updated
 	if woc.retryStrategy(processedTmpl) != nil {
@@ -1816,6 +1926,7 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 			node = lastChildNode
 		} else {
 			// Create a new child node and append it to the retry node.
+			decision = retryDecision(*woc.retryStrategy(processedTmpl), woc.wf.Status.Nodes, retryParentNode, lastChildNode)
 			nodeName = fmt.Sprintf("%s(%d)", retryNodeName, len(retryParentNode.Children))
 			woc.addChildNode(retryNodeName, nodeName)
 			node = nil
@@ -1880,6 +1991,14 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		}
 	}
 
+	if decision != nil {
+		if n, ok := woc.wf.Status.Nodes[node.ID]; ok {
+			n.RetryDecision = decision
+			woc.wf.Status.Nodes[node.ID] = n
+			node = &n
+		}
+	}
+
 	if node.Fulfilled() {
 		woc.controller.syncManager.Release(woc.wf, node.ID, processedTmpl.Synchronization)
 	}
@@ -2013,6 +2132,8 @@ func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.Wor
 				woc.updated = true
 				woc.wf.Status.Message = err.Error()
 			}
+			woc.cleanupCreatedResources(ctx)
+			woc.garbageCollectArtifacts(ctx)
 			if woc.controller.wfArchive.IsEnabled() {
 				if woc.controller.isArchivable(woc.wf) {
 					woc.log.Info("Marking workflow as pending archiving")
@@ -2061,6 +2182,19 @@ func (woc *wfOperationCtx) findTemplate(pod *apiv1.Pod) *wfv1.Template {
 	return woc.GetNodeTemplate(node)
 }
 
+// saveMemoizedOutputs saves the outputs of the memoized node to the cache it was looked up in
+func (woc *wfOperationCtx) saveMemoizedOutputs(ctx context.Context, node *wfv1.NodeStatus) error {
+	maxEntries := 0
+	if tmpl := woc.GetNodeTemplate(node); tmpl != nil && tmpl.Memoize != nil {
+		maxEntries = tmpl.Memoize.Cache.GetMaxEntries()
+	}
+	c := woc.controller.cacheFactory.GetCache(node.MemoizationStatus.CacheType, node.MemoizationStatus.CacheName, maxEntries)
+	if c == nil {
+		return fmt.Errorf("cache %s of type %s could not be found or created", node.MemoizationStatus.CacheName, node.MemoizationStatus.CacheType)
+	}
+	return c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs, woc.wf)
+}
+
 func (woc *wfOperationCtx) GetNodeTemplate(node *wfv1.NodeStatus) *wfv1.Template {
 	if node.TemplateRef != nil {
 		tmplCtx, err := woc.createTemplateContext(node.GetTemplateScope())
@@ -2874,19 +3008,20 @@ func (woc *wfOperationCtx) executeResource(ctx context.Context, nodeName string,
 	tmpl = tmpl.DeepCopy()
 
 	if tmpl.Resource.SetOwnerReference {
-		obj := unstructured.Unstructured{}
-		err := yaml.Unmarshal([]byte(tmpl.Resource.Manifest), &obj)
+		objs, err := k8s.ParseObjects([]byte(tmpl.Resource.Manifest))
 		if err != nil {
 			return node, err
 		}
 
-		ownerReferences := obj.GetOwnerReferences()
-		obj.SetOwnerReferences(append(ownerReferences, *metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind))))
-		bytes, err := yaml.Marshal(obj.Object)
+		for _, obj := range objs {
+			ownerReferences := obj.GetOwnerReferences()
+			obj.SetOwnerReferences(append(ownerReferences, *metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind))))
+		}
+		manifest, err := k8s.FormatObjects(objs)
 		if err != nil {
 			return node, err
 		}
-		tmpl.Resource.Manifest = string(bytes)
+		tmpl.Resource.Manifest = manifest
 	}
 
 	mainCtr := woc.newExecContainer(common.MainContainerName, tmpl)
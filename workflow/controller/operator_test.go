@@ -1369,6 +1369,19 @@ func TestAssessNodeStatus(t *testing.T) {
 		assert.True(t, got.Phase == wfv1.NodeSucceeded)
 	})
 
+	t.Run("Message annotation", func(t *testing.T) {
+		cancel, controller := newController()
+		defer cancel()
+		pod := &apiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{common.AnnotationKeyMessage: "repo: cloned in 1s"}},
+			Status:     apiv1.PodStatus{Phase: apiv1.PodSucceeded},
+		}
+		woc := newWorkflowOperationCtx(wf, controller)
+		got := woc.assessNodeStatus(pod, &wfv1.NodeStatus{Phase: wfv1.NodeRunning})
+		assert.Equal(t, wfv1.NodeSucceeded, got.Phase)
+		assert.Equal(t, "repo: cloned in 1s", got.Message)
+	})
+
 }
 
 func getPodTemplate(pod *apiv1.Pod) (*wfv1.Template, error) {
@@ -5280,7 +5293,7 @@ status:
       name: my-wf
       phase: Failed
 `)
-	wf, err := util.FormulateResubmitWorkflow(wf, true)
+	wf, err := util.FormulateResubmitWorkflow(wf, true, nil)
 	if assert.NoError(t, err) {
 		cancel, controller := newController(wf)
 		defer cancel()
@@ -7501,11 +7514,12 @@ func TestBuildRetryStrategyLocalScope(t *testing.T) {
 
 	localScope := buildRetryStrategyLocalScope(retryNode, wf.Status.Nodes)
 
-	assert.Len(t, localScope, 4)
+	assert.Len(t, localScope, 5)
 	assert.Equal(t, "1", localScope[common.LocalVarRetries])
 	assert.Equal(t, "1", localScope[common.LocalVarRetriesLastExitCode])
 	assert.Equal(t, string(wfv1.NodeFailed), localScope[common.LocalVarRetriesLastStatus])
 	assert.Equal(t, "6", localScope[common.LocalVarRetriesLastDuration])
+	assert.Equal(t, "", localScope[common.LocalVarRetriesLastReason])
 }
 
 func TestGetContainerRuntimeExecutor(t *testing.T) {
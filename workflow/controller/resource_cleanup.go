@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// addCreatedResources records the resources created by a resource template's pod in the workflow's status, so that
+// they can be cleaned up when the workflow completes. As the pod's annotations can be changed by the workflow, only
+// resources in the workflow's namespace with the cleanup policy of the pod's template are accepted, and they are
+// deleted as the service account that created them.
+func (woc *wfOperationCtx) addCreatedResources(nodeID string, pod *apiv1.Pod) bool {
+	value, ok := pod.Annotations[common.AnnotationKeyCreatedResources]
+	if !ok {
+		return false
+	}
+	logCtx := woc.log.WithField("nodeID", nodeID)
+	var resources wfv1.CreatedResources
+	if err := json.Unmarshal([]byte(value), &resources); err != nil {
+		logCtx.WithError(err).Warn("Failed to unmarshal created resources")
+		return false
+	}
+	tmpl, err := podTemplate(pod)
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to get the template of the pod of created resources")
+		return false
+	}
+	if tmpl.Resource == nil || tmpl.Resource.Cleanup == nil {
+		logCtx.Warn("Ignoring created resources of a template without a cleanup policy")
+		return false
+	}
+	cleanup := tmpl.Resource.Cleanup
+	serviceAccountName := woc.executorServiceAccountName(tmpl)
+	if serviceAccountName == "" {
+		serviceAccountName = pod.Spec.ServiceAccountName
+	}
+	if serviceAccountName == "" {
+		serviceAccountName = "default"
+	}
+	updated := false
+	for _, r := range resources {
+		if r.Namespace != woc.wf.Namespace || r.Cleanup.GetStrategy() != cleanup.GetStrategy() || r.Cleanup.GetDelay() != cleanup.GetDelay() {
+			logCtx.WithField("resource", r.DisplayName()).Warn("Ignoring created resource outside the workflow's namespace or with another cleanup policy")
+			continue
+		}
+		r.NodeID = nodeID
+		r.ServiceAccountName = serviceAccountName
+		r.CleanupPhase = ""
+		r.Message = ""
+		var added bool
+		woc.wf.Status.CreatedResources, added = woc.wf.Status.CreatedResources.Add(r)
+		if added {
+			logCtx.WithField("resource", r.DisplayName()).Info("Tracking created resource for cleanup")
+			updated = true
+		}
+	}
+	return updated
+}
+
+// podTemplate returns the template that the pod was created with, which cannot be changed once the pod is created
+func podTemplate(pod *apiv1.Pod) (*wfv1.Template, error) {
+	for _, c := range pod.Spec.Containers {
+		for _, e := range c.Env {
+			if e.Name == common.EnvVarTemplate {
+				tmpl := &wfv1.Template{}
+				return tmpl, json.Unmarshal([]byte(e.Value), tmpl)
+			}
+		}
+	}
+	return nil, fmt.Errorf("pod %s has no %s environment variable", pod.Name, common.EnvVarTemplate)
+}
+
+func (woc *wfOperationCtx) hasResourceCleanupFinalizer() bool {
+	return slice.ContainsString(woc.wf.Finalizers, common.FinalizerResourceCleanup)
+}
+
+// addResourceCleanupFinalizer adds the resource cleanup finalizer to a workflow that may create resources to delete,
+// so that it cannot be deleted before they are
+func (woc *wfOperationCtx) addResourceCleanupFinalizer() {
+	if woc.controller.resourceCleaner == nil || woc.wf.DeletionTimestamp != nil || woc.hasResourceCleanupFinalizer() || !woc.usesResourceCleanup() {
+		return
+	}
+	woc.log.Info("Adding resource cleanup finalizer")
+	woc.wf.Finalizers = append(woc.wf.Finalizers, common.FinalizerResourceCleanup)
+	woc.updated = true
+}
+
+// usesResourceCleanup returns whether any resource template of the workflow has a cleanup policy
+func (woc *wfOperationCtx) usesResourceCleanup() bool {
+	if woc.wf.Status.CreatedResources.CleanupPending() {
+		return true
+	}
+	templates := woc.execWf.Spec.Templates
+	for _, tmpl := range woc.wf.Status.StoredTemplates {
+		templates = append(templates, tmpl)
+	}
+	for _, tmpl := range templates {
+		if tmpl.Resource != nil && tmpl.Resource.Cleanup != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanupCreatedResources deletes the created resources that are due for deletion when the workflow completes, or all
+// of them when the completed workflow is being deleted, and then removes the resource cleanup finalizer. Those with a
+// delay are deleted later by the garbage collector, and those that cannot be deleted now are retried.
+func (woc *wfOperationCtx) cleanupCreatedResources(ctx context.Context) {
+	if woc.controller.resourceCleaner == nil {
+		return
+	}
+	if len(woc.wf.Status.CreatedResources) > 0 {
+		updated, err := woc.controller.resourceCleaner.Cleanup(ctx, woc.wf, woc.wf.Status.FinishedAt.Time, woc.wf.DeletionTimestamp != nil)
+		if updated {
+			woc.updated = true
+		}
+		if err != nil {
+			woc.log.WithError(err).Warn("Failed to clean up created resources, will retry")
+			if woc.wf.DeletionTimestamp != nil {
+				woc.requeue()
+			}
+		}
+	}
+	if woc.hasResourceCleanupFinalizer() && woc.wf.Status.Phase.Completed() && !woc.wf.Status.CreatedResources.CleanupPending() {
+		woc.log.Info("Removing resource cleanup finalizer")
+		woc.wf.Finalizers = slice.RemoveString(woc.wf.Finalizers, common.FinalizerResourceCleanup)
+		woc.updated = true
+	}
+}
@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+var resourceCleanupWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: resource-cleanup
+  namespace: my-ns
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      resource:
+        action: create
+        cleanup: {}
+        manifest: |
+          apiVersion: v1
+          kind: ConfigMap
+          metadata:
+            name: my-cm
+`
+
+func TestResourceCleanup(t *testing.T) {
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetNamespace("my-ns")
+	configMap.SetName("my-cm")
+	configMap.SetUID("my-uid")
+
+	wf := wfv1.MustUnmarshalWorkflow(resourceCleanupWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	configMaps := controller.dynamicInterface.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}).Namespace("my-ns")
+	ctx := context.Background()
+	_, err := configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Contains(t, woc.wf.Finalizers, common.FinalizerResourceCleanup)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withAnnotation(common.AnnotationKeyCreatedResources,
+		`[{"apiVersion":"v1","kind":"ConfigMap","namespace":"my-ns","name":"my-cm","uid":"my-uid","cleanup":{}}]`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, wfv1.WorkflowSucceeded, woc.wf.Status.Phase)
+	if assert.Len(t, woc.wf.Status.CreatedResources, 1) {
+		r := woc.wf.Status.CreatedResources[0]
+		assert.Equal(t, woc.wf.NodeID("resource-cleanup"), r.NodeID)
+		assert.Equal(t, "v1/ConfigMap my-ns/my-cm", r.DisplayName())
+		assert.Equal(t, "default", r.ServiceAccountName)
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, r.CleanupPhase)
+	}
+	_, err = configMaps.Get(ctx, "my-cm", metav1.GetOptions{})
+	assert.Error(t, err)
+	assert.NotContains(t, woc.wf.Finalizers, common.FinalizerResourceCleanup)
+}
+
+func TestResourceCleanupDeletedWhileRunning(t *testing.T) {
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetNamespace("my-ns")
+	configMap.SetName("my-cm")
+	configMap.SetUID("my-uid")
+
+	wf := wfv1.MustUnmarshalWorkflow(resourceCleanupWf)
+	wf.Spec.Templates[0].Resource.Cleanup.SecondsAfterCompletion = pointer.Int32Ptr(3600)
+	cancel, controller := newController(wf)
+	defer cancel()
+	configMaps := controller.dynamicInterface.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}).Namespace("my-ns")
+	ctx := context.Background()
+	_, err := configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	// the resource is not due for deletion until long after the workflow completes
+	makePodsPhase(ctx, woc, apiv1.PodRunning, withAnnotation(common.AnnotationKeyCreatedResources,
+		`[{"apiVersion":"v1","kind":"ConfigMap","namespace":"my-ns","name":"my-cm","uid":"my-uid","cleanup":{"secondsAfterCompletion":3600}}]`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Len(t, woc.wf.Status.CreatedResources, 1)
+
+	now := metav1.Now()
+	woc.wf.DeletionTimestamp = &now
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.ShutdownStrategyTerminate, woc.wf.Spec.Shutdown)
+	assert.Contains(t, woc.wf.Finalizers, common.FinalizerResourceCleanup)
+
+	makePodsPhase(ctx, woc, apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.True(t, woc.wf.Status.Phase.Completed())
+	if assert.Len(t, woc.wf.Status.CreatedResources, 1) {
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, woc.wf.Status.CreatedResources[0].CleanupPhase)
+	}
+	_, err = configMaps.Get(ctx, "my-cm", metav1.GetOptions{})
+	assert.Error(t, err)
+	assert.NotContains(t, woc.wf.Finalizers, common.FinalizerResourceCleanup)
+}
+
+func TestResourceCleanupRejected(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(resourceCleanupWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodRunning, withAnnotation(common.AnnotationKeyCreatedResources, `[
+{"apiVersion":"v1","kind":"ConfigMap","namespace":"kube-system","name":"my-cm","cleanup":{}},
+{"apiVersion":"v1","kind":"Namespace","name":"kube-system","cleanup":{}},
+{"apiVersion":"v1","kind":"ConfigMap","namespace":"my-ns","name":"my-cm","cleanup":{"secondsAfterCompletion":60}},
+{"apiVersion":"v1","kind":"ConfigMap","namespace":"my-ns","name":"my-cm","cleanup":{},"serviceAccountName":"argo"}
+]`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	if assert.Len(t, woc.wf.Status.CreatedResources, 1) {
+		r := woc.wf.Status.CreatedResources[0]
+		assert.Equal(t, "v1/ConfigMap my-ns/my-cm", r.DisplayName())
+		assert.Equal(t, "default", r.ServiceAccountName, "the service account is that of the pod")
+	}
+}
@@ -1,10 +1,21 @@
 package controller
 
 import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/env"
+	"k8s.io/utils/pointer"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/intstr"
 	wfretry "github.com/argoproj/argo-workflows/v3/workflow/util/retry"
 )
 
@@ -24,6 +35,16 @@ func FindRetryNode(nodes wfv1.Nodes, nodeID string) *wfv1.NodeStatus {
 	return nil
 }
 
+// findRetryParent returns the retry node that the node is an attempt of, if any
+func findRetryParent(nodes wfv1.Nodes, nodeID string) *wfv1.NodeStatus {
+	for _, node := range nodes {
+		if node.Type == wfv1.NodeTypeRetry && node.HasChild(nodeID) {
+			return &node
+		}
+	}
+	return nil
+}
+
 // RetryOnDifferentHost append affinity with fail host to pod
 func RetryOnDifferentHost(retryNodeName string) RetryTweak {
 	return func(retryStrategy wfv1.RetryStrategy, nodes wfv1.Nodes, pod *apiv1.Pod) {
@@ -37,3 +58,161 @@ func RetryOnDifferentHost(retryNodeName string) RetryTweak {
 		}
 	}
 }
+
+// EscalateResources multiplies the resources of the main containers of the template's pod, once for every previous
+// attempt that matched a retry rule with an escalation, e.g. to double the memory after each OOMKilled. The wait
+// container and sidecars are not escalated.
+func EscalateResources(retryNodeID string, tmpl *wfv1.Template) RetryTweak {
+	return func(retryStrategy wfv1.RetryStrategy, nodes wfv1.Nodes, pod *apiv1.Pod) {
+		escalations := retryEscalations(retryStrategy, nodes, retryNodeID)
+		if len(escalations) == 0 {
+			return
+		}
+		for i, c := range pod.Spec.Containers {
+			if !tmpl.IsMainContainerName(c.Name) {
+				continue
+			}
+			for _, e := range escalations {
+				e.apply(c.Resources.Requests)
+				e.apply(c.Resources.Limits)
+			}
+			pod.Spec.Containers[i] = c
+		}
+	}
+}
+
+// resourceEscalation is the factor that a resource is multiplied by, and the maximum it can be increased to
+type resourceEscalation struct {
+	name   apiv1.ResourceName
+	factor float64
+	max    *resource.Quantity
+}
+
+func (e resourceEscalation) apply(resources apiv1.ResourceList) {
+	q, ok := resources[e.name]
+	if !ok {
+		return
+	}
+	var escalated *resource.Quantity
+	if e.name == apiv1.ResourceCPU {
+		escalated = resource.NewMilliQuantity(int64(math.Ceil(float64(q.MilliValue())*e.factor)), q.Format)
+	} else {
+		escalated = resource.NewQuantity(int64(math.Ceil(float64(q.Value())*e.factor)), q.Format)
+	}
+	if e.max != nil && escalated.Cmp(*e.max) > 0 {
+		escalated = e.max
+	}
+	if escalated.Cmp(q) > 0 {
+		resources[e.name] = *escalated
+	}
+}
+
+func (e resourceEscalation) String() string {
+	s := fmt.Sprintf("%s x%s", e.name, strconv.FormatFloat(e.factor, 'f', -1, 64))
+	if e.max != nil {
+		s += fmt.Sprintf(" (max %s)", e.max.String())
+	}
+	return s
+}
+
+// retryEscalations returns the cumulative resource escalations for the next attempt of the retry node
+func retryEscalations(retryStrategy wfv1.RetryStrategy, nodes wfv1.Nodes, retryNodeID string) []resourceEscalation {
+	var escalations []resourceEscalation
+	escalate := func(name apiv1.ResourceName, factor string, max *resource.Quantity) {
+		f, err := strconv.ParseFloat(factor, 64)
+		if err != nil || f <= 0 {
+			return
+		}
+		for i, e := range escalations {
+			if e.name == name {
+				escalations[i].factor *= f
+				if max != nil {
+					escalations[i].max = max
+				}
+				return
+			}
+		}
+		escalations = append(escalations, resourceEscalation{name: name, factor: f, max: max})
+	}
+	for _, childID := range nodes[retryNodeID].Children {
+		child, ok := nodes[childID]
+		if !ok || !child.Fulfilled() {
+			continue
+		}
+		rule, _ := retryStrategy.MatchRule(child)
+		if rule == nil || rule.Escalate == nil {
+			continue
+		}
+		if rule.Escalate.Memory != "" {
+			escalate(apiv1.ResourceMemory, rule.Escalate.Memory, rule.Escalate.MaxMemory)
+		}
+		if rule.Escalate.CPU != "" {
+			escalate(apiv1.ResourceCPU, rule.Escalate.CPU, rule.Escalate.MaxCPU)
+		}
+	}
+	return escalations
+}
+
+// retryBackoff returns how long to wait after the last attempt of the retry node before the next one
+func retryBackoff(node *wfv1.NodeStatus, backoff wfv1.Backoff) (time.Duration, error) {
+	return backoffDuration("retryStrategy", backoff, len(node.Children), node.ID)
+}
+
+// backoffDuration returns how long to wait after the attempt of the number, counted from 1, before the next one.
+// The jitter is the same every time for the same seed and attempt, as the object is operated on many times while it
+// backs off.
+func backoffDuration(field string, backoff wfv1.Backoff, attempt int, seed string) (time.Duration, error) {
+	if backoff.Duration == "" {
+		return 0, fmt.Errorf("no base duration specified for %s", field)
+	}
+	baseDuration, err := parseStringToDuration(backoff.Duration)
+	if err != nil {
+		return 0, err
+	}
+	timeToWait := baseDuration
+	factor, err := intstr.Int32(backoff.Factor)
+	if err != nil {
+		return 0, err
+	}
+	if factor != nil && *factor > 0 {
+		// Formula: timeToWait = duration * factor^retry_number
+		// Note that timeToWait should equal to duration for the first retry attempt.
+		timeToWait = baseDuration * time.Duration(math.Pow(float64(*factor), float64(attempt-1)))
+	}
+	if backoff.Jitter != "" {
+		jitter, err := strconv.ParseFloat(backoff.Jitter, 64)
+		if err != nil {
+			return 0, fmt.Errorf("unable to parse %s as a backoff jitter: %w", backoff.Jitter, err)
+		}
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(fmt.Sprintf("%s/%d", seed, attempt)))
+		timeToWait += time.Duration(float64(timeToWait) * jitter * float64(h.Sum32()) / math.MaxUint32)
+	}
+	return timeToWait, nil
+}
+
+// retryDecision records why the retry node is retried after its last attempt, and how the next attempt is changed
+func retryDecision(retryStrategy wfv1.RetryStrategy, nodes wfv1.Nodes, retryNode *wfv1.NodeStatus, lastChildNode *wfv1.NodeStatus) *wfv1.RetryDecision {
+	if lastChildNode == nil {
+		return nil
+	}
+	decision := &wfv1.RetryDecision{Reason: lastChildNode.FailureReason}
+	if _, i := retryStrategy.MatchRule(*lastChildNode); i >= 0 {
+		decision.Rule = pointer.Int32Ptr(int32(i))
+	}
+	if retryStrategy.Backoff != nil {
+		// the attempt is not yet a child of the retry node
+		if timeToWait, err := retryBackoff(retryNode, *retryStrategy.Backoff); err == nil {
+			decision.Backoff = &metav1.Duration{Duration: timeToWait}
+		}
+	}
+	for _, e := range retryEscalations(retryStrategy, nodes, retryNode.ID) {
+		decision.Changes = append(decision.Changes, e.String())
+	}
+	if retryStrategy.Affinity != nil {
+		if hostNames := wfretry.GetFailHosts(nodes, retryNode.ID); len(hostNames) > 0 {
+			decision.Changes = append(decision.Changes, "avoid hosts "+strings.Join(hostNames, ", "))
+		}
+	}
+	return decision
+}
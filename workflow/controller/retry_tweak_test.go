@@ -1,11 +1,18 @@
 package controller
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	intstrutil "github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func TestFindRetryNode(t *testing.T) {
@@ -69,3 +76,252 @@ func TestFindRetryNode(t *testing.T) {
 		assert.Nil(t, a)
 	})
 }
+
+func TestEscalateResources(t *testing.T) {
+	retryStrategy := wfv1.RetryStrategy{Rules: []wfv1.RetryRule{{
+		Reasons:  []wfv1.FailureReason{wfv1.FailureReasonOOMKilled},
+		Escalate: &wfv1.ResourceEscalation{Memory: "2", CPU: "1.5", MaxMemory: resource.NewQuantity(3<<30, resource.BinarySI)},
+	}}}
+	nodes := wfv1.Nodes{
+		"retry": {ID: "retry", Type: wfv1.NodeTypeRetry, Children: []string{"a", "b", "c", "d"}},
+		"a":     {ID: "a", Phase: wfv1.NodeFailed, FailureReason: wfv1.FailureReasonOOMKilled},
+		"b":     {ID: "b", Phase: wfv1.NodeFailed, FailureReason: wfv1.FailureReasonEvicted},
+		"c":     {ID: "c", Phase: wfv1.NodeFailed, FailureReason: wfv1.FailureReasonOOMKilled},
+		"d":     {ID: "d", Phase: wfv1.NodePending},
+	}
+	newPod := func() *apiv1.Pod {
+		return &apiv1.Pod{Spec: apiv1.PodSpec{Containers: []apiv1.Container{
+			{Name: "wait", Resources: apiv1.ResourceRequirements{Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("64Mi")}}},
+			{Name: "sidecar", Resources: apiv1.ResourceRequirements{Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("128Mi")}}},
+			{Name: "main", Resources: apiv1.ResourceRequirements{
+				Requests: apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("512Mi"), apiv1.ResourceCPU: resource.MustParse("100m")},
+				Limits:   apiv1.ResourceList{apiv1.ResourceMemory: resource.MustParse("1Gi")},
+			}},
+		}}}
+	}
+	t.Run("Escalated", func(t *testing.T) {
+		pod := newPod()
+		EscalateResources("retry", &wfv1.Template{})(retryStrategy, nodes, pod)
+		assert.Equal(t, "64Mi", pod.Spec.Containers[0].Resources.Requests.Memory().String(), "the wait container is not escalated")
+		assert.Equal(t, "128Mi", pod.Spec.Containers[1].Resources.Requests.Memory().String(), "sidecars are not escalated")
+		main := pod.Spec.Containers[2].Resources
+		assert.Equal(t, "2Gi", main.Requests.Memory().String())
+		assert.Equal(t, "225m", main.Requests.Cpu().String())
+		assert.Equal(t, "3Gi", main.Limits.Memory().String(), "capped at maxMemory")
+		_, ok := main.Limits[apiv1.ResourceCPU]
+		assert.False(t, ok)
+	})
+	t.Run("NoRules", func(t *testing.T) {
+		pod := newPod()
+		EscalateResources("retry", &wfv1.Template{})(wfv1.RetryStrategy{}, nodes, pod)
+		assert.Equal(t, newPod(), pod)
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	node := &wfv1.NodeStatus{ID: "my-node", Children: []string{"a", "b", "c"}}
+	d, err := retryBackoff(node, wfv1.Backoff{Duration: "10", Factor: intstrutil.ParsePtr("2")})
+	if assert.NoError(t, err) {
+		assert.Equal(t, 40*time.Second, d)
+	}
+	_, err = retryBackoff(node, wfv1.Backoff{})
+	assert.EqualError(t, err, "no base duration specified for retryStrategy")
+
+	backoff := wfv1.Backoff{Duration: "10", Jitter: "0.5"}
+	d, err = retryBackoff(node, backoff)
+	if assert.NoError(t, err) {
+		assert.GreaterOrEqual(t, d, 10*time.Second)
+		assert.LessOrEqual(t, d, 15*time.Second)
+		again, _ := retryBackoff(node, backoff)
+		assert.Equal(t, d, again, "the jitter of an attempt does not change")
+	}
+	_, err = retryBackoff(node, wfv1.Backoff{Duration: "10", Jitter: "foo"})
+	assert.Error(t, err)
+}
+
+func TestPodFailureReason(t *testing.T) {
+	terminated := func(reason string) apiv1.ContainerStatus {
+		return apiv1.ContainerStatus{State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{Reason: reason, ExitCode: 137}}}
+	}
+	waiting := func(reason string) apiv1.ContainerStatus {
+		return apiv1.ContainerStatus{State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: reason}}}
+	}
+	for _, tt := range []struct {
+		name   string
+		status apiv1.PodStatus
+		want   wfv1.FailureReason
+	}{
+		{"OOMKilled", apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{terminated("Completed"), terminated("OOMKilled")}}, wfv1.FailureReasonOOMKilled},
+		{"Evicted", apiv1.PodStatus{Reason: "Evicted"}, wfv1.FailureReasonEvicted},
+		{"NodeShutdown", apiv1.PodStatus{Reason: "Shutdown"}, wfv1.FailureReasonNodeShutdown},
+		{"ImagePullBackOff", apiv1.PodStatus{Reason: "DeadlineExceeded", ContainerStatuses: []apiv1.ContainerStatus{waiting("ErrImagePull")}}, wfv1.FailureReasonImagePullBackOff},
+		{"DeadlineExceeded", apiv1.PodStatus{Reason: "DeadlineExceeded"}, wfv1.FailureReasonDeadlineExceeded},
+		{"Error", apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{terminated("Error")}}, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, podFailureReason(&apiv1.Pod{Status: tt.status}))
+		})
+	}
+}
+
+func TestProcessNodeRetriesWithRules(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(wfv1.MustUnmarshalWorkflow(helloWorldWf), controller)
+	nodeName := "test-node"
+	woc.initializeNode(nodeName, wfv1.NodeTypeRetry, "", &wfv1.WorkflowStep{}, "", wfv1.NodeRunning)
+	childNode := woc.initializeNode("child-node-0", wfv1.NodeTypePod, "", &wfv1.WorkflowStep{}, "", wfv1.NodeError)
+	childNode.FailureReason = wfv1.FailureReasonEvicted
+	childNode.Outputs = &wfv1.Outputs{ExitCode: pointer.StringPtr("2")}
+	woc.wf.Status.Nodes[childNode.ID] = *childNode
+	woc.addChildNode(nodeName, childNode.Name)
+
+	t.Run("RetriedByRule", func(t *testing.T) {
+		// the retry policy does not retry errors, but the rule does
+		retries := wfv1.RetryStrategy{Limit: intstrutil.ParsePtr("2"), Rules: []wfv1.RetryRule{{Reasons: []wfv1.FailureReason{wfv1.FailureReasonEvicted}}}}
+		n, _, err := woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.NodeRunning, n.Phase)
+		}
+	})
+	t.Run("NotRetriedByPolicy", func(t *testing.T) {
+		retries := wfv1.RetryStrategy{Limit: intstrutil.ParsePtr("2"), Rules: []wfv1.RetryRule{{Reasons: []wfv1.FailureReason{wfv1.FailureReasonOOMKilled}}}}
+		n, _, err := woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.NodeError, n.Phase)
+		}
+	})
+	t.Run("FailedByRule", func(t *testing.T) {
+		woc.markNodePhase(nodeName, wfv1.NodeRunning)
+		retries := wfv1.RetryStrategy{Limit: intstrutil.ParsePtr("2"), RetryPolicy: wfv1.RetryPolicyAlways, Rules: []wfv1.RetryRule{{ExitCodes: []int32{2}, Action: wfv1.RetryRuleActionFail}}}
+		n, _, err := woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.NodeError, n.Phase)
+		}
+	})
+}
+
+var retryOOMKilledWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: retry-oom-killed
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      retryStrategy:
+        limit: 2
+        rules:
+          - reasons: [OOMKilled]
+            escalate:
+              memory: "2"
+      container:
+        image: argoproj/argosay:v2
+        resources:
+          requests:
+            memory: 256Mi
+`
+
+func TestRetryOOMKilled(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(retryOOMKilledWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodFailed, func(pod *apiv1.Pod) {
+		pod.Status.ContainerStatuses = []apiv1.ContainerStatus{{
+			Name:  "main",
+			State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+		}}
+	})
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	first := woc.wf.Status.Nodes.FindByDisplayName("retry-oom-killed(0)")
+	if assert.NotNil(t, first) {
+		assert.Equal(t, wfv1.NodeFailed, first.Phase)
+		assert.Equal(t, wfv1.FailureReasonOOMKilled, first.FailureReason)
+		assert.Nil(t, first.RetryDecision)
+	}
+	second := woc.wf.Status.Nodes.FindByDisplayName("retry-oom-killed(1)")
+	if assert.NotNil(t, second) && assert.NotNil(t, second.RetryDecision) {
+		assert.Equal(t, wfv1.FailureReasonOOMKilled, second.RetryDecision.Reason)
+		assert.Equal(t, pointer.Int32Ptr(0), second.RetryDecision.Rule)
+		assert.Equal(t, []string{"memory x2"}, second.RetryDecision.Changes)
+	}
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) {
+		for _, pod := range pods.Items {
+			if pod.Annotations[common.AnnotationKeyNodeID] == second.ID {
+				assert.Equal(t, "512Mi", pod.Spec.Containers[1].Resources.Requests.Memory().String())
+			}
+		}
+	}
+}
+
+var retryImagePullBackOffWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: retry-image-pull-back-off
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      retryStrategy:
+        limit: 2
+        rules:
+          - reasons: [ImagePullBackOff]
+      container:
+        image: argoproj/argosay:v2
+`
+
+func TestRetryImagePullBackOff(t *testing.T) {
+	ctx := context.Background()
+	pullBackOff := func(pod *apiv1.Pod) {
+		pod.Status.ContainerStatuses = []apiv1.ContainerStatus{{
+			Name:  "main",
+			State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+		}}
+	}
+	t.Run("Retried", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(retryImagePullBackOffWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodPending, pullBackOff)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+
+		// the pod is still pending, but the attempt fails, and is retried
+		first := woc.wf.Status.Nodes.FindByDisplayName("retry-image-pull-back-off(0)")
+		if assert.NotNil(t, first) {
+			assert.Equal(t, wfv1.NodeFailed, first.Phase)
+			assert.Equal(t, wfv1.FailureReasonImagePullBackOff, first.FailureReason)
+			assert.False(t, first.FinishedAt.IsZero())
+		}
+		assert.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName("retry-image-pull-back-off(1)"))
+	})
+	t.Run("NoRule", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(retryImagePullBackOffWf)
+		wf.Spec.Templates[0].RetryStrategy.Rules = nil
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodPending, pullBackOff)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+
+		// the pod keeps pulling the image
+		first := woc.wf.Status.Nodes.FindByDisplayName("retry-image-pull-back-off(0)")
+		if assert.NotNil(t, first) {
+			assert.Equal(t, wfv1.NodePending, first.Phase)
+			assert.Equal(t, "ImagePullBackOff: Back-off pulling image", first.Message)
+		}
+		assert.Nil(t, woc.wf.Status.Nodes.FindByDisplayName("retry-image-pull-back-off(1)"))
+	})
+}
@@ -126,6 +126,9 @@ func (s *wfScope) resolveArtifact(art *wfv1.Artifact) (*wfv1.Artifact, error) {
 			return copyArt, errors.New(errors.CodeBadRequest, "failed to unmarshal artifact subpath for templating")
 		}
 
+		// the checksum is of the whole artifact, not of the object at the subpath
+		copyArt.Checksum = ""
+		copyArt.SizeBytes = 0
 		return copyArt, copyArt.AppendToKey(resolvedSubPath)
 	}
 
@@ -62,6 +62,8 @@ func TestSubPathResolution(t *testing.T) {
 	s3Artifact := `
   name: s3-artifact
   path: some/local/path
+  checksum: sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae
+  sizeBytes: 3
   s3:
     endpoint: minio:9000
     bucket: test-bucket
@@ -20,6 +20,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/errors"
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
 	"github.com/argoproj/argo-workflows/v3/util/template"
@@ -96,28 +97,43 @@ func (woc *wfOperationCtx) hasPodSpecPatch(tmpl *wfv1.Template) bool {
 	return woc.execWf.Spec.HasPodSpecPatch() || tmpl.HasPodSpecPatch()
 }
 
-// scheduleOnDifferentHost adds affinity to prevent retry on the same host when
-// retryStrategy.affinity.nodeAntiAffinity{} is specified
-func (woc *wfOperationCtx) scheduleOnDifferentHost(node *wfv1.NodeStatus, pod *apiv1.Pod) error {
+// applyRetryTweaks changes the pod of a retry: it adds affinity to prevent retry on the same host when
+// retryStrategy.affinity.nodeAntiAffinity{} is specified, and escalates its resources when a retry rule says so
+func (woc *wfOperationCtx) applyRetryTweaks(node *wfv1.NodeStatus, tmpl *wfv1.Template, pod *apiv1.Pod) error {
 	if node != nil && pod != nil {
-		if retryNode := FindRetryNode(woc.wf.Status.Nodes, node.ID); retryNode != nil {
-			// recover template for the retry node
-			tmplCtx, err := woc.createTemplateContext(retryNode.GetTemplateScope())
-			if err != nil {
-				return err
-			}
-			_, retryTmpl, _, err := tmplCtx.ResolveTemplate(retryNode)
+		retryNode := findRetryParent(woc.wf.Status.Nodes, node.ID)
+		if retryNode == nil {
+			retryNode = FindRetryNode(woc.wf.Status.Nodes, node.ID)
+		}
+		if retryNode != nil {
+			retryStrategy, err := woc.retryNodeStrategy(retryNode)
 			if err != nil {
 				return err
 			}
-			if retryStrategy := woc.retryStrategy(retryTmpl); retryStrategy != nil {
-				RetryOnDifferentHost(retryNode.ID)(*retryStrategy, woc.wf.Status.Nodes, pod)
+			if retryStrategy != nil {
+				for _, tweak := range []RetryTweak{RetryOnDifferentHost(retryNode.ID), EscalateResources(retryNode.ID, tmpl)} {
+					tweak(*retryStrategy, woc.wf.Status.Nodes, pod)
+				}
 			}
 		}
 	}
 	return nil
 }
 
+// retryNodeStrategy returns the retry strategy of the template of the retry node
+func (woc *wfOperationCtx) retryNodeStrategy(retryNode *wfv1.NodeStatus) (*wfv1.RetryStrategy, error) {
+	// recover template for the retry node
+	tmplCtx, err := woc.createTemplateContext(retryNode.GetTemplateScope())
+	if err != nil {
+		return nil, err
+	}
+	_, retryTmpl, _, err := tmplCtx.ResolveTemplate(retryNode)
+	if err != nil {
+		return nil, err
+	}
+	return woc.retryStrategy(retryTmpl), nil
+}
+
 type createWorkflowPodOpts struct {
 	includeScriptOutput bool
 	onExitPod           bool
@@ -291,7 +307,12 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	addSchedulingConstraints(pod, wfSpec, tmpl)
 	woc.addMetadata(pod, tmpl)
 
-	err = addVolumeReferences(pod, woc.volumes, tmpl, woc.wf.Status.PersistentVolumeClaims)
+	artifactPlugins, err := woc.getArtifactPlugins(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addVolumeReferences(pod, woc.volumes, tmpl, woc.wf.Status.PersistentVolumeClaims, artifactPlugins)
 	if err != nil {
 		return nil, err
 	}
@@ -350,6 +371,9 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		pod.Spec.Containers[i] = c
 	}
 
+	// the binaries of artifact plugins are copied before the init container loads the input artifacts
+	woc.addArtifactPluginBinaries(pod, artifactPlugins)
+
 	// Perform one last variable substitution here. Some variables come from the from workflow
 	// configmap (e.g. archive location) or volumes attribute, and were not substituted
 	// in executeTemplate.
@@ -443,7 +467,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return nil, err
 	}
 
-	if err := woc.scheduleOnDifferentHost(node, pod); err != nil {
+	if err := woc.applyRetryTweaks(node, tmpl, pod); err != nil {
 		return nil, err
 	}
 
@@ -720,12 +744,7 @@ func (woc *wfOperationCtx) newExecContainer(name string, tmpl *wfv1.Template) *a
 		exec.Args = append(exec.Args, "--kubeconfig="+path)
 	}
 
-	executorServiceAccountName := ""
-	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = tmpl.Executor.ServiceAccountName
-	} else if woc.execWf.Spec.Executor != nil && woc.execWf.Spec.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = woc.execWf.Spec.Executor.ServiceAccountName
-	}
+	executorServiceAccountName := woc.executorServiceAccountName(tmpl)
 	if executorServiceAccountName != "" {
 		exec.VolumeMounts = append(exec.VolumeMounts, apiv1.VolumeMount{
 			Name:      common.ServiceAccountTokenVolumeName,
@@ -814,7 +833,7 @@ func addSchedulingConstraints(pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *w
 
 // addVolumeReferences adds any volumeMounts that a container/sidecar is referencing, to the pod.spec.volumes
 // These are either specified in the workflow.spec.volumes or the workflow.spec.volumeClaimTemplate section
-func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Template, pvcs []apiv1.Volume) error {
+func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Template, pvcs []apiv1.Volume, artifactPlugins []*spec.ArtifactPlugin) error {
 	switch tmpl.GetType() {
 	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeContainerSet, wfv1.TemplateTypeScript, wfv1.TemplateTypeData:
 	default:
@@ -886,7 +905,7 @@ func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Templat
 		}
 	}
 
-	volumes, volumeMounts := createSecretVolumes(tmpl)
+	volumes, volumeMounts := createSecretVolumes(tmpl, artifactPlugins)
 	pod.Spec.Volumes = append(pod.Spec.Volumes, volumes...)
 
 	for idx, container := range pod.Spec.Containers {
@@ -1071,6 +1090,17 @@ func (woc *wfOperationCtx) IsArchiveLogs(tmpl *wfv1.Template) bool {
 	return archiveLogs
 }
 
+// executorServiceAccountName returns the name of the service account that the executor of the template uses, or an
+// empty string if it uses the service account of the pod
+func (woc *wfOperationCtx) executorServiceAccountName(tmpl *wfv1.Template) string {
+	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
+		return tmpl.Executor.ServiceAccountName
+	} else if woc.execWf.Spec.Executor != nil && woc.execWf.Spec.Executor.ServiceAccountName != "" {
+		return woc.execWf.Spec.Executor.ServiceAccountName
+	}
+	return ""
+}
+
 // setupServiceAccount sets up service account and token.
 func (woc *wfOperationCtx) setupServiceAccount(ctx context.Context, pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if tmpl.ServiceAccountName != "" {
@@ -1089,12 +1119,7 @@ func (woc *wfOperationCtx) setupServiceAccount(ctx context.Context, pod *apiv1.P
 		pod.Spec.AutomountServiceAccountToken = automountServiceAccountToken
 	}
 
-	executorServiceAccountName := ""
-	if tmpl.Executor != nil && tmpl.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = tmpl.Executor.ServiceAccountName
-	} else if woc.execWf.Spec.Executor != nil && woc.execWf.Spec.Executor.ServiceAccountName != "" {
-		executorServiceAccountName = woc.execWf.Spec.Executor.ServiceAccountName
-	}
+	executorServiceAccountName := woc.executorServiceAccountName(tmpl)
 	if executorServiceAccountName != "" {
 		tokenName, err := common.GetServiceAccountTokenName(ctx, woc.controller.kubeclientset, pod.Namespace, executorServiceAccountName)
 		if err != nil {
@@ -1192,8 +1217,9 @@ func verifyResolvedVariables(obj interface{}) error {
 	})
 }
 
-// createSecretVolumes will retrieve and create Volumes and Volumemount object for Pod
-func createSecretVolumes(tmpl *wfv1.Template) ([]apiv1.Volume, []apiv1.VolumeMount) {
+// createSecretVolumes will retrieve and create Volumes and Volumemount object for Pod, including the tokens of the
+// services of the artifact plugins that are called from it
+func createSecretVolumes(tmpl *wfv1.Template, artifactPlugins []*spec.ArtifactPlugin) ([]apiv1.Volume, []apiv1.VolumeMount) {
 	allVolumesMap := make(map[string]apiv1.Volume)
 	uniqueKeyMap := make(map[string]bool)
 	var secretVolumes []apiv1.Volume
@@ -1213,6 +1239,11 @@ func createSecretVolumes(tmpl *wfv1.Template) ([]apiv1.Volume, []apiv1.VolumeMou
 			createSecretVolume(allVolumesMap, *art, uniqueKeyMap)
 		}
 	}
+	for _, p := range artifactPlugins {
+		if p.Spec.Binary == nil && p.Spec.Service != nil {
+			createSecretVal(allVolumesMap, p.Spec.Service.Token, uniqueKeyMap)
+		}
+	}
 
 	for volMountName, val := range allVolumesMap {
 		secretVolumes = append(secretVolumes, val)
@@ -1246,9 +1277,18 @@ func createArchiveLocationSecret(tmpl *wfv1.Template, volMap map[string]apiv1.Vo
 	} else if ossRepo := tmpl.ArchiveLocation.OSS; ossRepo != nil {
 		createSecretVal(volMap, ossRepo.AccessKeySecret, uniqueKeyMap)
 		createSecretVal(volMap, ossRepo.SecretKeySecret, uniqueKeyMap)
+	} else if azureRepo := tmpl.ArchiveLocation.Azure; azureRepo != nil {
+		createSecretVal(volMap, azureRepo.AccountKeySecret, uniqueKeyMap)
+		createSecretVal(volMap, azureRepo.SASTokenSecret, uniqueKeyMap)
 	} else if gcsRepo := tmpl.ArchiveLocation.GCS; gcsRepo != nil {
 		createSecretVal(volMap, gcsRepo.ServiceAccountKeySecret, uniqueKeyMap)
 	}
+	if encryption := tmpl.ArchiveLocation.Encryption; encryption != nil {
+		createSecretVal(volMap, encryption.KeySecret, uniqueKeyMap)
+		for i := range encryption.PreviousKeySecrets {
+			createSecretVal(volMap, &encryption.PreviousKeySecrets[i], uniqueKeyMap)
+		}
+	}
 }
 
 func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMap map[string]bool) {
@@ -1270,6 +1310,30 @@ func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMa
 		createSecretVal(volMap, art.OSS.SecretKeySecret, keyMap)
 	} else if art.GCS != nil {
 		createSecretVal(volMap, art.GCS.ServiceAccountKeySecret, keyMap)
+	} else if art.Azure != nil {
+		createSecretVal(volMap, art.Azure.AccountKeySecret, keyMap)
+		createSecretVal(volMap, art.Azure.SASTokenSecret, keyMap)
+	} else if art.OCI != nil {
+		createSecretVal(volMap, art.OCI.DockerConfigSecret, keyMap)
+	} else if art.HTTP != nil {
+		for _, header := range art.HTTP.Headers {
+			if header.ValueFrom != nil {
+				createSecretVal(volMap, header.ValueFrom.SecretKeyRef, keyMap)
+			}
+		}
+		if auth := art.HTTP.Auth; auth != nil {
+			if auth.BasicAuth != nil {
+				createSecretVal(volMap, auth.BasicAuth.UsernameSecret, keyMap)
+				createSecretVal(volMap, auth.BasicAuth.PasswordSecret, keyMap)
+			}
+			createSecretVal(volMap, auth.BearerTokenSecret, keyMap)
+		}
+	}
+	if art.Encryption != nil {
+		createSecretVal(volMap, art.Encryption.KeySecret, keyMap)
+		for i := range art.Encryption.PreviousKeySecrets {
+			createSecretVal(volMap, &art.Encryption.PreviousKeySecrets[i], keyMap)
+		}
 	}
 }
 
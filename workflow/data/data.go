@@ -29,6 +29,26 @@ func processSource(source wfv1.DataSource, processor wfv1.DataSourceProcessor) (
 		if err != nil {
 			return nil, fmt.Errorf("unable to source artifact paths: %w", err)
 		}
+	case source.Value != nil:
+		data, err = parse([]byte(source.Value.Value), source.Value.Format)
+		if err != nil {
+			return nil, fmt.Errorf("unable to source value: %w", err)
+		}
+	case source.HTTP != nil:
+		data, err = processRaw(source.HTTP.Format, func() ([]byte, error) { return processor.ProcessHTTP(source.HTTP) })
+		if err != nil {
+			return nil, fmt.Errorf("unable to source http: %w", err)
+		}
+	case source.ConfigMap != nil:
+		data, err = processRaw(source.ConfigMap.Format, func() ([]byte, error) { return processor.ProcessConfigMap(source.ConfigMap) })
+		if err != nil {
+			return nil, fmt.Errorf("unable to source config map: %w", err)
+		}
+	case source.Artifact != nil:
+		data, err = processRaw(source.Artifact.Format, func() ([]byte, error) { return processor.ProcessArtifact(source.Artifact) })
+		if err != nil {
+			return nil, fmt.Errorf("unable to source artifact: %w", err)
+		}
 	default:
 		return nil, fmt.Errorf("no valid source is used for data template")
 	}
@@ -36,6 +56,14 @@ func processSource(source wfv1.DataSource, processor wfv1.DataSourceProcessor) (
 	return data, nil
 }
 
+func processRaw(format wfv1.DataFormat, get func() ([]byte, error)) (interface{}, error) {
+	raw, err := get()
+	if err != nil {
+		return nil, err
+	}
+	return parse(raw, format)
+}
+
 func processTransformation(data interface{}, transformation *wfv1.Transformation) (interface{}, error) {
 	if transformation == nil {
 		return data, nil
@@ -46,6 +74,18 @@ func processTransformation(data interface{}, transformation *wfv1.Transformation
 		switch {
 		case step.Expression != "":
 			data, err = processExpression(step.Expression, data)
+		case step.Filter != "":
+			data, err = filter(step.Filter, data)
+		case step.Map != "":
+			data, err = mapItems(step.Map, data)
+		case step.GroupBy != "":
+			data, err = groupBy(step.GroupBy, data)
+		case step.SortBy != "":
+			data, err = sortBy(step.SortBy, data)
+		case step.Chunk != 0:
+			data, err = chunk(int(step.Chunk), data)
+		case step.Dedupe != "":
+			data, err = dedupe(step.Dedupe, data)
 		}
 		if err != nil {
 			return nil, fmt.Errorf("error processing data step %d: %w", i, err)
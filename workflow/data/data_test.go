@@ -17,6 +17,18 @@ func (t testDataSourceProcessor) ProcessArtifactPaths(*v1alpha1.ArtifactPaths) (
 	return []interface{}{"foo.py", "bar.pdf", "goo/foo.py", "moo/bar.pdf"}, nil
 }
 
+func (t testDataSourceProcessor) ProcessHTTP(*v1alpha1.HTTPDataSource) ([]byte, error) {
+	return []byte(`{"foo": "bar"}`), nil
+}
+
+func (t testDataSourceProcessor) ProcessConfigMap(*v1alpha1.ConfigMapDataSource) ([]byte, error) {
+	return []byte("name,size\nfoo,1\nbar,2\n"), nil
+}
+
+func (t testDataSourceProcessor) ProcessArtifact(*v1alpha1.ArtifactDataSource) ([]byte, error) {
+	return []byte("{\"name\": \"foo\"}\n{\"name\": \"bar\"}\n"), nil
+}
+
 type nullTestDataSourceProcessor struct{}
 
 var _ v1alpha1.DataSourceProcessor = &nullTestDataSourceProcessor{}
@@ -25,6 +37,18 @@ func (t nullTestDataSourceProcessor) ProcessArtifactPaths(*v1alpha1.ArtifactPath
 	return nil, fmt.Errorf("cannot get artifacts")
 }
 
+func (t nullTestDataSourceProcessor) ProcessHTTP(*v1alpha1.HTTPDataSource) ([]byte, error) {
+	return nil, fmt.Errorf("cannot get http")
+}
+
+func (t nullTestDataSourceProcessor) ProcessConfigMap(*v1alpha1.ConfigMapDataSource) ([]byte, error) {
+	return nil, fmt.Errorf("cannot get config map")
+}
+
+func (t nullTestDataSourceProcessor) ProcessArtifact(*v1alpha1.ArtifactDataSource) ([]byte, error) {
+	return nil, fmt.Errorf("cannot get artifact")
+}
+
 func TestProcessSource(t *testing.T) {
 	artifactPathsSource := v1alpha1.DataSource{ArtifactPaths: &v1alpha1.ArtifactPaths{}}
 	data, err := processSource(artifactPathsSource, &testDataSourceProcessor{})
@@ -37,6 +61,46 @@ func TestProcessSource(t *testing.T) {
 
 	_, err = processSource(v1alpha1.DataSource{}, &nullTestDataSourceProcessor{})
 	assert.Error(t, err)
+
+	data, err = processSource(v1alpha1.DataSource{Value: &v1alpha1.DataValue{Value: `[1, "two"]`}}, &nullTestDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{1.0, "two"}, data)
+	}
+
+	_, err = processSource(v1alpha1.DataSource{Value: &v1alpha1.DataValue{Value: `[1,`}}, &nullTestDataSourceProcessor{})
+	assert.Error(t, err)
+
+	data, err = processSource(v1alpha1.DataSource{Value: &v1alpha1.DataValue{Value: "foo", Format: v1alpha1.DataFormatText}}, &nullTestDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "foo", data)
+	}
+
+	httpSource := v1alpha1.DataSource{HTTP: &v1alpha1.HTTPDataSource{}}
+	data, err = processSource(httpSource, &testDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{"foo": "bar"}, data)
+	}
+	_, err = processSource(httpSource, &nullTestDataSourceProcessor{})
+	assert.Error(t, err)
+
+	configMapSource := v1alpha1.DataSource{ConfigMap: &v1alpha1.ConfigMapDataSource{Format: v1alpha1.DataFormatCSV}}
+	data, err = processSource(configMapSource, &testDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "foo", "size": "1"}, map[string]interface{}{"name": "bar", "size": "2"}}, data)
+	}
+	_, err = processSource(configMapSource, &nullTestDataSourceProcessor{})
+	assert.Error(t, err)
+
+	artifactSource := v1alpha1.DataSource{Artifact: &v1alpha1.ArtifactDataSource{Format: v1alpha1.DataFormatNDJSON}}
+	data, err = processSource(artifactSource, &testDataSourceProcessor{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "foo"}, map[string]interface{}{"name": "bar"}}, data)
+	}
+	_, err = processSource(artifactSource, &nullTestDataSourceProcessor{})
+	assert.Error(t, err)
+
+	_, err = processSource(v1alpha1.DataSource{Value: &v1alpha1.DataValue{Value: "foo", Format: "yaml"}}, &nullTestDataSourceProcessor{})
+	assert.Error(t, err)
 }
 
 func TestProcessTransformation(t *testing.T) {
@@ -101,3 +165,62 @@ func TestProcessTransformation(t *testing.T) {
 	_, err = processTransformation(files, filterFiles)
 	assert.Error(t, err)
 }
+
+func TestProcessTransformationOps(t *testing.T) {
+	files := []string{"foo.py", "bar.pdf", "goo/foo.py", "moo/bar.pdf"}
+
+	filtered, err := processTransformation(files, &v1alpha1.Transformation{{Filter: `item endsWith '.py'`}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"foo.py", "goo/foo.py"}, filtered)
+	}
+
+	_, err = processTransformation(files, &v1alpha1.Transformation{{Filter: `item`}})
+	assert.Error(t, err)
+
+	_, err = processTransformation("foo", &v1alpha1.Transformation{{Filter: `true`}})
+	assert.Error(t, err)
+
+	mapped, err := processTransformation(files, &v1alpha1.Transformation{{Filter: `item contains '/'`}, {Map: `item + '.processed'`}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"goo/foo.py.processed", "moo/bar.pdf.processed"}, mapped)
+	}
+
+	grouped, err := processTransformation(files, &v1alpha1.Transformation{{GroupBy: `item endsWith '.py' ? 'python' : 'pdf'`}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]interface{}{
+			"python": []interface{}{"foo.py", "goo/foo.py"},
+			"pdf":    []interface{}{"bar.pdf", "moo/bar.pdf"},
+		}, grouped)
+	}
+
+	sorted, err := processTransformation(files, &v1alpha1.Transformation{{SortBy: `item`}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"bar.pdf", "foo.py", "goo/foo.py", "moo/bar.pdf"}, sorted)
+	}
+
+	items := []interface{}{
+		map[string]interface{}{"name": "a", "size": 3.0},
+		map[string]interface{}{"name": "b", "size": 1.0},
+		map[string]interface{}{"name": "c", "size": 2.0},
+	}
+	sorted, err = processTransformation(items, &v1alpha1.Transformation{{SortBy: `item.size`}, {Map: `item.name`}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"b", "c", "a"}, sorted)
+	}
+
+	_, err = processTransformation([]interface{}{"a", 1.0}, &v1alpha1.Transformation{{SortBy: `item`}})
+	assert.Error(t, err)
+
+	chunked, err := processTransformation(files, &v1alpha1.Transformation{{Chunk: 3}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{[]interface{}{"foo.py", "bar.pdf", "goo/foo.py"}, []interface{}{"moo/bar.pdf"}}, chunked)
+	}
+
+	_, err = processTransformation(files, &v1alpha1.Transformation{{Chunk: -1}})
+	assert.Error(t, err)
+
+	deduped, err := processTransformation(files, &v1alpha1.Transformation{{Dedupe: `item endsWith '.py'`}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{"foo.py", "bar.pdf"}, deduped)
+	}
+}
@@ -0,0 +1,67 @@
+package data
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// parse parses raw sourced data according to its format
+func parse(raw []byte, format wfv1.DataFormat) (interface{}, error) {
+	switch format {
+	case "", wfv1.DataFormatJSON:
+		var data interface{}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, fmt.Errorf("unable to parse JSON: %w", err)
+		}
+		return data, nil
+	case wfv1.DataFormatNDJSON:
+		return parseNDJSON(raw)
+	case wfv1.DataFormatCSV:
+		return parseCSV(raw)
+	case wfv1.DataFormatText:
+		return string(raw), nil
+	default:
+		return nil, fmt.Errorf("unknown data format %q", format)
+	}
+}
+
+func parseNDJSON(raw []byte) (interface{}, error) {
+	data := make([]interface{}, 0)
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	for {
+		var item interface{}
+		err := decoder.Decode(&item)
+		if err == io.EOF {
+			return data, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse NDJSON item %d: %w", len(data), err)
+		}
+		data = append(data, item)
+	}
+}
+
+func parseCSV(raw []byte) (interface{}, error) {
+	records, err := csv.NewReader(bytes.NewReader(raw)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse CSV: %w", err)
+	}
+	data := make([]interface{}, 0)
+	if len(records) == 0 {
+		return data, nil
+	}
+	header := records[0]
+	for _, record := range records[1:] {
+		row := make(map[string]interface{}, len(header))
+		for i, name := range header {
+			row[name] = record[i]
+		}
+		data = append(data, row)
+	}
+	return data, nil
+}
@@ -0,0 +1,175 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/antonmedv/expr"
+)
+
+// eachItem evaluates the expression once for every element of the data, with the element available as "item"
+func eachItem(expression string, data interface{}, f func(item, result interface{}) error) ([]interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, err
+	}
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	for i, item := range items {
+		result, err := expr.Run(program, map[string]interface{}{"item": item})
+		if err != nil {
+			return nil, fmt.Errorf("unable to evaluate item %d: %w", i, err)
+		}
+		if err := f(item, result); err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return items, nil
+}
+
+func toList(data interface{}) ([]interface{}, error) {
+	if items, ok := data.([]interface{}); ok {
+		return items, nil
+	}
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("data must be a list, got %T", data)
+	}
+	items := make([]interface{}, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+	return items, nil
+}
+
+func filter(expression string, data interface{}) (interface{}, error) {
+	out := make([]interface{}, 0)
+	_, err := eachItem(expression, data, func(item, result interface{}) error {
+		keep, ok := result.(bool)
+		if !ok {
+			return fmt.Errorf("filter expression must evaluate to a bool, got %T", result)
+		}
+		if keep {
+			out = append(out, item)
+		}
+		return nil
+	})
+	return out, err
+}
+
+func mapItems(expression string, data interface{}) (interface{}, error) {
+	out := make([]interface{}, 0)
+	_, err := eachItem(expression, data, func(_, result interface{}) error {
+		out = append(out, result)
+		return nil
+	})
+	return out, err
+}
+
+func groupBy(expression string, data interface{}) (interface{}, error) {
+	out := make(map[string]interface{})
+	_, err := eachItem(expression, data, func(item, result interface{}) error {
+		key := fmt.Sprint(result)
+		group, _ := out[key].([]interface{})
+		out[key] = append(group, item)
+		return nil
+	})
+	return out, err
+}
+
+func sortBy(expression string, data interface{}) (interface{}, error) {
+	var keys []interface{}
+	items, err := eachItem(expression, data, func(_, result interface{}) error {
+		keys = append(keys, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]int, len(items))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		var less bool
+		less, err = lessThan(keys[indices[i]], keys[indices[j]])
+		return less
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]interface{}, len(items))
+	for i, index := range indices {
+		out[i] = items[index]
+	}
+	return out, nil
+}
+
+func lessThan(a, b interface{}) (bool, error) {
+	if x, ok := toFloat(a); ok {
+		if y, ok := toFloat(b); ok {
+			return x < y, nil
+		}
+	}
+	if x, ok := a.(string); ok {
+		if y, ok := b.(string); ok {
+			return x < y, nil
+		}
+	}
+	return false, fmt.Errorf("cannot compare sort keys %v (%T) and %v (%T)", a, a, b, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch x := reflect.ValueOf(v); x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(x.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(x.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return x.Float(), true
+	}
+	return 0, false
+}
+
+func chunk(size int, data interface{}) (interface{}, error) {
+	if size < 0 {
+		return nil, fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	items, err := toList(data)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]interface{}, 0)
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		out = append(out, items[start:end])
+	}
+	return out, nil
+}
+
+func dedupe(expression string, data interface{}) (interface{}, error) {
+	seen := make(map[string]bool)
+	out := make([]interface{}, 0)
+	_, err := eachItem(expression, data, func(item, result interface{}) error {
+		key, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			out = append(out, item)
+		}
+		return nil
+	})
+	return out, err
+}
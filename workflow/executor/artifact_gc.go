@@ -0,0 +1,42 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// DeleteArtifacts deletes the output artifacts of a workflow for artifact GC, by node ID. It deletes all the artifacts
+// it can, and returns an error naming those it could not delete.
+func (we *WorkflowExecutor) DeleteArtifacts(ctx context.Context, artifacts map[string][]wfv1.Artifact) error {
+	var failed []string
+	for nodeID, arts := range artifacts {
+		for _, art := range arts {
+			art := art
+			log := log.WithFields(log.Fields{"nodeID": nodeID, "artifact": art.Name})
+			driver, err := we.InitDriver(ctx, &art)
+			if err == nil {
+				err = driver.Delete(&art)
+			}
+			switch {
+			case errors.Is(err, artifactcommon.ErrDeleteNotSupported):
+				log.Warn("Artifact cannot be deleted")
+			case err != nil:
+				log.WithError(err).Error("Failed to delete artifact")
+				failed = append(failed, fmt.Sprintf("%s/%s: %v", nodeID, art.Name, err))
+			default:
+				log.Info("Deleted artifact")
+			}
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to delete %d artifacts: %s", len(failed), strings.Join(failed, "; "))
+	}
+	return nil
+}
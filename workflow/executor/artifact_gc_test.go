@@ -0,0 +1,27 @@
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestWorkflowExecutor_DeleteArtifacts(t *testing.T) {
+	we := WorkflowExecutor{}
+	ctx := context.Background()
+	t.Run("NotSupported", func(t *testing.T) {
+		err := we.DeleteArtifacts(ctx, map[string][]wfv1.Artifact{
+			"my-node": {{Name: "foo", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "foo"}}}},
+		})
+		assert.NoError(t, err)
+	})
+	t.Run("Unsupported driver", func(t *testing.T) {
+		err := we.DeleteArtifacts(ctx, map[string][]wfv1.Artifact{
+			"my-node": {{Name: "foo"}, {Name: "bar", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "bar"}}}},
+		})
+		assert.EqualError(t, err, "failed to delete 1 artifacts: my-node/foo: Unsupported artifact driver for foo")
+	})
+}
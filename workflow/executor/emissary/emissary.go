@@ -16,6 +16,7 @@ import (
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor"
 	osspecific "github.com/argoproj/argo-workflows/v3/workflow/executor/os-specific"
 )
@@ -33,12 +34,15 @@ In the main container, the emissary creates these files:
 * `/var/run/argo/ctr/${containerName}/exitcode` The container exit code.
 * `/var/run/argo/ctr/${containerName}/stderr` A copy of stderr (if needed).
 * `/var/run/argo/ctr/${containerName}/stdout`  A copy of stdout (if needed).
+* `/var/run/argo/ctr/${containerName}/skipped` Present if the container was not run because its depends logic was false.
 
 If the container is named `main` it also copies base-layer artifacts to the shared volume:
 
 * `/var/run/argo/outputs/parameters/${path}` All output parameters are copied here, e.g. `/tmp/message` is moved to `/var/run/argo/outputs/parameters/tmp/message`.
 * `/var/run/argo/outputs/artifacts/${path}.tgz` All output artifacts are copied here, e.g. `/tmp/message` is moved to /var/run/argo/outputs/artifacts/tmp/message.tgz`.
 
+Other containers in a container set that have their own outputs copy them to `/var/run/argo/ctr/${containerName}/outputs/parameters/${path}` and `/var/run/argo/ctr/${containerName}/outputs/artifacts/${path}.tgz`.
+
 The wait container can create one file itself, used for terminating the sub-process:
 
 * `/var/run/argo/ctr/${containerName}/signal` The emissary binary listens to changes in this file, and signals the sub-process with the value found in this file.
@@ -69,16 +73,23 @@ func (e emissary) writeTemplate(t wfv1.Template) error {
 	return ioutil.WriteFile("/var/run/argo/template", data, 0o444) // chmod -r--r--r--
 }
 
-func (e emissary) GetFileContents(_ string, sourcePath string) (string, error) {
-	data, err := ioutil.ReadFile(filepath.Clean(filepath.Join("/var/run/argo/outputs/parameters", sourcePath)))
+// outputsDir returns the directory the emissary saved the outputs of the container to
+func outputsDir(containerName string) string {
+	if containerName == common.MainContainerName {
+		return "/var/run/argo/outputs"
+	}
+	return "/var/run/argo/ctr/" + containerName + "/outputs"
+}
+
+func (e emissary) GetFileContents(containerName string, sourcePath string) (string, error) {
+	data, err := ioutil.ReadFile(filepath.Clean(filepath.Join(outputsDir(containerName), "parameters", sourcePath)))
 	return string(data), err
 }
 
-func (e emissary) CopyFile(_ string, sourcePath string, destPath string, _ int) error {
+func (e emissary) CopyFile(containerName string, sourcePath string, destPath string, _ int) error {
 	// this implementation is very different, because we expect the emissary binary has already compressed the file
-	// so no compression can or needs to be implemented here
-	// TODO - warn the user we ignored compression?
-	sourceFile := filepath.Join("/var/run/argo/outputs/artifacts", strings.TrimSuffix(sourcePath, "/")+".tgz")
+	// with the compression level of the archive strategy of the artifact, so no compression needs to be implemented here
+	sourceFile := filepath.Join(outputsDir(containerName), "artifacts", strings.TrimSuffix(sourcePath, "/")+".tgz")
 	log.Infof("%s -> %s", sourceFile, destPath)
 	src, err := os.Open(filepath.Clean(sourceFile))
 	if err != nil {
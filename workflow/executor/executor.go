@@ -1,10 +1,8 @@
 package executor
 
 import (
-	"archive/tar"
 	"archive/zip"
 	"bufio"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -13,20 +11,25 @@ import (
 	"io/fs"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"os/signal"
 	"path"
 	"path/filepath"
+	"runtime"
 	"runtime/debug"
 	"strings"
 	"syscall"
 	"time"
 
 	argofile "github.com/argoproj/pkg/file"
+	"github.com/klauspost/compress/zstd"
 	log "github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
 
 	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -53,7 +56,8 @@ type WorkflowExecutor struct {
 	IncludeScriptOutput bool
 	Deadline            time.Time
 	ClientSet           kubernetes.Interface
-	RESTClient          rest.Interface
+	DynamicClient       dynamic.Interface
+	RESTMapper          meta.RESTMapper
 	Namespace           string
 	RuntimeExecutor     ContainerRuntimeExecutor
 
@@ -70,6 +74,12 @@ type WorkflowExecutor struct {
 
 	annotationPatchTickDuration  time.Duration
 	readProgressFileTickDuration time.Duration
+
+	// outputs of the containers of a container set, keyed by container name
+	containerOutputs map[string]*wfv1.Outputs
+
+	// resources created by a resource template, rather than updated
+	createdResources []*unstructured.Unstructured
 }
 
 type Initializer interface {
@@ -109,12 +119,13 @@ func isErrUnknownGetPods(err error) bool {
 }
 
 // NewExecutor instantiates a new workflow executor
-func NewExecutor(clientset kubernetes.Interface, restClient rest.Interface, podName, namespace string, cre ContainerRuntimeExecutor, template wfv1.Template, includeScriptOutput bool, deadline time.Time, annotationPatchTickDuration, readProgressFileTickDuration time.Duration) WorkflowExecutor {
+func NewExecutor(clientset kubernetes.Interface, dynamicClient dynamic.Interface, restMapper meta.RESTMapper, podName, namespace string, cre ContainerRuntimeExecutor, template wfv1.Template, includeScriptOutput bool, deadline time.Time, annotationPatchTickDuration, readProgressFileTickDuration time.Duration) WorkflowExecutor {
 	log.WithFields(log.Fields{"Steps": executorretry.Steps, "Duration": executorretry.Duration, "Factor": executorretry.Factor, "Jitter": executorretry.Jitter}).Info("Using executor retry strategy")
 	return WorkflowExecutor{
 		PodName:                      podName,
 		ClientSet:                    clientset,
-		RESTClient:                   restClient,
+		DynamicClient:                dynamicClient,
+		RESTMapper:                   restMapper,
 		Namespace:                    namespace,
 		RuntimeExecutor:              cre,
 		Template:                     template,
@@ -143,6 +154,7 @@ func (we *WorkflowExecutor) HandleError(ctx context.Context) {
 // LoadArtifacts loads artifacts from location to a container path
 func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 	log.Infof("Start loading input artifacts...")
+	var messages []string
 	for _, art := range we.Template.Inputs.Artifacts {
 
 		log.Infof("Downloading artifact: %s", art.Name)
@@ -193,6 +205,12 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			}
 			return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
 		}
+		if reporter, ok := artDriver.(artifactcommon.ArtifactLoadReporter); ok && reporter.LoadMessage() != "" {
+			messages = append(messages, fmt.Sprintf("%s: %s", art.Name, reporter.LoadMessage()))
+		}
+		if err := artifact.VerifyChecksum(&art, tempArtPath); err != nil {
+			return fmt.Errorf("artifact %s failed to verify: %w", art.Name, err)
+		}
 
 		isTar := false
 		isZip := false
@@ -207,7 +225,7 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			// explicitly a zip
 			isZip = true
 		} else {
-			// auto-detect if tarball
+			// auto-detect if tarball, compressed with gzip or zstd, or uncompressed
 			// (don't try to autodetect zip files for backwards compatibility)
 			isTar, err = isTarball(tempArtPath)
 			if err != nil {
@@ -236,6 +254,9 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			}
 		}
 	}
+	if len(messages) > 0 {
+		return we.AddAnnotation(ctx, common.AnnotationKeyMessage, strings.Join(messages, "; "))
+	}
 	return nil
 }
 
@@ -274,12 +295,16 @@ func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) error {
 		return argoerrs.InternalWrapError(err)
 	}
 
-	for i, art := range we.Template.Outputs.Artifacts {
-		err := we.saveArtifact(ctx, common.MainContainerName, &art)
+	return we.saveArtifacts(ctx, common.MainContainerName, we.Template.Outputs.Artifacts)
+}
+
+func (we *WorkflowExecutor) saveArtifacts(ctx context.Context, containerName string, artifacts wfv1.Artifacts) error {
+	for i, art := range artifacts {
+		err := we.saveArtifact(ctx, containerName, &art)
 		if err != nil {
 			return err
 		}
-		we.Template.Outputs.Artifacts[i] = art
+		artifacts[i] = art
 	}
 	return nil
 }
@@ -297,12 +322,16 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 		}
 		return err
 	}
+	if containerName != common.MainContainerName {
+		// the artifacts of different containers may have the same name
+		fileName = path.Join(containerName, fileName)
+	}
 	return we.saveArtifactFromFile(ctx, art, fileName, localArtPath)
 }
 
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
-	if !art.HasKey() {
+	if !art.HasLocationOrKey() {
 		key, err := we.Template.ArchiveLocation.GetKey()
 		if err != nil {
 			return err
@@ -326,10 +355,32 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	if err != nil {
 		return err
 	}
+	// the checksum is of the uploaded file, so that it can be verified when the artifact is loaded, before it is unarchived.
+	// A git artifact has none, as it is loaded as a clone of the whole repository, rather than of the committed files.
+	var checksum string
+	var size int64
+	if driverArt.Git == nil {
+		checksum, size, err = artifact.Checksum(localArtPath)
+		if err != nil {
+			return err
+		}
+	}
 	err = artDriver.Save(localArtPath, driverArt)
 	if err != nil {
 		return err
 	}
+	art.Checksum = checksum
+	art.SizeBytes = size
+	// record the encryption, with the ID of its key, so the artifact is decrypted when it is loaded
+	art.Encryption = driverArt.Encryption
+	if driverArt.Git != nil {
+		// the revision of a git artifact is the commit it was pushed in
+		art.Git = driverArt.Git
+	}
+	if driverArt.OCI != nil {
+		// the digest of an OCI artifact is the digest of its pushed manifest
+		art.OCI = driverArt.OCI
+	}
 	we.maybeDeleteLocalArtPath(localArtPath)
 	log.Infof("Successfully saved file: %s", localArtPath)
 	return nil
@@ -357,20 +408,18 @@ func (we *WorkflowExecutor) maybeDeleteLocalArtPath(localArtPath string) {
 func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Artifact) (string, string, error) {
 	log.Infof("Staging artifact: %s", art.Name)
 	strategy := art.Archive
-	if strategy == nil {
+	if strategy == nil && (art.Git != nil || art.OCI != nil) {
+		// the files of a git artifact are committed to the repository, and those of an OCI artifact are its layers
+		strategy = &wfv1.ArchiveStrategy{
+			None: &wfv1.NoneStrategy{},
+		}
+	} else if strategy == nil {
 		// If no strategy is specified, default to the tar strategy
 		strategy = &wfv1.ArchiveStrategy{
 			Tar: &wfv1.TarStrategy{},
 		}
 	}
-	compressionLevel := gzip.NoCompression
-	if strategy.Tar != nil {
-		if l := strategy.Tar.CompressionLevel; l != nil {
-			compressionLevel = int(*l)
-		} else {
-			compressionLevel = gzip.DefaultCompression
-		}
-	}
+	compressionLevel := archive.GzipLevel(strategy)
 
 	if !we.isBaseImagePath(art.Path) {
 		// If we get here, we are uploading an artifact from a mirrored volume mount which the wait
@@ -387,13 +436,20 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 			return fileName, mountedArtPath, nil
 		}
 		fileName := fmt.Sprintf("%s.tgz", art.Name)
+		if strategy.Tar != nil {
+			fileName = art.Name + archive.Extension(strategy.Tar)
+		}
 		localArtPath := filepath.Join(tempOutArtDir, fileName)
 		f, err := os.Create(localArtPath)
 		if err != nil {
 			return "", "", argoerrs.InternalWrapError(err)
 		}
 		w := bufio.NewWriter(f)
-		err = archive.TarGzToWriter(mountedArtPath, compressionLevel, w)
+		if strategy.Tar != nil {
+			err = archive.TarToWriter(mountedArtPath, strategy.Tar, w)
+		} else {
+			err = archive.TarGzToWriter(mountedArtPath, compressionLevel, w)
+		}
 		if err != nil {
 			return "", "", err
 		}
@@ -410,8 +466,13 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 		return "", "", err
 	}
 	if strategy.Tar != nil {
-		// NOTE we already tar gzip the file in the executor. So this is a noop.
-		return fileName, localArtPath, nil
+		if strategy.Tar.GetCompression() == wfv1.TarCompressionGzip {
+			// NOTE we already tar gzip the file in the executor. So this is a noop.
+			return fileName, localArtPath, nil
+		}
+		// the tarball was copied uncompressed, so it is compressed once, as the strategy specifies
+		log.Infof("Recompressing %s archive before upload", localArtPath)
+		return recompress(localArtPath, art.Name+archive.Extension(strategy.Tar), strategy.Tar)
 	}
 	// localArtPath now points to a .tgz file, and the archive strategy is *not* tar. We need to untar it
 	log.Infof("Untaring %s archive before upload", localArtPath)
@@ -447,6 +508,31 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 	return fileName, localArtPath, nil
 }
 
+// recompress recompresses a tar.gz as the strategy specifies to a file of a name, and deletes the tar.gz
+func recompress(tgzPath, fileName string, strategy *wfv1.TarStrategy) (string, string, error) {
+	src, err := os.Open(filepath.Clean(tgzPath))
+	if err != nil {
+		return "", "", argoerrs.InternalWrapError(err)
+	}
+	defer func() { _ = src.Close() }()
+	localArtPath := filepath.Join(filepath.Dir(tgzPath), fileName)
+	dst, err := os.Create(localArtPath)
+	if err != nil {
+		return "", "", argoerrs.InternalWrapError(err)
+	}
+	if err := archive.Recompress(src, strategy, dst); err != nil {
+		_ = dst.Close()
+		return "", "", err
+	}
+	if err := dst.Close(); err != nil {
+		return "", "", argoerrs.InternalWrapError(err)
+	}
+	if err := os.Remove(tgzPath); err != nil {
+		return "", "", argoerrs.InternalWrapError(err)
+	}
+	return fileName, localArtPath, nil
+}
+
 // isBaseImagePath checks if the given artifact path resides in the base image layer of the container
 // versus a shared volume mount between the wait and main container
 func (we *WorkflowExecutor) isBaseImagePath(path string) bool {
@@ -479,7 +565,11 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 		return nil
 	}
 	log.Infof("Saving output parameters")
-	for i, param := range we.Template.Outputs.Parameters {
+	return we.saveParameters(common.MainContainerName, we.Template.Outputs.Parameters)
+}
+
+func (we *WorkflowExecutor) saveParameters(containerName string, parameters []wfv1.Parameter) error {
+	for i, param := range parameters {
 		log.Infof("Saving path output parameter: %s", param.Name)
 		// Determine the file path of where to find the parameter
 		if param.ValueFrom == nil || param.ValueFrom.Path == "" {
@@ -495,7 +585,7 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 				continue
 			}
 			log.Infof("Copying %s from base image layer", param.ValueFrom.Path)
-			fileContents, err := we.RuntimeExecutor.GetFileContents(common.MainContainerName, param.ValueFrom.Path)
+			fileContents, err := we.RuntimeExecutor.GetFileContents(containerName, param.ValueFrom.Path)
 			if err != nil {
 				// We have a default value to use instead of returning an error
 				if param.ValueFrom.Default != nil {
@@ -524,12 +614,43 @@ func (we *WorkflowExecutor) SaveParameters(ctx context.Context) error {
 
 		// Trims off a single newline for user convenience
 		output = wfv1.AnyStringPtr(strings.TrimSuffix(output.String(), "\n"))
-		we.Template.Outputs.Parameters[i].Value = output
+		parameters[i].Value = output
 		log.Infof("Successfully saved output parameter: %s", param.Name)
 	}
 	return nil
 }
 
+// SaveContainerOutputs saves the outputs of the containers of a container set, other than the main container
+func (we *WorkflowExecutor) SaveContainerOutputs(ctx context.Context) error {
+	for _, node := range we.Template.ContainerSet.GetGraph() {
+		if node.Outputs == nil {
+			continue
+		}
+		if _, err := os.Stat(common.ContainerSkippedPath(node.Name)); err == nil {
+			log.Infof("Not saving outputs of skipped container %s", node.Name)
+			continue
+		}
+		log.Infof("Saving outputs of container %s", node.Name)
+		outputs := node.Outputs.DeepCopy()
+		if err := we.saveParameters(node.Name, outputs.Parameters); err != nil {
+			return fmt.Errorf("failed to save parameters of container %s: %w", node.Name, err)
+		}
+		if len(outputs.Artifacts) > 0 {
+			if err := os.MkdirAll(tempOutArtDir, os.ModePerm); err != nil {
+				return argoerrs.InternalWrapError(err)
+			}
+		}
+		if err := we.saveArtifacts(ctx, node.Name, outputs.Artifacts); err != nil {
+			return fmt.Errorf("failed to save artifacts of container %s: %w", node.Name, err)
+		}
+		if we.containerOutputs == nil {
+			we.containerOutputs = make(map[string]*wfv1.Outputs)
+		}
+		we.containerOutputs[node.Name] = outputs
+	}
+	return nil
+}
+
 // SaveLogs saves logs
 func (we *WorkflowExecutor) SaveLogs(ctx context.Context) (*wfv1.Artifact, error) {
 	if !we.Template.SaveLogsAsArtifact() {
@@ -728,6 +849,17 @@ func (we *WorkflowExecutor) AnnotateOutputs(ctx context.Context, logArt *wfv1.Ar
 		outputs.Artifacts = append(outputs.Artifacts, *logArt)
 	}
 
+	if len(we.containerOutputs) > 0 {
+		log.Infof("Annotating pod with container outputs")
+		containerOutputBytes, err := json.Marshal(we.containerOutputs)
+		if err != nil {
+			return argoerrs.InternalWrapError(err)
+		}
+		if err := we.AddAnnotation(ctx, common.AnnotationKeyContainerOutputs, string(containerOutputBytes)); err != nil {
+			return err
+		}
+	}
+
 	if !outputs.HasOutputs() {
 		return nil
 	}
@@ -770,22 +902,51 @@ func isTarball(filePath string) (bool, error) {
 			log.Fatalf("Error closing file[%s]: %v", filePath, err)
 		}
 	}()
-	gzr, err := gzip.NewReader(f)
+	info, err := f.Stat()
 	if err != nil {
+		return false, err
+	}
+	if info.IsDir() {
 		return false, nil
 	}
-	defer gzr.Close()
-	tarr := tar.NewReader(gzr)
-	_, err = tarr.Next()
-	return err == nil, nil
+	format, err := archive.DetectFormat(f)
+	if err != nil {
+		return false, err
+	}
+	return format == archive.FormatTarGzip || format == archive.FormatTarZstd || format == archive.FormatTar, nil
 }
 
 // untar extracts a tarball to a temporary directory,
 // renaming it to the desired location
 func untar(tarPath string, destPath string) error {
 	decompressor := func(src string, dest string) error {
-		_, err := common.RunCommand("tar", "-xf", src, "-C", dest)
-		return err
+		f, err := os.Open(filepath.Clean(src))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		format, err := archive.DetectFormat(f)
+		if err != nil {
+			return err
+		}
+		if format != archive.FormatTarZstd {
+			// tar detects gzip itself
+			_, err = common.RunCommand("tar", "-xf", src, "-C", dest)
+			return err
+		}
+		// tar may not support zstd, so it is decompressed here
+		zr, err := zstd.NewReader(f, zstd.WithDecoderConcurrency(runtime.GOMAXPROCS(0)))
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		cmd := exec.Command("tar", "-xf", "-", "-C", dest)
+		cmd.Stdin = zr
+		log.Info(strings.Join(cmd.Args, " "))
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return argoerrs.InternalErrorf("failed to untar %s: %s: %v", src, strings.TrimSpace(string(out)), err)
+		}
+		return nil
 	}
 
 	return unpack(tarPath, destPath, decompressor)
@@ -2,6 +2,12 @@ package executor
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -36,3 +42,63 @@ func (ep *executorDataSourceProcessor) ProcessArtifactPaths(artifacts *wfv1.Arti
 
 	return files, nil
 }
+
+func (ep *executorDataSourceProcessor) ProcessHTTP(source *wfv1.HTTPDataSource) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ep.ctx, http.MethodGet, source.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range source.Headers {
+		value := header.Value
+		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
+			secret, err := ep.we.GetSecrets(ep.ctx, ep.we.Namespace, header.ValueFrom.SecretKeyRef.Name, header.ValueFrom.SecretKeyRef.Key)
+			if err != nil {
+				return nil, err
+			}
+			value = string(secret)
+		}
+		request.Header.Add(header.Name, value)
+	}
+	timeout := 30 * time.Second
+	if source.TimeoutSeconds != nil {
+		timeout = time.Duration(*source.TimeoutSeconds) * time.Second
+	}
+	response, err := (&http.Client{Timeout: timeout}).Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s returned %s", source.URL, response.Status)
+	}
+	return ioutil.ReadAll(response.Body)
+}
+
+func (ep *executorDataSourceProcessor) ProcessConfigMap(source *wfv1.ConfigMapDataSource) ([]byte, error) {
+	value, err := ep.we.GetConfigMapKey(ep.ctx, source.Name, source.Key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(value), nil
+}
+
+func (ep *executorDataSourceProcessor) ProcessArtifact(source *wfv1.ArtifactDataSource) ([]byte, error) {
+	driverArt, err := ep.we.newDriverArt(&source.Artifact)
+	if err != nil {
+		return nil, err
+	}
+	artDriver, err := ep.we.InitDriver(ep.ctx, driverArt)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir("", "data")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	path := filepath.Join(dir, "artifact")
+	if err := artDriver.Load(driverArt, path); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(filepath.Clean(path))
+}
@@ -0,0 +1,102 @@
+package executor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var _ wfv1.DataSourceProcessor = &executorDataSourceProcessor{}
+
+func newTestDataSourceProcessor() *executorDataSourceProcessor {
+	we := &WorkflowExecutor{
+		Namespace: fakeNamespace,
+		ClientSet: fake.NewSimpleClientset(
+			&apiv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cm", Namespace: fakeNamespace},
+				Data:       map[string]string{"items": "name,size\nfoo,1\n"},
+			},
+			&apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: fakeNamespace},
+				Data:       map[string][]byte{"token": []byte("Bearer my-token")},
+			},
+		),
+		memoizedConfigMaps: map[string]string{},
+		memoizedSecrets:    map[string][]byte{},
+	}
+	return newExecutorDataSourceProcessor(context.Background(), we)
+}
+
+func TestProcessArtifactPaths(t *testing.T) {
+	ep := newTestDataSourceProcessor()
+	_, err := ep.ProcessArtifactPaths(&wfv1.ArtifactPaths{Artifact: wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "foo"}}}})
+	assert.Error(t, err)
+}
+
+func TestProcessHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/items":
+			if r.Header.Get("Authorization") != "Bearer my-token" || r.Header.Get("Accept") != "application/json" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`[{"name": "foo"}]`))
+		case "/slow":
+			<-r.Context().Done()
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	ep := newTestDataSourceProcessor()
+	headers := wfv1.HTTPHeaders{
+		{Name: "Accept", Value: "application/json"},
+		{Name: "Authorization", ValueFrom: &wfv1.HTTPHeaderSource{SecretKeyRef: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: "token"}}},
+	}
+
+	data, err := ep.ProcessHTTP(&wfv1.HTTPDataSource{URL: server.URL + "/items", Headers: headers})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `[{"name": "foo"}]`, string(data))
+	}
+
+	_, err = ep.ProcessHTTP(&wfv1.HTTPDataSource{URL: server.URL + "/items"})
+	assert.EqualError(t, err, server.URL+"/items returned 401 Unauthorized")
+
+	_, err = ep.ProcessHTTP(&wfv1.HTTPDataSource{URL: server.URL + "/missing"})
+	assert.EqualError(t, err, server.URL+"/missing returned 404 Not Found")
+
+	one := int64(1)
+	_, err = ep.ProcessHTTP(&wfv1.HTTPDataSource{URL: server.URL + "/slow", TimeoutSeconds: &one})
+	assert.Error(t, err)
+}
+
+func TestProcessConfigMap(t *testing.T) {
+	ep := newTestDataSourceProcessor()
+	data, err := ep.ProcessConfigMap(&wfv1.ConfigMapDataSource{ConfigMapKeySelector: apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-cm"}, Key: "items"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "name,size\nfoo,1\n", string(data))
+	}
+
+	_, err = ep.ProcessConfigMap(&wfv1.ConfigMapDataSource{ConfigMapKeySelector: apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-cm"}, Key: "missing"}})
+	assert.EqualError(t, err, "configmap 'my-cm' does not have the key 'missing'")
+}
+
+func TestProcessArtifact(t *testing.T) {
+	ep := newTestDataSourceProcessor()
+	data, err := ep.ProcessArtifact(&wfv1.ArtifactDataSource{Artifact: wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: `{"name": "foo"}`}}}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, `{"name": "foo"}`, string(data))
+	}
+
+	_, err = ep.ProcessArtifact(&wfv1.ArtifactDataSource{Artifact: wfv1.Artifact{Name: "my-art"}})
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
 )
@@ -221,7 +223,7 @@ func TestIsTarball(t *testing.T) {
 	}{
 		{"testdata/file", false, false},
 		{"testdata/file.zip", false, false},
-		{"testdata/file.tar", false, false},
+		{"testdata/file.tar", true, false},
 		{"testdata/file.gz", false, false},
 		{"testdata/file.tar.gz", true, false},
 		{"testdata/file.tgz", true, false},
@@ -239,6 +241,20 @@ func TestIsTarball(t *testing.T) {
 	}
 }
 
+func TestIsTarballZstd(t *testing.T) {
+	tarPath := filepath.Join(t.TempDir(), "file.tar.zst")
+	f, err := os.Create(tarPath)
+	assert.NoError(t, err)
+	assert.NoError(t, archive.TarToWriter("testdata/file", &wfv1.TarStrategy{Compression: wfv1.TarCompressionZstd}, f))
+	assert.NoError(t, f.Close())
+	ok, err := isTarball(tarPath)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = isTarball("testdata")
+	assert.NoError(t, err)
+	assert.False(t, ok, "a directory is not a tarball")
+}
+
 func TestUnzip(t *testing.T) {
 	zipPath := "testdata/file.zip"
 	destPath := "testdata/unzippedFile"
@@ -275,6 +291,25 @@ func TestUntar(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUntarZstd(t *testing.T) {
+	dir := t.TempDir()
+	tarPath := filepath.Join(dir, "file.tar.zst")
+	f, err := os.Create(tarPath)
+	assert.NoError(t, err)
+	assert.NoError(t, archive.TarToWriter("testdata/file.gz", &wfv1.TarStrategy{Compression: wfv1.TarCompressionZstd}, f))
+	assert.NoError(t, f.Close())
+	destPath := filepath.Join(dir, "untarredFile")
+
+	err = untar(tarPath, destPath)
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadFile(destPath)
+	assert.NoError(t, err)
+	expected, err := ioutil.ReadFile("testdata/file.gz")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, data)
+}
+
 func TestChmod(t *testing.T) {
 	type perm struct {
 		dir  string
@@ -412,7 +447,7 @@ func TestMonitorProgress(t *testing.T) {
 	progressFile := f.Name()
 
 	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
-	we := NewExecutor(fakeClientset, nil, fakePodName, fakeNamespace, &mockRuntimeExecutor, wfv1.Template{}, false, deadline, annotationPackTickDuration, readProgressFileTickDuration)
+	we := NewExecutor(fakeClientset, nil, nil, fakePodName, fakeNamespace, &mockRuntimeExecutor, wfv1.Template{}, false, deadline, annotationPackTickDuration, readProgressFileTickDuration)
 
 	go we.monitorProgress(ctx, progressFile)
 
@@ -2,25 +2,66 @@ package rpc
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	executorplugins "github.com/argoproj/argo-workflows/v3/pkg/plugins/executor"
+	"github.com/argoproj/argo-workflows/v3/pkg/plugins/spec"
 	rpc "github.com/argoproj/argo-workflows/v3/workflow/util/plugins"
 )
 
+var backoff = wait.Backoff{
+	Duration: time.Second,
+	Jitter:   0.2,
+	Factor:   2,
+	Steps:    5,
+}
+
 type plugin struct{ rpc.Client }
 
 func New(address string) *plugin {
-	return &plugin{Client: rpc.New(address, 30*time.Second, wait.Backoff{
-		Duration: time.Second,
-		Jitter:   0.2,
-		Factor:   2,
-		Steps:    5,
-	})}
+	return &plugin{Client: rpc.New(address, 30*time.Second, backoff)}
 }
 
 func (p *plugin) ExecuteTemplate(ctx context.Context, args executorplugins.ExecuteTemplateArgs, reply *executorplugins.ExecuteTemplateReply) error {
 	return p.Call(ctx, "template.execute", args, reply)
 }
+
+// service is a plugin that runs as a shared service, rather than as a sidecar
+type service struct {
+	plugin
+	healthCheck *spec.HealthCheck
+	mu          sync.Mutex
+	checkedAt   time.Time
+	unhealthy   error
+}
+
+func NewService(s spec.Service, token string) *service {
+	p := &service{plugin: plugin{Client: rpc.New(s.Address, s.GetTimeout(), backoff)}, healthCheck: s.HealthCheck}
+	p.Authorize(token)
+	p.Limit(s.Parallelism)
+	return p
+}
+
+func (p *service) ExecuteTemplate(ctx context.Context, args executorplugins.ExecuteTemplateArgs, reply *executorplugins.ExecuteTemplateReply) error {
+	if err := p.checkHealth(ctx); err != nil {
+		return fmt.Errorf("plugin service %s is unhealthy: %w", p.Address, err)
+	}
+	return p.plugin.ExecuteTemplate(ctx, args, reply)
+}
+
+func (p *service) checkHealth(ctx context.Context) error {
+	if p.healthCheck == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if time.Since(p.checkedAt) > p.healthCheck.GetPeriod() {
+		p.unhealthy = p.Healthy(ctx, p.healthCheck.GetPath())
+		p.checkedAt = time.Now()
+	}
+	return p.unhealthy
+}
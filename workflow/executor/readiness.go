@@ -0,0 +1,163 @@
+package executor
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+)
+
+type readinessStatus int
+
+const (
+	readinessInProgress readinessStatus = iota
+	readinessCurrent
+	readinessFailed
+)
+
+// checkReadiness checks whether the resource is ready.
+// The returning boolean indicates whether we should retry.
+func checkReadiness(obj *unstructured.Unstructured) (bool, error) {
+	status, message := readiness(obj)
+	switch status {
+	case readinessCurrent:
+		return false, nil
+	case readinessFailed:
+		return false, errors.Errorf(errors.CodeBadRequest, "resource %s failed: %s", resourceFullName(obj), message)
+	default:
+		return true, errors.Errorf(errors.CodeNotFound, "resource %s is not ready: %s", resourceFullName(obj), message)
+	}
+}
+
+// readiness computes the readiness of a resource from its standard status fields and conditions, similar to kstatus.
+// Resources without a status are ready.
+func readiness(obj *unstructured.Unstructured) (readinessStatus, string) {
+	if obj.GetDeletionTimestamp() != nil {
+		return readinessInProgress, "resource is being deleted"
+	}
+	if observedGeneration, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); found && observedGeneration < obj.GetGeneration() {
+		return readinessInProgress, fmt.Sprintf("observed generation %d is behind generation %d", observedGeneration, obj.GetGeneration())
+	}
+	switch obj.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Kind: "Pod"}:
+		return podReadiness(obj)
+	case schema.GroupKind{Kind: "PersistentVolumeClaim"}:
+		if phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase"); phase != "Bound" {
+			return readinessInProgress, fmt.Sprintf("claim is %s", phase)
+		}
+		return readinessCurrent, ""
+	case schema.GroupKind{Kind: "Service"}:
+		serviceType, _, _ := unstructured.NestedString(obj.Object, "spec", "type")
+		ingress, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+		if serviceType == "LoadBalancer" && len(ingress) == 0 {
+			return readinessInProgress, "load balancer has no ingress"
+		}
+		return readinessCurrent, ""
+	case schema.GroupKind{Group: "batch", Kind: "Job"}:
+		return jobReadiness(obj)
+	case schema.GroupKind{Group: "apps", Kind: "Deployment"}:
+		return deploymentReadiness(obj)
+	case schema.GroupKind{Group: "apps", Kind: "StatefulSet"}:
+		replicas := specReplicas(obj)
+		if n := statusInt(obj, "readyReplicas"); n < replicas {
+			return readinessInProgress, fmt.Sprintf("%d of %d replicas ready", n, replicas)
+		}
+		if n := statusInt(obj, "updatedReplicas"); n < replicas {
+			return readinessInProgress, fmt.Sprintf("%d of %d replicas updated", n, replicas)
+		}
+		return readinessCurrent, ""
+	case schema.GroupKind{Group: "apps", Kind: "DaemonSet"}:
+		desired := statusInt(obj, "desiredNumberScheduled")
+		if n := statusInt(obj, "updatedNumberScheduled"); n < desired {
+			return readinessInProgress, fmt.Sprintf("%d of %d pods updated", n, desired)
+		}
+		if n := statusInt(obj, "numberAvailable"); n < desired {
+			return readinessInProgress, fmt.Sprintf("%d of %d pods available", n, desired)
+		}
+		return readinessCurrent, ""
+	}
+	return conditionsReadiness(obj)
+}
+
+func podReadiness(obj *unstructured.Unstructured) (readinessStatus, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return readinessCurrent, ""
+	case "Failed":
+		message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+		return readinessFailed, fmt.Sprintf("pod failed: %s", message)
+	case "Running":
+		if c := getCondition(obj, "Ready"); c != nil && c["status"] == "True" {
+			return readinessCurrent, ""
+		}
+	}
+	return readinessInProgress, fmt.Sprintf("pod is %s", phase)
+}
+
+func jobReadiness(obj *unstructured.Unstructured) (readinessStatus, string) {
+	if c := getCondition(obj, "Failed"); c != nil && c["status"] == "True" {
+		return readinessFailed, fmt.Sprintf("job failed: %v", c["message"])
+	}
+	if c := getCondition(obj, "Complete"); c != nil && c["status"] == "True" {
+		return readinessCurrent, ""
+	}
+	return readinessInProgress, "job has not completed"
+}
+
+func deploymentReadiness(obj *unstructured.Unstructured) (readinessStatus, string) {
+	if c := getCondition(obj, "Progressing"); c != nil && c["reason"] == "ProgressDeadlineExceeded" {
+		return readinessFailed, fmt.Sprintf("deployment exceeded its progress deadline: %v", c["message"])
+	}
+	replicas := specReplicas(obj)
+	updated := statusInt(obj, "updatedReplicas")
+	if updated < replicas {
+		return readinessInProgress, fmt.Sprintf("%d of %d replicas updated", updated, replicas)
+	}
+	if n := statusInt(obj, "replicas"); n > updated {
+		return readinessInProgress, fmt.Sprintf("%d old replicas pending termination", n-updated)
+	}
+	if n := statusInt(obj, "availableReplicas"); n < replicas {
+		return readinessInProgress, fmt.Sprintf("%d of %d replicas available", n, replicas)
+	}
+	return readinessCurrent, ""
+}
+
+// conditionsReadiness computes the readiness of any resource from the standard "Stalled", "Reconciling" and "Ready"
+// conditions.
+func conditionsReadiness(obj *unstructured.Unstructured) (readinessStatus, string) {
+	if c := getCondition(obj, "Stalled"); c != nil && c["status"] == "True" {
+		return readinessFailed, fmt.Sprintf("resource is stalled: %v", c["message"])
+	}
+	if c := getCondition(obj, "Reconciling"); c != nil && c["status"] == "True" {
+		return readinessInProgress, fmt.Sprintf("resource is reconciling: %v", c["message"])
+	}
+	if c := getCondition(obj, "Ready"); c != nil && c["status"] != "True" {
+		return readinessInProgress, fmt.Sprintf("resource is not ready: %v", c["message"])
+	}
+	return readinessCurrent, ""
+}
+
+func getCondition(obj *unstructured.Unstructured, conditionType string) map[string]interface{} {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		if c, ok := item.(map[string]interface{}); ok && c["type"] == conditionType {
+			return c
+		}
+	}
+	return nil
+}
+
+func specReplicas(obj *unstructured.Unstructured) int64 {
+	if replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found {
+		return replicas
+	}
+	return 1
+}
+
+func statusInt(obj *unstructured.Unstructured, field string) int64 {
+	n, _, _ := unstructured.NestedInt64(obj.Object, "status", field)
+	return n
+}
@@ -0,0 +1,47 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestReadiness(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		obj     string
+		want    readinessStatus
+		message string
+	}{
+		{"NoStatus", `{"apiVersion": "v1", "kind": "ConfigMap"}`, readinessCurrent, ""},
+		{"ObservedGeneration", `{"apiVersion": "example.com/v1", "kind": "Widget", "metadata": {"generation": 2}, "status": {"observedGeneration": 1}}`, readinessInProgress, "observed generation 1 is behind generation 2"},
+		{"Ready", `{"apiVersion": "example.com/v1", "kind": "Widget", "status": {"conditions": [{"type": "Ready", "status": "True"}]}}`, readinessCurrent, ""},
+		{"NotReady", `{"apiVersion": "example.com/v1", "kind": "Widget", "status": {"conditions": [{"type": "Ready", "status": "False", "message": "waiting"}]}}`, readinessInProgress, "resource is not ready: waiting"},
+		{"Reconciling", `{"apiVersion": "example.com/v1", "kind": "Widget", "status": {"conditions": [{"type": "Reconciling", "status": "True", "message": "busy"}]}}`, readinessInProgress, "resource is reconciling: busy"},
+		{"Stalled", `{"apiVersion": "example.com/v1", "kind": "Widget", "status": {"conditions": [{"type": "Stalled", "status": "True", "message": "oops"}]}}`, readinessFailed, "resource is stalled: oops"},
+		{"PodRunning", `{"apiVersion": "v1", "kind": "Pod", "status": {"phase": "Running", "conditions": [{"type": "Ready", "status": "True"}]}}`, readinessCurrent, ""},
+		{"PodPending", `{"apiVersion": "v1", "kind": "Pod", "status": {"phase": "Pending"}}`, readinessInProgress, "pod is Pending"},
+		{"PodFailed", `{"apiVersion": "v1", "kind": "Pod", "status": {"phase": "Failed", "message": "oops"}}`, readinessFailed, "pod failed: oops"},
+		{"JobComplete", `{"apiVersion": "batch/v1", "kind": "Job", "status": {"conditions": [{"type": "Complete", "status": "True"}]}}`, readinessCurrent, ""},
+		{"JobFailed", `{"apiVersion": "batch/v1", "kind": "Job", "status": {"conditions": [{"type": "Failed", "status": "True", "message": "oops"}]}}`, readinessFailed, "job failed: oops"},
+		{"JobRunning", `{"apiVersion": "batch/v1", "kind": "Job", "status": {"active": 1}}`, readinessInProgress, "job has not completed"},
+		{"DeploymentAvailable", `{"apiVersion": "apps/v1", "kind": "Deployment", "spec": {"replicas": 2}, "status": {"replicas": 2, "updatedReplicas": 2, "availableReplicas": 2}}`, readinessCurrent, ""},
+		{"DeploymentRollingOut", `{"apiVersion": "apps/v1", "kind": "Deployment", "spec": {"replicas": 2}, "status": {"replicas": 3, "updatedReplicas": 2, "availableReplicas": 2}}`, readinessInProgress, "1 old replicas pending termination"},
+		{"DeploymentUnavailable", `{"apiVersion": "apps/v1", "kind": "Deployment", "status": {"replicas": 1, "updatedReplicas": 1}}`, readinessInProgress, "0 of 1 replicas available"},
+		{"DeploymentDeadlineExceeded", `{"apiVersion": "apps/v1", "kind": "Deployment", "status": {"conditions": [{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded", "message": "oops"}]}}`, readinessFailed, "deployment exceeded its progress deadline: oops"},
+		{"StatefulSetNotReady", `{"apiVersion": "apps/v1", "kind": "StatefulSet", "spec": {"replicas": 3}, "status": {"readyReplicas": 2}}`, readinessInProgress, "2 of 3 replicas ready"},
+		{"DaemonSetAvailable", `{"apiVersion": "apps/v1", "kind": "DaemonSet", "status": {"desiredNumberScheduled": 2, "updatedNumberScheduled": 2, "numberAvailable": 2}}`, readinessCurrent, ""},
+		{"ClaimPending", `{"apiVersion": "v1", "kind": "PersistentVolumeClaim", "status": {"phase": "Pending"}}`, readinessInProgress, "claim is Pending"},
+		{"LoadBalancerPending", `{"apiVersion": "v1", "kind": "Service", "spec": {"type": "LoadBalancer"}}`, readinessInProgress, "load balancer has no ingress"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{}
+			if assert.NoError(t, obj.UnmarshalJSON([]byte(tt.obj))) {
+				status, message := readiness(obj)
+				assert.Equal(t, tt.want, status)
+				assert.Equal(t, tt.message, message)
+			}
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package executor
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,132 +16,449 @@ import (
 	"github.com/tidwall/gjson"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	envutil "github.com/argoproj/argo-workflows/v3/util/env"
 	argoerr "github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/k8s"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
-// ExecResource will run kubectl action against a manifest
-func (we *WorkflowExecutor) ExecResource(action string, manifestPath string, flags []string) (string, string, string, error) {
-	args, err := we.getKubectlArguments(action, manifestPath, flags)
-	if err != nil {
-		return "", "", "", err
-	}
+// resourceRetry is the backoff of the requests of resource templates, about 30s in total, which is long enough for the
+// custom resource definition of a kind created earlier in the manifest to become established
+var resourceRetry = wait.Backoff{Duration: time.Second, Factor: 2, Jitter: 0.1, Steps: 6}
 
-	var out []byte
-	err = retry.OnError(retry.DefaultBackoff, argoerr.IsTransientErr, func() error {
-		cmd := exec.Command("kubectl", args...)
-		log.Info(strings.Join(cmd.Args, " "))
+// resourceFlags are the kubectl-style flags supported by resource templates
+type resourceFlags struct {
+	// resource is the type of the resources named by the flags, e.g. "pod" or "deployment.apps"
+	resource       string
+	names          []string
+	selector       string
+	namespace      string
+	ignoreNotFound bool
+	forceConflicts bool
+}
 
-		out, err = cmd.Output()
-		return err
-	})
-	if err != nil {
-		if exErr, ok := err.(*exec.ExitError); ok {
-			errMsg := strings.TrimSpace(string(exErr.Stderr))
-			err = errors.Wrap(err, errors.CodeBadRequest, errMsg)
-		} else {
-			err = errors.Wrap(err, errors.CodeBadRequest, err.Error())
+// targeted returns whether the flags identify the resources to act upon, rather than the manifest
+func (f resourceFlags) targeted() bool {
+	return f.resource != ""
+}
+
+func parseResourceFlags(flags []string) (resourceFlags, error) {
+	f := resourceFlags{}
+	for i := 0; i < len(flags); i++ {
+		flag := flags[i]
+		if !strings.HasPrefix(flag, "-") {
+			resource, name := flag, ""
+			if parts := strings.SplitN(flag, "/", 2); len(parts) == 2 {
+				resource, name = parts[0], parts[1]
+			} else if f.resource != "" {
+				resource, name = f.resource, flag
+			}
+			if f.resource != "" && f.resource != resource {
+				return f, errors.Errorf(errors.CodeBadRequest, "resources of more than one type are not supported: %s and %s", f.resource, resource)
+			}
+			f.resource = resource
+			if name != "" {
+				f.names = append(f.names, name)
+			}
+			continue
+		}
+		name, value, hasValue := flag, "", false
+		if parts := strings.SplitN(flag, "=", 2); len(parts) == 2 {
+			name, value, hasValue = parts[0], parts[1], true
+		}
+		switch name {
+		case "-l", "--selector", "-n", "--namespace":
+			if !hasValue {
+				if i+1 >= len(flags) {
+					return f, errors.Errorf(errors.CodeBadRequest, "flag %s needs an argument", name)
+				}
+				i++
+				value = flags[i]
+			}
+			if name == "-l" || name == "--selector" {
+				f.selector = value
+			} else {
+				f.namespace = value
+			}
+		case "--ignore-not-found", "--force-conflicts", "--server-side", "--validate":
+			enabled := true
+			if hasValue {
+				var err error
+				enabled, err = strconv.ParseBool(value)
+				if err != nil {
+					return f, errors.Errorf(errors.CodeBadRequest, "flag %s has an invalid value %q", name, value)
+				}
+			}
+			switch name {
+			case "--ignore-not-found":
+				f.ignoreNotFound = enabled
+			case "--force-conflicts":
+				f.forceConflicts = enabled
+			default:
+				log.Warnf("Ignoring flag %s, resources are always applied and validated by the server", flag)
+			}
+		default:
+			return f, errors.Errorf(errors.CodeBadRequest, "unsupported flag %s", flag)
 		}
-		err = errors.Wrap(err, errors.CodeBadRequest, "no more retries "+err.Error())
-		return "", "", "", err
 	}
-	if action == "delete" {
-		return "", "", "", nil
+	if f.resource != "" && len(f.names) == 0 && f.selector == "" {
+		return f, errors.Errorf(errors.CodeBadRequest, "resource type %s requires a name or a selector", f.resource)
+	}
+	return f, nil
+}
+
+// ExecResource will perform the action against the objects of the manifest, or the resources identified by the flags.
+// It returns the resulting objects, in order.
+func (we *WorkflowExecutor) ExecResource(ctx context.Context, action string, manifestPath string, flags []string) ([]*unstructured.Unstructured, error) {
+	buff, err := ioutil.ReadFile(filepath.Clean(manifestPath))
+	if err != nil {
+		return nil, errors.New(errors.CodeBadRequest, err.Error())
 	}
-	if action == "get" && len(out) == 0 {
-		return "", "", "", nil
+	if len(bytes.TrimSpace(buff)) == 0 && len(flags) == 0 {
+		return nil, errors.New(errors.CodeBadRequest, "Must provide at least one of flags or manifest.")
 	}
-	obj := unstructured.Unstructured{}
-	err = json.Unmarshal(out, &obj)
+	f, err := parseResourceFlags(flags)
 	if err != nil {
-		return "", "", "", err
-	}
-	resourceGroup := obj.GroupVersionKind().Group
-	resourceName := obj.GetName()
-	resourceKind := obj.GroupVersionKind().Kind
-	if resourceName == "" || resourceKind == "" {
-		return "", "", "", errors.New(errors.CodeBadRequest, "Kind and name are both required but at least one of them is missing from the manifest")
-	}
-	resourceFullName := fmt.Sprintf("%s.%s/%s", strings.ToLower(resourceKind), resourceGroup, resourceName)
-	selfLink := inferObjectSelfLink(obj)
-	log.Infof("Resource: %s/%s. SelfLink: %s", obj.GetNamespace(), resourceFullName, selfLink)
-	return obj.GetNamespace(), resourceFullName, selfLink, nil
-}
+		return nil, err
+	}
 
-func inferObjectSelfLink(obj unstructured.Unstructured) string {
-	gvk := obj.GroupVersionKind()
-	// This is the best guess we can do here and is what `kubectl` uses under the hood. Hopefully future versions of the
-	// REST client would remove the need to infer the plural name.
-	pluralGVR, _ := meta.UnsafeGuessKindToResource(gvk)
-	var selfLinkPrefix string
-	if gvk.Group == "" {
-		selfLinkPrefix = "api"
-	} else {
-		selfLinkPrefix = "apis"
+	switch action {
+	case "get", "delete":
+		var objs []*unstructured.Unstructured
+		if f.targeted() {
+			objs, err = we.listTargetedResources(ctx, f)
+		} else {
+			objs, err = k8s.ParseObjects(buff)
+		}
+		if err != nil {
+			return nil, errors.New(errors.CodeBadRequest, err.Error())
+		}
+		if action == "delete" {
+			return nil, we.deleteResources(ctx, objs, f)
+		}
+		return we.getResources(ctx, objs, f)
+	case "patch":
+		return we.patchResources(ctx, buff, f)
+	case "create", "apply", "replace":
+		if f.targeted() {
+			return nil, errors.Errorf(errors.CodeBadRequest, "the %s action does not support resource types or names as flags", action)
+		}
+		objs, err := k8s.ParseObjects(buff)
+		if err != nil {
+			return nil, errors.New(errors.CodeBadRequest, err.Error())
+		}
+		var results []*unstructured.Unstructured
+		for _, obj := range objs {
+			result, err := we.execResourceObject(ctx, action, obj, f)
+			if err != nil {
+				// the objects acted upon so far are returned, so that they can be cleaned up
+				return results, err
+			}
+			results = append(results, result)
+		}
+		return results, nil
+	default:
+		return nil, errors.Errorf(errors.CodeBadRequest, "unsupported action %s", action)
 	}
-	// We cannot use `obj.GetSelfLink()` directly since it is deprecated and will be removed after Kubernetes 1.21: https://github.com/kubernetes/enhancements/tree/master/keps/sig-api-machinery/1164-remove-selflink
-	return fmt.Sprintf("%s/%s/namespaces/%s/%s/%s",
-		selfLinkPrefix, obj.GetAPIVersion(), obj.GetNamespace(), pluralGVR.Resource, obj.GetName())
 }
 
-func (we *WorkflowExecutor) getKubectlArguments(action string, manifestPath string, flags []string) ([]string, error) {
-	buff, err := ioutil.ReadFile(filepath.Clean(manifestPath))
+func (we *WorkflowExecutor) execResourceObject(ctx context.Context, action string, obj *unstructured.Unstructured, f resourceFlags) (*unstructured.Unstructured, error) {
+	fieldManager := we.Template.Resource.GetFieldManager()
+	var result *unstructured.Unstructured
+	err := we.withResourceClient(ctx, action, obj, f, func(client dynamic.ResourceInterface) error {
+		var err error
+		switch action {
+		case "create":
+			result, err = client.Create(ctx, obj, metav1.CreateOptions{FieldManager: fieldManager})
+			if err == nil {
+				we.createdResources = append(we.createdResources, result)
+			}
+		case "replace":
+			result, err = client.Update(ctx, obj, metav1.UpdateOptions{FieldManager: fieldManager})
+		case "apply":
+			if obj.GetName() == "" {
+				return errors.New(errors.CodeBadRequest, "metadata.name is required to apply a resource")
+			}
+			data, err := obj.MarshalJSON()
+			if err != nil {
+				return err
+			}
+			// only a resource that did not exist is created by applying it
+			_, err = client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+			created := apierr.IsNotFound(err)
+			if err != nil && !created {
+				return err
+			}
+			result, err = client.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{FieldManager: fieldManager, Force: &f.forceConflicts})
+			if err == nil && created {
+				we.createdResources = append(we.createdResources, result)
+			}
+			return err
+		}
+		return err
+	})
+	return result, err
+}
+
+// listTargetedResources returns the objects identified by the flags. Only their identity is populated.
+func (we *WorkflowExecutor) listTargetedResources(ctx context.Context, f resourceFlags) ([]*unstructured.Unstructured, error) {
+	gvk, err := we.kindFor(f.resource)
 	if err != nil {
-		return []string{}, errors.New(errors.CodeBadRequest, err.Error())
+		return nil, err
 	}
-	if len(buff) == 0 && len(flags) == 0 {
-		return []string{}, errors.New(errors.CodeBadRequest, "Must provide at least one of flags or manifest.")
+	var objs []*unstructured.Unstructured
+	for _, name := range f.names {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetNamespace(f.namespace)
+		obj.SetName(name)
+		objs = append(objs, obj)
+	}
+	if f.selector != "" {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetNamespace(f.namespace)
+		err = we.withResourceClient(ctx, "list", obj, f, func(client dynamic.ResourceInterface) error {
+			list, err := client.List(ctx, metav1.ListOptions{LabelSelector: f.selector})
+			if err != nil {
+				return err
+			}
+			for i := range list.Items {
+				item := &list.Items[i]
+				item.SetGroupVersionKind(gvk)
+				objs = append(objs, item)
+			}
+			return nil
+		})
 	}
+	return objs, err
+}
 
-	args := []string{
-		action,
+func (we *WorkflowExecutor) getResources(ctx context.Context, objs []*unstructured.Unstructured, f resourceFlags) ([]*unstructured.Unstructured, error) {
+	var results []*unstructured.Unstructured
+	for _, obj := range objs {
+		result, err := we.getResource(ctx, obj)
+		if apierr.IsNotFound(errors.Cause(err)) && f.ignoreNotFound {
+			log.Infof("Ignoring %s, it was not found", resourceFullName(obj))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
 	}
-	output := "json"
+	return results, nil
+}
+
+// getResource returns the latest version of the object
+func (we *WorkflowExecutor) getResource(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	var result *unstructured.Unstructured
+	err := we.withResourceClient(ctx, "get", obj, resourceFlags{}, func(client dynamic.ResourceInterface) error {
+		var err error
+		result, err = client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		return err
+	})
+	return result, err
+}
 
-	if action == "delete" {
-		args = append(args, "--ignore-not-found")
-		output = "name"
+func (we *WorkflowExecutor) deleteResources(ctx context.Context, objs []*unstructured.Unstructured, f resourceFlags) error {
+	propagationPolicy := metav1.DeletePropagationBackground
+	for _, obj := range objs {
+		err := we.withResourceClient(ctx, "delete", obj, f, func(client dynamic.ResourceInterface) error {
+			return client.Delete(ctx, obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+		})
+		// deleting a resource that does not exist is not an error
+		if apierr.IsNotFound(errors.Cause(err)) {
+			log.Infof("Resource %s was not found", resourceFullName(obj))
+			continue
+		}
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	appendFileFlag := true
-	if action == "patch" {
-		mergeStrategy := "strategic"
-		if we.Template.Resource.MergeStrategy != "" {
-			mergeStrategy = we.Template.Resource.MergeStrategy
-			if mergeStrategy == "json" {
-				// Action "patch" require flag "-p" with resource arguments.
-				// But kubectl disallow specify both "-f" flag and resource arguments.
-				// Flag "-f" should be excluded for action "patch" here if it's a json patch.
-				appendFileFlag = false
+// patchResources patches the resources identified by the flags with the manifest, or, for strategic and merge
+// patches, patches each of the objects of the manifest with itself.
+func (we *WorkflowExecutor) patchResources(ctx context.Context, buff []byte, f resourceFlags) ([]*unstructured.Unstructured, error) {
+	patchType := types.StrategicMergePatchType
+	switch we.Template.Resource.MergeStrategy {
+	case "", "strategic":
+	case "merge":
+		patchType = types.MergePatchType
+	case "json":
+		patchType = types.JSONPatchType
+	default:
+		return nil, errors.Errorf(errors.CodeBadRequest, "unsupported merge strategy %s", we.Template.Resource.MergeStrategy)
+	}
+	type patch struct {
+		obj  *unstructured.Unstructured
+		data []byte
+	}
+	var patches []patch
+	if f.targeted() {
+		data, err := yaml.YAMLToJSON(buff)
+		if err != nil {
+			return nil, errors.New(errors.CodeBadRequest, err.Error())
+		}
+		objs, err := we.listTargetedResources(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			patches = append(patches, patch{obj, data})
+		}
+	} else {
+		if patchType == types.JSONPatchType {
+			return nil, errors.New(errors.CodeBadRequest, "json patches require the resource type and name as flags")
+		}
+		objs, err := k8s.ParseObjects(buff)
+		if err != nil {
+			return nil, errors.New(errors.CodeBadRequest, err.Error())
+		}
+		for _, obj := range objs {
+			data, err := obj.MarshalJSON()
+			if err != nil {
+				return nil, err
 			}
+			patches = append(patches, patch{obj, data})
 		}
+	}
+	var results []*unstructured.Unstructured
+	for _, p := range patches {
+		var result *unstructured.Unstructured
+		err := we.withResourceClient(ctx, "patch", p.obj, f, func(client dynamic.ResourceInterface) error {
+			var err error
+			result, err = client.Patch(ctx, p.obj.GetName(), patchType, p.data, metav1.PatchOptions{FieldManager: we.Template.Resource.GetFieldManager()})
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
 
-		args = append(args, "--type")
-		args = append(args, mergeStrategy)
+// kindFor returns the kind of a resource type, e.g. "pod", "pods", "po" or "deployments.apps"
+func (we *WorkflowExecutor) kindFor(resource string) (schema.GroupVersionKind, error) {
+	gvr, gr := schema.ParseResourceArg(strings.ToLower(resource))
+	if gvr == nil {
+		gvr = &schema.GroupVersionResource{Group: gr.Group, Resource: gr.Resource}
+	}
+	gvk, err := we.RESTMapper.KindFor(*gvr)
+	if err != nil {
+		return gvk, errors.Errorf(errors.CodeBadRequest, "unknown resource type %s: %v", resource, err)
+	}
+	return gvk, nil
+}
 
-		args = append(args, "-p")
-		args = append(args, string(buff))
+// withResourceClient calls f with the client of the object's resource, retrying transient errors.
+// Objects without a namespace are in the workflow's namespace, unless the resource is cluster scoped.
+func (we *WorkflowExecutor) withResourceClient(ctx context.Context, action string, obj *unstructured.Unstructured, flags resourceFlags, f func(client dynamic.ResourceInterface) error) error {
+	gvk := obj.GroupVersionKind()
+	if gvk.Kind == "" {
+		return errors.New(errors.CodeBadRequest, "Kind is required but is missing from the manifest")
+	}
+	if obj.GetName() == "" && !(action == "create" || action == "list") {
+		return errors.Errorf(errors.CodeBadRequest, "metadata.name is required to %s a resource", action)
+	}
+	log.Infof("%s %s", action, resourceFullName(obj))
+	err := retry.OnError(resourceRetry, isRetryableResourceErr, func() error {
+		mapping, err := we.RESTMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) {
+			we.resetRESTMapper()
+		}
+		if err != nil {
+			return err
+		}
+		if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+			return f(we.DynamicClient.Resource(mapping.Resource))
+		}
+		if obj.GetNamespace() == "" {
+			namespace := flags.namespace
+			if namespace == "" {
+				namespace = we.Namespace
+			}
+			obj.SetNamespace(namespace)
+		}
+		return f(we.DynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()))
+	})
+	if err != nil {
+		if argoErr, ok := err.(errors.ArgoError); ok {
+			return argoErr
+		}
+		return errors.Wrap(err, errors.CodeBadRequest, fmt.Sprintf("failed to %s %s: %v", action, resourceFullName(obj), err))
 	}
+	return nil
+}
 
-	if len(flags) != 0 {
-		args = append(args, flags...)
+// resetRESTMapper invalidates the discovered resources of the REST mapper, if it caches them, so that kinds that were
+// not discoverable, such as those of custom resource definitions created since, are discovered again
+func (we *WorkflowExecutor) resetRESTMapper() {
+	if mapper, ok := we.RESTMapper.(interface{ Reset() }); ok {
+		mapper.Reset()
 	}
+}
 
-	if len(buff) != 0 && appendFileFlag {
-		args = append(args, "-f")
-		args = append(args, manifestPath)
+func isRetryableResourceErr(err error) bool {
+	// the kind may not be discoverable yet, e.g. when its custom resource definition was created earlier in the manifest
+	return meta.IsNoMatchError(err) || argoerr.IsTransientErr(err)
+}
+
+func resourceFullName(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	name := obj.GetName()
+	if name == "" {
+		name = obj.GetGenerateName()
+	}
+	fullName := strings.ToLower(gvk.Kind) + "/" + name
+	if gvk.Group != "" {
+		fullName = fmt.Sprintf("%s.%s/%s", strings.ToLower(gvk.Kind), gvk.Group, name)
+	}
+	if obj.GetNamespace() != "" {
+		fullName = obj.GetNamespace() + "/" + fullName
 	}
-	args = append(args, "-o")
-	args = append(args, output)
+	return fullName
+}
 
-	return args, nil
+// AnnotateCreatedResources records the resources created by a template with a cleanup policy, so that the controller
+// can delete them. Resources that already existed, e.g. because they were applied, are not recorded.
+func (we *WorkflowExecutor) AnnotateCreatedResources(ctx context.Context) error {
+	if we.Template.Resource.Cleanup == nil || len(we.createdResources) == 0 {
+		return nil
+	}
+	var resources wfv1.CreatedResources
+	for _, obj := range we.createdResources {
+		resources = append(resources, wfv1.CreatedResource{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+			UID:        string(obj.GetUID()),
+			Cleanup:    we.Template.Resource.Cleanup,
+		})
+	}
+	data, err := json.Marshal(resources)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	log.Infof("Annotating pod with %d created resources", len(resources))
+	return we.AddAnnotation(ctx, common.AnnotationKeyCreatedResources, string(data))
 }
 
 // gjsonLabels is an implementation of labels.Labels interface
@@ -159,43 +478,67 @@ func (g gjsonLabels) Get(label string) string {
 	return gjson.GetBytes(g.json, label).String()
 }
 
-// WaitResource waits for a specific resource to satisfy either the success or failure condition
-func (we *WorkflowExecutor) WaitResource(ctx context.Context, resourceNamespace, resourceName, selfLink string) error {
-	if we.Template.Resource.SuccessCondition == "" && we.Template.Resource.FailureCondition == "" {
+// WaitResource waits for each of the resources to satisfy either the success or failure condition, or, when there
+// are no conditions, to become ready, unless the template does not wait for readiness.
+func (we *WorkflowExecutor) WaitResource(ctx context.Context, objs []*unstructured.Unstructured) error {
+	res := we.Template.Resource
+	if res.SuccessCondition == "" && res.FailureCondition == "" && !res.ShouldWaitForReady() {
 		return nil
 	}
 	var successReqs labels.Requirements
-	if we.Template.Resource.SuccessCondition != "" {
-		successSelector, err := labels.Parse(we.Template.Resource.SuccessCondition)
+	if res.SuccessCondition != "" {
+		successSelector, err := labels.Parse(res.SuccessCondition)
 		if err != nil {
-			return errors.Errorf(errors.CodeBadRequest, "success condition '%s' failed to parse: %v", we.Template.Resource.SuccessCondition, err)
+			return errors.Errorf(errors.CodeBadRequest, "success condition '%s' failed to parse: %v", res.SuccessCondition, err)
 		}
 		log.Infof("Waiting for conditions: %s", successSelector)
 		successReqs, _ = successSelector.Requirements()
 	}
 
 	var failReqs labels.Requirements
-	if we.Template.Resource.FailureCondition != "" {
-		failSelector, err := labels.Parse(we.Template.Resource.FailureCondition)
+	if res.FailureCondition != "" {
+		failSelector, err := labels.Parse(res.FailureCondition)
 		if err != nil {
-			return errors.Errorf(errors.CodeBadRequest, "fail condition '%s' failed to parse: %v", we.Template.Resource.FailureCondition, err)
+			return errors.Errorf(errors.CodeBadRequest, "fail condition '%s' failed to parse: %v", res.FailureCondition, err)
 		}
 		log.Infof("Failing for conditions: %s", failSelector)
 		failReqs, _ = failSelector.Requirements()
 	}
+
+	check := checkReadiness
+	if successReqs != nil || failReqs != nil {
+		check = func(obj *unstructured.Unstructured) (bool, error) {
+			jsonBytes, err := obj.MarshalJSON()
+			if err != nil {
+				return false, err
+			}
+			log.Debug(string(jsonBytes))
+			return matchConditions(jsonBytes, successReqs, failReqs)
+		}
+	}
+	for _, obj := range objs {
+		if err := we.waitResource(ctx, obj, check); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (we *WorkflowExecutor) waitResource(ctx context.Context, obj *unstructured.Unstructured, check func(obj *unstructured.Unstructured) (bool, error)) error {
+	resourceName := resourceFullName(obj)
 	err := wait.PollImmediateInfinite(envutil.LookupEnvDurationOr("RESOURCE_STATE_CHECK_INTERVAL", time.Second*5),
 		func() (bool, error) {
-			isErrRetryable, err := we.checkResourceState(ctx, selfLink, successReqs, failReqs)
+			isErrRetryable, err := we.checkResourceState(ctx, obj, check)
 			if err == nil {
-				log.Infof("Returning from successful wait for resource %s in namespace %s", resourceName, resourceNamespace)
+				log.Infof("Returning from successful wait for resource %s", resourceName)
 				return true, nil
 			}
 			if isErrRetryable || argoerr.IsTransientErr(err) {
-				log.Infof("Waiting for resource %s in namespace %s resulted in retryable error: %v", resourceName, resourceNamespace, err)
+				log.Infof("Waiting for resource %s resulted in retryable error: %v", resourceName, err)
 				return false, nil
 			}
 
-			log.Warnf("Waiting for resource %s in namespace %s resulted in non-retryable error: %v", resourceName, resourceNamespace, err)
+			log.Warnf("Waiting for resource %s resulted in non-retryable error: %v", resourceName, err)
 			return false, err
 		})
 	if err != nil {
@@ -209,31 +552,17 @@ func (we *WorkflowExecutor) WaitResource(ctx context.Context, resourceNamespace,
 	return nil
 }
 
-// checkResourceState performs resource status checking and then waiting on json reading.
+// checkResourceState gets the latest version of the resource and then checks its state.
 // The returning boolean indicates whether we should retry.
-func (we *WorkflowExecutor) checkResourceState(ctx context.Context, selfLink string, successReqs labels.Requirements, failReqs labels.Requirements) (bool, error) {
-	request := we.RESTClient.Get().RequestURI(selfLink)
-	stream, err := request.Stream(ctx)
-
+func (we *WorkflowExecutor) checkResourceState(ctx context.Context, obj *unstructured.Unstructured, check func(obj *unstructured.Unstructured) (bool, error)) (bool, error) {
+	current, err := we.getResource(ctx, obj)
 	if err != nil {
-		err = errors.Cause(err)
-		if apierr.IsNotFound(err) {
+		if apierr.IsNotFound(errors.Cause(err)) {
 			return false, errors.Errorf(errors.CodeNotFound, "The resource has been deleted while its status was still being checked. Will not be retried: %v", err)
 		}
 		return false, err
 	}
-
-	defer func() { _ = stream.Close() }()
-	jsonBytes, err := ioutil.ReadAll(stream)
-	if err != nil {
-		return false, err
-	}
-	jsonString := string(jsonBytes)
-	log.Debug(jsonString)
-	if !gjson.Valid(jsonString) {
-		return false, errors.Errorf(errors.CodeNotFound, "Encountered invalid JSON response when checking resource status. Will not be retried: %q", jsonString)
-	}
-	return matchConditions(jsonBytes, successReqs, failReqs)
+	return check(current)
 }
 
 // matchConditions checks whether the returned JSON bytes match success or failure conditions.
@@ -264,8 +593,9 @@ func matchConditions(jsonBytes []byte, successReqs labels.Requirements, failReqs
 	return true, errors.Errorf(errors.CodeNotFound, "Neither success condition nor the failure condition has been matched. Retrying...")
 }
 
-// SaveResourceParameters will save any resource output parameters
-func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, resourceNamespace string, resourceName string) error {
+// SaveResourceParameters will save any resource output parameters. When there is more than one resource, the value
+// of each parameter is a JSON list of its values for each resource.
+func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, objs []*unstructured.Unstructured) error {
 	if len(we.Template.Outputs.Parameters) == 0 {
 		log.Infof("No output parameters")
 		return nil
@@ -275,7 +605,7 @@ func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, resource
 		if param.ValueFrom == nil {
 			continue
 		}
-		if resourceNamespace == "" && resourceName == "" {
+		if len(objs) == 0 {
 			output := ""
 			if param.ValueFrom.Default != nil {
 				output = param.ValueFrom.Default.String()
@@ -283,40 +613,75 @@ func (we *WorkflowExecutor) SaveResourceParameters(ctx context.Context, resource
 			we.Template.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(output)
 			continue
 		}
-		var cmd *exec.Cmd
-		if param.ValueFrom.JSONPath != "" {
-			args := []string{"get", resourceName, "-o", fmt.Sprintf("jsonpath=%s", param.ValueFrom.JSONPath)}
-			if resourceNamespace != "" {
-				args = append(args, "-n", resourceNamespace)
-			}
-			cmd = exec.Command("kubectl", args...)
-		} else if param.ValueFrom.JQFilter != "" {
-			resArgs := []string{resourceName}
-			if resourceNamespace != "" {
-				resArgs = append(resArgs, "-n", resourceNamespace)
-			}
-			cmdStr := fmt.Sprintf("kubectl get %s -o json | jq -rc '%s'", strings.Join(resArgs, " "), param.ValueFrom.JQFilter)
-			cmd = exec.Command("sh", "-c", cmdStr)
-		} else {
+		if param.ValueFrom.JSONPath == "" && param.ValueFrom.JQFilter == "" {
 			continue
 		}
-		log.Info(cmd.Args)
-		out, err := cmd.Output()
-		if err != nil {
-			// We have a default value to use instead of returning an error
-			if param.ValueFrom.Default != nil {
-				out = []byte(param.ValueFrom.Default.String())
-			} else {
-				if exErr, ok := err.(*exec.ExitError); ok {
-					log.Errorf("`%s` stderr:\n%s", cmd.Args, string(exErr.Stderr))
+		var values []string
+		for _, obj := range objs {
+			value, err := we.resourceParameter(ctx, obj, param.ValueFrom)
+			if err != nil {
+				// We have a default value to use instead of returning an error
+				if param.ValueFrom.Default == nil {
+					return errors.InternalWrapError(err)
 				}
+				value = param.ValueFrom.Default.String()
+			}
+			values = append(values, value)
+		}
+		output := values[0]
+		if len(values) > 1 {
+			for j, value := range values {
+				values[j] = strings.TrimSuffix(value, "\n")
+			}
+			data, err := json.Marshal(values)
+			if err != nil {
 				return errors.InternalWrapError(err)
 			}
+			output = string(data)
 		}
-		output := string(out)
 		we.Template.Outputs.Parameters[i].Value = wfv1.AnyStringPtr(output)
 		log.Infof("Saved output parameter: %s, value: %s", param.Name, output)
 	}
 	err := we.AnnotateOutputs(ctx, nil)
 	return err
 }
+
+// resourceParameter returns the value of a parameter for the latest version of the resource
+func (we *WorkflowExecutor) resourceParameter(ctx context.Context, obj *unstructured.Unstructured, valueFrom *wfv1.ValueFrom) (string, error) {
+	current, err := we.getResource(ctx, obj)
+	if err != nil {
+		return "", err
+	}
+	if valueFrom.JSONPath != "" {
+		return jsonPathValue(current, valueFrom.JSONPath)
+	}
+	return jqValue(current, valueFrom.JQFilter)
+}
+
+func jsonPathValue(obj *unstructured.Unstructured, expression string) (string, error) {
+	j := jsonpath.New("")
+	j.AllowMissingKeys(true)
+	if err := j.Parse(expression); err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	if err := j.Execute(buf, obj.Object); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func jqValue(obj *unstructured.Unstructured, filter string) (string, error) {
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	cmd := exec.Command("jq", "-rc", filter)
+	cmd.Stdin = bytes.NewReader(data)
+	log.Info(cmd.Args)
+	out, err := cmd.Output()
+	if exErr, ok := err.(*exec.ExitError); ok {
+		log.Errorf("`%s` stderr:\n%s", cmd.Args, string(exErr.Stderr))
+	}
+	return string(out), err
+}
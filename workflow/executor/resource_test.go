@@ -1,122 +1,223 @@
 package executor
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
-	"path"
-	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
-	"k8s.io/client-go/util/retry"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
 )
 
-// TestResourceFlags tests whether Resource Flags
-// are properly passed to `kubectl` command
-func TestResourceFlags(t *testing.T) {
-	manifestPath := "../../examples/hello-world.yaml"
-	fakeClientset := fake.NewSimpleClientset()
-	fakeFlags := []string{"--fake=true"}
+var configMapsGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
 
-	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
-
-	template := wfv1.Template{
-		Resource: &wfv1.ResourceTemplate{
-			Action: "fake",
-			Flags:  fakeFlags,
-		},
-	}
-
-	we := WorkflowExecutor{
+func newResourceExecutor(resource *wfv1.ResourceTemplate, objs ...runtime.Object) (*WorkflowExecutor, *dynamicfake.FakeDynamicClient) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		configMapsGVR: "ConfigMapList",
+	}, objs...)
+	return &WorkflowExecutor{
 		PodName:         fakePodName,
-		Template:        template,
-		ClientSet:       fakeClientset,
+		Template:        wfv1.Template{Resource: resource},
+		ClientSet:       fake.NewSimpleClientset(&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fakePodName, Namespace: fakeNamespace}}),
+		DynamicClient:   dynamicClient,
+		RESTMapper:      mapper,
 		Namespace:       fakeNamespace,
-		RuntimeExecutor: &mockRuntimeExecutor,
-	}
-	args, err := we.getKubectlArguments("fake", manifestPath, fakeFlags)
-	assert.NoError(t, err)
-	assert.Contains(t, args, fakeFlags[0])
-
-	_, err = we.getKubectlArguments("fake", manifestPath, nil)
-	assert.NoError(t, err)
-	_, err = we.getKubectlArguments("fake", "unknown-location", fakeFlags)
-	assert.EqualError(t, err, "open unknown-location: no such file or directory")
-
-	emptyFile, err := ioutil.TempFile("/tmp", "empty-manifest")
-	assert.NoError(t, err)
-	defer func() { _ = os.Remove(emptyFile.Name()) }()
-	_, err = we.getKubectlArguments("fake", emptyFile.Name(), nil)
-	assert.EqualError(t, err, "Must provide at least one of flags or manifest.")
+		RuntimeExecutor: &mocks.ContainerRuntimeExecutor{},
+	}, dynamicClient
 }
 
-// TestResourcePatchFlags tests whether Resource Flags
-// are properly passed to `kubectl patch` command
-func TestResourcePatchFlags(t *testing.T) {
-	fakeClientset := fake.NewSimpleClientset()
-	manifestPath := "../../examples/hello-world.yaml"
-	buff, err := ioutil.ReadFile(manifestPath)
-	assert.NoError(t, err)
-	fakeFlags := []string{"patch", "--type", "strategic", "-p", string(buff), "-f", manifestPath, "-o", "json"}
+// resettableMapper is a REST mapper that maps config maps once it is reset
+type resettableMapper struct {
+	*meta.DefaultRESTMapper
+	resets int
+}
 
-	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+func (m *resettableMapper) Reset() {
+	m.resets++
+	m.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+}
 
-	template := wfv1.Template{
-		Resource: &wfv1.ResourceTemplate{
-			Action: "patch",
-			Flags:  fakeFlags,
-		},
-	}
+func newConfigMap(name string, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetNamespace(fakeNamespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	return obj
+}
 
-	we := WorkflowExecutor{
-		PodName:         fakePodName,
-		Template:        template,
-		ClientSet:       fakeClientset,
-		Namespace:       fakeNamespace,
-		RuntimeExecutor: &mockRuntimeExecutor,
+func writeManifest(t *testing.T, manifest string) string {
+	file, err := ioutil.TempFile("", "manifest")
+	if !assert.NoError(t, err) {
+		t.FailNow()
 	}
-	args, err := we.getKubectlArguments("patch", manifestPath, nil)
-
+	t.Cleanup(func() { _ = os.Remove(file.Name()) })
+	_, err = file.WriteString(manifest)
 	assert.NoError(t, err)
-	assert.Equal(t, args, fakeFlags)
+	assert.NoError(t, file.Close())
+	return file.Name()
 }
 
-// TestResourcePatchFlagsJson tests whether Resource Flags
-// are properly passed to `kubectl patch` command in json patches
-func TestResourcePatchFlagsJson(t *testing.T) {
-	fakeClientset := fake.NewSimpleClientset()
-	manifestPath := "../../examples/hello-world.yaml"
-	buff, err := ioutil.ReadFile(manifestPath)
-	assert.NoError(t, err)
-	fakeFlags := []string{"patch", "--type", "json", "-p", string(buff), "-o", "json"}
-
-	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
-
-	template := wfv1.Template{
-		Resource: &wfv1.ResourceTemplate{
-			Action:        "patch",
-			Flags:         fakeFlags,
-			MergeStrategy: "json",
-		},
+func names(objs []*unstructured.Unstructured) []string {
+	var names []string
+	for _, obj := range objs {
+		names = append(names, obj.GetName())
 	}
+	return names
+}
 
-	we := WorkflowExecutor{
-		PodName:         fakePodName,
-		Template:        template,
-		ClientSet:       fakeClientset,
-		Namespace:       fakeNamespace,
-		RuntimeExecutor: &mockRuntimeExecutor,
+func TestParseResourceFlags(t *testing.T) {
+	f, err := parseResourceFlags([]string{"pod", "a", "b", "-n", "my-ns", "--ignore-not-found", "--validate=false"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, resourceFlags{resource: "pod", names: []string{"a", "b"}, namespace: "my-ns", ignoreNotFound: true}, f)
 	}
-	args, err := we.getKubectlArguments("patch", manifestPath, nil)
-	assert.NoError(t, err)
-	assert.Equal(t, args, fakeFlags)
+	f, err = parseResourceFlags([]string{"configmap/a", "configmap/b", "--force-conflicts=true"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, resourceFlags{resource: "configmap", names: []string{"a", "b"}, forceConflicts: true}, f)
+	}
+	f, err = parseResourceFlags([]string{"configmap", "--selector=app=my-app"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, resourceFlags{resource: "configmap", selector: "app=my-app"}, f)
+	}
+	_, err = parseResourceFlags([]string{"--fake=true"})
+	assert.EqualError(t, err, "unsupported flag --fake=true")
+	_, err = parseResourceFlags([]string{"configmap", "--selector"})
+	assert.EqualError(t, err, "flag --selector needs an argument")
+	_, err = parseResourceFlags([]string{"configmap"})
+	assert.EqualError(t, err, "resource type configmap requires a name or a selector")
+	_, err = parseResourceFlags([]string{"configmap/a", "secret/b"})
+	assert.EqualError(t, err, "resources of more than one type are not supported: configmap and secret")
+}
+
+func TestExecResource(t *testing.T) {
+	ctx := context.Background()
+	t.Run("NoManifest", func(t *testing.T) {
+		we, _ := newResourceExecutor(&wfv1.ResourceTemplate{})
+		_, err := we.ExecResource(ctx, "get", "unknown-location", nil)
+		assert.EqualError(t, err, "open unknown-location: no such file or directory")
+		_, err = we.ExecResource(ctx, "get", writeManifest(t, ""), nil)
+		assert.EqualError(t, err, "Must provide at least one of flags or manifest.")
+	})
+	t.Run("CreateMultipleDocuments", func(t *testing.T) {
+		we, client := newResourceExecutor(&wfv1.ResourceTemplate{})
+		objs, err := we.ExecResource(ctx, "create", writeManifest(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: b
+  namespace: other
+`), nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a", "b"}, names(objs))
+			_, err = client.Resource(configMapsGVR).Namespace(fakeNamespace).Get(ctx, "a", metav1.GetOptions{})
+			assert.NoError(t, err)
+			_, err = client.Resource(configMapsGVR).Namespace("other").Get(ctx, "b", metav1.GetOptions{})
+			assert.NoError(t, err)
+		}
+	})
+	t.Run("Apply", func(t *testing.T) {
+		we, client := newResourceExecutor(&wfv1.ResourceTemplate{})
+		var patchTypes []types.PatchType
+		client.PrependReactor("patch", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			patch := action.(k8stesting.PatchAction)
+			patchTypes = append(patchTypes, patch.GetPatchType())
+			obj := &unstructured.Unstructured{}
+			return true, obj, obj.UnmarshalJSON(patch.GetPatch())
+		})
+		objs, err := we.ExecResource(ctx, "apply", writeManifest(t, `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "a"}}`), nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a"}, names(objs))
+			assert.Equal(t, []types.PatchType{types.ApplyPatchType}, patchTypes)
+		}
+		_, err = we.ExecResource(ctx, "apply", writeManifest(t, `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"generateName": "a-"}}`), nil)
+		assert.EqualError(t, err, "metadata.name is required to apply a resource")
+	})
+	t.Run("JSONPatch", func(t *testing.T) {
+		we, client := newResourceExecutor(&wfv1.ResourceTemplate{MergeStrategy: "json"}, newConfigMap("a", nil))
+		manifest := writeManifest(t, `
+- op: add
+  path: /metadata/labels
+  value: {foo: bar}
+`)
+		objs, err := we.ExecResource(ctx, "patch", manifest, []string{"configmap", "a"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a"}, names(objs))
+			obj, err := client.Resource(configMapsGVR).Namespace(fakeNamespace).Get(ctx, "a", metav1.GetOptions{})
+			if assert.NoError(t, err) {
+				assert.Equal(t, map[string]string{"foo": "bar"}, obj.GetLabels())
+			}
+		}
+		_, err = we.ExecResource(ctx, "patch", manifest, nil)
+		assert.EqualError(t, err, "json patches require the resource type and name as flags")
+	})
+	t.Run("GetAndDeleteBySelector", func(t *testing.T) {
+		we, _ := newResourceExecutor(&wfv1.ResourceTemplate{},
+			newConfigMap("a", map[string]string{"app": "my-app"}),
+			newConfigMap("b", map[string]string{"app": "my-app"}),
+			newConfigMap("c", nil),
+		)
+		manifest := writeManifest(t, "")
+		objs, err := we.ExecResource(ctx, "get", manifest, []string{"configmap", "--selector", "app=my-app"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a", "b"}, names(objs))
+		}
+		_, err = we.ExecResource(ctx, "delete", manifest, []string{"configmap", "-l", "app=my-app"})
+		assert.NoError(t, err)
+		objs, err = we.ExecResource(ctx, "get", manifest, []string{"configmap", "a", "c", "--ignore-not-found"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"c"}, names(objs))
+		}
+		_, err = we.ExecResource(ctx, "get", manifest, []string{"configmap/a"})
+		assert.Error(t, err)
+		// deleting missing resources is not an error
+		_, err = we.ExecResource(ctx, "delete", writeManifest(t, `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "a"}}`), nil)
+		assert.NoError(t, err)
+	})
+	t.Run("DiscoveredKind", func(t *testing.T) {
+		defer func(backoff wait.Backoff) { resourceRetry = backoff }(resourceRetry)
+		resourceRetry = wait.Backoff{Duration: time.Millisecond, Steps: 3}
+		we, _ := newResourceExecutor(&wfv1.ResourceTemplate{})
+		// the kind is not discovered until the mapper is reset, as when its custom resource definition was just created
+		mapper := &resettableMapper{DefaultRESTMapper: meta.NewDefaultRESTMapper(nil)}
+		we.RESTMapper = mapper
+		objs, err := we.ExecResource(ctx, "create", writeManifest(t, `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "a"}}`), nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a"}, names(objs))
+			assert.Equal(t, 1, mapper.resets)
+		}
+	})
+	t.Run("UnknownKind", func(t *testing.T) {
+		we, _ := newResourceExecutor(&wfv1.ResourceTemplate{})
+		_, err := we.ExecResource(ctx, "get", writeManifest(t, ""), []string{"widget/a"})
+		assert.Error(t, err)
+	})
 }
 
 // TestResourceConditionsMatching tests whether the JSON response match
@@ -149,55 +250,98 @@ func TestResourceConditionsMatching(t *testing.T) {
 	assert.True(t, finished)
 }
 
-// TestInferSelfLink tests whether the inferred self link for k8s objects are correct.
-func TestInferSelfLink(t *testing.T) {
-	obj := unstructured.Unstructured{}
-	obj.SetNamespace("test-namespace")
-	obj.SetName("test-name")
-	obj.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "",
-		Version: "v1",
-		Kind:    "Pod",
-	})
-	assert.Equal(t, "api/v1/namespaces/test-namespace/pods/test-name", inferObjectSelfLink(obj))
+func TestWaitResource(t *testing.T) {
+	ctx := context.Background()
+	ready := newConfigMap("a", map[string]string{"ready": "true"})
+	ready.Object["status"] = map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}}}
+	stalled := newConfigMap("b", nil)
+	stalled.Object["status"] = map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Stalled", "status": "True", "message": "oops"}}}
 
-	obj.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "test.group",
-		Version: "v1",
-		Kind:    "TestKind",
-	})
-	assert.Equal(t, "apis/test.group/v1/namespaces/test-namespace/testkinds/test-name", inferObjectSelfLink(obj))
+	// waiting for readiness is the default
+	we, _ := newResourceExecutor(&wfv1.ResourceTemplate{}, ready, stalled)
+	assert.NoError(t, we.WaitResource(ctx, []*unstructured.Unstructured{ready}))
+	assert.EqualError(t, we.WaitResource(ctx, []*unstructured.Unstructured{ready, stalled}), "resource default/configmap/b failed: resource is stalled: oops")
 
-	obj.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "test.group",
-		Version: "v1",
-		Kind:    "Duty",
-	})
-	assert.Equal(t, "apis/test.group/v1/namespaces/test-namespace/duties/test-name", inferObjectSelfLink(obj))
+	we.Template.Resource = &wfv1.ResourceTemplate{WaitForReady: pointer.BoolPtr(false)}
+	assert.NoError(t, we.WaitResource(ctx, []*unstructured.Unstructured{ready, stalled}))
+
+	we.Template.Resource = &wfv1.ResourceTemplate{SuccessCondition: "metadata.labels.ready == true"}
+	assert.NoError(t, we.WaitResource(ctx, []*unstructured.Unstructured{ready}))
+	we.Template.Resource = &wfv1.ResourceTemplate{FailureCondition: "metadata.name == a"}
+	assert.EqualError(t, we.WaitResource(ctx, []*unstructured.Unstructured{ready}), "failure condition '{metadata.name == [a]}' evaluated true")
 }
 
-// TestResourceExecRetry tests whether Exec retries transitive errors
-func TestResourceExecRetry(t *testing.T) {
-	we := WorkflowExecutor{
-		PodName:         fakePodName,
-		Template:        wfv1.Template{},
-		ClientSet:       fake.NewSimpleClientset(),
-		Namespace:       fakeNamespace,
-		RuntimeExecutor: &mocks.ContainerRuntimeExecutor{},
+func TestSaveResourceParameters(t *testing.T) {
+	ctx := context.Background()
+	a, b := newConfigMap("a", nil), newConfigMap("b", nil)
+	we, _ := newResourceExecutor(&wfv1.ResourceTemplate{}, a, b)
+	we.Template.Outputs.Parameters = []wfv1.Parameter{
+		{Name: "name", ValueFrom: &wfv1.ValueFrom{JSONPath: "{.metadata.name}"}},
+		{Name: "missing", ValueFrom: &wfv1.ValueFrom{JSONPath: "{.metadata.missing}"}},
+	}
+	if assert.NoError(t, we.SaveResourceParameters(ctx, []*unstructured.Unstructured{a})) {
+		assert.Equal(t, "a", we.Template.Outputs.Parameters[0].Value.String())
+		assert.Equal(t, "", we.Template.Outputs.Parameters[1].Value.String())
+	}
+	if assert.NoError(t, we.SaveResourceParameters(ctx, []*unstructured.Unstructured{a, b})) {
+		assert.Equal(t, `["a","b"]`, we.Template.Outputs.Parameters[0].Value.String())
+	}
+	we.Template.Outputs.Parameters = []wfv1.Parameter{
+		{Name: "default", ValueFrom: &wfv1.ValueFrom{JSONPath: "{.metadata.name}", Default: wfv1.AnyStringPtr("none")}},
+	}
+	if assert.NoError(t, we.SaveResourceParameters(ctx, nil)) {
+		assert.Equal(t, "none", we.Template.Outputs.Parameters[0].Value.String())
 	}
+}
 
-	_, filename, _, _ := runtime.Caller(0)
-	dirname := path.Dir(filename)
-	duration := retry.DefaultBackoff.Duration
-	path := os.Getenv("PATH")
-	defer func() {
-		os.Setenv("PATH", path)
-		retry.DefaultBackoff.Duration = duration
-	}()
-	retry.DefaultBackoff.Duration = 0
-	os.Setenv("PATH", dirname+"/testdata")
-
-	_, _, _, err := we.ExecResource("", "../../examples/hello-world.yaml", nil)
-	assert.Error(t, err)
-	assert.Equal(t, "no more retries i/o timeout", err.Error())
+func TestAnnotateCreatedResources(t *testing.T) {
+	ctx := context.Background()
+	getAnnotation := func(we *WorkflowExecutor) string {
+		pod, err := we.ClientSet.CoreV1().Pods(fakeNamespace).Get(ctx, fakePodName, metav1.GetOptions{})
+		assert.NoError(t, err)
+		return pod.Annotations[common.AnnotationKeyCreatedResources]
+	}
+	manifest := `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "a", "uid": "a-uid"}}`
+	t.Run("NoCleanup", func(t *testing.T) {
+		we, _ := newResourceExecutor(&wfv1.ResourceTemplate{Action: "create"})
+		_, err := we.ExecResource(ctx, "create", writeManifest(t, manifest), nil)
+		assert.NoError(t, err)
+		assert.NoError(t, we.AnnotateCreatedResources(ctx))
+		assert.Empty(t, getAnnotation(we))
+	})
+	t.Run("Get", func(t *testing.T) {
+		we, _ := newResourceExecutor(&wfv1.ResourceTemplate{Action: "get", Cleanup: &wfv1.ResourceCleanup{}}, newConfigMap("a", nil))
+		_, err := we.ExecResource(ctx, "get", writeManifest(t, manifest), nil)
+		assert.NoError(t, err)
+		assert.NoError(t, we.AnnotateCreatedResources(ctx))
+		assert.Empty(t, getAnnotation(we))
+	})
+	t.Run("Create", func(t *testing.T) {
+		we, _ := newResourceExecutor(&wfv1.ResourceTemplate{Action: "create", Cleanup: &wfv1.ResourceCleanup{Strategy: wfv1.ResourceCleanupOnWorkflowSuccess}})
+		_, err := we.ExecResource(ctx, "create", writeManifest(t, manifest), nil)
+		assert.NoError(t, err)
+		assert.NoError(t, we.AnnotateCreatedResources(ctx))
+		assert.JSONEq(t, `[{"apiVersion":"v1","kind":"ConfigMap","namespace":"default","name":"a","uid":"a-uid","cleanup":{"strategy":"OnWorkflowSuccess"}}]`, getAnnotation(we))
+	})
+	t.Run("Apply", func(t *testing.T) {
+		we, client := newResourceExecutor(&wfv1.ResourceTemplate{Action: "apply", Cleanup: &wfv1.ResourceCleanup{}}, newConfigMap("a", nil))
+		client.PrependReactor("patch", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			obj := &unstructured.Unstructured{}
+			return true, obj, obj.UnmarshalJSON(action.(k8stesting.PatchAction).GetPatch())
+		})
+		_, err := we.ExecResource(ctx, "apply", writeManifest(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: b
+`), nil)
+		assert.NoError(t, err)
+		assert.NoError(t, we.AnnotateCreatedResources(ctx))
+		assert.JSONEq(t, `[{"apiVersion":"v1","kind":"ConfigMap","namespace":"default","name":"b","cleanup":{}}]`, getAnnotation(we), "the existing resource is not recorded")
+	})
 }
@@ -3,6 +3,7 @@ package gccontroller
 import (
 	"container/heap"
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
@@ -11,6 +12,7 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -23,6 +25,7 @@ import (
 	commonutil "github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+	"github.com/argoproj/argo-workflows/v3/workflow/resourcecleanup"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
@@ -31,16 +34,20 @@ var ticker *time.Ticker = time.NewTicker(50 * time.Millisecond)
 type Controller struct {
 	wfclientset      wfclientset.Interface
 	wfInformer       cache.SharedIndexInformer
-	workqueue        workqueue.DelayingInterface
+	workqueue        workqueue.RateLimitingInterface
 	clock            clock.Clock
 	metrics          *metrics.Metrics
 	orderedQueueLock sync.Mutex
 	orderedQueue     map[wfv1.WorkflowPhase]*gcHeap
 	retentionPolicy  *config.RetentionPolicy
+	resourceCleaner  *resourcecleanup.Cleaner
 }
 
+// resourceCleanupKey is the workqueue key of a workflow whose created resources are due for deletion
+type resourceCleanupKey string
+
 // NewController returns a new workflow ttl controller
-func NewController(wfClientset wfclientset.Interface, wfInformer cache.SharedIndexInformer, metrics *metrics.Metrics, retentionPolicy *config.RetentionPolicy) *Controller {
+func NewController(wfClientset wfclientset.Interface, wfInformer cache.SharedIndexInformer, metrics *metrics.Metrics, retentionPolicy *config.RetentionPolicy, resourceCleaner *resourcecleanup.Cleaner) *Controller {
 
 	orderedQueue := map[wfv1.WorkflowPhase]*gcHeap{
 		wfv1.WorkflowFailed:    NewHeap(),
@@ -55,6 +62,7 @@ func NewController(wfClientset wfclientset.Interface, wfInformer cache.SharedInd
 		metrics:         metrics,
 		orderedQueue:    orderedQueue,
 		retentionPolicy: retentionPolicy,
+		resourceCleaner: resourceCleaner,
 	}
 
 	wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
@@ -167,7 +175,17 @@ func (c *Controller) processNextWorkItem(ctx context.Context) bool {
 		return false
 	}
 	defer c.workqueue.Done(key)
-	runtimeutil.HandleError(c.deleteWorkflow(ctx, key.(string)))
+	switch key := key.(type) {
+	case resourceCleanupKey:
+		if err := c.cleanupResources(ctx, string(key)); err != nil {
+			runtimeutil.HandleError(err)
+			c.workqueue.AddRateLimited(key)
+		} else {
+			c.workqueue.Forget(key)
+		}
+	case string:
+		runtimeutil.HandleError(c.deleteWorkflow(ctx, key))
+	}
 
 	return true
 }
@@ -186,6 +204,7 @@ func (c *Controller) enqueueWF(obj interface{}) {
 		log.Warnf("Failed to unmarshal workflow %v object: %v", obj, err)
 		return
 	}
+	c.enqueueResourceCleanup(wf)
 	remaining, ok := c.expiresIn(wf)
 	if !ok {
 		return
@@ -206,6 +225,13 @@ func (c *Controller) deleteWorkflow(ctx context.Context, key string) error {
 	namespace, name, _ := cache.SplitMetaNamespaceKey(key)
 
 	// Any workflow that was queued must need deleting, therefore we do not check the expiry again.
+	// Its created resources are deleted first, even if they are not yet due.
+	// Those that cannot be deleted now are deleted by the controller before it removes the workflow's finalizer.
+	if wf, ok := c.getWorkflow(key); ok && c.resourceCleaner != nil {
+		if _, err := c.resourceCleaner.Cleanup(ctx, wf, c.clock.Now(), true); err != nil {
+			log.WithError(err).Warnf("Failed to clean up the resources of workflow '%s'", key)
+		}
+	}
 	log.Infof("Deleting garbage collected workflow '%s'", key)
 	err := c.wfclientset.ArgoprojV1alpha1().Workflows(namespace).Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: commonutil.GetDeletePropagation()})
 	if err != nil {
@@ -246,3 +272,58 @@ func ttl(wf *wfv1.Workflow) (ttl time.Duration, ok bool) {
 	}
 	return 0, false
 }
+
+// enqueueResourceCleanup queues the workflow for when the next of its created resources is due for deletion
+func (c *Controller) enqueueResourceCleanup(wf *wfv1.Workflow) {
+	if c.resourceCleaner == nil {
+		return
+	}
+	dueIn, ok := resourcecleanup.DueIn(wf, c.clock.Now())
+	if !ok {
+		return
+	}
+	key, _ := cache.MetaNamespaceKeyFunc(wf)
+	log.Infof("Queueing workflow %s for resource cleanup in %v", key, dueIn.Truncate(time.Second))
+	c.workqueue.AddAfter(resourceCleanupKey(key), dueIn)
+}
+
+// cleanupResources deletes the workflow's created resources that are due for deletion, and records their cleanup
+// phase in the workflow's status. It returns an error if any of them are to be retried.
+func (c *Controller) cleanupResources(ctx context.Context, key string) error {
+	wf, ok := c.getWorkflow(key)
+	if !ok {
+		return nil
+	}
+	updated, cleanupErr := c.resourceCleaner.Cleanup(ctx, wf, c.clock.Now(), false)
+	if !updated {
+		return cleanupErr
+	}
+	data, err := json.Marshal(map[string]interface{}{"status": map[string]interface{}{"createdResources": wf.Status.CreatedResources}})
+	if err != nil {
+		return err
+	}
+	_, err = c.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Patch(ctx, wf.Name, types.MergePatchType, data, metav1.PatchOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return cleanupErr
+}
+
+func (c *Controller) getWorkflow(key string) (*wfv1.Workflow, bool) {
+	obj, exists, err := c.wfInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return nil, false
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false
+	}
+	wf, err := util.FromUnstructured(un)
+	if err != nil {
+		log.Warnf("Failed to unmarshal workflow %s: %v", key, err)
+		return nil, false
+	}
+	return wf, true
+}
@@ -350,7 +350,7 @@ func newTTLController() *Controller {
 		wfclientset: wfclientset,
 		wfInformer:  wfInformer,
 		clock:       clock,
-		workqueue:   workqueue.NewDelayingQueue(),
+		workqueue:   workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 		metrics:     metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
 	}
 }
@@ -44,15 +44,16 @@ type Metrics struct {
 	metricsConfig   ServerConfig
 	telemetryConfig ServerConfig
 
-	workflowsProcessed prometheus.Counter
-	podsByPhase        map[corev1.PodPhase]prometheus.Gauge
-	workflowsByPhase   map[v1alpha1.NodePhase]prometheus.Gauge
-	workflows          map[string][]string
-	operationDurations prometheus.Histogram
-	errors             map[ErrorCause]prometheus.Counter
-	customMetrics      map[string]metric
-	workqueueMetrics   map[string]prometheus.Metric
-	workersBusy        map[string]prometheus.Gauge
+	workflowsProcessed  prometheus.Counter
+	workflowAutoRetries prometheus.Counter
+	podsByPhase         map[corev1.PodPhase]prometheus.Gauge
+	workflowsByPhase    map[v1alpha1.NodePhase]prometheus.Gauge
+	workflows           map[string][]string
+	operationDurations  prometheus.Histogram
+	errors              map[ErrorCause]prometheus.Counter
+	customMetrics       map[string]metric
+	workqueueMetrics    map[string]prometheus.Metric
+	workersBusy         map[string]prometheus.Gauge
 
 	// Used to quickly check if a metric desc is already used by the system
 	defaultMetricDescs map[string]bool
@@ -73,19 +74,20 @@ var _ prometheus.Collector = &Metrics{}
 
 func New(metricsConfig, telemetryConfig ServerConfig) *Metrics {
 	metrics := &Metrics{
-		metricsConfig:      metricsConfig,
-		telemetryConfig:    telemetryConfig,
-		workflowsProcessed: newCounter("workflows_processed_count", "Number of workflow updates processed", nil),
-		podsByPhase:        getPodPhaseGauges(),
-		workflowsByPhase:   getWorkflowPhaseGauges(),
-		workflows:          make(map[string][]string),
-		operationDurations: newHistogram("operation_duration_seconds", "Histogram of durations of operations", nil, []float64{0.1, 0.25, 0.5, 0.75, 1.0, 1.25, 1.5, 1.75, 2.0, 2.5, 3.0}),
-		errors:             getErrorCounters(),
-		customMetrics:      make(map[string]metric),
-		workqueueMetrics:   make(map[string]prometheus.Metric),
-		workersBusy:        make(map[string]prometheus.Gauge),
-		defaultMetricDescs: make(map[string]bool),
-		metricNameHelps:    make(map[string]string),
+		metricsConfig:       metricsConfig,
+		telemetryConfig:     telemetryConfig,
+		workflowsProcessed:  newCounter("workflows_processed_count", "Number of workflow updates processed", nil),
+		workflowAutoRetries: newCounter("workflow_auto_retries_count", "Number of times failed workflows were automatically retried", nil),
+		podsByPhase:         getPodPhaseGauges(),
+		workflowsByPhase:    getWorkflowPhaseGauges(),
+		workflows:           make(map[string][]string),
+		operationDurations:  newHistogram("operation_duration_seconds", "Histogram of durations of operations", nil, []float64{0.1, 0.25, 0.5, 0.75, 1.0, 1.25, 1.5, 1.75, 2.0, 2.5, 3.0}),
+		errors:              getErrorCounters(),
+		customMetrics:       make(map[string]metric),
+		workqueueMetrics:    make(map[string]prometheus.Metric),
+		workersBusy:         make(map[string]prometheus.Gauge),
+		defaultMetricDescs:  make(map[string]bool),
+		metricNameHelps:     make(map[string]string),
 		logMetric: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "log_messages",
 			Help: "Total number of log messages.",
@@ -111,6 +113,7 @@ func (m *Metrics) allMetrics() []prometheus.Metric {
 
 	allMetrics := []prometheus.Metric{
 		m.workflowsProcessed,
+		m.workflowAutoRetries,
 		m.operationDurations,
 	}
 	for _, metric := range m.workflowsByPhase {
@@ -157,6 +160,13 @@ func (m *Metrics) OperationCompleted(durationSeconds float64) {
 	m.operationDurations.Observe(durationSeconds)
 }
 
+func (m *Metrics) WorkflowAutoRetried() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.workflowAutoRetries.Inc()
+}
+
 func (m *Metrics) GetCustomMetric(key string) prometheus.Metric {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
@@ -0,0 +1,167 @@
+package resourcecleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
+	"github.com/argoproj/argo-workflows/v3/util/retry"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+)
+
+// ClientFunc returns a client that acts as the named service account of the namespace
+type ClientFunc func(namespace, serviceAccountName string) (dynamic.Interface, error)
+
+// Impersonate returns a ClientFunc that impersonates the service accounts, so that a workflow can only get resources
+// deleted that its own service account could delete
+func Impersonate(config *rest.Config) ClientFunc {
+	return func(namespace, serviceAccountName string) (dynamic.Interface, error) {
+		config := rest.CopyConfig(config)
+		config.Impersonate = rest.ImpersonationConfig{UserName: fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccountName)}
+		return dynamic.NewForConfig(config)
+	}
+}
+
+// Cleaner deletes the resources created by resource templates, according to their cleanup policy
+type Cleaner struct {
+	clientFor ClientFunc
+	mapper    meta.RESTMapper
+}
+
+func NewCleaner(clientFor ClientFunc, mapper meta.RESTMapper) *Cleaner {
+	return &Cleaner{clientFor: clientFor, mapper: mapper}
+}
+
+// DueIn returns how long until the next of the workflow's created resources is due for deletion, which may be <= 0.
+// ok is false if there are no resources to delete, or the workflow has not completed.
+func DueIn(wf *wfv1.Workflow, now time.Time) (dueIn time.Duration, ok bool) {
+	if !wf.Status.Phase.Completed() {
+		return 0, false
+	}
+	for _, r := range wf.Status.CreatedResources {
+		if !r.CleanupPending() {
+			continue
+		}
+		d := wf.Status.FinishedAt.Add(r.Cleanup.GetDelay()).Sub(now)
+		if !ok || d < dueIn {
+			dueIn, ok = d, true
+		}
+	}
+	return dueIn, ok
+}
+
+// Cleanup deletes the completed workflow's created resources that are due for deletion, and records the outcome in
+// their cleanup phase. If force is true, all resources are deleted regardless of their delay, e.g. because the
+// workflow is being deleted. It returns whether any of the resources were updated, and an error if any could not be
+// deleted for now, in which case they are left pending so that they are deleted on a later attempt.
+func (c *Cleaner) Cleanup(ctx context.Context, wf *wfv1.Workflow, now time.Time, force bool) (bool, error) {
+	if !wf.Status.Phase.Completed() {
+		return false, nil
+	}
+	updated := false
+	var retryErr error
+	for i, r := range wf.Status.CreatedResources {
+		if !r.CleanupPending() {
+			continue
+		}
+		if r.Cleanup.GetStrategy() == wfv1.ResourceCleanupOnWorkflowSuccess && !wf.Status.Successful() {
+			r.CleanupPhase = wfv1.ResourceCleanupRetained
+			r.Message = "workflow did not succeed"
+		} else if force || !wf.Status.FinishedAt.Add(r.Cleanup.GetDelay()).After(now) {
+			if err := c.delete(ctx, wf.Namespace, r); apierr.IsForbidden(err) || errors.Is(err, errPermanent) {
+				r.CleanupPhase = wfv1.ResourceCleanupFailed
+				r.Message = err.Error()
+			} else if err != nil {
+				retryErr = err
+				if r.CleanupPhase == wfv1.ResourceCleanupPending && r.Message == err.Error() {
+					continue
+				}
+				r.CleanupPhase = wfv1.ResourceCleanupPending
+				r.Message = err.Error()
+			} else {
+				r.CleanupPhase = wfv1.ResourceCleanupDeleted
+				r.Message = ""
+			}
+		} else {
+			if r.CleanupPhase == "" {
+				r.CleanupPhase = wfv1.ResourceCleanupPending
+				wf.Status.CreatedResources[i] = r
+				updated = true
+			}
+			continue
+		}
+		log.WithFields(log.Fields{"workflow": wf.Name, "namespace": wf.Namespace, "resource": r.DisplayName(), "phase": r.CleanupPhase}).
+			Info("Cleaned up created resource")
+		wf.Status.CreatedResources[i] = r
+		updated = true
+	}
+	return updated, retryErr
+}
+
+// errPermanent is wrapped by the errors of resources that cannot be deleted however many times we retry
+var errPermanent = errors.New("cannot be deleted")
+
+// delete deletes the resource as the service account that created it, retrying transient errors. Only resources in
+// the workflow's namespace are deleted. A resource that no longer exists is deleted.
+func (c *Cleaner) delete(ctx context.Context, namespace string, r wfv1.CreatedResource) error {
+	if r.Namespace != namespace {
+		return fmt.Errorf("%s %w: it is not in the workflow's namespace", r.DisplayName(), errPermanent)
+	}
+	if r.ServiceAccountName == "" {
+		return fmt.Errorf("%s %w: the service account that created it is unknown", r.DisplayName(), errPermanent)
+	}
+	gv, err := schema.ParseGroupVersion(r.APIVersion)
+	if err != nil {
+		return fmt.Errorf("%s %w: %v", r.DisplayName(), errPermanent, err)
+	}
+	mapping, err := c.mapper.RESTMapping(gv.WithKind(r.Kind).GroupKind(), gv.Version)
+	if meta.IsNoMatchError(err) {
+		// the kind no longer exists, e.g. because its CRD was deleted, and so neither does the resource
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return fmt.Errorf("%s %w: it is not namespaced", r.DisplayName(), errPermanent)
+	}
+	dynamicClient, err := c.clientFor(r.Namespace, r.ServiceAccountName)
+	if err != nil {
+		return fmt.Errorf("%s %w: %v", r.DisplayName(), errPermanent, err)
+	}
+	client := dynamicClient.Resource(mapping.Resource).Namespace(r.Namespace)
+	propagationPolicy := metav1.DeletePropagationBackground
+	opts := metav1.DeleteOptions{PropagationPolicy: &propagationPolicy}
+	if r.UID != "" {
+		uid := types.UID(r.UID)
+		opts.Preconditions = &metav1.Preconditions{UID: &uid}
+	}
+	err = waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
+		err := client.Delete(ctx, r.Name, opts)
+		switch {
+		case err == nil, apierr.IsNotFound(err):
+			return true, nil
+		case apierr.IsConflict(err):
+			// the UID precondition failed, so the resource was replaced by another one that we must not delete
+			return true, nil
+		case apierr.IsForbidden(err):
+			return true, err
+		}
+		return !errorsutil.IsTransientErr(err), err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", r.DisplayName(), err)
+	}
+	return nil
+}
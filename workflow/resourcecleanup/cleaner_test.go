@@ -0,0 +1,269 @@
+package resourcecleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+var (
+	configMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	namespaceGVR = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+)
+
+func newCleaner(objs ...runtime.Object) (*Cleaner, *dynamicfake.FakeDynamicClient) {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		configMapGVR: "ConfigMapList",
+		namespaceGVR: "NamespaceList",
+	}, objs...)
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	return NewCleaner(func(namespace, serviceAccountName string) (dynamic.Interface, error) {
+		if namespace != "my-ns" || serviceAccountName != "my-sa" {
+			return nil, fmt.Errorf("unexpected service account %s/%s", namespace, serviceAccountName)
+		}
+		return client, nil
+	}, mapper), client
+}
+
+func newObject(kind, namespace, name, uid string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetUID(types.UID(uid))
+	return obj
+}
+
+func newWorkflow(phase wfv1.WorkflowPhase, finishedAt time.Time, resources ...wfv1.CreatedResource) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf"},
+		Status: wfv1.WorkflowStatus{
+			Phase:            phase,
+			FinishedAt:       metav1.NewTime(finishedAt),
+			CreatedResources: resources,
+		},
+	}
+}
+
+func configMap(name string, cleanup *wfv1.ResourceCleanup) wfv1.CreatedResource {
+	return wfv1.CreatedResource{NodeID: "my-node", APIVersion: "v1", Kind: "ConfigMap", Namespace: "my-ns", Name: name, UID: name + "-uid", Cleanup: cleanup, ServiceAccountName: "my-sa"}
+}
+
+func cleanup(t *testing.T, c *Cleaner, wf *wfv1.Workflow, now time.Time, force bool) bool {
+	updated, err := c.Cleanup(context.Background(), wf, now, force)
+	assert.NoError(t, err)
+	return updated
+}
+
+func TestDueIn(t *testing.T) {
+	now := time.Now()
+	ten := int32(10)
+	five := int32(5)
+	t.Run("Running", func(t *testing.T) {
+		wf := newWorkflow(wfv1.WorkflowRunning, time.Time{}, configMap("a", &wfv1.ResourceCleanup{}))
+		_, ok := DueIn(wf, now)
+		assert.False(t, ok)
+	})
+	t.Run("NoResources", func(t *testing.T) {
+		_, ok := DueIn(newWorkflow(wfv1.WorkflowSucceeded, now), now)
+		assert.False(t, ok)
+	})
+	t.Run("Earliest", func(t *testing.T) {
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now,
+			configMap("a", &wfv1.ResourceCleanup{SecondsAfterCompletion: &ten}),
+			configMap("b", &wfv1.ResourceCleanup{SecondsAfterCompletion: &five}),
+		)
+		dueIn, ok := DueIn(wf, now)
+		if assert.True(t, ok) {
+			assert.Equal(t, 5*time.Second, dueIn)
+		}
+	})
+	t.Run("SkipsDone", func(t *testing.T) {
+		deleted := configMap("b", &wfv1.ResourceCleanup{SecondsAfterCompletion: &five})
+		deleted.CleanupPhase = wfv1.ResourceCleanupDeleted
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, configMap("a", &wfv1.ResourceCleanup{SecondsAfterCompletion: &ten}), deleted)
+		dueIn, ok := DueIn(wf, now)
+		if assert.True(t, ok) {
+			assert.Equal(t, 10*time.Second, dueIn)
+		}
+	})
+}
+
+func TestCleanup(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	ten := int32(10)
+	t.Run("Running", func(t *testing.T) {
+		c, _ := newCleaner()
+		wf := newWorkflow(wfv1.WorkflowRunning, time.Time{}, configMap("a", &wfv1.ResourceCleanup{}))
+		assert.False(t, cleanup(t, c, wf, now, false))
+		assert.Empty(t, wf.Status.CreatedResources[0].CleanupPhase)
+	})
+	t.Run("Deleted", func(t *testing.T) {
+		c, client := newCleaner(newObject("ConfigMap", "my-ns", "a", "a-uid"))
+		wf := newWorkflow(wfv1.WorkflowFailed, now, configMap("a", &wfv1.ResourceCleanup{}))
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, wf.Status.CreatedResources[0].CleanupPhase)
+		list, err := client.Resource(configMapGVR).Namespace("my-ns").List(ctx, metav1.ListOptions{})
+		if assert.NoError(t, err) {
+			assert.Empty(t, list.Items)
+		}
+	})
+	t.Run("NotInNamespace", func(t *testing.T) {
+		c, client := newCleaner(newObject("ConfigMap", "my-other-ns", "a", "a-uid"), newObject("Namespace", "", "my-other-ns", "my-other-ns-uid"))
+		other := configMap("a", &wfv1.ResourceCleanup{})
+		other.Namespace = "my-other-ns"
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now,
+			other,
+			wfv1.CreatedResource{APIVersion: "v1", Kind: "Namespace", Name: "my-other-ns", UID: "my-other-ns-uid", Cleanup: &wfv1.ResourceCleanup{}, ServiceAccountName: "my-sa"},
+		)
+		assert.True(t, cleanup(t, c, wf, now, false))
+		for _, r := range wf.Status.CreatedResources {
+			assert.Equal(t, wfv1.ResourceCleanupFailed, r.CleanupPhase)
+			assert.Contains(t, r.Message, "workflow's namespace")
+		}
+		_, err := client.Resource(configMapGVR).Namespace("my-other-ns").Get(ctx, "a", metav1.GetOptions{})
+		assert.NoError(t, err)
+		_, err = client.Resource(namespaceGVR).Get(ctx, "my-other-ns", metav1.GetOptions{})
+		assert.NoError(t, err)
+	})
+	t.Run("ClusterScoped", func(t *testing.T) {
+		c, client := newCleaner(newObject("Namespace", "", "my-other-ns", "my-other-ns-uid"))
+		r := configMap("my-other-ns", &wfv1.ResourceCleanup{})
+		r.Kind = "Namespace"
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, r)
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupFailed, wf.Status.CreatedResources[0].CleanupPhase)
+		assert.Contains(t, wf.Status.CreatedResources[0].Message, "not namespaced")
+		_, err := client.Resource(namespaceGVR).Get(ctx, "my-other-ns", metav1.GetOptions{})
+		assert.NoError(t, err)
+	})
+	t.Run("UnknownServiceAccount", func(t *testing.T) {
+		c, client := newCleaner(newObject("ConfigMap", "my-ns", "a", "a-uid"))
+		r := configMap("a", &wfv1.ResourceCleanup{})
+		r.ServiceAccountName = ""
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, r)
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupFailed, wf.Status.CreatedResources[0].CleanupPhase)
+		_, err := client.Resource(configMapGVR).Namespace("my-ns").Get(ctx, "a", metav1.GetOptions{})
+		assert.NoError(t, err)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		c, _ := newCleaner()
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, configMap("a", &wfv1.ResourceCleanup{}))
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, wf.Status.CreatedResources[0].CleanupPhase)
+	})
+	t.Run("UnknownKind", func(t *testing.T) {
+		c, _ := newCleaner()
+		r := configMap("a", &wfv1.ResourceCleanup{})
+		r.Kind = "Unknown"
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, r)
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, wf.Status.CreatedResources[0].CleanupPhase)
+	})
+	t.Run("InvalidAPIVersion", func(t *testing.T) {
+		c, _ := newCleaner()
+		r := configMap("a", &wfv1.ResourceCleanup{})
+		r.APIVersion = "a/b/c"
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, r)
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupFailed, wf.Status.CreatedResources[0].CleanupPhase)
+		assert.NotEmpty(t, wf.Status.CreatedResources[0].Message)
+	})
+	t.Run("Forbidden", func(t *testing.T) {
+		c, client := newCleaner(newObject("ConfigMap", "my-ns", "a", "a-uid"))
+		client.PrependReactor("delete", "configmaps", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierr.NewForbidden(configMapGVR.GroupResource(), "a", errors.New("no RBAC"))
+		})
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, configMap("a", &wfv1.ResourceCleanup{}))
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupFailed, wf.Status.CreatedResources[0].CleanupPhase)
+		assert.Contains(t, wf.Status.CreatedResources[0].Message, "forbidden")
+	})
+	t.Run("Transient", func(t *testing.T) {
+		c, client := newCleaner(newObject("ConfigMap", "my-ns", "a", "a-uid"))
+		attempts := 0
+		client.PrependReactor("delete", "configmaps", func(k8stesting.Action) (bool, runtime.Object, error) {
+			attempts++
+			if attempts <= 2 {
+				return true, nil, apierr.NewServiceUnavailable("try again")
+			}
+			return false, nil, nil
+		})
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, configMap("a", &wfv1.ResourceCleanup{}))
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, wf.Status.CreatedResources[0].CleanupPhase)
+		assert.Equal(t, 3, attempts)
+	})
+	t.Run("Retried", func(t *testing.T) {
+		c, client := newCleaner(newObject("ConfigMap", "my-ns", "a", "a-uid"))
+		failing := true
+		client.PrependReactor("delete", "configmaps", func(k8stesting.Action) (bool, runtime.Object, error) {
+			if failing {
+				return true, nil, apierr.NewInternalError(errors.New("etcd unavailable"))
+			}
+			return false, nil, nil
+		})
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, configMap("a", &wfv1.ResourceCleanup{}))
+		updated, err := c.Cleanup(ctx, wf, now, false)
+		assert.True(t, updated)
+		assert.Error(t, err)
+		assert.Equal(t, wfv1.ResourceCleanupPending, wf.Status.CreatedResources[0].CleanupPhase)
+		assert.Contains(t, wf.Status.CreatedResources[0].Message, "etcd unavailable")
+
+		updated, err = c.Cleanup(ctx, wf, now, false)
+		assert.False(t, updated, "the same error is not recorded again")
+		assert.Error(t, err)
+
+		failing = false
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, wf.Status.CreatedResources[0].CleanupPhase)
+		assert.Empty(t, wf.Status.CreatedResources[0].Message)
+	})
+	t.Run("Retained", func(t *testing.T) {
+		c, client := newCleaner(newObject("ConfigMap", "my-ns", "a", "a-uid"))
+		wf := newWorkflow(wfv1.WorkflowFailed, now, configMap("a", &wfv1.ResourceCleanup{Strategy: wfv1.ResourceCleanupOnWorkflowSuccess}))
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupRetained, wf.Status.CreatedResources[0].CleanupPhase)
+		_, err := client.Resource(configMapGVR).Namespace("my-ns").Get(ctx, "a", metav1.GetOptions{})
+		assert.NoError(t, err)
+	})
+	t.Run("Delayed", func(t *testing.T) {
+		c, client := newCleaner(newObject("ConfigMap", "my-ns", "a", "a-uid"))
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, configMap("a", &wfv1.ResourceCleanup{SecondsAfterCompletion: &ten}))
+		assert.True(t, cleanup(t, c, wf, now, false))
+		assert.Equal(t, wfv1.ResourceCleanupPending, wf.Status.CreatedResources[0].CleanupPhase)
+		assert.False(t, cleanup(t, c, wf, now, false), "pending resources are not updated again")
+		_, err := client.Resource(configMapGVR).Namespace("my-ns").Get(ctx, "a", metav1.GetOptions{})
+		assert.NoError(t, err)
+
+		assert.True(t, cleanup(t, c, wf, now.Add(10*time.Second), false))
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, wf.Status.CreatedResources[0].CleanupPhase)
+	})
+	t.Run("Force", func(t *testing.T) {
+		c, _ := newCleaner(newObject("ConfigMap", "my-ns", "a", "a-uid"))
+		wf := newWorkflow(wfv1.WorkflowSucceeded, now, configMap("a", &wfv1.ResourceCleanup{SecondsAfterCompletion: &ten}))
+		assert.True(t, cleanup(t, c, wf, now, true))
+		assert.Equal(t, wfv1.ResourceCleanupDeleted, wf.Status.CreatedResources[0].CleanupPhase)
+	})
+}
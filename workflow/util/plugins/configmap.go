@@ -16,9 +16,19 @@ func ToConfigMap(p *spec.Plugin) (*apiv1.ConfigMap, error) {
 	if err := p.Validate(); err != nil {
 		return nil, err
 	}
-	data, err := yaml.Marshal(p.Spec.Sidecar.Container)
-	if err != nil {
-		return nil, err
+	data := map[string]string{}
+	if p.Spec.Service != nil {
+		service, err := yaml.Marshal(p.Spec.Service)
+		if err != nil {
+			return nil, err
+		}
+		data["service"] = string(service)
+	} else {
+		container, err := yaml.Marshal(p.Spec.Sidecar.Container)
+		if err != nil {
+			return nil, err
+		}
+		data["sidecar.container"] = string(container)
 	}
 	cm := &apiv1.ConfigMap{
 		TypeMeta: metav1.TypeMeta{
@@ -32,9 +42,7 @@ func ToConfigMap(p *spec.Plugin) (*apiv1.ConfigMap, error) {
 				common.LabelKeyConfigMapType: p.Kind,
 			},
 		},
-		Data: map[string]string{
-			"sidecar.container": string(data),
-		},
+		Data: data,
 	}
 	for k, v := range p.Annotations {
 		cm.Annotations[k] = v
@@ -63,8 +71,93 @@ func FromConfigMap(cm *apiv1.ConfigMap) (*spec.Plugin, error) {
 		p.Labels[k] = v
 	}
 	delete(p.Labels, common.LabelKeyConfigMapType)
-	if err := yaml.UnmarshalStrict([]byte(cm.Data["sidecar.container"]), &p.Spec.Sidecar.Container); err != nil {
+	if service, ok := cm.Data["service"]; ok {
+		p.Spec.Service = &spec.Service{}
+		if err := yaml.UnmarshalStrict([]byte(service), p.Spec.Service); err != nil {
+			return nil, err
+		}
+	} else if err := yaml.UnmarshalStrict([]byte(cm.Data["sidecar.container"]), &p.Spec.Sidecar.Container); err != nil {
 		return nil, err
 	}
 	return p, p.Validate()
 }
+
+// ArtifactPluginConfigMapName returns the name of the config map of an artifact plugin.
+func ArtifactPluginConfigMapName(name string) string {
+	return name + "-artifact-plugin"
+}
+
+func ArtifactPluginToConfigMap(p *spec.ArtifactPlugin) (*apiv1.ConfigMap, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	data := map[string]string{}
+	if p.Spec.Binary != nil {
+		binary, err := yaml.Marshal(p.Spec.Binary)
+		if err != nil {
+			return nil, err
+		}
+		data["binary"] = string(binary)
+	}
+	if p.Spec.Service != nil {
+		service, err := yaml.Marshal(p.Spec.Service)
+		if err != nil {
+			return nil, err
+		}
+		data["service"] = string(service)
+	}
+	cm := &apiv1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ArtifactPluginConfigMapName(p.Name),
+			Annotations: map[string]string{},
+			Labels: map[string]string{
+				common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapArtifactPlugin,
+			},
+		},
+		Data: data,
+	}
+	for k, v := range p.Annotations {
+		cm.Annotations[k] = v
+	}
+	for k, v := range p.Labels {
+		cm.Labels[k] = v
+	}
+	return cm, nil
+}
+
+func ArtifactPluginFromConfigMap(cm *apiv1.ConfigMap) (*spec.ArtifactPlugin, error) {
+	p := &spec.ArtifactPlugin{
+		TypeMeta: metav1.TypeMeta{
+			Kind: cm.Labels[common.LabelKeyConfigMapType],
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        strings.TrimSuffix(cm.Name, "-artifact-plugin"),
+			Annotations: map[string]string{},
+			Labels:      map[string]string{},
+		},
+	}
+	for k, v := range cm.Annotations {
+		p.Annotations[k] = v
+	}
+	for k, v := range cm.Labels {
+		p.Labels[k] = v
+	}
+	delete(p.Labels, common.LabelKeyConfigMapType)
+	if binary, ok := cm.Data["binary"]; ok {
+		p.Spec.Binary = &spec.Binary{}
+		if err := yaml.UnmarshalStrict([]byte(binary), p.Spec.Binary); err != nil {
+			return nil, err
+		}
+	}
+	if service, ok := cm.Data["service"]; ok {
+		p.Spec.Service = &spec.Service{}
+		if err := yaml.UnmarshalStrict([]byte(service), p.Spec.Service); err != nil {
+			return nil, err
+		}
+	}
+	return p, p.Validate()
+}
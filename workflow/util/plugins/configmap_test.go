@@ -97,3 +97,59 @@ func TestFromConfigMap(t *testing.T) {
 		}
 	})
 }
+
+func TestServiceConfigMap(t *testing.T) {
+	cm, err := ToConfigMap(&spec.Plugin{
+		TypeMeta:   metav1.TypeMeta{Kind: common.LabelValueTypeConfigMapExecutorPlugin},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-plug"},
+		Spec: spec.PluginSpec{
+			Service: &spec.Service{Address: "http://my-svc:4355", Parallelism: 2},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string]string{
+			"service": "address: http://my-svc:4355\nparallelism: 2\n",
+		}, cm.Data)
+		p, err := FromConfigMap(cm)
+		if assert.NoError(t, err) {
+			assert.Equal(t, &spec.Service{Address: "http://my-svc:4355", Parallelism: 2}, p.Spec.Service)
+		}
+	}
+}
+
+func TestArtifactPluginConfigMap(t *testing.T) {
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ArtifactPluginToConfigMap(&spec.ArtifactPlugin{})
+		assert.EqualError(t, err, "at least one of binary or service must be specified")
+		_, err = ArtifactPluginFromConfigMap(&apiv1.ConfigMap{Data: map[string]string{"binary": "image: my-image"}})
+		assert.EqualError(t, err, `binary is invalid: path "" must be absolute`)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cm, err := ArtifactPluginToConfigMap(&spec.ArtifactPlugin{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-plug", Labels: map[string]string{"my-label": "my-value"}},
+			Spec: spec.ArtifactPluginSpec{
+				Binary:  &spec.Binary{Image: "my-image", Path: "/bin/my-plug"},
+				Service: &spec.Service{Address: "http://my-svc:4355"},
+			},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "my-plug-artifact-plugin", cm.Name)
+			assert.Equal(t, map[string]string{
+				"my-label":                             "my-value",
+				"workflows.argoproj.io/configmap-type": "ArtifactPlugin",
+			}, cm.Labels)
+			assert.Equal(t, map[string]string{
+				"binary":  "image: my-image\npath: /bin/my-plug\n",
+				"service": "address: http://my-svc:4355\n",
+			}, cm.Data)
+			p, err := ArtifactPluginFromConfigMap(cm)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "ArtifactPlugin", p.Kind)
+				assert.Equal(t, "my-plug", p.Name)
+				assert.Equal(t, map[string]string{"my-label": "my-value"}, p.Labels)
+				assert.Equal(t, &spec.Binary{Image: "my-image", Path: "/bin/my-plug"}, p.Spec.Binary)
+				assert.Equal(t, &spec.Service{Address: "http://my-svc:4355"}, p.Spec.Service)
+			}
+		}
+	})
+}
@@ -22,6 +22,8 @@ type Client struct {
 	client  http.Client
 	invalid map[string]bool
 	backoff wait.Backoff
+	token   string
+	sema    chan struct{}
 }
 
 func New(address string, timeout time.Duration, backoff wait.Backoff) Client {
@@ -35,6 +37,43 @@ func New(address string, timeout time.Duration, backoff wait.Backoff) Client {
 	}
 }
 
+// Authorize sets a bearer token that is sent with every call.
+func (p *Client) Authorize(token string) {
+	p.token = token
+}
+
+// Limit limits the number of concurrent calls, zero means unlimited.
+func (p *Client) Limit(parallelism int) {
+	if parallelism > 0 {
+		p.sema = make(chan struct{}, parallelism)
+	}
+}
+
+// Healthy returns an error unless a GET of the path returns 200.
+func (p *Client) Healthy(ctx context.Context, path string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", p.Address+path, nil)
+	if err != nil {
+		return err
+	}
+	p.authorize(req)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("health check returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (p *Client) authorize(req *http.Request) {
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+}
+
 func (p *Client) Call(ctx context.Context, method string, args interface{}, reply interface{}) error {
 	if p.invalid[method] {
 		return nil
@@ -44,6 +83,14 @@ func (p *Client) Call(ctx context.Context, method string, args interface{}, repl
 	if err != nil {
 		return err
 	}
+	if p.sema != nil {
+		select {
+		case p.sema <- struct{}{}:
+			defer func() { <-p.sema }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 	return retry.OnError(p.backoff, func(err error) bool {
 		log.WithError(err).Debug("Plugin returned error")
 		switch e := err.(type) {
@@ -59,6 +106,7 @@ func (p *Client) Call(ctx context.Context, method string, args interface{}, repl
 		if err != nil {
 			return err
 		}
+		p.authorize(req)
 		resp, err := p.client.Do(req)
 		if err != nil {
 			return err
@@ -44,6 +44,7 @@ import (
 	cmdutil "github.com/argoproj/argo-workflows/v3/util/cmd"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/util/retry"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
 	unstructutil "github.com/argoproj/argo-workflows/v3/util/unstructured"
 	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
@@ -608,7 +609,8 @@ func RandSuffix() string {
 }
 
 // FormulateResubmitWorkflow formulate a new workflow from a previous workflow, optionally re-using successful nodes
-func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow, error) {
+// whose inputs are not changed by the parameters
+func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool, parameters []string) (*wfv1.Workflow, error) {
 	newWF := wfv1.Workflow{}
 	newWF.TypeMeta = wf.TypeMeta
 
@@ -640,6 +642,11 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 
 	newWF.Spec.Shutdown = ""
 
+	changedParameters, err := overrideParameters(&newWF, parameters)
+	if err != nil {
+		return nil, err
+	}
+
 	// carry over user labels and annotations from previous workflow.
 	if newWF.ObjectMeta.Labels == nil {
 		newWF.ObjectMeta.Labels = make(map[string]string)
@@ -673,13 +680,21 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 	replaceRegexp := regexp.MustCompile("^" + wf.ObjectMeta.Name)
 	newWF.Status.Nodes = make(map[string]wfv1.NodeStatus)
 	onExitNodeName := wf.ObjectMeta.Name + ".onExit"
-	err := packer.DecompressWorkflow(wf)
+	err = packer.DecompressWorkflow(wf)
 	if err != nil {
 		log.Fatal(err)
 	}
+	// nodes affected by the changed parameters are run again
+	nodeIDsToReset := make(map[string]bool)
+	for _, nodeID := range getNodeIDsAffectedByParameters(wf, changedParameters) {
+		addNodeAndChildren(nodeIDsToReset, wf.Status.Nodes, nodeID)
+	}
+	for _, nodeID := range getNodeIDsWithDeletedArtifacts(wf.Status.Nodes) {
+		addNodeAndChildren(nodeIDsToReset, wf.Status.Nodes, nodeID)
+	}
 	for _, node := range wf.Status.Nodes {
 		newNode := node.DeepCopy()
-		if strings.HasPrefix(node.Name, onExitNodeName) {
+		if strings.HasPrefix(node.Name, onExitNodeName) || nodeIDsToReset[node.ID] {
 			continue
 		}
 		originalID := node.ID
@@ -695,14 +710,18 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 			newNode.StartedAt = metav1.Time{Time: time.Now().UTC()}
 			newNode.FinishedAt = newNode.StartedAt
 		}
-		newChildren := make([]string, len(node.Children))
-		for i, childID := range node.Children {
-			newChildren[i] = convertNodeID(&newWF, replaceRegexp, childID, wf.Status.Nodes)
+		var newChildren []string
+		for _, childID := range node.Children {
+			if !nodeIDsToReset[childID] {
+				newChildren = append(newChildren, convertNodeID(&newWF, replaceRegexp, childID, wf.Status.Nodes))
+			}
 		}
 		newNode.Children = newChildren
-		newOutboundNodes := make([]string, len(node.OutboundNodes))
-		for i, outboundID := range node.OutboundNodes {
-			newOutboundNodes[i] = convertNodeID(&newWF, replaceRegexp, outboundID, wf.Status.Nodes)
+		var newOutboundNodes []string
+		for _, outboundID := range node.OutboundNodes {
+			if !nodeIDsToReset[outboundID] {
+				newOutboundNodes = append(newOutboundNodes, convertNodeID(&newWF, replaceRegexp, outboundID, wf.Status.Nodes))
+			}
 		}
 		newNode.OutboundNodes = newOutboundNodes
 		if !newNode.FailedOrError() && newNode.Type == wfv1.NodeTypePod {
@@ -734,12 +753,13 @@ func convertNodeID(newWf *wfv1.Workflow, regex *regexp.Regexp, oldNodeID string,
 	return newWf.NodeID(newNodeName)
 }
 
-// RetryWorkflow updates a workflow, deleting all failed steps as well as the onExit node (and children)
-func RetryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, error) {
+// RetryWorkflow updates a workflow, deleting all failed steps as well as the onExit node (and children), and the
+// steps whose inputs are changed by the parameters
+func RetryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool, nodeFieldSelector string, parameters []string) (*wfv1.Workflow, error) {
 	var updated *wfv1.Workflow
 	err := waitutil.Backoff(retry.DefaultRetry, func() (bool, error) {
 		var err error
-		updated, err = retryWorkflow(ctx, kubeClient, hydrator, wfClient, name, restartSuccessful, nodeFieldSelector)
+		updated, err = retryWorkflow(ctx, kubeClient, hydrator, wfClient, name, restartSuccessful, nodeFieldSelector, parameters)
 		return !errorsutil.IsTransientErr(err), err
 	})
 	if err != nil {
@@ -748,7 +768,7 @@ func RetryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 	return updated, err
 }
 
-func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool, nodeFieldSelector string) (*wfv1.Workflow, error) {
+func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrator hydrator.Interface, wfClient v1alpha1.WorkflowInterface, name string, restartSuccessful bool, nodeFieldSelector string, parameters []string) (*wfv1.Workflow, error) {
 	wf, err := wfClient.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -763,34 +783,79 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		return nil, err
 	}
 
-	newWF := wf.DeepCopy()
+	newWF, podsToDelete, err := FormulateRetryWorkflow(wf, restartSuccessful, nodeFieldSelector, parameters)
+	if err != nil {
+		return nil, err
+	}
+
 	podIf := kubeClient.CoreV1().Pods(wf.ObjectMeta.Namespace)
+	for _, podName := range podsToDelete {
+		log.Infof("Deleting pod: %s", podName)
+		err := podIf.Delete(ctx, podName, metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return nil, errors.InternalWrapError(err)
+		}
+	}
+
+	err = hydrator.Dehydrate(newWF)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
+	}
+
+	return wfClient.Update(ctx, newWF, metav1.UpdateOptions{})
+}
+
+// FormulateRetryWorkflow returns a copy of a hydrated workflow that is reset to run again, keeping its successful nodes
+// but none of its failed nodes or its onExit node (and children), and the names of the pods of the nodes that were
+// reset, which must be deleted. Successful nodes whose inputs are changed by the parameters are reset too.
+func FormulateRetryWorkflow(wf *wfv1.Workflow, restartSuccessful bool, nodeFieldSelector string, parameters []string) (*wfv1.Workflow, []string, error) {
+	newWF := wf.DeepCopy()
 
 	// Delete/reset fields which indicate workflow completed
 	delete(newWF.Labels, common.LabelKeyCompleted)
 	delete(newWF.Labels, common.LabelKeyWorkflowArchivingStatus)
 	newWF.Status.Conditions.UpsertCondition(wfv1.Condition{Status: metav1.ConditionFalse, Type: wfv1.ConditionTypeCompleted})
+	if newWF.ObjectMeta.Labels == nil {
+		newWF.ObjectMeta.Labels = map[string]string{}
+	}
 	newWF.ObjectMeta.Labels[common.LabelKeyPhase] = string(wfv1.NodeRunning)
 	newWF.Status.Phase = wfv1.WorkflowRunning
 	newWF.Status.Nodes = make(wfv1.Nodes)
 	newWF.Status.Message = ""
 	newWF.Status.StartedAt = metav1.Time{Time: time.Now().UTC()}
 	newWF.Status.FinishedAt = metav1.Time{}
+	// the artifacts of the nodes that run again are garbage collected once the workflow completes again
+	newWF.Status.ArtifactGCStatus = nil
 	newWF.Spec.Shutdown = ""
 	if newWF.Spec.ActiveDeadlineSeconds != nil && *newWF.Spec.ActiveDeadlineSeconds == 0 {
 		// if it was terminated, unset the deadline
 		newWF.Spec.ActiveDeadlineSeconds = nil
 	}
 
+	changedParameters, err := overrideParameters(newWF, parameters)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	onExitNodeName := wf.ObjectMeta.Name + ".onExit"
 	// Get all children of nodes that match filter
 	nodeIDsToReset, err := getNodeIDsToReset(restartSuccessful, nodeFieldSelector, wf.Status.Nodes)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	for _, nodeID := range getNodeIDsAffectedByParameters(wf, changedParameters) {
+		addNodeAndChildren(nodeIDsToReset, wf.Status.Nodes, nodeID)
+	}
+	// nodes whose output artifacts were garbage collected must run again to produce them
+	for _, nodeID := range getNodeIDsWithDeletedArtifacts(wf.Status.Nodes) {
+		addNodeAndChildren(nodeIDsToReset, wf.Status.Nodes, nodeID)
 	}
+	// successful steps and DAGs that contain a reset node must run again
+	nodeIDsToRerun := getAncestorNodeIDs(wf.Status.Nodes, nodeIDsToReset)
 
 	// Iterate the previous nodes. If it was successful Pod carry it forward
 	deletedNodes := make(map[string]bool)
+	var podsToDelete []string
 	for _, node := range wf.Status.Nodes {
 		doForceResetNode := false
 		if _, present := nodeIDsToReset[node.ID]; present {
@@ -800,9 +865,18 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		switch node.Phase {
 		case wfv1.NodeSucceeded, wfv1.NodeSkipped:
 			if !strings.HasPrefix(node.Name, onExitNodeName) && !doForceResetNode {
-				newWF.Status.Nodes[node.ID] = node
+				if nodeIDsToRerun[node.ID] {
+					newNode := node.DeepCopy()
+					newNode.Phase = wfv1.NodeRunning
+					newNode.Message = ""
+					newNode.FinishedAt = metav1.Time{}
+					newWF.Status.Nodes[newNode.ID] = *newNode
+				} else {
+					newWF.Status.Nodes[node.ID] = node
+				}
 				continue
 			}
+			deletedNodes[node.ID] = true
 		case wfv1.NodeError, wfv1.NodeFailed, wfv1.NodeOmitted:
 			if !strings.HasPrefix(node.Name, onExitNodeName) && (node.Type == wfv1.NodeTypeDAG || node.Type == wfv1.NodeTypeTaskGroup || node.Type == wfv1.NodeTypeStepGroup) {
 				newNode := node.DeepCopy()
@@ -818,17 +892,12 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 			// do not add this status to the node. pretend as if this node never existed.
 		default:
 			// Do not allow retry of workflows with pods in Running/Pending phase
-			return nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
+			return nil, nil, errors.InternalErrorf("Workflow cannot be retried with node %s in %s phase", node.Name, node.Phase)
 		}
 		if node.Type == wfv1.NodeTypePod {
 			templateName := getTemplateFromNode(node)
 			version := GetWorkflowPodNameVersion(wf)
-			podName := PodName(wf.Name, node.Name, templateName, node.ID, version)
-			log.Infof("Deleting pod: %s", podName)
-			err := podIf.Delete(ctx, podName, metav1.DeleteOptions{})
-			if err != nil && !apierr.IsNotFound(err) {
-				return nil, errors.InternalWrapError(err)
-			}
+			podsToDelete = append(podsToDelete, PodName(wf.Name, node.Name, templateName, node.ID, version))
 		} else if node.Name == wf.ObjectMeta.Name {
 			newNode := node.DeepCopy()
 			newNode.Phase = wfv1.NodeRunning
@@ -862,17 +931,12 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 		}
 	}
 
-	err = hydrator.Dehydrate(newWF)
-	if err != nil {
-		return nil, fmt.Errorf("unable to compress or offload workflow nodes: %s", err)
-	}
-
 	newWF.Status.StoredTemplates = make(map[string]wfv1.Template)
 	for id, tmpl := range wf.Status.StoredTemplates {
 		newWF.Status.StoredTemplates[id] = tmpl
 	}
 
-	return wfClient.Update(ctx, newWF, metav1.UpdateOptions{})
+	return newWF, podsToDelete, nil
 }
 
 func getTemplateFromNode(node wfv1.NodeStatus) string {
@@ -894,25 +958,221 @@ func getNodeIDsToReset(restartSuccessful bool, nodeFieldSelector string, nodes w
 	} else {
 		for _, node := range nodes {
 			if SelectorMatchesNode(selector, node) {
-				// traverse all children of the node
-				var queue []string
-				queue = append(queue, node.ID)
-
-				for len(queue) > 0 {
-					childNode := queue[0]
-					// if the child isn't already in nodeIDsToReset then we add it and traverse its children
-					if _, present := nodeIDsToReset[childNode]; !present {
-						nodeIDsToReset[childNode] = true
-						queue = append(queue, nodes[childNode].Children...)
-					}
-					queue = queue[1:]
-				}
+				addNodeAndChildren(nodeIDsToReset, nodes, node.ID)
 			}
 		}
 	}
 	return nodeIDsToReset, nil
 }
 
+// getNodeIDsWithDeletedArtifacts returns the IDs of the nodes with an output artifact that has been deleted by
+// artifact garbage collection
+func getNodeIDsWithDeletedArtifacts(nodes wfv1.Nodes) []string {
+	var nodeIDs []string
+	for _, node := range nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			if art.Deleted {
+				nodeIDs = append(nodeIDs, node.ID)
+				break
+			}
+		}
+	}
+	return nodeIDs
+}
+
+// addNodeAndChildren adds the node, and all of its children, to the node IDs
+func addNodeAndChildren(nodeIDs map[string]bool, nodes wfv1.Nodes, nodeID string) {
+	queue := []string{nodeID}
+	for len(queue) > 0 {
+		childNode := queue[0]
+		// if the child isn't already in nodeIDs then we add it and traverse its children
+		if _, present := nodeIDs[childNode]; !present {
+			nodeIDs[childNode] = true
+			queue = append(queue, nodes[childNode].Children...)
+		}
+		queue = queue[1:]
+	}
+}
+
+// getAncestorNodeIDs returns the IDs of the steps, DAGs, step groups and task groups that contain any of the nodes
+func getAncestorNodeIDs(nodes wfv1.Nodes, nodeIDs map[string]bool) map[string]bool {
+	ancestors := make(map[string]bool)
+	for _, node := range nodes {
+		switch node.Type {
+		case wfv1.NodeTypeStepGroup, wfv1.NodeTypeTaskGroup:
+			for _, childID := range node.Children {
+				if nodeIDs[childID] {
+					ancestors[node.ID] = true
+				}
+			}
+		}
+	}
+	for nodeID := range nodeIDs {
+		for boundaryID := nodes[nodeID].BoundaryID; boundaryID != "" && !ancestors[boundaryID]; boundaryID = nodes[boundaryID].BoundaryID {
+			ancestors[boundaryID] = true
+		}
+	}
+	for nodeID := range ancestors {
+		if nodeIDs[nodeID] {
+			delete(ancestors, nodeID)
+		}
+	}
+	return ancestors
+}
+
+// overrideParameters sets the arguments of the workflow to the parameters, of the form NAME=VALUE, and returns the names
+// of the parameters whose values were changed
+func overrideParameters(wf *wfv1.Workflow, parameters []string) ([]string, error) {
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+	wf.Spec.Arguments = *wf.Spec.Arguments.DeepCopy()
+	var changed []string
+	for _, paramStr := range parameters {
+		parts := strings.SplitN(paramStr, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf(errors.CodeBadRequest, "expected parameter of the form: NAME=VALUE. Received: %s", paramStr)
+		}
+		name, value := parts[0], parts[1]
+		previous := wf.Spec.Arguments.GetParameterByName(name)
+		if previous == nil && wf.Status.StoredWorkflowSpec != nil {
+			previous = wf.Status.StoredWorkflowSpec.Arguments.GetParameterByName(name)
+		}
+		if previous != nil && previous.Value != nil && previous.Value.String() == value {
+			continue
+		}
+		setParameter(&wf.Spec.Arguments, name, value)
+		if wf.Status.StoredWorkflowSpec != nil {
+			setParameter(&wf.Status.StoredWorkflowSpec.Arguments, name, value)
+		}
+		changed = append(changed, name)
+	}
+	return changed, nil
+}
+
+func setParameter(args *wfv1.Arguments, name, value string) {
+	for i, param := range args.Parameters {
+		if param.Name == name {
+			args.Parameters[i].Value = wfv1.AnyStringPtr(value)
+			args.Parameters[i].ValueFrom = nil
+			return
+		}
+	}
+	args.Parameters = append(args.Parameters, wfv1.Parameter{Name: name, Value: wfv1.AnyStringPtr(value)})
+}
+
+// getNodeIDsAffectedByParameters returns the IDs of the nodes whose inputs may be changed by the parameters, because
+// their template, or the step or task that runs them, references any of the parameters. The nodes that use the
+// outputs of these nodes are their children, and are not returned.
+func getNodeIDsAffectedByParameters(wf *wfv1.Workflow, names []string) []string {
+	if len(names) == 0 {
+		return nil
+	}
+	// the entrypoint gets its inputs from the arguments of the workflow
+	var inputs []string
+	if root, ok := wf.Status.Nodes[wf.NodeID(wf.Name)]; ok {
+		if tmpl := getNodeTemplate(wf, root); tmpl != nil {
+			for _, name := range names {
+				if tmpl.Inputs.GetParameterByName(name) != nil {
+					inputs = append(inputs, name)
+				}
+			}
+		}
+	}
+	var nodeIDs []string
+	for _, node := range wf.Status.Nodes {
+		affected := false
+		switch node.Type {
+		case wfv1.NodeTypeSteps, wfv1.NodeTypeDAG, wfv1.NodeTypeStepGroup, wfv1.NodeTypeTaskGroup:
+			// the steps or tasks that they run are checked instead
+		default:
+			affected = referencesParameters("workflow", names, getNodeTemplate(wf, node))
+		}
+		if step := getInvokingStep(wf, node); step != nil {
+			affected = affected || referencesParameters("workflow", names, step) ||
+				(len(inputs) > 0 && node.BoundaryID == wf.NodeID(wf.Name) && referencesParameters("inputs", inputs, step))
+		}
+		if affected {
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+	}
+	return nodeIDs
+}
+
+// parameterReference matches a reference to a parameter, e.g. "workflow.parameters.name" or
+// "inputs.parameters['name']", or to the parameters as a whole, e.g. "workflow.parameters" or
+// "workflow.parameters[key]" in an expression
+var parameterReference = regexp.MustCompile(`(^|[^\w.])(workflow|inputs)\.parameters(\.([\w-]+)|\[\\?['"]([\w-]+)\\?['"]\])?`)
+
+// referencesParameters returns whether v references any of the named parameters of the scope, e.g. "workflow". A
+// reference to the parameters as a whole, or one whose name is computed by an expression, references all of them.
+func referencesParameters(scope string, names []string, v interface{}) bool {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	for _, match := range parameterReference.FindAllSubmatch(data, -1) {
+		if string(match[2]) != scope {
+			continue
+		}
+		name := string(match[4]) + string(match[5])
+		if name == "" || slice.ContainsString(names, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// getNodeTemplate returns the template of the node, or nil if it cannot be found
+func getNodeTemplate(wf *wfv1.Workflow, node wfv1.NodeStatus) *wfv1.Template {
+	scope, resourceName := node.GetTemplateScope()
+	if tmpl := wf.GetStoredTemplate(scope, resourceName, &node); tmpl != nil {
+		return tmpl
+	}
+	if node.TemplateName == "" {
+		return nil
+	}
+	return wf.GetTemplateByName(node.TemplateName)
+}
+
+// getInvokingStep returns the step or DAG task that runs the node, or nil if there is none. The step is found by the
+// name of the node, which is the name of its boundary node followed by that of the step, e.g. "wf[0].step" or
+// "wf.task", because the display names of nodes are not unique.
+func getInvokingStep(wf *wfv1.Workflow, node wfv1.NodeStatus) interface{} {
+	boundary, ok := wf.Status.Nodes[node.BoundaryID]
+	if !ok {
+		return nil
+	}
+	tmpl := getNodeTemplate(wf, boundary)
+	if tmpl == nil {
+		return nil
+	}
+	for i, group := range tmpl.Steps {
+		for _, step := range group.Steps {
+			if isNodeOf(node.Name, fmt.Sprintf("%s[%d].%s", boundary.Name, i, step.Name)) {
+				return &step
+			}
+		}
+	}
+	if tmpl.DAG != nil {
+		for _, task := range tmpl.DAG.Tasks {
+			if isNodeOf(node.Name, fmt.Sprintf("%s.%s", boundary.Name, task.Name)) {
+				return &task
+			}
+		}
+	}
+	return nil
+}
+
+// isNodeOf returns whether the node is the one named after a step or task, or one of its expansions or retries,
+// e.g. "wf[0].step(0:item)" or "wf[0].step(1)"
+func isNodeOf(nodeName, name string) bool {
+	return nodeName == name || strings.HasPrefix(nodeName, name+"(")
+}
+
 var errSuspendedCompletedWorkflow = errors.Errorf(errors.CodeBadRequest, "cannot suspend completed workflows")
 
 // IsWorkflowSuspended returns whether or not a workflow is considered suspended
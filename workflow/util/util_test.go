@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -68,7 +69,7 @@ func TestResubmitWorkflowWithOnExit(t *testing.T) {
 		Name:  onExitName,
 		Phase: wfv1.NodeSucceeded,
 	}
-	newWF, err := FormulateResubmitWorkflow(&wf, true)
+	newWF, err := FormulateResubmitWorkflow(&wf, true, nil)
 	assert.NoError(t, err)
 	newWFOnExitName := newWF.ObjectMeta.Name + ".onExit"
 	newWFOneExitID := newWF.NodeID(newWFOnExitName)
@@ -642,7 +643,7 @@ func TestFormulateResubmitWorkflow(t *testing.T) {
 				},
 			},
 		}
-		wf, err := FormulateResubmitWorkflow(wf, false)
+		wf, err := FormulateResubmitWorkflow(wf, false, nil)
 		if assert.NoError(t, err) {
 			assert.Contains(t, wf.GetLabels(), common.LabelKeyControllerInstanceID)
 			assert.Contains(t, wf.GetLabels(), common.LabelKeyClusterWorkflowTemplate)
@@ -819,7 +820,7 @@ func TestDeepDeleteNodes(t *testing.T) {
 	ctx := context.Background()
 	wf, err := wfIf.Create(ctx, origWf, metav1.CreateOptions{})
 	if assert.NoError(t, err) {
-		newWf, err := RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfIf, wf.Name, false, "")
+		newWf, err := RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfIf, wf.Name, false, "", nil)
 		assert.NoError(t, err)
 		newWfBytes, err := yaml.Marshal(newWf)
 		assert.NoError(t, err)
@@ -853,7 +854,7 @@ func TestRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		assert.NoError(t, err)
-		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "")
+		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", nil)
 		if assert.NoError(t, err) {
 			assert.Equal(t, wfv1.WorkflowRunning, wf.Status.Phase)
 			assert.Equal(t, metav1.Time{}, wf.Status.FinishedAt)
@@ -890,7 +891,7 @@ func TestRetryWorkflow(t *testing.T) {
 		}
 		_, err := wfClient.Create(ctx, wf, metav1.CreateOptions{})
 		assert.NoError(t, err)
-		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "")
+		wf, err = RetryWorkflow(ctx, kubeClient, hydratorfake.Noop, wfClient, wf.Name, false, "", nil)
 		if assert.NoError(t, err) {
 			if assert.Len(t, wf.Status.Nodes, 1) {
 				assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes[""].Phase)
@@ -963,3 +964,355 @@ func TestGetTemplateFromNode(t *testing.T) {
 		assert.Equal(t, tc.expectedTemplateName, actual)
 	}
 }
+
+var parametersWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: params
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: x
+        value: "1"
+      - name: y
+        value: "1"
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: echo
+            arguments:
+              parameters:
+                - name: message
+                  value: "{{workflow.parameters.x}}"
+          - name: b
+            template: echo
+            arguments:
+              parameters:
+                - name: message
+                  value: hello
+        - - name: c
+            template: fail
+    - name: echo
+      inputs:
+        parameters:
+          - name: message
+      container:
+        image: argoproj/argosay:v2
+    - name: fail
+      container:
+        image: argoproj/argosay:v2
+status:
+  phase: Failed
+  nodes:
+    params:
+      id: params
+      name: params
+      displayName: params
+      type: Steps
+      templateName: main
+      phase: Failed
+      children: [params-1]
+    params-1:
+      id: params-1
+      name: params[0]
+      displayName: "[0]"
+      type: StepGroup
+      templateName: main
+      boundaryID: params
+      phase: Succeeded
+      children: [params-a, params-b]
+    params-a:
+      id: params-a
+      name: params[0].a
+      displayName: a
+      type: Pod
+      templateName: echo
+      boundaryID: params
+      phase: Succeeded
+      children: [params-2]
+    params-b:
+      id: params-b
+      name: params[0].b
+      displayName: b
+      type: Pod
+      templateName: echo
+      boundaryID: params
+      phase: Succeeded
+      children: [params-2]
+    params-2:
+      id: params-2
+      name: params[1]
+      displayName: "[1]"
+      type: StepGroup
+      templateName: main
+      boundaryID: params
+      phase: Failed
+      children: [params-c]
+    params-c:
+      id: params-c
+      name: params[1].c
+      displayName: c
+      type: Pod
+      templateName: fail
+      boundaryID: params
+      phase: Failed
+`
+
+func TestFormulateRetryWorkflowWithParameters(t *testing.T) {
+	t.Run("Changed", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(parametersWorkflow)
+		newWF, podsToDelete, err := FormulateRetryWorkflow(wf, false, "", []string{"x=2"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "2", newWF.Spec.Arguments.GetParameterByName("x").Value.String())
+			assert.Equal(t, "1", wf.Spec.Arguments.GetParameterByName("x").Value.String(), "the original workflow is not changed")
+			assert.ElementsMatch(t, []string{"params", "params-1", "params-b", "params-2"}, getNodeIDs(newWF))
+			assert.Equal(t, wfv1.NodeRunning, newWF.Status.Nodes["params-1"].Phase)
+			assert.Equal(t, []string{"params-b"}, newWF.Status.Nodes["params-1"].Children)
+			assert.Equal(t, wfv1.NodeSucceeded, newWF.Status.Nodes["params-b"].Phase)
+			assert.ElementsMatch(t, []string{"params-a", "params-c"}, podsToDelete)
+		}
+	})
+	t.Run("Unchanged", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(parametersWorkflow)
+		newWF, _, err := FormulateRetryWorkflow(wf, false, "", []string{"x=1", "y=2"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "2", newWF.Spec.Arguments.GetParameterByName("y").Value.String())
+			assert.ElementsMatch(t, []string{"params", "params-1", "params-a", "params-b", "params-2"}, getNodeIDs(newWF))
+			assert.Equal(t, wfv1.NodeSucceeded, newWF.Status.Nodes["params-1"].Phase)
+		}
+	})
+	t.Run("New", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(parametersWorkflow)
+		newWF, _, err := FormulateRetryWorkflow(wf, false, "", []string{"z=1"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "1", newWF.Spec.Arguments.GetParameterByName("z").Value.String())
+		}
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		wf := wfv1.MustUnmarshalWorkflow(parametersWorkflow)
+		_, _, err := FormulateRetryWorkflow(wf, false, "", []string{"x"})
+		assert.EqualError(t, err, "expected parameter of the form: NAME=VALUE. Received: x")
+	})
+}
+
+func TestFormulateResubmitWorkflowWithParameters(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(parametersWorkflow)
+	newWF, err := FormulateResubmitWorkflow(wf, true, []string{"x=2"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "2", newWF.Spec.Arguments.GetParameterByName("x").Value.String())
+		assert.Equal(t, "1", wf.Spec.Arguments.GetParameterByName("x").Value.String(), "the original workflow is not changed")
+		var names []string
+		for _, node := range newWF.Status.Nodes {
+			names = append(names, strings.TrimPrefix(node.Name, newWF.Name))
+		}
+		assert.ElementsMatch(t, []string{"", "[0]", "[0].b"}, names)
+		node := newWF.GetNodeByName(newWF.Name + "[0]")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, []string{newWF.NodeID(newWF.Name + "[0].b")}, node.Children)
+		}
+	}
+}
+
+func TestFormulateRetryWorkflowWithDeletedArtifacts(t *testing.T) {
+	withDeletedArtifact := func() *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(parametersWorkflow)
+		node := wf.Status.Nodes["params-a"]
+		node.Outputs = &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: "my-art", Deleted: true}}}
+		wf.Status.Nodes["params-a"] = node
+		wf.Status.ArtifactGCStatus = &wfv1.ArtGCStatus{StrategiesProcessed: map[wfv1.ArtifactGCStrategy]bool{wfv1.ArtifactGCOnWorkflowCompletion: true}}
+		return wf
+	}
+	t.Run("Retry", func(t *testing.T) {
+		newWF, podsToDelete, err := FormulateRetryWorkflow(withDeletedArtifact(), false, "", nil)
+		if assert.NoError(t, err) {
+			assert.ElementsMatch(t, []string{"params", "params-1", "params-b", "params-2"}, getNodeIDs(newWF))
+			assert.ElementsMatch(t, []string{"params-a", "params-c"}, podsToDelete)
+			assert.Nil(t, newWF.Status.ArtifactGCStatus)
+		}
+	})
+	t.Run("Resubmit", func(t *testing.T) {
+		newWF, err := FormulateResubmitWorkflow(withDeletedArtifact(), true, nil)
+		if assert.NoError(t, err) {
+			assert.Nil(t, newWF.GetNodeByName(newWF.Name+"[0].a"))
+			assert.NotNil(t, newWF.GetNodeByName(newWF.Name+"[0].b"))
+		}
+	})
+}
+
+func getNodeIDs(wf *wfv1.Workflow) []string {
+	var nodeIDs []string
+	for nodeID := range wf.Status.Nodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	return nodeIDs
+}
+
+func TestReferencesParameters(t *testing.T) {
+	for text, expected := range map[string]bool{
+		"{{workflow.parameters.x}}":                          true,
+		"{{ workflow.parameters.x }}":                        true,
+		`{{=workflow.parameters['x']}}`:                      true,
+		`{{=workflow.parameters["x"]}}`:                      true,
+		"{{=sprig.upper(workflow.parameters.x)}}":            true,
+		"{{=workflow.parameters.y + workflow.parameters.x}}": true,
+		"{{=workflow.parameters[inputs.parameters.name]}}":   true,
+		`{{=workflow.parameters['x' + 'y']}}`:                true,
+		"{{=toJson(workflow.parameters)}}":                   true,
+		"{{workflow.parameters}}":                            true,
+		"{{workflow.parameters.xy}}":                         false,
+		"{{workflow.parameters.x-y}}":                        false,
+		"{{workflow.parameters.y}}":                          false,
+		`{{=jsonpath(workflow.parameters.y, '$.x')}}`:        false,
+		"{{inputs.parameters.x}}":                            false,
+		"{{=inputs.parameters.x}}":                           false,
+		"{{steps.workflow.parameters.outputs.parameters.x}}": false,
+	} {
+		assert.Equal(t, expected, referencesParameters("workflow", []string{"x"}, text), text)
+	}
+}
+
+var calledTwiceWorkflow = `
+metadata:
+  name: twice
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+      - name: x
+        value: "1"
+      - name: key
+        value: x
+  templates:
+    - name: main
+      steps:
+        - - name: a
+            template: sub
+            arguments:
+              parameters:
+                - name: message
+                  value: "{{=sprig.upper(workflow.parameters.x)}}"
+          - name: b
+            template: sub
+            arguments:
+              parameters:
+                - name: message
+                  value: hello
+    - name: sub
+      inputs:
+        parameters:
+          - name: message
+      dag:
+        tasks:
+          - name: print
+            template: echo
+            arguments:
+              parameters:
+                - name: message
+                  value: "{{inputs.parameters.message}}"
+          - name: lookup
+            template: echo
+            arguments:
+              parameters:
+                - name: message
+                  value: "{{=workflow.parameters[workflow.parameters.key]}}"
+    - name: echo
+      inputs:
+        parameters:
+          - name: message
+      container:
+        image: argoproj/argosay:v2
+status:
+  phase: Succeeded
+  nodes:
+    twice:
+      id: twice
+      name: twice
+      displayName: twice
+      type: Steps
+      templateName: main
+      phase: Succeeded
+      children: [twice-1]
+    twice-1:
+      id: twice-1
+      name: twice[0]
+      displayName: "[0]"
+      type: StepGroup
+      templateName: main
+      boundaryID: twice
+      phase: Succeeded
+      children: [twice-a, twice-b]
+    twice-a:
+      id: twice-a
+      name: twice[0].a
+      displayName: a
+      type: DAG
+      templateName: sub
+      boundaryID: twice
+      phase: Succeeded
+      children: [twice-a-print, twice-a-lookup]
+    twice-a-print:
+      id: twice-a-print
+      name: twice[0].a.print
+      displayName: print
+      type: Pod
+      templateName: echo
+      boundaryID: twice-a
+      phase: Succeeded
+    twice-a-lookup:
+      id: twice-a-lookup
+      name: twice[0].a.lookup
+      displayName: lookup
+      type: Pod
+      templateName: echo
+      boundaryID: twice-a
+      phase: Succeeded
+    twice-b:
+      id: twice-b
+      name: twice[0].b
+      displayName: b
+      type: DAG
+      templateName: sub
+      boundaryID: twice
+      phase: Succeeded
+      children: [twice-b-print, twice-b-lookup]
+    twice-b-print:
+      id: twice-b-print
+      name: twice[0].b.print
+      displayName: print
+      type: Pod
+      templateName: echo
+      boundaryID: twice-b
+      phase: Succeeded
+    twice-b-lookup:
+      id: twice-b-lookup
+      name: twice[0].b.lookup
+      displayName: lookup
+      type: Pod
+      templateName: echo
+      boundaryID: twice-b
+      phase: Succeeded
+`
+
+func TestGetNodeIDsAffectedByParameters(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(calledTwiceWorkflow)
+	t.Run("Expression", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"twice-a", "twice-a-lookup", "twice-b-lookup"}, getNodeIDsAffectedByParameters(wf, []string{"x"}))
+	})
+	t.Run("ComputedName", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"twice-a-lookup", "twice-b-lookup"}, getNodeIDsAffectedByParameters(wf, []string{"key"}))
+	})
+	t.Run("CalledTwice", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		tmpl := wf.GetTemplateByName("main")
+		tmpl.Steps[0].Steps[1].Arguments.Parameters[0].Value = wfv1.AnyStringPtr("{{workflow.parameters.y}}")
+		wf.Spec.Templates[0] = *tmpl
+		assert.ElementsMatch(t, []string{"twice-b", "twice-a-lookup", "twice-b-lookup"}, getNodeIDsAffectedByParameters(wf, []string{"y"}))
+	})
+	t.Run("Retried", func(t *testing.T) {
+		assert.Equal(t, &wf.Spec.Templates[0].Steps[0].Steps[0], getInvokingStep(wf, wfv1.NodeStatus{Name: "twice[0].a(1)", BoundaryID: "twice"}))
+		assert.Nil(t, getInvokingStep(wf, wfv1.NodeStatus{Name: "twice[0].ab", BoundaryID: "twice"}))
+	})
+}
@@ -3,22 +3,24 @@ package validate
 import (
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/antonmedv/expr"
 	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apivalidation "k8s.io/apimachinery/pkg/util/validation"
-	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
 	"github.com/argoproj/argo-workflows/v3/util/intstr"
+	"github.com/argoproj/argo-workflows/v3/util/k8s"
 	"github.com/argoproj/argo-workflows/v3/util/sorting"
 	"github.com/argoproj/argo-workflows/v3/util/template"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/hdfs"
@@ -195,6 +197,12 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 		return nil, errors.New(errors.CodeBadRequest, "spec.entrypoint is required")
 	}
 
+	if wf.Spec.AutoRetry != nil {
+		if err := validateAutoRetry(wf.Spec.AutoRetry); err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "spec.autoRetry%s", err.Error())
+		}
+	}
+
 	if !opts.IgnoreEntrypoint {
 		var args wfv1.ArgumentsProvider
 		args = &wfArgs
@@ -226,6 +234,9 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if _, err := wf.Spec.PodGC.GetLabelSelector(); err != nil {
 		return nil, errors.Errorf(errors.CodeBadRequest, "podGC.labelSelector invalid: %v", err)
 	}
+	if !wf.Spec.ArtifactGC.GetStrategy().IsValid() {
+		return nil, errors.Errorf(errors.CodeBadRequest, "artifactGC.strategy unknown strategy '%s'", wf.Spec.ArtifactGC.Strategy)
+	}
 
 	// Check if all templates can be resolved.
 	for _, template := range wf.Spec.Templates {
@@ -346,10 +357,12 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 		localParams[common.LocalVarRetriesLastExitCode] = placeholderGenerator.NextPlaceholder()
 		localParams[common.LocalVarRetriesLastStatus] = placeholderGenerator.NextPlaceholder()
 		localParams[common.LocalVarRetriesLastDuration] = placeholderGenerator.NextPlaceholder()
+		localParams[common.LocalVarRetriesLastReason] = placeholderGenerator.NextPlaceholder()
 		scope[common.LocalVarRetries] = placeholderGenerator.NextPlaceholder()
 		scope[common.LocalVarRetriesLastExitCode] = placeholderGenerator.NextPlaceholder()
 		scope[common.LocalVarRetriesLastStatus] = placeholderGenerator.NextPlaceholder()
 		scope[common.LocalVarRetriesLastDuration] = placeholderGenerator.NextPlaceholder()
+		scope[common.LocalVarRetriesLastReason] = placeholderGenerator.NextPlaceholder()
 	}
 	if tmpl.IsLeaf() {
 		for _, art := range tmpl.Outputs.Artifacts {
@@ -381,6 +394,22 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 
 	}
 
+	if newTmpl.Memoize != nil {
+		if err := validateMemoizeCache(newTmpl.Memoize.Cache); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache %s", newTmpl.Name, err.Error())
+		}
+		if (newTmpl.Memoize.Key == "") == !newTmpl.Memoize.Auto {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize must have exactly one of key or auto", newTmpl.Name)
+		}
+		if newTmpl.Memoize.Auto {
+			for _, art := range newTmpl.Inputs.Artifacts {
+				if !hasArtifactDigest(art) {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.artifacts.%s has no digest of its content in its storage, which memoize.auto requires", newTmpl.Name, art.Name)
+				}
+			}
+		}
+	}
+
 	tmplID := getTemplateID(tmpl)
 	_, ok := ctx.results[tmplID]
 	if ok {
@@ -481,11 +510,103 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 		default:
 			return nil, fmt.Errorf("%s is not a valid RetryPolicy", resolvedTmpl.RetryStrategy.RetryPolicy)
 		}
+		if err := validateRetryStrategy(resolvedTmpl.RetryStrategy); err != nil {
+			return nil, err
+		}
 	}
 
 	return resolvedTmpl, ctx.validateTemplate(resolvedTmpl, tmplCtx, args)
 }
 
+// validateRetryStrategy validates the backoff jitter and the retry rules of a retry strategy
+func validateRetryStrategy(retryStrategy *wfv1.RetryStrategy) error {
+	if backoff := retryStrategy.Backoff; backoff != nil && backoff.Jitter != "" {
+		if jitter, err := strconv.ParseFloat(backoff.Jitter, 64); err != nil || jitter < 0 {
+			return fmt.Errorf("retryStrategy.backoff.jitter must be a number >= 0")
+		}
+	}
+	for i, rule := range retryStrategy.Rules {
+		if len(rule.Reasons) == 0 && len(rule.ExitCodes) == 0 {
+			return fmt.Errorf("retryStrategy.rules[%d] must have reasons or exitCodes", i)
+		}
+		for _, reason := range rule.Reasons {
+			switch reason {
+			case wfv1.FailureReasonOOMKilled, wfv1.FailureReasonEvicted, wfv1.FailureReasonNodeShutdown, wfv1.FailureReasonImagePullBackOff, wfv1.FailureReasonDeadlineExceeded:
+			default:
+				return fmt.Errorf("retryStrategy.rules[%d].reasons: %s is not a valid reason", i, reason)
+			}
+		}
+		switch rule.Action {
+		case "", wfv1.RetryRuleActionRetry, wfv1.RetryRuleActionFail:
+		default:
+			return fmt.Errorf("retryStrategy.rules[%d].action must be one of: %s, %s", i, wfv1.RetryRuleActionRetry, wfv1.RetryRuleActionFail)
+		}
+		if e := rule.Escalate; e != nil {
+			for name, factor := range map[string]string{"memory": e.Memory, "cpu": e.CPU} {
+				if factor == "" {
+					continue
+				}
+				if f, err := strconv.ParseFloat(factor, 64); err != nil || f < 1 {
+					return fmt.Errorf("retryStrategy.rules[%d].escalate.%s must be a number >= 1", i, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hasArtifactDigest returns whether the storage of an artifact may have a digest of its content in its metadata, from
+// which automatic memoization keys are computed, as the controller does not download artifacts. Artifacts whose location
+// is only known at runtime are checked then.
+func hasArtifactDigest(art wfv1.Artifact) bool {
+	switch {
+	case art.Artifactory != nil, art.HDFS != nil, art.OSS != nil, art.Plugin != nil:
+		return false
+	case art.Git != nil:
+		// the commits fetched in addition to the revision are part of the content too
+		return len(art.Git.Fetch) == 0
+	case art.S3 != nil && art.S3.EncryptionOptions != nil:
+		// the metadata of objects encrypted with a customer key can only be read with the key
+		return art.S3.EncryptionOptions.ServerSideCustomerKeySecret == nil
+	}
+	return true
+}
+
+func validateAutoRetry(autoRetry *wfv1.AutoRetryStrategy) error {
+	if limit, err := intstr.Int32(autoRetry.Limit); err != nil || (limit != nil && *limit < 0) {
+		return fmt.Errorf(".limit must be an integer >= 0")
+	}
+	if backoff := autoRetry.Backoff; backoff != nil {
+		if _, err := parseDuration(backoff.Duration); err != nil {
+			return fmt.Errorf(".backoff.duration is invalid: %v", err)
+		}
+		if backoff.MaxDuration != "" {
+			if _, err := parseDuration(backoff.MaxDuration); err != nil {
+				return fmt.Errorf(".backoff.maxDuration is invalid: %v", err)
+			}
+		}
+		if backoff.Jitter != "" {
+			if jitter, err := strconv.ParseFloat(backoff.Jitter, 64); err != nil || jitter < 0 {
+				return fmt.Errorf(".backoff.jitter must be a number >= 0")
+			}
+		}
+	}
+	if autoRetry.Expression != "" {
+		if _, err := expr.Compile(autoRetry.Expression); err != nil {
+			return fmt.Errorf(".expression is invalid: %v", err)
+		}
+	}
+	return nil
+}
+
+// parseDuration parses a duration, which is a number of seconds if it has no units
+func parseDuration(s string) (time.Duration, error) {
+	if i, err := strconv.Atoi(s); err == nil {
+		return time.Duration(i) * time.Second, nil
+	}
+	return time.ParseDuration(s)
+}
+
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
@@ -552,6 +673,29 @@ func validateArtifactLocation(errPrefix string, art wfv1.ArtifactLocation) error
 		if art.Git.Repo == "" {
 			return errors.Errorf(errors.CodeBadRequest, "%s.git.repo is required", errPrefix)
 		}
+		for i, dir := range art.Git.SparseCheckout {
+			if dir := filepath.Clean(dir); filepath.IsAbs(dir) || dir == "." || dir == ".." || strings.HasPrefix(dir, "../") {
+				return errors.Errorf(errors.CodeBadRequest, "%s.git.sparseCheckout[%d] must be a directory in the repository", errPrefix, i)
+			}
+		}
+		if art.Git.SubmoduleRecursionDepth != nil && *art.Git.SubmoduleRecursionDepth < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "%s.git.submoduleRecursionDepth must not be negative", errPrefix)
+		}
+	}
+	if art.OCI != nil {
+		if err := validateOCIArtifact(errPrefix, art.OCI); err != nil {
+			return err
+		}
+	}
+	if art.HTTP != nil {
+		if err := validateHTTPArtifact(errPrefix, art.HTTP); err != nil {
+			return err
+		}
+	}
+	if art.Plugin != nil {
+		if err := validatePluginArtifact(errPrefix, art.Plugin); err != nil {
+			return err
+		}
 	}
 	if art.HDFS != nil {
 		err := hdfs.ValidateArtifact(fmt.Sprintf("%s.hdfs", errPrefix), art.HDFS)
@@ -559,10 +703,80 @@ func validateArtifactLocation(errPrefix string, art wfv1.ArtifactLocation) error
 			return err
 		}
 	}
+	if !art.ArtifactGC.GetStrategy().IsValid() {
+		return errors.Errorf(errors.CodeBadRequest, "%s.artifactGC.strategy unknown strategy '%s'", errPrefix, art.ArtifactGC.Strategy)
+	}
+	if err := validateArtifactEncryption(errPrefix, art.Encryption); err != nil {
+		return err
+	}
 	// TODO: validate other artifact locations
 	return nil
 }
 
+func validateGitOutputArtifact(errPrefix string, git *wfv1.GitArtifact) error {
+	if git.Repo == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s.git.repo is required", errPrefix)
+	}
+	if git.Branch == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s.git.branch is required", errPrefix)
+	}
+	if dir := filepath.Clean(git.Dir); filepath.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, "../") {
+		return errors.Errorf(errors.CodeBadRequest, "%s.git.dir must be a relative path in the repository", errPrefix)
+	}
+	return nil
+}
+
+func validateOCIArtifact(errPrefix string, oci *wfv1.OCIArtifact) error {
+	if oci.Repository == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s.oci.repository is required", errPrefix)
+	}
+	if oci.Digest != "" && !strings.Contains(oci.Digest, "{{") && !ociDigestRegex.MatchString(oci.Digest) {
+		return errors.Errorf(errors.CodeBadRequest, "%s.oci.digest '%s' is not a digest, such as sha256:<hex>", errPrefix, oci.Digest)
+	}
+	return nil
+}
+
+func validatePluginArtifact(errPrefix string, plugin *wfv1.PluginArtifact) error {
+	if plugin.Name == "" {
+		if plugin.Key == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.plugin.name is required", errPrefix)
+		}
+		// the plugin of the key is that of the archive location
+		return nil
+	}
+	if !strings.Contains(plugin.Name, "{{") {
+		// the name is part of the names of the config map and the init container of the plugin
+		if errs := apivalidation.IsDNS1123Label("artifact-plugin-" + plugin.Name); len(errs) > 0 {
+			return errors.Errorf(errors.CodeBadRequest, "%s.plugin.name '%s' is invalid: %s", errPrefix, plugin.Name, errs[0])
+		}
+	}
+	return nil
+}
+
+func validateHTTPArtifact(errPrefix string, http *wfv1.HTTPArtifact) error {
+	if http.URL == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s.http.url is required", errPrefix)
+	}
+	for i, header := range http.Headers {
+		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef == nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s.http.headers[%d].valueFrom.secretKeyRef is required", errPrefix, i)
+		}
+	}
+	switch http.GetMethod() {
+	case "PUT", "POST", "PATCH":
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "%s.http.method '%s' is not PUT, POST or PATCH", errPrefix, http.Method)
+	}
+	return nil
+}
+
+func validateArtifactEncryption(errPrefix string, encryption *wfv1.ArtifactEncryption) error {
+	if encryption != nil && (encryption.KeySecret == nil || encryption.KeySecret.Name == "" || encryption.KeySecret.Key == "") {
+		return errors.Errorf(errors.CodeBadRequest, "%s.encryption.keySecret name and key are required", errPrefix)
+	}
+	return nil
+}
+
 // resolveAllVariables is a helper to ensure all {{variables}} are resolvable from current scope
 func resolveAllVariables(scope map[string]interface{}, globalParams map[string]string, tmplStr string) error {
 	_, allowAllItemRefs := scope[anyItemMagicValue] // 'item.*' is a magic placeholder value set by addItemsToScope
@@ -647,6 +861,10 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.%s", tmpl.Name, err.Error())
 		}
+		err = common.ValidateContainerSetDepends(tmpl.ContainerSet)
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.%s", tmpl.Name, err.Error())
+		}
 		if len(tmpl.Inputs.Artifacts) > 0 || len(tmpl.Outputs.Parameters) > 0 || len(tmpl.Outputs.Artifacts) > 0 {
 			if !tmpl.ContainerSet.HasContainerNamed("main") {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers must have a container named \"main\" for input or output", tmpl.Name)
@@ -664,13 +882,28 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 			}
 		}
 		if !placeholderGenerator.IsPlaceholder(tmpl.Resource.Manifest) {
-			// Try to unmarshal the given manifest, just ensuring it's a valid YAML.
-			var obj interface{}
-			err := yaml.Unmarshal([]byte(tmpl.Resource.Manifest), &obj)
+			// Try to split the given manifest into documents, just ensuring it's a valid YAML.
+			_, err := k8s.SplitManifest([]byte(tmpl.Resource.Manifest))
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.manifest must be a valid yaml", tmpl.Name)
 			}
 		}
+		if cleanup := tmpl.Resource.Cleanup; cleanup != nil {
+			switch cleanup.Strategy {
+			case "", wfv1.ResourceCleanupOnWorkflowCompletion, wfv1.ResourceCleanupOnWorkflowSuccess:
+				// OK
+			default:
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.cleanup.strategy must be one of: %s, %s", tmpl.Name, wfv1.ResourceCleanupOnWorkflowCompletion, wfv1.ResourceCleanupOnWorkflowSuccess)
+			}
+			if cleanup.SecondsAfterCompletion != nil && *cleanup.SecondsAfterCompletion < 0 {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.resource.cleanup.secondsAfterCompletion must be >= 0", tmpl.Name)
+			}
+		}
+	}
+	if tmpl.Data != nil {
+		if err := validateData(tmpl.Data); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.%s", tmpl.Name, err.Error())
+		}
 	}
 	if tmpl.Script != nil {
 		if tmpl.Script.Image == "" {
@@ -707,6 +940,65 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 	return nil
 }
 
+// validateData validates that a data template has exactly one source, and that each of its transformation steps is
+// exactly one of the known steps
+func validateData(data *wfv1.Data) error {
+	source := data.Source
+	var format wfv1.DataFormat
+	sources := 0
+	if source.ArtifactPaths != nil {
+		sources++
+	}
+	if source.Value != nil {
+		sources++
+		format = source.Value.Format
+	}
+	if source.HTTP != nil {
+		sources++
+		format = source.HTTP.Format
+		if source.HTTP.URL == "" {
+			return fmt.Errorf("source.http.url is required")
+		}
+	}
+	if source.ConfigMap != nil {
+		sources++
+		format = source.ConfigMap.Format
+		if source.ConfigMap.Name == "" || source.ConfigMap.Key == "" {
+			return fmt.Errorf("source.configMap.name and source.configMap.key are required")
+		}
+	}
+	if source.Artifact != nil {
+		sources++
+		format = source.Artifact.Format
+	}
+	if sources != 1 {
+		return fmt.Errorf("source must have exactly one of artifactPaths, value, http, configMap or artifact")
+	}
+	switch format {
+	case "", wfv1.DataFormatJSON, wfv1.DataFormatNDJSON, wfv1.DataFormatCSV, wfv1.DataFormatText:
+		// OK
+	default:
+		if !placeholderGenerator.IsPlaceholder(string(format)) {
+			return fmt.Errorf("source format must be one of: %s, %s, %s, %s", wfv1.DataFormatJSON, wfv1.DataFormatNDJSON, wfv1.DataFormatCSV, wfv1.DataFormatText)
+		}
+	}
+	for i, step := range data.Transformation {
+		steps := 0
+		for _, set := range []bool{step.Expression != "", step.Filter != "", step.Map != "", step.GroupBy != "", step.SortBy != "", step.Chunk != 0, step.Dedupe != ""} {
+			if set {
+				steps++
+			}
+		}
+		if steps != 1 {
+			return fmt.Errorf("transformation[%d] must have exactly one of expression, filter, map, groupBy, sortBy, chunk or dedupe", i)
+		}
+		if step.Chunk < 0 {
+			return fmt.Errorf("transformation[%d].chunk must be > 0", i)
+		}
+	}
+	return nil
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments, allowEmptyValues bool) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -968,6 +1260,35 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.globalName: %s", tmpl.Name, artRef, errs[0])
 			}
 		}
+		if !art.ArtifactGC.GetStrategy().IsValid() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.artifactGC.strategy unknown strategy '%s'", tmpl.Name, artRef, art.ArtifactGC.Strategy)
+		}
+		if err := validateArtifactEncryption(fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef), art.Encryption); err != nil {
+			return err
+		}
+		if art.Archive != nil && art.Archive.Tar != nil && !art.Archive.Tar.Compression.IsValid() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.archive.tar.compression unknown compression '%s'", tmpl.Name, artRef, art.Archive.Tar.Compression)
+		}
+		if art.OCI != nil {
+			if err := validateOCIArtifact(fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef), art.OCI); err != nil {
+				return err
+			}
+		}
+		if art.HTTP != nil {
+			if err := validateHTTPArtifact(fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef), art.HTTP); err != nil {
+				return err
+			}
+		}
+		if art.Plugin != nil {
+			if err := validatePluginArtifact(fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef), art.Plugin); err != nil {
+				return err
+			}
+		}
+		if art.Git != nil {
+			if err := validateGitOutputArtifact(fmt.Sprintf("templates.%s.%s", tmpl.Name, artRef), art.Git); err != nil {
+				return err
+			}
+		}
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
@@ -1441,6 +1762,8 @@ var (
 	paramRegex               = regexp.MustCompile(`{{[-a-zA-Z0-9]+(\.[-a-zA-Z0-9_]+)*}}`)
 	paramOrArtifactNameRegex = regexp.MustCompile(`^[-a-zA-Z0-9_]+[-a-zA-Z0-9_]*$`)
 	workflowFieldNameRegex   = regexp.MustCompile("^" + workflowFieldNameFmt + "$")
+	// ociDigestRegex matches the digest of OCI content, e.g. sha256:<hex>
+	ociDigestRegex = regexp.MustCompile(`^[a-z0-9]+([+._-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`)
 )
 
 func isParameter(p string) bool {
@@ -1477,3 +1800,26 @@ func isValidWorkflowFieldName(name string) []string {
 func getTemplateID(tmpl *wfv1.Template) string {
 	return tmpl.Name
 }
+
+// validateMemoizeCache checks that exactly one backend of the cache is configured
+func validateMemoizeCache(cache *wfv1.Cache) error {
+	if cache == nil {
+		return fmt.Errorf("is required")
+	}
+	backends := 0
+	for _, set := range []bool{cache.ConfigMap != nil, cache.SQL != nil, cache.Artifact != nil} {
+		if set {
+			backends++
+		}
+	}
+	if backends != 1 {
+		return fmt.Errorf("must have exactly one of configMap, sql or artifact")
+	}
+	if cache.GetName() == "" {
+		return fmt.Errorf("name is required")
+	}
+	if (cache.SQL != nil && cache.SQL.MaxEntries < 0) || (cache.Artifact != nil && cache.Artifact.MaxEntries < 0) {
+		return fmt.Errorf("maxEntries must be greater than or equal to 0")
+	}
+	return nil
+}
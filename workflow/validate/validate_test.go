@@ -2,6 +2,7 @@ package validate
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -1802,6 +1803,26 @@ spec:
           name: whalesay-cm
 `
 
+var invalidCleanupResourceWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: invalid-resource-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    resource:
+      action: create
+      cleanup:
+        strategy: foo
+      manifest: |
+        apiVersion: v1
+        kind: ConfigMap
+        metadata:
+          name: whalesay-cm
+`
+
 // TestInvalidResourceWorkflow verifies an error against a workflow of an invalid resource.
 func TestInvalidResourceWorkflow(t *testing.T) {
 	wf := unmarshalWf(invalidResourceWorkflow)
@@ -1811,6 +1832,74 @@ func TestInvalidResourceWorkflow(t *testing.T) {
 	wf = unmarshalWf(invalidActionResourceWorkflow)
 	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
 	assert.EqualError(t, err, "templates.whalesay.resource.action must be one of: get, create, apply, delete, replace, patch")
+
+	wf = unmarshalWf(invalidCleanupResourceWorkflow)
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.whalesay.resource.cleanup.strategy must be one of: OnWorkflowCompletion, OnWorkflowSuccess")
+}
+
+var dataTemplateWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: data-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    data:
+      source:
+        value:
+          value: '[{"name": "foo"}, {"name": "bar"}]'
+      transformation:
+      - filter: item.name != "bar"
+      - chunk: 10
+`
+
+func TestDataTemplate(t *testing.T) {
+	_, err := validate(dataTemplateWorkflow)
+	assert.NoError(t, err)
+
+	for name, test := range map[string]struct {
+		modify func(data *wfv1.Data)
+		error  string
+	}{
+		"NoSource": {
+			modify: func(data *wfv1.Data) { data.Source = wfv1.DataSource{} },
+			error:  "templates.main.data.source must have exactly one of artifactPaths, value, http, configMap or artifact",
+		},
+		"TwoSources": {
+			modify: func(data *wfv1.Data) { data.Source.HTTP = &wfv1.HTTPDataSource{URL: "http://my-url"} },
+			error:  "templates.main.data.source must have exactly one of artifactPaths, value, http, configMap or artifact",
+		},
+		"NoURL": {
+			modify: func(data *wfv1.Data) { data.Source = wfv1.DataSource{HTTP: &wfv1.HTTPDataSource{}} },
+			error:  "templates.main.data.source.http.url is required",
+		},
+		"UnknownFormat": {
+			modify: func(data *wfv1.Data) { data.Source.Value.Format = "xml" },
+			error:  "templates.main.data.source format must be one of: json, ndjson, csv, text",
+		},
+		"EmptyStep": {
+			modify: func(data *wfv1.Data) { data.Transformation = append(data.Transformation, wfv1.TransformationStep{}) },
+			error:  "templates.main.data.transformation[2] must have exactly one of expression, filter, map, groupBy, sortBy, chunk or dedupe",
+		},
+		"TwoSteps": {
+			modify: func(data *wfv1.Data) { data.Transformation[0].Map = "item.name" },
+			error:  "templates.main.data.transformation[0] must have exactly one of expression, filter, map, groupBy, sortBy, chunk or dedupe",
+		},
+		"NegativeChunk": {
+			modify: func(data *wfv1.Data) { data.Transformation[1].Chunk = -1 },
+			error:  "templates.main.data.transformation[1].chunk must be > 0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := unmarshalWf(dataTemplateWorkflow)
+			test.modify(wf.Spec.Templates[0].Data)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			assert.EqualError(t, err, test.error)
+		})
+	}
 }
 
 var invalidPodGC = `
@@ -1833,6 +1922,86 @@ func TestIncorrectPodGCStrategy(t *testing.T) {
 	assert.EqualError(t, err, "podGC.strategy unknown strategy 'Foo'")
 }
 
+func TestIncorrectArtifactGCStrategy(t *testing.T) {
+	wf := unmarshalWf(`
+metadata:
+  generateName: artifact-gc-strategy-unknown-
+spec:
+  artifactGC:
+    strategy: Foo
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "artifactGC.strategy unknown strategy 'Foo'")
+
+	wf = unmarshalWf(`
+metadata:
+  generateName: artifact-gc-strategy-unknown-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+        artifactGC:
+          strategy: Foo
+`)
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.outputs.artifacts.out.artifactGC.strategy unknown strategy 'Foo'")
+}
+
+func TestArtifactEncryptionWithoutKeySecret(t *testing.T) {
+	wf := unmarshalWf(`
+metadata:
+  generateName: artifact-encryption-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+        encryption:
+          keySecret:
+            name: my-key
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.outputs.artifacts.out.encryption.keySecret name and key are required")
+}
+
+func TestUnknownTarCompression(t *testing.T) {
+	wf := unmarshalWf(`
+metadata:
+  generateName: tar-compression-unknown-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+        archive:
+          tar:
+            compression: bzip2
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.outputs.artifacts.out.archive.tar.compression unknown compression 'bzip2'")
+}
+
 func TestInvalidPodGCLabelSelector(t *testing.T) {
 	wf := unmarshalWf(`
 metadata:
@@ -3288,3 +3457,381 @@ func TestInitContainerHasName(t *testing.T) {
 	_, err := validate(testInitContainerHasName)
 	assert.EqualError(t, err, "templates.main.tasks.spurious initContainers must all have container name")
 }
+
+var testRetryRules = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: retry-rules-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      backoff:
+        duration: "1"
+        jitter: "%s"
+      rules:
+      - %s
+    container:
+      image: alpine:latest
+`
+
+func TestRetryRules(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		jitter string
+		rule   string
+		err    string
+	}{
+		{"Valid", "0.2", "{reasons: [OOMKilled], escalate: {memory: '2', maxMemory: 1Gi}}", ""},
+		{"InvalidJitter", "foo", "{exitCodes: [1]}", "retryStrategy.backoff.jitter must be a number >= 0"},
+		{"Empty", "0.2", "{action: Fail}", "retryStrategy.rules[0] must have reasons or exitCodes"},
+		{"InvalidReason", "0.2", "{reasons: [Foo]}", "retryStrategy.rules[0].reasons: Foo is not a valid reason"},
+		{"InvalidAction", "0.2", "{exitCodes: [1], action: Foo}", "retryStrategy.rules[0].action must be one of: Retry, Fail"},
+		{"InvalidEscalation", "0.2", "{reasons: [OOMKilled], escalate: {cpu: '0.5'}}", "retryStrategy.rules[0].escalate.cpu must be a number >= 1"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validate(fmt.Sprintf(testRetryRules, tt.jitter, tt.rule))
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+var testAutoRetry = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: auto-retry-
+spec:
+  entrypoint: main
+  autoRetry: %s
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+`
+
+func TestAutoRetry(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		autoRetry string
+		err       string
+	}{
+		{"Valid", "{limit: 2, backoff: {duration: 1m, factor: 2, maxDuration: 1h}, expression: 'workflow.retries < 2'}", ""},
+		{"InvalidLimit", "{limit: -1}", "spec.autoRetry.limit must be an integer >= 0"},
+		{"InvalidDuration", "{backoff: {duration: foo}}", `spec.autoRetry.backoff.duration is invalid: time: invalid duration "foo"`},
+		{"InvalidJitter", "{backoff: {duration: 1m, jitter: foo}}", "spec.autoRetry.backoff.jitter must be a number >= 0"},
+		{"InvalidExpression", "{expression: 'workflow.retries <'}", "spec.autoRetry.expression is invalid"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validate(fmt.Sprintf(testAutoRetry, tt.autoRetry))
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+var testMemoizeCache = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoized-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      memoize:
+        key: my-key
+        cache: %s
+      container:
+        image: my-image
+`
+
+func TestMemoizeCache(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		cache string
+		err   string
+	}{
+		{"ConfigMap", "{configMap: {name: my-cache}}", ""},
+		{"SQL", "{sql: {name: my-cache, maxEntries: 100}}", ""},
+		{"Artifact", "{artifact: {name: my-cache}}", ""},
+		{"NoBackend", "{}", "templates.main.memoize.cache must have exactly one of configMap, sql or artifact"},
+		{"TwoBackends", "{sql: {name: my-cache}, artifact: {name: my-cache}}", "must have exactly one of configMap, sql or artifact"},
+		{"NoName", "{sql: {maxEntries: 1}}", "templates.main.memoize.cache name is required"},
+		{"InvalidMaxEntries", "{artifact: {name: my-cache, maxEntries: -1}}", "maxEntries must be greater than or equal to 0"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validate(fmt.Sprintf(testMemoizeCache, tt.cache))
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+var testMemoizeKey = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoized-
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      memoize:
+        %s
+        cache:
+          configMap:
+            name: my-cache
+      inputs:
+        artifacts:
+          - name: data
+            path: /tmp/data
+            %s
+      container:
+        image: my-image
+`
+
+func TestMemoizeKey(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		key  string
+		err  string
+	}{
+		{"Key", "key: my-key", ""},
+		{"Auto", "auto: true", ""},
+		{"None", "maxAge: 1h", "templates.main.memoize must have exactly one of key or auto"},
+		{"Both", "key: my-key\n        auto: true", "templates.main.memoize must have exactly one of key or auto"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validate(fmt.Sprintf(testMemoizeKey, tt.key, "raw: {data: foo}"))
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+func TestMemoizeAutoArtifacts(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		location string
+		err      string
+	}{
+		{"S3", "s3: {key: my-key}", ""},
+		{"Git", "git: {repo: 'https://github.com/argoproj/argo-workflows.git', revision: main}", ""},
+		{"GitFetch", "git: {repo: 'https://github.com/argoproj/argo-workflows.git', fetch: ['refs/meta/*']}", "templates.main.inputs.artifacts.data has no digest of its content in its storage, which memoize.auto requires"},
+		{"S3CustomerKey", "s3: {key: my-key, encryptionOptions: {serverSideCustomerKeySecret: {name: my-secret, key: key}}}", "templates.main.inputs.artifacts.data has no digest"},
+		{"HDFS", "hdfs: {addresses: [my-namenode:8020], path: /my-path, hdfsUser: my-user}", "templates.main.inputs.artifacts.data has no digest"},
+		{"Plugin", "plugin: {name: my-plugin, key: my-key}", "templates.main.inputs.artifacts.data has no digest"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validate(fmt.Sprintf(testMemoizeKey, "auto: true", tt.location))
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+func TestGitOutputArtifact(t *testing.T) {
+	wf := unmarshalWf(`
+metadata:
+  generateName: git-output-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: manifests
+        path: /tmp/manifests
+        git:
+          repo: https://github.com/argoproj/argo-workflows.git
+          branch: main
+          dir: manifests
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Git.Dir = "../manifests"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.outputs.artifacts.manifests.git.dir must be a relative path in the repository")
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Git.Branch = ""
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.outputs.artifacts.manifests.git.branch is required")
+}
+
+func TestOCIArtifact(t *testing.T) {
+	wf := unmarshalWf(`
+metadata:
+  generateName: oci-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      artifacts:
+      - name: model
+        path: /tmp/model
+        oci:
+          repository: registry.example.com/models/bert
+          digest: sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: bundle
+        path: /tmp/bundle
+        oci:
+          repository: registry.example.com/bundles/app
+          tag: v1
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf.Spec.Templates[0].Inputs.Artifacts[0].OCI.Digest = "v1"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.inputs.artifacts.model.oci.digest 'v1' is not a digest, such as sha256:<hex>")
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].OCI.Repository = ""
+	wf.Spec.Templates[0].Inputs.Artifacts[0].OCI.Digest = ""
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.outputs.artifacts.bundle.oci.repository is required")
+}
+
+func TestHTTPArtifact(t *testing.T) {
+	wf := unmarshalWf(`
+metadata:
+  generateName: http-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: report
+        path: /tmp/report.txt
+        http:
+          url: https://nexus.example.com/repository/raw/report.txt
+          method: POST
+          headers:
+          - name: X-Api-Key
+            valueFrom:
+              secretKeyRef:
+                name: nexus
+                key: api-key
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].HTTP.Method = "GET"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.outputs.artifacts.report.http.method 'GET' is not PUT, POST or PATCH")
+
+	wf.Spec.Templates[0].Outputs.Artifacts[0].HTTP.Method = ""
+	wf.Spec.Templates[0].Outputs.Artifacts[0].HTTP.Headers[0].ValueFrom.SecretKeyRef = nil
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.outputs.artifacts.report.http.headers[0].valueFrom.secretKeyRef is required")
+}
+
+func TestGitInputArtifact(t *testing.T) {
+	wf := unmarshalWf(`
+metadata:
+  generateName: git-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      artifacts:
+      - name: repo
+        path: /src
+        git:
+          repo: https://github.com/argoproj/argo-workflows.git
+          filter: blob:none
+          sparseCheckout: [docs, examples]
+          submoduleRecursionDepth: 1
+          lfs:
+            include: ["*.png"]
+    container:
+      image: alpine/git
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf.Spec.Templates[0].Inputs.Artifacts[0].Git.SparseCheckout[1] = "../examples"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.inputs.artifacts.repo.git.sparseCheckout[1] must be a directory in the repository")
+
+	wf.Spec.Templates[0].Inputs.Artifacts[0].Git.SparseCheckout = nil
+	depth := int32(-1)
+	wf.Spec.Templates[0].Inputs.Artifacts[0].Git.SubmoduleRecursionDepth = &depth
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.inputs.artifacts.repo.git.submoduleRecursionDepth must not be negative")
+}
+
+func TestPluginArtifact(t *testing.T) {
+	wf := unmarshalWf(`
+metadata:
+  generateName: plugin-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    inputs:
+      artifacts:
+      - name: data
+        path: /tmp/data
+        plugin:
+          name: blob-store
+          configuration: "bucket: my-bucket"
+          key: data.tgz
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: report
+        path: /tmp/report.txt
+        plugin:
+          name: blob-store
+          key: report.tgz
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	wf.Spec.Templates[0].Inputs.Artifacts[0].Plugin.Name = ""
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err, "a key of the plugin of the archive location")
+
+	wf.Spec.Templates[0].Inputs.Artifacts[0].Plugin.Key = ""
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.inputs.artifacts.data.plugin.name is required")
+
+	wf.Spec.Templates[0].Inputs.Artifacts = nil
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Plugin.Name = "Blob_Store"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "templates.main.outputs.artifacts.report.plugin.name 'Blob_Store' is invalid: ")
+	}
+}